@@ -262,6 +262,23 @@ controllers:
     jitterUpdates: {{ .Values.config.controllers.managedSeed.jitterUpdates }}
     {{- end }}
   {{- end }}
+  {{- if .Values.config.controllers.managedResourceRollout }}
+  managedResourceRollout:
+    {{- if .Values.config.controllers.managedResourceRollout.concurrentSyncs }}
+    concurrentSyncs: {{ .Values.config.controllers.managedResourceRollout.concurrentSyncs }}
+    {{- end }}
+    {{- if .Values.config.controllers.managedResourceRollout.managedResourceNames }}
+    managedResourceNames:
+{{ toYaml .Values.config.controllers.managedResourceRollout.managedResourceNames | indent 4 }}
+    {{- end }}
+    {{- if .Values.config.controllers.managedResourceRollout.waves }}
+    waves:
+{{ toYaml .Values.config.controllers.managedResourceRollout.waves | indent 4 }}
+    {{- end }}
+    {{- if .Values.config.controllers.managedResourceRollout.soakDuration }}
+    soakDuration: {{ .Values.config.controllers.managedResourceRollout.soakDuration }}
+    {{- end }}
+  {{- end }}
   {{- if .Values.config.controllers.networkPolicy }}
   networkPolicy:
     {{- if .Values.config.controllers.networkPolicy.concurrentSyncs }}
@@ -362,6 +379,18 @@ exposureClassHandlers:
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
 {{- end}}
+{{- if .Values.config.helmChartCache }}
+helmChartCache:
+{{ toYaml .Values.config.helmChartCache | indent 2 }}
+{{- end }}
+{{- if .Values.config.secretsManagement }}
+secretsManagement:
+{{ toYaml .Values.config.secretsManagement | indent 2 }}
+{{- end }}
+{{- if .Values.config.nodeAgent }}
+nodeAgent:
+{{ toYaml .Values.config.nodeAgent | indent 2 }}
+{{- end }}
 {{- end -}}
 
 {{- define "gardenlet.config.name" -}}
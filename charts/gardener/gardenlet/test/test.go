@@ -787,6 +787,11 @@ func ComputeExpectedGardenletConfiguration(
 				ConcurrentSyncs:         &five,
 				TokenExpirationDuration: &metav1.Duration{Duration: 6 * time.Hour},
 			},
+			ManagedResourceRollout: &gardenletconfigv1alpha1.ManagedResourceRolloutControllerConfiguration{
+				ConcurrentSyncs: &five,
+				Waves:           []int32{10, 50, 100},
+				SoakDuration:    &metav1.Duration{Duration: 5 * time.Minute},
+			},
 			VPAEvictionRequirements: &gardenletconfigv1alpha1.VPAEvictionRequirementsControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
@@ -879,6 +884,9 @@ func ComputeExpectedGardenletConfiguration(
 			DefaultNotReadyTolerationSeconds:    new(int64(60)),
 			DefaultUnreachableTolerationSeconds: new(int64(60)),
 		},
+		HelmChartCache: &gardenletconfigv1alpha1.HelmChartCacheConfiguration{
+			MaxSize: new(resource.MustParse("256Mi")),
+		},
 	}
 
 	if hasGardenClientConnectionKubeconfig {
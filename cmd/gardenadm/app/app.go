@@ -13,10 +13,13 @@ import (
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/bootstrap"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/connect"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/diagnose"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/discover"
 	initcmd "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/token"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/version"
 )
 
@@ -69,6 +72,9 @@ func prepareClusterBootstrapGroup(cmd *cobra.Command, opts *cmd.Options) {
 		join.NewCommand(opts),
 		bootstrap.NewCommand(opts),
 		token.NewCommand(opts),
+		reset.NewCommand(opts),
+		upgrade.NewCommand(opts),
+		diagnose.NewCommand(opts),
 	} {
 		subcommand.GroupID = group.ID
 		cmd.AddCommand(subcommand)
@@ -46,6 +46,7 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/bootstrappers"
 	"github.com/gardener/gardener/pkg/nodeagent/controller"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/status"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -163,6 +164,31 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *n
 		return err
 	}
 
+	log.Info("Setting up status server")
+	if err := mgr.Add(&manager.Server{
+		Name: "status",
+		Server: &http.Server{
+			Addr: net.JoinHostPort(cfg.Server.Status.BindAddress, strconv.Itoa(cfg.Server.Status.Port)),
+			Handler: &status.Handler{
+				Log:            log.WithName("status"),
+				Client:         mgr.GetClient(),
+				DBus:           dbus.New(log),
+				FS:             fs,
+				HostName:       hostName,
+				NodeName:       nodeName,
+				SecretName:     cfg.Controllers.OperatingSystemConfig.SecretName,
+				HealthCheckers: map[string]healthz.Checker{
+					"ping":          healthz.Ping,
+					"informer-sync": gardenerhealthz.NewCacheSyncHealthzWithDeadline(mgr.GetLogger(), clock.RealClock{}, mgr.GetCache(), gardenerhealthz.DefaultCacheSyncDeadline),
+				},
+			},
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		ShutdownTimeout: new(5 * time.Second),
+	}); err != nil {
+		return fmt.Errorf("failed adding status server to manager: %w", err)
+	}
+
 	log.Info("Creating directory for temporary files", "path", nodeagentconfigv1alpha1.TempDir)
 	if err := fs.MkdirAll(nodeagentconfigv1alpha1.TempDir, os.ModeDir); err != nil {
 		return fmt.Errorf("unable to create directory for temporary files %q: %w", nodeagentconfigv1alpha1.TempDir, err)
@@ -38,6 +38,7 @@ import (
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	"github.com/gardener/gardener/pkg/operator/controller"
 	"github.com/gardener/gardener/pkg/operator/webhook"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // Name is a const for the name of this component.
@@ -89,6 +90,15 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *o
 		}
 	}
 
+	log.Info("Setting up Helm chart cache")
+	helmChartCache, err := oci.NewCache(oci.CacheOptions{
+		Directory:    ptr.Deref(cfg.HelmChartCache.Directory, ""),
+		MaxSizeBytes: cfg.HelmChartCache.MaxSize.Value(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed setting up Helm chart cache: %w", err)
+	}
+
 	log.Info("Setting up manager")
 	mgr, err := manager.New(restConfig, manager.Options{
 		Logger:                  log,
@@ -195,7 +205,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *o
 	}
 
 	log.Info("Adding controllers to manager")
-	if err := controller.AddToManager(cancel, mgr, cfg, gardenClientMap); err != nil {
+	if err := controller.AddToManager(cancel, mgr, cfg, gardenClientMap, helmChartCache); err != nil {
 		return fmt.Errorf("failed adding controllers to manager: %w", err)
 	}
 
@@ -41,6 +41,7 @@ import (
 	controllerconfig "sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/gardener/gardener/cmd/utils/initrun"
@@ -67,12 +68,15 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrap/certificate"
 	"github.com/gardener/gardener/pkg/gardenlet/bootstrappers"
 	"github.com/gardener/gardener/pkg/gardenlet/controller"
+	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	"github.com/gardener/gardener/pkg/utils/retry"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // Name is a const for the name of this component.
@@ -127,6 +131,15 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 		}
 	}
 
+	log.Info("Setting up Helm chart cache")
+	helmChartCache, err := oci.NewCache(oci.CacheOptions{
+		Directory:    ptr.Deref(cfg.HelmChartCache.Directory, ""),
+		MaxSizeBytes: cfg.HelmChartCache.MaxSize.Value(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed setting up Helm chart cache: %w", err)
+	}
+
 	log.Info("Setting up manager")
 	mgr, err := manager.New(runtimeRESTConfig, manager.Options{
 		Logger:                  log,
@@ -183,6 +196,20 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 		return err
 	}
 
+	// The secrets are read via the API reader, i.e., only the secrets managed by secrets managers are listed on demand
+	// instead of caching all secrets of the seed cluster.
+	if cfg.SecretsManagement != nil && cfg.SecretsManagement.InventoryMetrics != nil && ptr.Deref(cfg.SecretsManagement.InventoryMetrics.Enabled, false) {
+		log.Info("Setting up secrets inventory metrics")
+		if err := runtimemetrics.Registry.Register(secretsmanager.NewInventoryCollector(log.WithName("secrets-inventory"), mgr.GetAPIReader(), clock.RealClock{}, gardenletmetrics.Namespace)); err != nil {
+			return fmt.Errorf("failed registering secrets inventory metrics collector: %w", err)
+		}
+	}
+	if cfg.Debugging != nil && ptr.Deref(cfg.Debugging.EnableProfiling, false) {
+		if err := mgr.AddMetricsServerExtraHandler("/debug/secrets-inventory", secretsmanager.NewInventoryHandler(mgr.GetAPIReader(), clock.RealClock{})); err != nil {
+			return fmt.Errorf("failed adding secrets inventory debug endpoint: %w", err)
+		}
+	}
+
 	var selfHostedShootInfo *gardenlet.SelfHostedShootInfo
 	if gardenlet.IsResponsibleForSelfHostedShoot() {
 		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ConfigMapNameShootInfo, Namespace: metav1.NamespaceSystem}}
@@ -219,6 +246,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *g
 					selfHostedShootInfo:       selfHostedShootInfo,
 					healthManager:             healthManager,
 					kubeconfigBootstrapResult: kubeconfigBootstrapResult,
+					helmChartCache:            helmChartCache,
 				},
 			},
 		}
@@ -248,6 +276,7 @@ type garden struct {
 	selfHostedShootInfo       *gardenlet.SelfHostedShootInfo
 	healthManager             gardenerhealthz.Manager
 	kubeconfigBootstrapResult *bootstrappers.KubeconfigBootstrapResult
+	helmChartCache            oci.Cache
 }
 
 func (g *garden) Start(ctx context.Context) error {
@@ -605,6 +634,7 @@ func (g *garden) Start(ctx context.Context) error {
 		g.config,
 		g.healthManager,
 		shoot,
+		g.helmChartCache,
 	); err != nil {
 		return fmt.Errorf("failed adding controllers to manager: %w", err)
 	}
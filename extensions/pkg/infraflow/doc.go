@@ -0,0 +1,13 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package infraflow provides a library for provider extensions to reconcile infrastructure in-process instead of
+// running Terraform in separate Terraformer pods (see package terraformer).
+// The infrastructure reconciliation is expressed as typed, idempotent steps which are executed as a flow (see package
+// github.com/gardener/gardener/pkg/utils/flow). The steps share a Whiteboard holding the identifiers of the created
+// infrastructure resources, which is persisted as State in the `.status.state` field of the Infrastructure resource
+// whenever a step changed it. Infrastructures which were previously reconciled with Terraform can be migrated by
+// importing the Terraform state with ImportTerraformState and cleaning up the Terraformer resources afterwards with
+// TerraformerCleanupStep.
+package infraflow
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// persistTimeout is the timeout for persisting the state after a step. The state is also persisted if the context of
+// the step is already canceled, e.g., because the step timed out, so that identifiers of created resources don't get
+// lost.
+const persistTimeout = 30 * time.Second
+
+// StepFn reconciles or deletes a part of the infrastructure. It must be idempotent, i.e., it must neither fail nor
+// create duplicate resources if it is run again after it (partially) succeeded before. Hence, it should store the
+// identifiers of created resources in the whiteboard as soon as they are known and remove them from the whiteboard as
+// soon as the resources have been deleted.
+type StepFn func(ctx context.Context, whiteboard *Whiteboard) error
+
+// Step is a step of an infrastructure flow.
+type Step struct {
+	// Name is the name of the step. It must be unique within the graph.
+	Name string
+	// Fn is the function of the step.
+	Fn StepFn
+	// SkipIf specifies whether the step is skipped.
+	SkipIf bool
+	// Dependencies are the IDs of the steps which must succeed before this step is started.
+	Dependencies flow.TaskIDs
+	// Timeout is the maximum duration of a single attempt of Fn. If it is zero, no timeout is applied.
+	Timeout time.Duration
+	// RetryPolicy defines how Fn is retried if it fails. If it is nil, Fn is not retried.
+	RetryPolicy *flow.RetryPolicy
+}
+
+// PersistFunc persists the given state.
+type PersistFunc func(ctx context.Context, state *State) error
+
+// Graph is a builder for an infrastructure flow. Its steps operate on a shared Whiteboard, which is persisted after
+// every step which changed it - also if the step failed.
+type Graph struct {
+	graph      *flow.Graph
+	whiteboard *Whiteboard
+	persist    PersistFunc
+
+	mu        sync.Mutex
+	persisted uint64
+}
+
+// NewGraph returns a new Graph with the given name whose steps operate on the given whiteboard. The data of the
+// whiteboard is persisted with the given function. If it is nil, the data is not persisted.
+func NewGraph(name string, whiteboard *Whiteboard, persist PersistFunc) *Graph {
+	_, revision := whiteboard.snapshot()
+
+	return &Graph{
+		graph:      flow.NewGraph(name),
+		whiteboard: whiteboard,
+		persist:    persist,
+		persisted:  revision,
+	}
+}
+
+// Add adds the given step to the graph and returns its ID which can be used as dependency of other steps.
+// Like flow.Graph.Add, this panics if a step with the same name already exists or if a dependency is not present.
+func (g *Graph) Add(step Step) flow.TaskID {
+	return g.graph.Add(flow.Task{
+		Name: step.Name,
+		Fn: func(ctx context.Context) error {
+			err := step.Fn(ctx, g.whiteboard)
+			if persistErr := g.persistIfChanged(ctx); persistErr != nil {
+				return errors.Join(err, persistErr)
+			}
+			return err
+		},
+		SkipIf:       step.SkipIf,
+		Dependencies: step.Dependencies,
+		Timeout:      step.Timeout,
+		RetryPolicy:  step.RetryPolicy,
+	})
+}
+
+// Run compiles the graph and runs the resulting flow with the given options.
+func (g *Graph) Run(ctx context.Context, opts flow.Opts) error {
+	return g.graph.Compile().Run(ctx, opts)
+}
+
+// persistIfChanged persists the data of the whiteboard if it changed since it was persisted the last time. Calls are
+// serialized, so that concurrent steps don't persist outdated data.
+func (g *Graph) persistIfChanged(ctx context.Context) error {
+	if g.persist == nil {
+		return nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	state, revision := g.whiteboard.snapshot()
+	if revision == g.persisted {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), persistTimeout)
+	defer cancel()
+
+	if err := g.persist(ctx, state); err != nil {
+		return fmt.Errorf("failed persisting infrastructure state: %w", err)
+	}
+	g.persisted = revision
+	return nil
+}
+
+// InfrastructureStatePersister returns a PersistFunc which stores the state in the `.status.state` field of the given
+// Infrastructure.
+func InfrastructureStatePersister(c client.Client, infrastructure *extensionsv1alpha1.Infrastructure) PersistFunc {
+	return func(ctx context.Context, state *State) error {
+		raw, err := state.Encode()
+		if err != nil {
+			return err
+		}
+
+		patch := client.MergeFrom(infrastructure.DeepCopy())
+		infrastructure.Status.State = raw
+		return c.Status().Patch(ctx, infrastructure, patch)
+	}
+}
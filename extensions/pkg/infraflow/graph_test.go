@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/infraflow"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Graph", func() {
+	var (
+		ctx     = context.Background()
+		fakeErr = errors.New("fake")
+
+		whiteboard *Whiteboard
+
+		mu        sync.Mutex
+		persisted []*State
+		persist   PersistFunc
+	)
+
+	BeforeEach(func() {
+		whiteboard = NewWhiteboard(NewState(map[string]string{"vpc": "vpc-1"}))
+
+		persisted = nil
+		persist = func(_ context.Context, state *State) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			persisted = append(persisted, state)
+			return nil
+		}
+	})
+
+	It("should run the steps in order and persist the state after steps which changed it", func() {
+		g := NewGraph("test", whiteboard, persist)
+
+		ensureVPC := g.Add(Step{
+			Name: "Ensuring VPC",
+			Fn: func(_ context.Context, whiteboard *Whiteboard) error {
+				// The VPC already exists, hence, the state does not change.
+				whiteboard.Set("vpc", "vpc-1")
+				return nil
+			},
+		})
+		ensureSubnet := g.Add(Step{
+			Name: "Ensuring subnet",
+			Fn: func(_ context.Context, whiteboard *Whiteboard) error {
+				whiteboard.Set("subnet", "subnet-in-"+whiteboard.Get("vpc"))
+				return nil
+			},
+			Dependencies: flow.NewTaskIDs(ensureVPC),
+		})
+		g.Add(Step{
+			Name: "Ensuring route table",
+			Fn: func(_ context.Context, whiteboard *Whiteboard) error {
+				whiteboard.Set("routeTable", "rt-for-"+whiteboard.Get("subnet"))
+				return nil
+			},
+			Dependencies: flow.NewTaskIDs(ensureSubnet),
+		})
+
+		Expect(g.Run(ctx, flow.Opts{})).To(Succeed())
+
+		Expect(persisted).To(Equal([]*State{
+			NewState(map[string]string{"vpc": "vpc-1", "subnet": "subnet-in-vpc-1"}),
+			NewState(map[string]string{"vpc": "vpc-1", "subnet": "subnet-in-vpc-1", "routeTable": "rt-for-subnet-in-vpc-1"}),
+		}))
+	})
+
+	It("should persist the state if a step failed after it changed the state", func() {
+		g := NewGraph("test", whiteboard, persist)
+
+		g.Add(Step{
+			Name: "Ensuring subnet",
+			Fn: func(_ context.Context, whiteboard *Whiteboard) error {
+				whiteboard.Set("subnet", "subnet-1")
+				return fakeErr
+			},
+		})
+
+		Expect(g.Run(ctx, flow.Opts{})).To(MatchError(ContainSubstring("fake")))
+		Expect(persisted).To(Equal([]*State{NewState(map[string]string{"vpc": "vpc-1", "subnet": "subnet-1"})}))
+	})
+
+	It("should persist the state even if the context of the step is canceled", func() {
+		g := NewGraph("test", whiteboard, func(ctx context.Context, state *State) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return persist(ctx, state)
+		})
+
+		g.Add(Step{
+			Name: "Ensuring subnet",
+			Fn: func(ctx context.Context, whiteboard *Whiteboard) error {
+				whiteboard.Set("subnet", "subnet-1")
+				return ctx.Err()
+			},
+			Timeout: 1,
+		})
+
+		Expect(g.Run(ctx, flow.Opts{})).To(HaveOccurred())
+		Expect(persisted).To(Equal([]*State{NewState(map[string]string{"vpc": "vpc-1", "subnet": "subnet-1"})}))
+	})
+
+	It("should fail the step if the state cannot be persisted", func() {
+		g := NewGraph("test", whiteboard, func(context.Context, *State) error { return fakeErr })
+
+		var ran bool
+		ensureSubnet := g.Add(Step{
+			Name: "Ensuring subnet",
+			Fn: func(_ context.Context, whiteboard *Whiteboard) error {
+				whiteboard.Set("subnet", "subnet-1")
+				return nil
+			},
+		})
+		g.Add(Step{
+			Name: "Ensuring route table",
+			Fn: func(context.Context, *Whiteboard) error {
+				ran = true
+				return nil
+			},
+			Dependencies: flow.NewTaskIDs(ensureSubnet),
+		})
+
+		Expect(g.Run(ctx, flow.Opts{})).To(MatchError(ContainSubstring("failed persisting infrastructure state: fake")))
+		Expect(ran).To(BeFalse())
+	})
+
+	It("should not persist the state if no persist function is given", func() {
+		g := NewGraph("test", whiteboard, nil)
+
+		g.Add(Step{
+			Name: "Ensuring subnet",
+			Fn: func(_ context.Context, whiteboard *Whiteboard) error {
+				whiteboard.Set("subnet", "subnet-1")
+				return nil
+			},
+		})
+
+		Expect(g.Run(ctx, flow.Opts{})).To(Succeed())
+		Expect(whiteboard.Get("subnet")).To(Equal("subnet-1"))
+	})
+
+	It("should skip steps", func() {
+		g := NewGraph("test", whiteboard, persist)
+
+		g.Add(Step{
+			Name: "Ensuring subnet",
+			Fn: func(context.Context, *Whiteboard) error {
+				return fakeErr
+			},
+			SkipIf: true,
+		})
+
+		Expect(g.Run(ctx, flow.Opts{})).To(Succeed())
+		Expect(persisted).To(BeEmpty())
+	})
+
+	Describe("#InfrastructureStatePersister", func() {
+		It("should persist the state in the status of the Infrastructure", func() {
+			scheme := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			infrastructure := &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"}}
+			c := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(infrastructure).WithStatusSubresource(infrastructure).Build()
+
+			Expect(InfrastructureStatePersister(c, infrastructure)(ctx, NewState(map[string]string{"vpc": "vpc-1"}))).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(infrastructure), infrastructure)).To(Succeed())
+			Expect(LoadState(infrastructure, nil)).To(Equal(NewState(map[string]string{"vpc": "vpc-1"})))
+		})
+	})
+})
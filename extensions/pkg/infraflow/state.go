@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// StateAPIVersion is the API version of the State persisted in the status of Infrastructure resources.
+	StateAPIVersion = "infraflow.extensions.gardener.cloud/v1alpha1"
+	// StateKind is the kind of the State persisted in the status of Infrastructure resources.
+	StateKind = "InfrastructureState"
+)
+
+// State is the state of an infrastructure which is persisted in the `.status.state` field of the Infrastructure resource.
+type State struct {
+	metav1.TypeMeta `json:",inline"`
+	// Data contains the identifiers of the infrastructure resources and other information which is needed to reconcile
+	// or delete them, e.g., the ID of a network.
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// NewState returns a new State with the given data.
+func NewState(data map[string]string) *State {
+	return &State{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: StateAPIVersion,
+			Kind:       StateKind,
+		},
+		Data: data,
+	}
+}
+
+// Encode encodes the state so that it can be stored in the `.status.state` field of the Infrastructure resource.
+func (s *State) Encode() (*runtime.RawExtension, error) {
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding infrastructure state: %w", err)
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+// IsState returns true if the given raw state is a State, in contrast to, e.g., a Terraform state.
+func IsState(raw *runtime.RawExtension) bool {
+	if raw == nil || len(raw.Raw) == 0 {
+		return false
+	}
+
+	typeMeta := &metav1.TypeMeta{}
+	if err := json.Unmarshal(raw.Raw, typeMeta); err != nil {
+		return false
+	}
+	return typeMeta.APIVersion == StateAPIVersion && typeMeta.Kind == StateKind
+}
+
+// DecodeState decodes the given raw state. It returns an empty State if the raw state is empty.
+func DecodeState(raw *runtime.RawExtension) (*State, error) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return NewState(nil), nil
+	}
+
+	if !IsState(raw) {
+		return nil, fmt.Errorf("infrastructure state is not of kind %s in version %s", StateKind, StateAPIVersion)
+	}
+
+	state := &State{}
+	if err := json.Unmarshal(raw.Raw, state); err != nil {
+		return nil, fmt.Errorf("failed decoding infrastructure state: %w", err)
+	}
+	return state, nil
+}
+
+// LoadState returns the State persisted in the status of the given Infrastructure. If the status still contains the
+// state of a previous reconciliation with Terraform and a TerraformImport is given, the Terraform state is imported.
+func LoadState(infrastructure *extensionsv1alpha1.Infrastructure, terraformImport *TerraformImport) (*State, error) {
+	raw := infrastructure.Status.State
+	if terraformImport != nil && raw != nil && len(raw.Raw) > 0 && !IsState(raw) {
+		return ImportTerraformState(raw.Raw, *terraformImport)
+	}
+	return DecodeState(raw)
+}
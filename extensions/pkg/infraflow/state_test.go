@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	. "github.com/gardener/gardener/extensions/pkg/infraflow"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("State", func() {
+	Describe("#Encode", func() {
+		It("should encode the state", func() {
+			raw, err := NewState(map[string]string{"vpc": "vpc-1"}).Encode()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(raw.Raw).To(MatchJSON(`{"apiVersion":"infraflow.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureState","data":{"vpc":"vpc-1"}}`))
+		})
+	})
+
+	Describe("#IsState", func() {
+		It("should return true for a state", func() {
+			raw, err := NewState(nil).Encode()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(IsState(raw)).To(BeTrue())
+		})
+
+		It("should return false for other states", func() {
+			Expect(IsState(nil)).To(BeFalse())
+			Expect(IsState(&runtime.RawExtension{})).To(BeFalse())
+			Expect(IsState(&runtime.RawExtension{Raw: []byte(`{"data":"","encoding":"none"}`)})).To(BeFalse())
+			Expect(IsState(&runtime.RawExtension{Raw: []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureState"}`)})).To(BeFalse())
+			Expect(IsState(&runtime.RawExtension{Raw: []byte(`foo`)})).To(BeFalse())
+		})
+	})
+
+	Describe("#DecodeState", func() {
+		It("should return an empty state if the raw state is empty", func() {
+			Expect(DecodeState(nil)).To(Equal(NewState(nil)))
+			Expect(DecodeState(&runtime.RawExtension{})).To(Equal(NewState(nil)))
+		})
+
+		It("should decode the state", func() {
+			raw, err := NewState(map[string]string{"vpc": "vpc-1"}).Encode()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(DecodeState(raw)).To(Equal(NewState(map[string]string{"vpc": "vpc-1"})))
+		})
+
+		It("should fail if the raw state is no state", func() {
+			_, err := DecodeState(&runtime.RawExtension{Raw: []byte(`{"data":"","encoding":"none"}`)})
+			Expect(err).To(MatchError("infrastructure state is not of kind InfrastructureState in version infraflow.extensions.gardener.cloud/v1alpha1"))
+		})
+	})
+
+	Describe("#LoadState", func() {
+		var infrastructure *extensionsv1alpha1.Infrastructure
+
+		BeforeEach(func() {
+			infrastructure = &extensionsv1alpha1.Infrastructure{}
+		})
+
+		It("should return an empty state if the status does not contain a state", func() {
+			Expect(LoadState(infrastructure, &TerraformImport{})).To(Equal(NewState(nil)))
+		})
+
+		It("should return the persisted state", func() {
+			raw, err := NewState(map[string]string{"vpc": "vpc-1"}).Encode()
+			Expect(err).NotTo(HaveOccurred())
+			infrastructure.Status.State = raw
+
+			Expect(LoadState(infrastructure, &TerraformImport{Resources: map[string]string{"vpc": "aws_vpc.vpc"}})).To(Equal(NewState(map[string]string{"vpc": "vpc-1"})))
+		})
+
+		It("should import the Terraform state", func() {
+			infrastructure.Status.State = &runtime.RawExtension{Raw: []byte(`{"data":"{\"version\":4,\"outputs\":{\"vpc_id\":{\"value\":\"vpc-1\"}}}","encoding":"none"}`)}
+
+			Expect(LoadState(infrastructure, &TerraformImport{Outputs: map[string]string{"vpc": "vpc_id"}})).To(Equal(NewState(map[string]string{"vpc": "vpc-1"})))
+		})
+
+		It("should fail for a Terraform state if no import is given", func() {
+			infrastructure.Status.State = &runtime.RawExtension{Raw: []byte(`{"data":"","encoding":"none"}`)}
+
+			_, err := LoadState(infrastructure, nil)
+			Expect(err).To(MatchError(ContainSubstring("infrastructure state is not of kind InfrastructureState")))
+		})
+	})
+})
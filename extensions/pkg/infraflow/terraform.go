@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/extensions/pkg/terraformer"
+)
+
+// TerraformImport defines which information of a Terraform state is imported into a State.
+type TerraformImport struct {
+	// Resources maps keys of the State to addresses of resource instances in the Terraform state, e.g., `aws_vpc.vpc`,
+	// `aws_subnet.nodes[0]`, `data.aws_vpc.default` or `module.zone["a"].aws_subnet.nodes`. The `id` attribute of the
+	// resource instance is imported.
+	Resources map[string]string
+	// Outputs maps keys of the State to names of output values in the Terraform state. String values are imported as
+	// they are, all other values (numbers, booleans, lists, maps, and objects) are imported JSON-encoded.
+	Outputs map[string]string
+}
+
+// terraformState contains the relevant fields of a Terraform state in format version 4.
+type terraformState struct {
+	Version *int `json:"version"`
+	Outputs map[string]struct {
+		Value any `json:"value"`
+	} `json:"outputs"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   any            `json:"index_key"`
+			Attributes map[string]any `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// ImportTerraformState imports the given Terraform state into a new State. The Terraform state is expected in the format
+// stored by the terraformer package in the `.status.state` field of Infrastructure resources (see
+// terraformer.UnmarshalRawState). Only format version 4 of Terraform states is supported. Resources and outputs which
+// are not contained in the Terraform state are skipped, as they might not have been created yet.
+func ImportTerraformState(rawState []byte, terraformImport TerraformImport) (*State, error) {
+	tfRawState, err := terraformer.UnmarshalRawState(rawState)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding Terraform raw state: %w", err)
+	}
+
+	state := NewState(make(map[string]string))
+	if len(tfRawState.Data) == 0 {
+		return state, nil
+	}
+
+	tfState := &terraformState{}
+	if err := json.Unmarshal([]byte(tfRawState.Data), tfState); err != nil {
+		return nil, fmt.Errorf("failed decoding Terraform state: %w", err)
+	}
+	if tfState.Version == nil || *tfState.Version != 4 {
+		return nil, fmt.Errorf("the Terraform state uses format version %s, only version 4 is supported", formatVersion(tfState.Version))
+	}
+
+	ids := make(map[string]string)
+	for _, resource := range tfState.Resources {
+		address := resource.Type + "." + resource.Name
+		if resource.Mode == "data" {
+			address = "data." + address
+		}
+		if resource.Module != "" {
+			address = resource.Module + "." + address
+		}
+
+		for _, instance := range resource.Instances {
+			id, ok := instance.Attributes["id"]
+			if !ok || id == nil {
+				continue
+			}
+			ids[address+formatIndexKey(instance.IndexKey)] = fmt.Sprint(id)
+		}
+	}
+
+	for key, address := range terraformImport.Resources {
+		if id, ok := ids[address]; ok {
+			state.Data[key] = id
+		}
+	}
+
+	for key, name := range terraformImport.Outputs {
+		output, ok := tfState.Outputs[name]
+		if !ok || output.Value == nil {
+			continue
+		}
+
+		value, err := formatOutputValue(output.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed encoding Terraform output %q: %w", name, err)
+		}
+		state.Data[key] = value
+	}
+
+	return state, nil
+}
+
+// TerraformerCleanupStep returns a step which deletes the ConfigMaps and the Secret in which the terraformer package
+// stored the configuration, state, and variables of the Terraform run with the given name and purpose in the given
+// namespace. It should be added to the graph once the State was imported from a Terraform state (see LoadState), as
+// these resources are not needed anymore. The imported Terraform state itself remains in the `.status.state` field of
+// the Infrastructure until the State is persisted.
+func TerraformerCleanupStep(log logr.Logger, c client.Client, namespace, name, purpose string) Step {
+	return Step{
+		Name: "Cleaning up Terraformer resources",
+		Fn: func(ctx context.Context, _ *Whiteboard) error {
+			tf := terraformer.New(log, c, nil, purpose, namespace, name, "")
+			if err := tf.RemoveTerraformerFinalizerFromConfig(ctx); err != nil {
+				return err
+			}
+			return tf.CleanupConfiguration(ctx)
+		},
+	}
+}
+
+// formatOutputValue returns string output values as they are and JSON-encodes all other values so that they can be
+// decoded again, e.g., `["subnet-0","subnet-1"]` for lists.
+func formatOutputValue(value any) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// formatIndexKey returns the index of a resource instance in the address format, i.e., `[0]` for resources using
+// `count` and `["key"]` for resources using `for_each`.
+func formatIndexKey(indexKey any) string {
+	switch key := indexKey.(type) {
+	case float64:
+		return "[" + strconv.FormatFloat(key, 'f', -1, 64) + "]"
+	case string:
+		return "[" + strconv.Quote(key) + "]"
+	default:
+		return ""
+	}
+}
+
+func formatVersion(version *int) string {
+	if version == nil {
+		return "<unknown>"
+	}
+	return strconv.Itoa(*version)
+}
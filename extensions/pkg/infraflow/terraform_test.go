@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/infraflow"
+	"github.com/gardener/gardener/extensions/pkg/terraformer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Terraform", func() {
+	Describe("#ImportTerraformState", func() {
+		var (
+			terraformState = `{
+  "version": 4,
+  "outputs": {
+    "vpc_id": {"value": "vpc-1", "type": "string"},
+    "nat_gateways": {"value": 2, "type": "number"},
+    "subnet_ids": {"value": ["subnet-0", "subnet-1"], "type": ["list", "string"]},
+    "zones": {"value": {"a": {"cidr": "10.0.0.0/24", "public": true}}, "type": ["map", ["object", {"cidr": "string", "public": "bool"}]]}
+  },
+  "resources": [
+    {"mode": "managed", "type": "aws_vpc", "name": "vpc", "instances": [{"attributes": {"id": "vpc-1", "cidr_block": "10.0.0.0/16"}}]},
+    {"mode": "data", "type": "aws_vpc", "name": "default", "instances": [{"attributes": {"id": "vpc-default"}}]},
+    {"mode": "managed", "type": "aws_subnet", "name": "nodes", "instances": [
+      {"index_key": 0, "attributes": {"id": "subnet-0"}},
+      {"index_key": 1, "attributes": {"id": "subnet-1"}}
+    ]},
+    {"module": "module.zone[\"a\"]", "mode": "managed", "type": "aws_route_table", "name": "private", "instances": [{"attributes": {"id": "rt-a"}}]},
+    {"mode": "managed", "type": "aws_eip", "name": "nat", "instances": [{"index_key": "zone-a", "attributes": {"id": "eip-a"}}]}
+  ]
+}`
+
+			terraformImport = TerraformImport{
+				Resources: map[string]string{
+					"vpc":               "aws_vpc.vpc",
+					"defaultVPC":        "data.aws_vpc.default",
+					"subnet/0":          "aws_subnet.nodes[0]",
+					"subnet/1":          "aws_subnet.nodes[1]",
+					"routeTable/a":      "module.zone[\"a\"].aws_route_table.private",
+					"eip/zone-a":        `aws_eip.nat["zone-a"]`,
+					"internetGateway":   "aws_internet_gateway.igw",
+					"securityGroup/ssh": "aws_security_group.ssh",
+				},
+				Outputs: map[string]string{
+					"vpcID":       "vpc_id",
+					"natGateways": "nat_gateways",
+					"subnetIDs":   "subnet_ids",
+					"zones":       "zones",
+					"missing":     "missing",
+				},
+			}
+
+			expectedState = NewState(map[string]string{
+				"vpc":          "vpc-1",
+				"defaultVPC":   "vpc-default",
+				"subnet/0":     "subnet-0",
+				"subnet/1":     "subnet-1",
+				"routeTable/a": "rt-a",
+				"eip/zone-a":   "eip-a",
+				"vpcID":        "vpc-1",
+				"natGateways":  "2",
+				"subnetIDs":    `["subnet-0","subnet-1"]`,
+				"zones":        `{"a":{"cidr":"10.0.0.0/24","public":true}}`,
+			})
+		)
+
+		It("should import a base64-encoded raw state", func() {
+			rawState := `{"data":"` + base64.StdEncoding.EncodeToString([]byte(terraformState)) + `","encoding":"base64"}`
+
+			Expect(ImportTerraformState([]byte(rawState), terraformImport)).To(Equal(expectedState))
+		})
+
+		It("should import a raw state without encoding", func() {
+			rawState := `{"data":` + quote(terraformState) + `,"encoding":"none"}`
+
+			Expect(ImportTerraformState([]byte(rawState), terraformImport)).To(Equal(expectedState))
+		})
+
+		It("should return an empty state if the Terraform state is empty", func() {
+			Expect(ImportTerraformState(nil, terraformImport)).To(Equal(NewState(map[string]string{})))
+			Expect(ImportTerraformState([]byte(`{"data":"","encoding":"none"}`), terraformImport)).To(Equal(NewState(map[string]string{})))
+		})
+
+		It("should fail for unsupported Terraform state versions", func() {
+			_, err := ImportTerraformState([]byte(`{"data":`+quote(`{"version":3,"modules":[]}`)+`,"encoding":"none"}`), terraformImport)
+			Expect(err).To(MatchError("the Terraform state uses format version 3, only version 4 is supported"))
+		})
+
+		It("should fail for unsupported encodings", func() {
+			_, err := ImportTerraformState([]byte(`{"data":"","encoding":"gzip"}`), terraformImport)
+			Expect(err).To(MatchError(`failed decoding Terraform raw state: unrecognised encoding "gzip" for RawState.Data`))
+		})
+
+		It("should fail for invalid base64 data", func() {
+			_, err := ImportTerraformState([]byte(`{"data":"%%%","encoding":"base64"}`), terraformImport)
+			Expect(err).To(MatchError(ContainSubstring("failed decoding Terraform raw state")))
+		})
+	})
+
+	Describe("#TerraformerCleanupStep", func() {
+		It("should delete the Terraformer resources", func() {
+			var (
+				ctx       = context.Background()
+				namespace = "shoot--foo--bar"
+				objects   = []client.Object{
+					&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar.infra" + terraformer.ConfigSuffix, Finalizers: []string{terraformer.TerraformerFinalizer}}},
+					&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar.infra" + terraformer.StateSuffix, Finalizers: []string{terraformer.TerraformerFinalizer}}},
+					&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar.infra" + terraformer.VariablesSuffix, Finalizers: []string{terraformer.TerraformerFinalizer}}},
+				}
+				otherConfigMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "bar.other" + terraformer.ConfigSuffix}}
+				c              = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(append(objects, otherConfigMap)...).Build()
+			)
+
+			step := TerraformerCleanupStep(logr.Discard(), c, namespace, "bar", "infra")
+			Expect(step.Fn(ctx, NewWhiteboard(nil))).To(Succeed())
+
+			for _, obj := range objects {
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(BeNotFoundError())
+			}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(otherConfigMap), otherConfigMap)).To(Succeed())
+		})
+
+		It("should succeed if the Terraformer resources do not exist", func() {
+			c := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+			step := TerraformerCleanupStep(logr.Discard(), c, "shoot--foo--bar", "bar", "infra")
+			Expect(step.Fn(context.Background(), NewWhiteboard(nil))).To(Succeed())
+		})
+	})
+})
+
+func quote(s string) string {
+	b, err := json.Marshal(s)
+	Expect(err).NotTo(HaveOccurred())
+	return string(b)
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Whiteboard holds the data of the State while the steps of an infrastructure flow are executed. It is safe for
+// concurrent use by the steps. In addition to the persisted data, it can hold arbitrary objects which are only kept in
+// memory, e.g., to pass infrastructure resources which have been read by one step to subsequent steps.
+type Whiteboard struct {
+	mu      sync.RWMutex
+	data    map[string]string
+	objects map[string]any
+	// revision is increased whenever the data changes, so that unchanged data is not persisted again.
+	revision uint64
+}
+
+// NewWhiteboard returns a new Whiteboard initialized with the data of the given state.
+func NewWhiteboard(state *State) *Whiteboard {
+	w := &Whiteboard{
+		data:    make(map[string]string),
+		objects: make(map[string]any),
+	}
+	if state != nil {
+		maps.Copy(w.data, state.Data)
+	}
+	return w
+}
+
+// Get returns the value for the given key. It returns an empty string if the key is not set.
+func (w *Whiteboard) Get(key string) string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return w.data[key]
+}
+
+// Has returns true if the given key is set.
+func (w *Whiteboard) Has(key string) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	_, ok := w.data[key]
+	return ok
+}
+
+// Keys returns the sorted keys with the given prefix, e.g., to find the identifiers of all resources of a kind.
+func (w *Whiteboard) Keys(prefix string) []string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	var keys []string
+	for key := range w.data {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Set sets the value for the given key. Steps should call it as soon as the identifier of a created resource is known.
+func (w *Whiteboard) Set(key, value string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if oldValue, ok := w.data[key]; ok && oldValue == value {
+		return
+	}
+	w.data[key] = value
+	w.revision++
+}
+
+// Delete removes the given key. Steps should call it as soon as the respective resource has been deleted.
+func (w *Whiteboard) Delete(key string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, ok := w.data[key]; !ok {
+		return
+	}
+	delete(w.data, key)
+	w.revision++
+}
+
+// GetObject returns the in-memory object for the given key. It returns nil if the key is not set.
+func (w *Whiteboard) GetObject(key string) any {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return w.objects[key]
+}
+
+// SetObject sets the in-memory object for the given key. Objects are not persisted. If the object is nil, the key is
+// removed.
+func (w *Whiteboard) SetObject(key string, obj any) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if obj == nil {
+		delete(w.objects, key)
+		return
+	}
+	w.objects[key] = obj
+}
+
+// State returns a new State containing the current data of the whiteboard.
+func (w *Whiteboard) State() *State {
+	state, _ := w.snapshot()
+	return state
+}
+
+func (w *Whiteboard) snapshot() (*State, uint64) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return NewState(maps.Clone(w.data)), w.revision
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/extensions/pkg/infraflow"
+)
+
+var _ = Describe("Whiteboard", func() {
+	var whiteboard *Whiteboard
+
+	BeforeEach(func() {
+		whiteboard = NewWhiteboard(NewState(map[string]string{"vpc": "vpc-1"}))
+	})
+
+	It("should return the data of the state", func() {
+		Expect(whiteboard.Has("vpc")).To(BeTrue())
+		Expect(whiteboard.Get("vpc")).To(Equal("vpc-1"))
+		Expect(whiteboard.Has("subnet")).To(BeFalse())
+		Expect(whiteboard.Get("subnet")).To(BeEmpty())
+	})
+
+	It("should set and delete data", func() {
+		whiteboard.Set("subnet/zone-a", "subnet-a")
+		whiteboard.Set("subnet/zone-b", "subnet-b")
+		whiteboard.Delete("vpc")
+
+		Expect(whiteboard.Keys("subnet/")).To(Equal([]string{"subnet/zone-a", "subnet/zone-b"}))
+		Expect(whiteboard.State()).To(Equal(NewState(map[string]string{"subnet/zone-a": "subnet-a", "subnet/zone-b": "subnet-b"})))
+	})
+
+	It("should not modify the given state", func() {
+		state := NewState(map[string]string{"vpc": "vpc-1"})
+		whiteboard = NewWhiteboard(state)
+
+		whiteboard.Set("vpc", "vpc-2")
+
+		Expect(state.Data).To(HaveKeyWithValue("vpc", "vpc-1"))
+	})
+
+	It("should keep objects in memory only", func() {
+		whiteboard.SetObject("vpc", struct{ ID string }{ID: "vpc-1"})
+		Expect(whiteboard.GetObject("vpc")).To(Equal(struct{ ID string }{ID: "vpc-1"}))
+		Expect(whiteboard.State()).To(Equal(NewState(map[string]string{"vpc": "vpc-1"})))
+
+		whiteboard.SetObject("vpc", nil)
+		Expect(whiteboard.GetObject("vpc")).To(BeNil())
+	})
+})
@@ -6,7 +6,7 @@
 // on seed clusters to execute Terraform scripts for infrastructure reconciliation.
 //
 // Deprecated: This package is deprecated and will be removed after v1.154 has been released.
-// Instead, consider using plain SDK calls for infrastructure reconciliation.
+// Instead, consider using plain SDK calls for infrastructure reconciliation, e.g., with the infraflow package.
 //
 // TODO(kon-angelo): Remove this package after v1.154 has been released.
 package terraformer
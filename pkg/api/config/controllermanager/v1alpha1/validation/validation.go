@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	schedulervalidation "github.com/gardener/gardener/pkg/api/config/scheduler/v1alpha1/validation"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
@@ -51,6 +52,11 @@ func validateControllerManagerControllerConfiguration(conf controllermanagerconf
 		allErrs = append(allErrs, validateShootStateControllerConfiguration(conf.ShootState, shootStateFldPath)...)
 	}
 
+	shootRebalancingFldPath := fldPath.Child("shootRebalancing")
+	if conf.ShootRebalancing != nil {
+		allErrs = append(allErrs, validateShootRebalancingControllerConfiguration(conf.ShootRebalancing, shootRebalancingFldPath)...)
+	}
+
 	return allErrs
 }
 
@@ -77,3 +83,30 @@ func validateShootStateControllerConfiguration(conf *controllermanagerconfigv1al
 	}
 	return allErrs
 }
+
+var availableShootRebalancingPolicies = sets.New(
+	controllermanagerconfigv1alpha1.ShootRebalancingPolicyRecommend,
+	controllermanagerconfigv1alpha1.ShootRebalancingPolicyMigrate,
+)
+
+func validateShootRebalancingControllerConfiguration(conf *controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*conf.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod.Duration.String(), "must be positive"))
+	}
+	if conf.Policy != nil && !availableShootRebalancingPolicies.Has(*conf.Policy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policy"), *conf.Policy, sets.List(availableShootRebalancingPolicies)))
+	}
+	if conf.MinShootCountDifference != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*conf.MinShootCountDifference), fldPath.Child("minShootCountDifference"))...)
+	}
+
+	allErrs = append(allErrs, schedulervalidation.ValidateStrategy(conf.Strategy, fldPath.Child("strategy"))...)
+	allErrs = append(allErrs, schedulervalidation.ValidateScoring(conf.Scoring, fldPath.Child("scoring"))...)
+
+	return allErrs
+}
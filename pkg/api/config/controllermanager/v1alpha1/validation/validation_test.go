@@ -15,6 +15,7 @@ import (
 
 	. "github.com/gardener/gardener/pkg/api/config/controllermanager/v1alpha1/validation"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 )
 
 var _ = Describe("#ValidateControllerManagerConfiguration", func() {
@@ -192,4 +193,62 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 			})
 		})
 	})
+
+	Context("ShootRebalancingControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.ShootRebalancing = &controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration{}
+			controllermanagerconfigv1alpha1.SetObjectDefaults_ControllerManagerConfiguration(conf)
+		})
+
+		It("should allow the default configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should allow the Scored strategy with valid score plugins", func() {
+			conf.Controllers.ShootRebalancing.Strategy = schedulerconfigv1alpha1.Scored
+			conf.Controllers.ShootRebalancing.Scoring = &schedulerconfigv1alpha1.ScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{{Name: schedulerconfigv1alpha1.ScorePluginSeedAge, Weight: 1}},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should return errors because some values are invalid", func() {
+			conf.Controllers.ShootRebalancing.ConcurrentSyncs = new(-1)
+			conf.Controllers.ShootRebalancing.SyncPeriod = &metav1.Duration{}
+			conf.Controllers.ShootRebalancing.Policy = new(controllermanagerconfigv1alpha1.ShootRebalancingPolicy("Foo"))
+			conf.Controllers.ShootRebalancing.MinShootCountDifference = new(int32(-1))
+			conf.Controllers.ShootRebalancing.Strategy = "Foo"
+			conf.Controllers.ShootRebalancing.Scoring = &schedulerconfigv1alpha1.ScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{{Name: "Foo", Weight: 1}},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootRebalancing.concurrentSyncs"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootRebalancing.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.shootRebalancing.policy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootRebalancing.minShootCountDifference"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.shootRebalancing.strategy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.shootRebalancing.scoring.plugins[0].name"),
+				})),
+			))
+		})
+	})
 })
@@ -7,6 +7,7 @@ package validation
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -16,11 +17,16 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
+	nodeagentvalidation "github.com/gardener/gardener/pkg/api/config/nodeagent/v1alpha1/validation"
 	gardencorehelper "github.com/gardener/gardener/pkg/api/core/helper"
 	gardencorevalidation "github.com/gardener/gardener/pkg/api/core/validation"
+	extensionsvalidation "github.com/gardener/gardener/pkg/api/extensions/validation"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
@@ -59,6 +65,9 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		if cfg.Controllers.ManagedSeed != nil {
 			allErrs = append(allErrs, validateManagedSeedControllerConfiguration(cfg.Controllers.ManagedSeed, fldPath.Child("controllers", "managedSeed"))...)
 		}
+		if cfg.Controllers.ManagedResourceRollout != nil {
+			allErrs = append(allErrs, validateManagedResourceRolloutControllerConfiguration(cfg.Controllers.ManagedResourceRollout, fldPath.Child("controllers", "managedResourceRollout"))...)
+		}
 		if cfg.Controllers.NetworkPolicy != nil {
 			allErrs = append(allErrs, validateNetworkPolicyControllerConfiguration(cfg.Controllers.NetworkPolicy, fldPath.Child("controllers", "networkPolicy"))...)
 		}
@@ -119,6 +128,81 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	allErrs = append(allErrs, ValidateHelmChartCacheConfiguration(cfg.HelmChartCache, fldPath.Child("helmChartCache"))...)
+	allErrs = append(allErrs, validateSecretsManagementConfiguration(cfg.SecretsManagement, fldPath.Child("secretsManagement"))...)
+
+	if cfg.NodeAgent != nil && cfg.NodeAgent.ImageVerification != nil {
+		allErrs = append(allErrs, extensionsvalidation.ValidateImageVerification(&extensionsv1alpha1.ImageVerification{PublicKeys: cfg.NodeAgent.ImageVerification.PublicKeys}, fldPath.Child("nodeAgent", "imageVerification"))...)
+	}
+	if cfg.NodeAgent != nil && cfg.NodeAgent.DriftDetectionMode != nil {
+		allErrs = append(allErrs, nodeagentvalidation.ValidateDriftDetectionMode(nodeagentconfigv1alpha1.DriftDetectionMode(*cfg.NodeAgent.DriftDetectionMode), fldPath.Child("nodeAgent", "driftDetectionMode"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateHelmChartCacheConfiguration validates the configuration of the Helm chart cache.
+func ValidateHelmChartCacheConfiguration(cfg *gardenletconfigv1alpha1.HelmChartCacheConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg == nil {
+		return allErrs
+	}
+
+	if cfg.Directory != nil && *cfg.Directory == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("directory"), *cfg.Directory, "must not be empty if set"))
+	}
+
+	if cfg.MaxSize != nil && cfg.MaxSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSize"), cfg.MaxSize.String(), "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+// availableKeyAlgorithms are the key algorithms which are supported by all components of shoot control planes. Ed25519
+// is not supported since, e.g., Kubernetes can't sign service account tokens and Envoy can't serve certificates with
+// such keys.
+var availableKeyAlgorithms = sets.New(
+	string(secretsutils.KeyAlgorithmRSA),
+	string(secretsutils.KeyAlgorithmECDSAP256),
+	string(secretsutils.KeyAlgorithmECDSAP384),
+)
+
+func validateSecretsManagementConfiguration(cfg *gardenletconfigv1alpha1.SecretsManagementConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg == nil {
+		return allErrs
+	}
+
+	if cfg.KeyAlgorithm != nil && !availableKeyAlgorithms.Has(*cfg.KeyAlgorithm) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("keyAlgorithm"), *cfg.KeyAlgorithm, sets.List(availableKeyAlgorithms)))
+	}
+
+	if cfg.KeyBackend == nil {
+		return allErrs
+	}
+
+	keyBackendPath := fldPath.Child("keyBackend")
+	if cfg.KeyBackend.VaultTransit == nil {
+		return append(allErrs, field.Required(keyBackendPath.Child("vaultTransit"), "must be set if key backend is configured"))
+	}
+
+	vaultTransit, vaultTransitPath := cfg.KeyBackend.VaultTransit, keyBackendPath.Child("vaultTransit")
+	if address, err := url.ParseRequestURI(vaultTransit.Address); err != nil || (address.Scheme != "https" && address.Scheme != "http") {
+		allErrs = append(allErrs, field.Invalid(vaultTransitPath.Child("address"), vaultTransit.Address, "must be a valid http or https URL"))
+	}
+	if vaultTransit.MountPath != nil && *vaultTransit.MountPath == "" {
+		allErrs = append(allErrs, field.Invalid(vaultTransitPath.Child("mountPath"), *vaultTransit.MountPath, "must not be empty if set"))
+	}
+	if vaultTransit.TokenFile == "" {
+		allErrs = append(allErrs, field.Required(vaultTransitPath.Child("tokenFile"), "must be set"))
+	}
+	if vaultTransit.CAFile != nil && *vaultTransit.CAFile == "" {
+		allErrs = append(allErrs, field.Invalid(vaultTransitPath.Child("caFile"), *vaultTransit.CAFile, "must not be empty if set"))
+	}
+
 	return allErrs
 }
 
@@ -274,6 +358,42 @@ func validateManagedSeedControllerConfiguration(cfg *gardenletconfigv1alpha1.Man
 	return allErrs
 }
 
+func validateManagedResourceRolloutControllerConfiguration(cfg *gardenletconfigv1alpha1.ManagedResourceRolloutControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, name := range cfg.ManagedResourceNames {
+		idxPath := fldPath.Child("managedResourceNames").Index(i)
+		if names.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, name))
+		}
+		names.Insert(name)
+
+		for _, msg := range apivalidation.NameIsDNSSubdomain(name, false) {
+			allErrs = append(allErrs, field.Invalid(idxPath, name, msg))
+		}
+	}
+
+	for i, wave := range cfg.Waves {
+		idxPath := fldPath.Child("waves").Index(i)
+		if wave <= 0 || wave > 100 {
+			allErrs = append(allErrs, field.Invalid(idxPath, wave, "must be in the range (0, 100]"))
+		}
+		if i > 0 && wave <= cfg.Waves[i-1] {
+			allErrs = append(allErrs, field.Invalid(idxPath, wave, "must be greater than the previous wave"))
+		}
+	}
+	if len(cfg.Waves) > 0 && cfg.Waves[len(cfg.Waves)-1] != 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("waves").Index(len(cfg.Waves)-1), cfg.Waves[len(cfg.Waves)-1], "last wave must be 100"))
+	}
+
+	if cfg.SoakDuration != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.SoakDuration.Duration), fldPath.Child("soakDuration"))...)
+	}
+
+	return allErrs
+}
+
 func validateNetworkPolicyControllerConfiguration(cfg *gardenletconfigv1alpha1.NetworkPolicyControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
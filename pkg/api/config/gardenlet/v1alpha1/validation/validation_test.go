@@ -5,6 +5,11 @@
 package validation_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -516,6 +521,68 @@ var _ = Describe("GardenletConfiguration", func() {
 			})
 		})
 
+		Context("managed resource rollout controller", func() {
+			BeforeEach(func() {
+				cfg.Controllers.ManagedResourceRollout = &gardenletconfigv1alpha1.ManagedResourceRolloutControllerConfiguration{
+					ManagedResourceNames: []string{"shoot-core-coredns", "shoot-core-kube-proxy"},
+					Waves:                []int32{10, 50, 100},
+					SoakDuration:         &metav1.Duration{Duration: 5 * time.Minute},
+				}
+			})
+
+			It("should allow valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid duplicate or invalid managed resource names", func() {
+				cfg.Controllers.ManagedResourceRollout.ManagedResourceNames = []string{"foo", "foo", "Bar"}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.managedResourceRollout.managedResourceNames[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.managedResourceRollout.managedResourceNames[2]"),
+					})),
+				))
+			})
+
+			It("should forbid invalid waves", func() {
+				cfg.Controllers.ManagedResourceRollout.Waves = []int32{0, 50, 40, 90}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("controllers.managedResourceRollout.waves[0]"),
+						"Detail": Equal("must be in the range (0, 100]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("controllers.managedResourceRollout.waves[2]"),
+						"Detail": Equal("must be greater than the previous wave"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("controllers.managedResourceRollout.waves[3]"),
+						"Detail": Equal("last wave must be 100"),
+					})),
+				))
+			})
+
+			It("should forbid negative soak duration", func() {
+				cfg.Controllers.ManagedResourceRollout.SoakDuration = &metav1.Duration{Duration: -time.Minute}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.managedResourceRollout.soakDuration"),
+					})),
+				))
+			})
+		})
+
 		Context("token requestor workload identity controller", func() {
 			BeforeEach(func() {
 				cfg.Controllers.TokenRequestorWorkloadIdentity = &gardenletconfigv1alpha1.TokenRequestorWorkloadIdentityControllerConfiguration{}
@@ -799,6 +866,162 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("helmChartCache", func() {
+			It("should pass with a valid configuration", func() {
+				cfg.HelmChartCache = &gardenletconfigv1alpha1.HelmChartCacheConfiguration{
+					Directory: new("/var/cache/gardenlet/charts"),
+					MaxSize:   new(resource.MustParse("1Gi")),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail with an empty directory and a non-positive maximum size", func() {
+				cfg.HelmChartCache = &gardenletconfigv1alpha1.HelmChartCacheConfiguration{
+					Directory: new(""),
+					MaxSize:   new(resource.MustParse("0")),
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("helmChartCache.directory"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("helmChartCache.maxSize"),
+					})),
+				))
+			})
+		})
+
+		Context("secretsManagement", func() {
+			It("should pass with a valid Vault transit key backend", func() {
+				cfg.SecretsManagement = &gardenletconfigv1alpha1.SecretsManagementConfiguration{
+					KeyBackend: &gardenletconfigv1alpha1.KeyBackendConfiguration{
+						VaultTransit: &gardenletconfigv1alpha1.VaultTransitKeyBackend{
+							Address:   "https://vault.example.com:8200",
+							MountPath: new("transit"),
+							TokenFile: "/var/run/secrets/vault/token",
+							CAFile:    new("/var/run/secrets/vault/ca.crt"),
+						},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should pass with a supported key algorithm", func() {
+				cfg.SecretsManagement = &gardenletconfigv1alpha1.SecretsManagementConfiguration{KeyAlgorithm: new("ECDSA-P256")}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail with an unsupported key algorithm", func() {
+				cfg.SecretsManagement = &gardenletconfigv1alpha1.SecretsManagementConfiguration{KeyAlgorithm: new("Ed25519")}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("secretsManagement.keyAlgorithm"),
+					})),
+				))
+			})
+
+			It("should fail if no key backend type is set", func() {
+				cfg.SecretsManagement = &gardenletconfigv1alpha1.SecretsManagementConfiguration{
+					KeyBackend: &gardenletconfigv1alpha1.KeyBackendConfiguration{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("secretsManagement.keyBackend.vaultTransit"),
+					})),
+				))
+			})
+
+			It("should fail with an invalid Vault transit key backend", func() {
+				cfg.SecretsManagement = &gardenletconfigv1alpha1.SecretsManagementConfiguration{
+					KeyBackend: &gardenletconfigv1alpha1.KeyBackendConfiguration{
+						VaultTransit: &gardenletconfigv1alpha1.VaultTransitKeyBackend{
+							Address:   "vault.example.com",
+							MountPath: new(""),
+							CAFile:    new(""),
+						},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("secretsManagement.keyBackend.vaultTransit.address"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("secretsManagement.keyBackend.vaultTransit.mountPath"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("secretsManagement.keyBackend.vaultTransit.tokenFile"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("secretsManagement.keyBackend.vaultTransit.caFile"),
+					})),
+				))
+			})
+		})
+
+		Context("nodeAgent", func() {
+			It("should pass with valid trusted keys for the image verification", func() {
+				privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				der, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+				Expect(err).NotTo(HaveOccurred())
+
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfiguration{
+					ImageVerification: &gardenletconfigv1alpha1.NodeAgentImageVerification{
+						PublicKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail with invalid trusted keys for the image verification", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfiguration{
+					ImageVerification: &gardenletconfigv1alpha1.NodeAgentImageVerification{
+						PublicKeys: []string{"foo"},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeAgent.imageVerification.publicKeys[0]"),
+					})),
+				))
+			})
+
+			It("should pass with a valid drift detection mode", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfiguration{DriftDetectionMode: new("Restore")}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should fail with an unsupported drift detection mode", func() {
+				cfg.NodeAgent = &gardenletconfigv1alpha1.NodeAgentConfiguration{DriftDetectionMode: new("Ignore")}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("nodeAgent.driftDetectionMode"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"net"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,12 +32,25 @@ func ValidateNodeAgentConfiguration(conf *nodeagentconfigv1alpha1.NodeAgentConfi
 		allErrs = append(allErrs, field.NotSupported(field.NewPath("logFormat"), conf.LogFormat, logger.AllLogFormats))
 	}
 
+	allErrs = append(allErrs, validateServerConfiguration(conf.Server, field.NewPath("server"))...)
 	allErrs = append(allErrs, validateBootstrapConfiguration(conf.Bootstrap, field.NewPath("bootstrap"))...)
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 
 	return allErrs
 }
 
+func validateServerConfiguration(conf nodeagentconfigv1alpha1.ServerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.Status != nil {
+		if ip := net.ParseIP(conf.Status.BindAddress); conf.Status.BindAddress != "localhost" && (ip == nil || !ip.IsLoopback()) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("status", "bindAddress"), conf.Status.BindAddress, "must be a loopback address"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateBootstrapConfiguration(_ *nodeagentconfigv1alpha1.BootstrapConfiguration, _ *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -49,6 +63,7 @@ func validateControllerConfiguration(conf nodeagentconfigv1alpha1.ControllerConf
 	allErrs = append(allErrs, validateOperatingSystemConfigControllerConfiguration(conf.OperatingSystemConfig, fldPath.Child("operatingSystemConfig"))...)
 	allErrs = append(allErrs, validateTokenControllerConfiguration(conf.Token, fldPath.Child("token"))...)
 	allErrs = append(allErrs, validateSystemdUnitCheckControllerConfiguration(conf.SystemdUnitCheck, fldPath.Child("systemdUnitCheck"))...)
+	allErrs = append(allErrs, validateDriftDetectionControllerConfiguration(conf.DriftDetection, fldPath.Child("driftDetection"))...)
 
 	return allErrs
 }
@@ -120,3 +135,31 @@ func validateSystemdUnitCheckControllerConfiguration(conf nodeagentconfigv1alpha
 
 	return allErrs
 }
+
+var availableDriftDetectionModes = sets.New(
+	string(nodeagentconfigv1alpha1.DriftDetectionModeReport),
+	string(nodeagentconfigv1alpha1.DriftDetectionModeRestore),
+)
+
+func validateDriftDetectionControllerConfiguration(conf nodeagentconfigv1alpha1.DriftDetectionControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
+
+	if conf.Mode != nil {
+		allErrs = append(allErrs, ValidateDriftDetectionMode(*conf.Mode, fldPath.Child("mode"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateDriftDetectionMode validates the mode of the drift detection controller.
+func ValidateDriftDetectionMode(mode nodeagentconfigv1alpha1.DriftDetectionMode, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableDriftDetectionModes.Has(string(mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, mode, sets.List(availableDriftDetectionModes)))
+	}
+
+	return allErrs
+}
@@ -41,6 +41,10 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 					SyncPeriod:     &metav1.Duration{Duration: time.Minute},
 					StuckThreshold: &metav1.Duration{Duration: 5 * time.Minute},
 				},
+				DriftDetection: DriftDetectionControllerConfig{
+					SyncPeriod: &metav1.Duration{Duration: 5 * time.Minute},
+					Mode:       new(DriftDetectionModeReport),
+				},
 			},
 		}
 	})
@@ -78,6 +82,26 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 		})
 	})
 
+	Context("server configuration", func() {
+		It("should allow loopback addresses for the status server", func() {
+			for _, bindAddress := range []string{"127.0.0.1", "::1", "localhost"} {
+				config.Server.Status = &Server{BindAddress: bindAddress, Port: 2753}
+				Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+			}
+		})
+
+		It("should forbid non-loopback addresses for the status server", func() {
+			config.Server.Status = &Server{BindAddress: "0.0.0.0", Port: 2753}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("server.status.bindAddress"),
+				})),
+			))
+		})
+	})
+
 	Context("Operating System Config Controller", func() {
 		It("should fail because kubernetes version is empty", func() {
 			config.Controllers.OperatingSystemConfig.KubernetesVersion = nil
@@ -189,4 +213,34 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 			))
 		})
 	})
+
+	Context("Drift Detection Controller", func() {
+		It("should fail because sync period is too small", func() {
+			config.Controllers.DriftDetection.SyncPeriod = &metav1.Duration{Duration: 10 * time.Second}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.driftDetection.syncPeriod"),
+				})),
+			))
+		})
+
+		It("should allow mode Restore", func() {
+			config.Controllers.DriftDetection.Mode = new(DriftDetectionModeRestore)
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because mode is not supported", func() {
+			config.Controllers.DriftDetection.Mode = new(DriftDetectionMode("Ignore"))
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.driftDetection.mode"),
+				})),
+			))
+		})
+	})
 })
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
+	gardenletvalidation "github.com/gardener/gardener/pkg/api/config/gardenlet/v1alpha1/validation"
 	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/operator/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
@@ -37,6 +38,7 @@ func ValidateOperatorConfiguration(conf *operatorconfigv1alpha1.OperatorConfigur
 
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateNodeTolerationConfiguration(conf.NodeToleration, field.NewPath("nodeToleration"))...)
+	allErrs = append(allErrs, gardenletvalidation.ValidateHelmChartCacheConfiguration(conf.HelmChartCache, field.NewPath("helmChartCache"))...)
 
 	return allErrs
 }
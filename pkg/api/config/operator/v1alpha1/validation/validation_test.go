@@ -11,11 +11,13 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 
 	. "github.com/gardener/gardener/pkg/api/config/operator/v1alpha1/validation"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/operator/v1alpha1"
 )
 
@@ -291,4 +293,23 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 			)
 		})
 	})
+
+	Context("Helm chart cache", func() {
+		It("should pass with a valid configuration", func() {
+			conf.HelmChartCache = &gardenletconfigv1alpha1.HelmChartCacheConfiguration{MaxSize: new(resource.MustParse("1Gi"))}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail with a negative maximum size", func() {
+			conf.HelmChartCache = &gardenletconfigv1alpha1.HelmChartCacheConfiguration{MaxSize: new(resource.MustParse("-1Gi"))}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("helmChartCache.maxSize"),
+				})),
+			))
+		})
+	})
 })
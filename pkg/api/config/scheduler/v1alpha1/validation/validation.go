@@ -6,6 +6,7 @@ package validation
 
 import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -50,13 +51,15 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfigv1alpha1
 
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
-		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, ValidateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, ValidateScoring(schedulers.Shoot.Scoring, fldPath.Child("shoot", "scoring"))...)
 	}
 
 	return allErrs
 }
 
-func validateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy, fldPath *field.Path) field.ErrorList {
+// ValidateStrategy validates the given candidate determination strategy.
+func ValidateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs             = field.ErrorList{}
 		supportedStrategies []string
@@ -74,3 +77,38 @@ func validateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStr
 
 	return allErrs
 }
+
+// ValidateScoring validates the given scoring configuration.
+func ValidateScoring(scoring *schedulerconfigv1alpha1.ScoringConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if scoring == nil {
+		return allErrs
+	}
+
+	var (
+		supportedPlugins = sets.New(schedulerconfigv1alpha1.ScorePlugins...)
+		pluginNames      = sets.New[schedulerconfigv1alpha1.ScorePluginName]()
+	)
+
+	for i, plugin := range scoring.Plugins {
+		idxPath := fldPath.Child("plugins").Index(i)
+
+		if !supportedPlugins.Has(plugin.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), plugin.Name, schedulerconfigv1alpha1.ScorePlugins))
+		} else if pluginNames.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		pluginNames.Insert(plugin.Name)
+
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(plugin.Weight), idxPath.Child("weight"))...)
+	}
+
+	if scoring.LabelAffinity != nil {
+		for i, selector := range scoring.LabelAffinity.PreferredSeedSelectors {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&selector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("labelAffinity", "preferredSeedSelectors").Index(i))...)
+		}
+	}
+
+	return allErrs
+}
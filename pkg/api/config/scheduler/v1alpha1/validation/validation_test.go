@@ -126,6 +126,58 @@ var _ = Describe("#ValidateConfiguration", func() {
 			Expect(err).To(BeEmpty())
 		})
 
+		It("should pass because the Gardener Scheduler Configuration with the 'Scored' Strategy is a valid configuration", func() {
+			scoredConfiguration := conf.DeepCopy()
+			scoredConfiguration.Schedulers.Shoot.Strategy = schedulerconfigv1alpha1.Scored
+			scoredConfiguration.Schedulers.Shoot.Scoring = &schedulerconfigv1alpha1.ScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginCapacityHeadroom, Weight: 2},
+					{Name: schedulerconfigv1alpha1.ScorePluginLabelAffinity, Weight: 1},
+				},
+				LabelAffinity: &schedulerconfigv1alpha1.LabelAffinityArgs{
+					PreferredSeedSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"foo": "bar"}}},
+				},
+			}
+			err := ValidateConfiguration(scoredConfiguration)
+
+			Expect(err).To(BeEmpty())
+		})
+
+		It("should fail because the scoring configuration is invalid", func() {
+			invalidConfiguration := conf.DeepCopy()
+			invalidConfiguration.Schedulers.Shoot.Strategy = schedulerconfigv1alpha1.Scored
+			invalidConfiguration.Schedulers.Shoot.Scoring = &schedulerconfigv1alpha1.ScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: "Unknown", Weight: 1},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedAge, Weight: -1},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedAge, Weight: 1},
+				},
+				LabelAffinity: &schedulerconfigv1alpha1.LabelAffinityArgs{
+					PreferredSeedSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"foo": "%bar"}}},
+				},
+			}
+			err := ValidateConfiguration(invalidConfiguration)
+
+			Expect(err).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.scoring.plugins[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.scoring.plugins[1].weight"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("schedulers.shoot.scoring.plugins[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.scoring.labelAffinity.preferredSeedSelectors[0].matchLabels"),
+				})),
+			))
+		})
+
 		It("should fail because the Gardener Scheduler Configuration contains an invalid strategy", func() {
 			invalidConfiguration := conf.DeepCopy()
 			invalidConfiguration.Schedulers.Shoot.Strategy = "invalidStrategy"
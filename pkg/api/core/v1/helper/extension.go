@@ -25,3 +25,16 @@ func GetSecretsForOCIRepository(ociRepository *gardencorev1.OCIRepository) []str
 
 	return secretNames
 }
+
+// GetOCIRepositoryForControllerDeployment returns the OCIRepository of the Helm or manifests deployment of the given
+// ControllerDeployment, or nil if it does not pull an OCI artifact.
+func GetOCIRepositoryForControllerDeployment(controllerDeployment *gardencorev1.ControllerDeployment) *gardencorev1.OCIRepository {
+	switch {
+	case controllerDeployment.Helm != nil:
+		return controllerDeployment.Helm.OCIRepository
+	case controllerDeployment.Manifests != nil:
+		return controllerDeployment.Manifests.OCIRepository
+	default:
+		return nil
+	}
+}
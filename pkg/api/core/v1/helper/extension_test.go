@@ -41,4 +41,21 @@ var _ = Describe("Extension", func() {
 			[]string{"ca-bundle", "pull-secret"},
 		),
 	)
+
+	DescribeTable("#GetOCIRepositoryForControllerDeployment",
+		func(controllerDeployment *gardencorev1.ControllerDeployment, expected *gardencorev1.OCIRepository) {
+			Expect(GetOCIRepositoryForControllerDeployment(controllerDeployment)).To(Equal(expected))
+		},
+
+		Entry("custom type", &gardencorev1.ControllerDeployment{}, nil),
+		Entry("helm with raw chart", &gardencorev1.ControllerDeployment{Helm: &gardencorev1.HelmControllerDeployment{RawChart: []byte("foo")}}, nil),
+		Entry("helm with OCIRepository",
+			&gardencorev1.ControllerDeployment{Helm: &gardencorev1.HelmControllerDeployment{OCIRepository: &gardencorev1.OCIRepository{Ref: new("chart")}}},
+			&gardencorev1.OCIRepository{Ref: new("chart")},
+		),
+		Entry("manifests",
+			&gardencorev1.ControllerDeployment{Manifests: &gardencorev1.ManifestsControllerDeployment{OCIRepository: &gardencorev1.OCIRepository{Ref: new("manifests")}}},
+			&gardencorev1.OCIRepository{Ref: new("manifests")},
+		),
+	)
 })
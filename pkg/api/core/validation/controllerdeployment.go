@@ -6,6 +6,8 @@ package validation
 
 import (
 	"fmt"
+	"path"
+	"slices"
 	"strings"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -13,6 +15,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/utils/cosign"
 )
 
 // ValidateControllerDeployment validates a ControllerDeployment object.
@@ -32,6 +35,15 @@ func ValidateControllerDeployment(controllerDeployment *core.ControllerDeploymen
 		deploymentType = "helm"
 
 		allErrs = append(allErrs, ValidateHelmControllerDeployment(controllerDeployment.Helm, controllerDeployment.Resources, field.NewPath("helm"))...)
+
+		if controllerDeployment.Manifests != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("manifests"), "must not provide manifests if helm is used"))
+		}
+	case controllerDeployment.Manifests != nil:
+		isBuiltInType = true
+		deploymentType = "manifests"
+
+		allErrs = append(allErrs, ValidateManifestsControllerDeployment(controllerDeployment.Manifests, controllerDeployment.Resources, field.NewPath("manifests"))...)
 	}
 
 	allErrs = append(allErrs, ValidateResources(controllerDeployment.Resources, field.NewPath("resources"), false)...)
@@ -46,7 +58,7 @@ func ValidateControllerDeployment(controllerDeployment *core.ControllerDeploymen
 			allErrs = append(allErrs, field.Forbidden(field.NewPath("providerConfig"), fmt.Sprintf("must not provide providerConfig if a built-in deployment type (%s) is used", deploymentType)))
 		}
 	} else if len(controllerDeployment.Type) == 0 {
-		allErrs = append(allErrs, field.Forbidden(field.NewPath(""), "must use either helm or a custom deployment configuration"))
+		allErrs = append(allErrs, field.Forbidden(field.NewPath(""), "must use either helm, manifests or a custom deployment configuration"))
 	}
 	// If a custom type is configured, only type and providerConfig can be set, and other fields must be empty.
 	// We don't need to validate this case, as it is covered by the built-in type case. In other words, configuring a
@@ -77,6 +89,26 @@ func ValidateHelmControllerDeployment(helmControllerDeployment *core.HelmControl
 	return allErrs
 }
 
+// ValidateManifestsControllerDeployment validates manifests controller deployment configs.
+func ValidateManifestsControllerDeployment(manifestsControllerDeployment *core.ManifestsControllerDeployment, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if manifestsControllerDeployment.OCIRepository == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ociRepository"), "must provide ociRepository"))
+	}
+	allErrs = append(allErrs, ValidateOCIRepository(manifestsControllerDeployment.OCIRepository, fldPath.Child("ociRepository"))...)
+
+	if manifestsPath := ptr.Deref(manifestsControllerDeployment.Path, ""); path.IsAbs(manifestsPath) || slices.Contains(strings.Split(manifestsPath, "/"), "..") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), manifestsPath, "must be a relative path within the artifact"))
+	}
+
+	if manifestsControllerDeployment.Values != nil {
+		allErrs = append(allErrs, ValidateValuesTemplates(manifestsControllerDeployment.Values.Raw, resources, fldPath.Child("values"))...)
+	}
+
+	return allErrs
+}
+
 // ValidateOCIRepository validates the OCI repository config.
 func ValidateOCIRepository(oci *core.OCIRepository, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -90,6 +122,8 @@ func ValidateOCIRepository(oci *core.OCIRepository, fldPath *field.Path) field.E
 		return allErrs
 	}
 
+	allErrs = append(allErrs, validateOCIRepositoryVerification(oci.Verification, fldPath.Child("verification"))...)
+
 	if oci.Ref != nil {
 		// all other fields must be empty if ref is set.
 		for name, val := range map[string]*string{
@@ -116,3 +150,61 @@ func ValidateOCIRepository(oci *core.OCIRepository, fldPath *field.Path) field.E
 
 	return allErrs
 }
+
+func validateOCIRepositoryVerification(verification *core.OCIRepositoryVerification, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if verification == nil {
+		return allErrs
+	}
+
+	if len(verification.PublicKeys) == 0 && verification.Keyless == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "must provide either publicKeys or keyless"))
+	}
+
+	allErrs = append(allErrs, validatePublicKeys(verification.PublicKeys, fldPath.Child("publicKeys"))...)
+
+	if keyless := verification.Keyless; keyless != nil {
+		keylessPath := fldPath.Child("keyless")
+
+		if len(keyless.Identities) == 0 {
+			allErrs = append(allErrs, field.Required(keylessPath.Child("identities"), "must provide at least one identity"))
+		}
+		for i, identity := range keyless.Identities {
+			if identity.Issuer == "" {
+				allErrs = append(allErrs, field.Required(keylessPath.Child("identities").Index(i).Child("issuer"), ""))
+			}
+			if identity.Subject == "" {
+				allErrs = append(allErrs, field.Required(keylessPath.Child("identities").Index(i).Child("subject"), ""))
+			}
+		}
+
+		if _, _, err := cosign.ParseCertificateAuthorities(keyless.CertificateAuthorities); err != nil {
+			allErrs = append(allErrs, field.Invalid(keylessPath.Child("certificateAuthorities"), keyless.CertificateAuthorities, fmt.Sprintf("must be a PEM-encoded certificate bundle: %v", err)))
+		}
+
+		if len(keyless.TransparencyLogPublicKeys) == 0 {
+			allErrs = append(allErrs, field.Required(keylessPath.Child("transparencyLogPublicKeys"), "must provide at least one public key"))
+		}
+		allErrs = append(allErrs, validatePublicKeys(keyless.TransparencyLogPublicKeys, keylessPath.Child("transparencyLogPublicKeys"))...)
+
+		if len(keyless.CertificateTransparencyLogPublicKeys) == 0 {
+			allErrs = append(allErrs, field.Required(keylessPath.Child("certificateTransparencyLogPublicKeys"), "must provide at least one public key"))
+		}
+		allErrs = append(allErrs, validatePublicKeys(keyless.CertificateTransparencyLogPublicKeys, keylessPath.Child("certificateTransparencyLogPublicKeys"))...)
+	}
+
+	return allErrs
+}
+
+func validatePublicKeys(publicKeys []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, publicKey := range publicKeys {
+		if _, err := cosign.ParsePublicKeys([]string{publicKey}); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), publicKey, "must be a PEM-encoded public key"))
+		}
+	}
+
+	return allErrs
+}
@@ -5,6 +5,12 @@
 package validation_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -17,6 +23,7 @@ import (
 
 	. "github.com/gardener/gardener/pkg/api/core/validation"
 	. "github.com/gardener/gardener/pkg/apis/core"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
 var _ = Describe("#ValidateControllerDeployment", func() {
@@ -76,7 +83,7 @@ var _ = Describe("#ValidateControllerDeployment", func() {
 
 		Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 			"Type":   Equal(field.ErrorTypeForbidden),
-			"Detail": Equal("must use either helm or a custom deployment configuration"),
+			"Detail": Equal("must use either helm, manifests or a custom deployment configuration"),
 		}))))
 	})
 
@@ -231,6 +238,200 @@ var _ = Describe("#ValidateControllerDeployment", func() {
 		})
 	})
 
+	Context("helm type with ociRepository.verification", func() {
+		var publicKey, certificateAuthorities string
+
+		BeforeEach(func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			der, err := x509.MarshalPKIXPublicKey(key.Public())
+			Expect(err).NotTo(HaveOccurred())
+			publicKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+			ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			certificateAuthorities = string(ca.CertificatePEM)
+
+			controllerDeployment.Helm = &HelmControllerDeployment{
+				OCIRepository: &OCIRepository{
+					Ref:          new("foo:v1.0.0"),
+					Verification: &OCIRepositoryVerification{},
+				},
+			}
+		})
+
+		It("should allow a valid verification with public keys", func() {
+			controllerDeployment.Helm.OCIRepository.Verification.PublicKeys = []string{publicKey}
+
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(BeEmpty())
+		})
+
+		It("should allow a valid keyless verification", func() {
+			controllerDeployment.Helm.OCIRepository.Verification.Keyless = &KeylessVerification{
+				Identities:                           []KeylessIdentity{{Issuer: "https://token.actions.githubusercontent.com", Subject: "foo@example.com"}},
+				CertificateAuthorities:               certificateAuthorities,
+				TransparencyLogPublicKeys:            []string{publicKey},
+				CertificateTransparencyLogPublicKeys: []string{publicKey},
+			}
+
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(BeEmpty())
+		})
+
+		It("should require public keys or keyless", func() {
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeRequired),
+				"Field":  Equal("helm.ociRepository.verification"),
+				"Detail": Equal("must provide either publicKeys or keyless"),
+			}))))
+		})
+
+		It("should forbid invalid public keys", func() {
+			controllerDeployment.Helm.OCIRepository.Verification.PublicKeys = []string{publicKey, "foo"}
+
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("helm.ociRepository.verification.publicKeys[1]"),
+			}))))
+		})
+
+		It("should forbid an invalid keyless verification", func() {
+			controllerDeployment.Helm.OCIRepository.Verification.Keyless = &KeylessVerification{
+				Identities:             []KeylessIdentity{{}},
+				CertificateAuthorities: "foo",
+			}
+
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("helm.ociRepository.verification.keyless.identities[0].issuer"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("helm.ociRepository.verification.keyless.identities[0].subject"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("helm.ociRepository.verification.keyless.certificateAuthorities"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("helm.ociRepository.verification.keyless.transparencyLogPublicKeys"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("helm.ociRepository.verification.keyless.certificateTransparencyLogPublicKeys"),
+				})),
+			))
+		})
+
+		It("should forbid invalid certificate transparency log public keys", func() {
+			controllerDeployment.Helm.OCIRepository.Verification.Keyless = &KeylessVerification{
+				Identities:                           []KeylessIdentity{{Issuer: "https://token.actions.githubusercontent.com", Subject: "foo@example.com"}},
+				CertificateAuthorities:               certificateAuthorities,
+				TransparencyLogPublicKeys:            []string{publicKey},
+				CertificateTransparencyLogPublicKeys: []string{"foo"},
+			}
+
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("helm.ociRepository.verification.keyless.certificateTransparencyLogPublicKeys[0]"),
+			}))))
+		})
+
+		It("should require keyless identities", func() {
+			controllerDeployment.Helm.OCIRepository.Verification.Keyless = &KeylessVerification{
+				CertificateAuthorities:               certificateAuthorities,
+				TransparencyLogPublicKeys:            []string{publicKey},
+				CertificateTransparencyLogPublicKeys: []string{publicKey},
+			}
+
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("helm.ociRepository.verification.keyless.identities"),
+			}))))
+		})
+	})
+
+	Context("manifests type", func() {
+		BeforeEach(func() {
+			controllerDeployment.Helm = nil
+			controllerDeployment.Manifests = &ManifestsControllerDeployment{
+				OCIRepository: &OCIRepository{Ref: new("example.com/foo:1.0.0")},
+				Path:          new("deploy/overlay"),
+				Values:        &apiextensionsv1.JSON{Raw: []byte(`{"foo":"bar"}`)},
+			}
+		})
+
+		It("should allow a valid manifests deployment configuration", func() {
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(BeEmpty())
+		})
+
+		It("should forbid setting type", func() {
+			controllerDeployment.Type = "custom"
+
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("type"),
+				"Detail": Equal("must not provide type if a built-in deployment type (manifests) is used"),
+			}))))
+		})
+
+		It("should forbid setting helm at the same time", func() {
+			controllerDeployment.Helm = &HelmControllerDeployment{RawChart: []byte("foo")}
+
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("manifests"),
+			}))))
+		})
+
+		It("should require setting ociRepository", func() {
+			controllerDeployment.Manifests.OCIRepository = nil
+
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("manifests.ociRepository"),
+			}))))
+		})
+
+		It("should validate the ociRepository", func() {
+			controllerDeployment.Manifests.OCIRepository = &OCIRepository{Repository: new("example.com/foo")}
+
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeRequired),
+				"Field":  Equal("manifests.ociRepository"),
+				"Detail": Equal("must provide either tag or digest"),
+			}))))
+		})
+
+		DescribeTable("path",
+			func(path string, matcher gomegatypes.GomegaMatcher) {
+				controllerDeployment.Manifests.Path = &path
+
+				Expect(ValidateControllerDeployment(controllerDeployment)).To(matcher)
+			},
+
+			Entry("should allow the root directory", "", BeEmpty()),
+			Entry("should allow relative paths", "./deploy/", BeEmpty()),
+			Entry("should forbid absolute paths", "/deploy", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("manifests.path"),
+			})))),
+			Entry("should forbid paths outside of the artifact", "deploy/../../foo", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("manifests.path"),
+			})))),
+		)
+
+		It("should validate the values templates", func() {
+			controllerDeployment.Manifests.Values = &apiextensionsv1.JSON{Raw: []byte(`{"foo":"{{ .resources.missing.data.key }}"}`)}
+
+			Expect(ValidateControllerDeployment(controllerDeployment)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Field": Equal("manifests.values"),
+			}))))
+		})
+	})
+
 	Context("custom type", func() {
 		BeforeEach(func() {
 			controllerDeployment.Helm = nil
@@ -159,6 +159,11 @@ var (
 	)
 	availableUpdateStrategies = sets.New(core.AutoRollingUpdate, core.AutoInPlaceUpdate, core.ManualInPlaceUpdate)
 
+	availableOperatingSystemConfigUpdatePolicies = sets.New(
+		core.OperatingSystemConfigUpdatePolicyImmediate,
+		core.OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow,
+	)
+
 	availableEncryptionAtRestProviders = sets.New(
 		core.EncryptionProviderTypeAESCBC,
 		core.EncryptionProviderTypeAESGCM,
@@ -2370,6 +2375,13 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, shootNamespa
 			allErrs = append(allErrs, field.NotSupported(fldPath.Child("updateStrategy"), *worker.UpdateStrategy, sets.List(availableUpdateStrategies)))
 		}
 	}
+	if worker.OperatingSystemConfigUpdatePolicy != nil {
+		if !availableOperatingSystemConfigUpdatePolicies.Has(*worker.OperatingSystemConfigUpdatePolicy) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("operatingSystemConfigUpdatePolicy"), *worker.OperatingSystemConfigUpdatePolicy, sets.List(availableOperatingSystemConfigUpdatePolicies)))
+		} else if *worker.OperatingSystemConfigUpdatePolicy == core.OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow && helper.IsUpdateStrategyInPlace(worker.UpdateStrategy) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("operatingSystemConfigUpdatePolicy"), "holding operating system config changes until the maintenance time window is not supported for worker pools with `AutoInPlaceUpdate` or `ManualInPlaceUpdate` update strategies"))
+		}
+	}
 	if worker.Priority != nil && *worker.Priority < -1 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("priority"), *worker.Priority, "can not be less than -1"))
 	}
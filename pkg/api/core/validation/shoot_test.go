@@ -9074,6 +9074,66 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})
 		})
 
+		Describe("operating system config update policy validation", func() {
+			var (
+				worker  core.Worker
+				fldPath *field.Path
+			)
+
+			BeforeEach(func() {
+				worker = core.Worker{
+					Name:           "worker-1",
+					MaxUnavailable: new(intstr.FromInt32(1)),
+					Machine: core.Machine{
+						Type: "xlarge",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+					},
+				}
+
+				fldPath = field.NewPath("workers").Index(0)
+			})
+
+			It("should fail if the policy is not supported", func() {
+				worker.OperatingSystemConfigUpdatePolicy = new(core.OperatingSystemConfigUpdatePolicy("foo"))
+
+				Expect(ValidateWorker(worker, core.Kubernetes{}, shootNamespace, providerType, fldPath, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeNotSupported),
+						"Field":  Equal("workers[0].operatingSystemConfigUpdatePolicy"),
+						"Detail": Equal("supported values: \"Immediate\", \"MaintenanceTimeWindow\""),
+					})),
+				))
+			})
+
+			It("should succeed if the policy is supported", func() {
+				worker.OperatingSystemConfigUpdatePolicy = new(core.OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow)
+
+				Expect(ValidateWorker(worker, core.Kubernetes{}, shootNamespace, providerType, fldPath, false)).To(BeEmpty())
+			})
+
+			It("should succeed if the policy is Immediate and the update strategy is in-place", func() {
+				worker.UpdateStrategy = new(core.AutoInPlaceUpdate)
+				worker.OperatingSystemConfigUpdatePolicy = new(core.OperatingSystemConfigUpdatePolicyImmediate)
+
+				Expect(ValidateWorker(worker, core.Kubernetes{}, shootNamespace, providerType, fldPath, false)).To(BeEmpty())
+			})
+
+			It("should fail if the policy is MaintenanceTimeWindow and the update strategy is in-place", func() {
+				worker.UpdateStrategy = new(core.AutoInPlaceUpdate)
+				worker.OperatingSystemConfigUpdatePolicy = new(core.OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow)
+
+				Expect(ValidateWorker(worker, core.Kubernetes{}, shootNamespace, providerType, fldPath, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("workers[0].operatingSystemConfigUpdatePolicy"),
+					})),
+				))
+			})
+		})
+
 		Describe("#ValidateInPlaceUpdateStrategyOnCreation", func() {
 			var (
 				shoot = &core.Shoot{
@@ -5,7 +5,9 @@
 package validation
 
 import (
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"net/url"
 	"regexp"
@@ -13,6 +15,7 @@ import (
 	"strings"
 
 	"github.com/go-test/deep"
+	"github.com/opencontainers/go-digest"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -64,6 +67,34 @@ func ValidateOperatingSystemConfigSpec(spec *extensionsv1alpha1.OperatingSystemC
 	allErrs = append(allErrs, ValidateCRIConfig(spec.CRIConfig, spec.Purpose, fldPath.Child("criConfig"))...)
 	allErrs = append(allErrs, ValidateUnits(spec.Units, pathsFromFiles, fldPath.Child("units"))...)
 	allErrs = append(allErrs, ValidateFiles(spec.Files, fldPath.Child("files"))...)
+	allErrs = append(allErrs, ValidateImageVerification(spec.ImageVerification, fldPath.Child("imageVerification"))...)
+
+	return allErrs
+}
+
+// ValidateImageVerification validates the image verification configuration of an OperatingSystemConfig.
+func ValidateImageVerification(imageVerification *extensionsv1alpha1.ImageVerification, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if imageVerification == nil {
+		return allErrs
+	}
+
+	if len(imageVerification.PublicKeys) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("publicKeys"), "at least one public key must be provided"))
+	}
+
+	for i, publicKey := range imageVerification.PublicKeys {
+		block, _ := pem.Decode([]byte(publicKey))
+		if block == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("publicKeys").Index(i), publicKey, "must be a PEM-encoded public key"))
+			continue
+		}
+
+		if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("publicKeys").Index(i), publicKey, fmt.Sprintf("failed parsing public key: %v", err)))
+		}
+	}
 
 	return allErrs
 }
@@ -371,6 +402,11 @@ func ValidateFiles(files []extensionsv1alpha1.File, fldPath *field.Path) field.E
 			if len(file.Content.ImageRef.FilePathInImage) == 0 {
 				allErrs = append(allErrs, field.Required(idxPath.Child("content", "imageRef", "filePathInImage"), "field is required"))
 			}
+			if file.Content.ImageRef.Digest != nil {
+				if _, err := digest.Parse(*file.Content.ImageRef.Digest); err != nil {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("content", "imageRef", "digest"), *file.Content.ImageRef.Digest, err.Error()))
+				}
+			}
 		}
 
 		if file.HostName != nil {
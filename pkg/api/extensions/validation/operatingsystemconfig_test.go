@@ -609,11 +609,61 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 			}))))
 		})
 
+		It("should forbid imageRef files with an invalid digest", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.Files[1].Content.ImageRef.Digest = new("sha256:foo")
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.files[1].content.imageRef.digest"),
+			}))))
+		})
+
+		It("should forbid invalid image verification configuration", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.ImageVerification = &extensionsv1alpha1.ImageVerification{}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.imageVerification.publicKeys"),
+			}))))
+
+			oscCopy.Spec.ImageVerification.PublicKeys = []string{
+				"foo",
+				"-----BEGIN PUBLIC KEY-----\nZm9v\n-----END PUBLIC KEY-----\n",
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.imageVerification.publicKeys[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.imageVerification.publicKeys[1]"),
+				})),
+			))
+		})
+
 		It("should allow valid osc resources", func() {
 			errorList := ValidateOperatingSystemConfig(osc)
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should allow valid osc resources with image verification", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.Files[1].Content.ImageRef.Digest = new("sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")
+			oscCopy.Spec.ImageVerification = &extensionsv1alpha1.ImageVerification{
+				PublicKeys: []string{`-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAENKc1cYHEuCaYva/9eWVZjRuqgo3L
+PZXwBFOkrDHOS0Ie6J/RK2E6AIfQUOLF3vf865I09LjBBHHPhk0r+K0xoQ==
+-----END PUBLIC KEY-----
+`},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(BeEmpty())
+		})
 	})
 
 	Describe("#ValidOperatingSystemConfigUpdate", func() {
@@ -6,6 +6,7 @@ package conversion
 
 import (
 	admissioncontrollerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/admissioncontroller/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 )
 
@@ -38,3 +39,33 @@ func ConvertToAdmissionControllerResourceAdmissionConfiguration(config *operator
 
 	return out
 }
+
+// ConvertToSchedulerScoringConfiguration converts the given 'GardenerSchedulerScoring' into a
+// 'schedulerconfigv1alpha1.ScoringConfiguration' object.
+// Note: References from the given config are re-used, the function does not deep copy the data.
+func ConvertToSchedulerScoringConfiguration(config *operatorv1alpha1.GardenerSchedulerScoring) *schedulerconfigv1alpha1.ScoringConfiguration {
+	if config == nil {
+		return nil
+	}
+
+	out := &schedulerconfigv1alpha1.ScoringConfiguration{}
+
+	for _, plugin := range config.Plugins {
+		if out.Plugins == nil {
+			out.Plugins = make([]schedulerconfigv1alpha1.ScorePlugin, 0, len(config.Plugins))
+		}
+
+		out.Plugins = append(out.Plugins, schedulerconfigv1alpha1.ScorePlugin{
+			Name:   schedulerconfigv1alpha1.ScorePluginName(plugin.Name),
+			Weight: plugin.Weight,
+		})
+	}
+
+	if config.LabelAffinity != nil {
+		out.LabelAffinity = &schedulerconfigv1alpha1.LabelAffinityArgs{
+			PreferredSeedSelectors: config.LabelAffinity.PreferredSeedSelectors,
+		}
+	}
+
+	return out
+}
@@ -12,9 +12,11 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/gardener/gardener/pkg/api/operator/v1alpha1/conversion"
 	admissioncontrollerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/admissioncontroller/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 )
 
@@ -67,4 +69,32 @@ var _ = Describe("conversion", func() {
 			))
 		})
 	})
+
+	Describe("#ConvertToSchedulerScoringConfiguration", func() {
+		It("should return 'nil' when given config is 'nil'", func() {
+			Expect(ConvertToSchedulerScoringConfiguration(nil)).To(BeNil())
+		})
+
+		It("should convert given config", func() {
+			operatorConfig := &operatorv1alpha1.GardenerSchedulerScoring{
+				Plugins: []operatorv1alpha1.GardenerSchedulerScorePlugin{
+					{Name: "CapacityHeadroom", Weight: 2},
+					{Name: "LabelAffinity", Weight: 1},
+				},
+				LabelAffinity: &operatorv1alpha1.GardenerSchedulerLabelAffinity{
+					PreferredSeedSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"foo": "bar"}}},
+				},
+			}
+
+			Expect(ConvertToSchedulerScoringConfiguration(operatorConfig)).To(Equal(&schedulerconfigv1alpha1.ScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginCapacityHeadroom, Weight: 2},
+					{Name: schedulerconfigv1alpha1.ScorePluginLabelAffinity, Weight: 1},
+				},
+				LabelAffinity: &schedulerconfigv1alpha1.LabelAffinityArgs{
+					PreferredSeedSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"foo": "bar"}}},
+				},
+			}))
+		})
+	})
 })
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+)
+
+// IsDeploymentSpecified returns true if the given deployment specifies either a Helm chart or manifests.
+func IsDeploymentSpecified(deployment *operatorv1alpha1.DeploymentSpec) bool {
+	return deployment != nil && (deployment.Helm != nil || deployment.Manifests != nil)
+}
+
+// GetOCIRepository returns the OCI repository of the Helm chart or the manifests of the given deployment. It returns nil
+// if neither is specified.
+func GetOCIRepository(deployment *operatorv1alpha1.DeploymentSpec) *gardencorev1.OCIRepository {
+	switch {
+	case deployment == nil:
+		return nil
+	case deployment.Helm != nil:
+		return deployment.Helm.OCIRepository
+	case deployment.Manifests != nil:
+		return deployment.Manifests.OCIRepository
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/api/operator/v1alpha1/helper"
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+)
+
+var _ = Describe("Extension", func() {
+	var ociRepository = &gardencorev1.OCIRepository{Ref: new("example.com/foo:v1.0.0")}
+
+	DescribeTable("#IsDeploymentSpecified",
+		func(deployment *operatorv1alpha1.DeploymentSpec, expected bool) {
+			Expect(IsDeploymentSpecified(deployment)).To(Equal(expected))
+		},
+
+		Entry("deployment nil", nil, false),
+		Entry("neither helm nor manifests", &operatorv1alpha1.DeploymentSpec{}, false),
+		Entry("helm", &operatorv1alpha1.DeploymentSpec{Helm: &operatorv1alpha1.ExtensionHelm{}}, true),
+		Entry("manifests", &operatorv1alpha1.DeploymentSpec{Manifests: &operatorv1alpha1.ExtensionManifests{}}, true),
+	)
+
+	DescribeTable("#GetOCIRepository",
+		func(deployment *operatorv1alpha1.DeploymentSpec, expected *gardencorev1.OCIRepository) {
+			Expect(GetOCIRepository(deployment)).To(Equal(expected))
+		},
+
+		Entry("deployment nil", nil, nil),
+		Entry("neither helm nor manifests", &operatorv1alpha1.DeploymentSpec{}, nil),
+		Entry("helm", &operatorv1alpha1.DeploymentSpec{Helm: &operatorv1alpha1.ExtensionHelm{OCIRepository: ociRepository}}, ociRepository),
+		Entry("manifests", &operatorv1alpha1.DeploymentSpec{Manifests: &operatorv1alpha1.ExtensionManifests{OCIRepository: ociRepository}}, ociRepository),
+	)
+})
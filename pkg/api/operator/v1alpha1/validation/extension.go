@@ -83,7 +83,7 @@ func validateExtensionDeployment(deployment *operatorv1alpha1.ExtensionDeploymen
 		return allErrs
 	}
 
-	allErrs = append(allErrs, validateHelmDeployment(deployment.Helm, fldPath.Child("helm"))...)
+	allErrs = append(allErrs, validateDeploymentSpec(deployment.DeploymentSpec, fldPath)...)
 	if deployment.Values != nil {
 		allErrs = append(allErrs, gardencorevalidation.ValidateValuesTemplates(deployment.Values.Raw, resources, fldPath.Child("values"))...)
 	}
@@ -106,11 +106,11 @@ func validateAdmissionDeployment(deployment *operatorv1alpha1.AdmissionDeploymen
 	}
 
 	if deployment.RuntimeCluster != nil {
-		allErrs = append(allErrs, validateHelmDeployment(deployment.RuntimeCluster.Helm, fldPath.Child("runtimeCluster", "helm"))...)
+		allErrs = append(allErrs, validateDeploymentSpec(*deployment.RuntimeCluster, fldPath.Child("runtimeCluster"))...)
 	}
 
 	if deployment.VirtualCluster != nil {
-		allErrs = append(allErrs, validateHelmDeployment(deployment.VirtualCluster.Helm, fldPath.Child("virtualCluster", "helm"))...)
+		allErrs = append(allErrs, validateDeploymentSpec(*deployment.VirtualCluster, fldPath.Child("virtualCluster"))...)
 	}
 
 	if deployment.Values != nil {
@@ -120,6 +120,37 @@ func validateAdmissionDeployment(deployment *operatorv1alpha1.AdmissionDeploymen
 	return allErrs
 }
 
+func validateDeploymentSpec(deployment operatorv1alpha1.DeploymentSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if deployment.Manifests == nil {
+		return append(allErrs, validateHelmDeployment(deployment.Helm, fldPath.Child("helm"))...)
+	}
+
+	if deployment.Helm != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("manifests"), "must not provide manifests if helm is used"))
+	}
+	allErrs = append(allErrs, validateManifestsDeployment(deployment.Manifests, fldPath.Child("manifests"))...)
+
+	return allErrs
+}
+
+func validateManifestsDeployment(manifests *operatorv1alpha1.ExtensionManifests, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	coreManifests := &gardencore.ManifestsControllerDeployment{Path: manifests.Path}
+	if manifests.OCIRepository != nil {
+		coreManifests.OCIRepository = &gardencore.OCIRepository{}
+		if err := gardenCoreScheme.Convert(manifests.OCIRepository, coreManifests.OCIRepository, nil); err != nil {
+			return append(allErrs, field.InternalError(fldPath.Child("ociRepository"), err))
+		}
+	}
+
+	allErrs = append(allErrs, gardencorevalidation.ValidateManifestsControllerDeployment(coreManifests, nil, fldPath)...)
+
+	return allErrs
+}
+
 func validateHelmDeployment(helm *operatorv1alpha1.ExtensionHelm, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -257,6 +257,64 @@ func validateExtensionTests(test func() field.ErrorList, extension func() *opera
 
 			Expect(test()).To(BeEmpty())
 		})
+
+		It("should return no errors when extension deployment has valid manifests config", func() {
+			extension().Spec.Deployment.ExtensionDeployment = &operatorv1alpha1.ExtensionDeploymentSpec{
+				DeploymentSpec: operatorv1alpha1.DeploymentSpec{
+					Manifests: &operatorv1alpha1.ExtensionManifests{
+						OCIRepository: &gardencorev1.OCIRepository{
+							Ref: new("example.com/manifests:v1.0.0"),
+						},
+						Path: new("deploy"),
+					},
+				},
+			}
+
+			Expect(test()).To(BeEmpty())
+		})
+
+		It("should return an error when extension deployment has helm and manifests config", func() {
+			extension().Spec.Deployment.ExtensionDeployment = &operatorv1alpha1.ExtensionDeploymentSpec{
+				DeploymentSpec: operatorv1alpha1.DeploymentSpec{
+					Helm: &operatorv1alpha1.ExtensionHelm{
+						OCIRepository: &gardencorev1.OCIRepository{
+							Ref: new("example.com/chart:v1.0.0"),
+						},
+					},
+					Manifests: &operatorv1alpha1.ExtensionManifests{
+						OCIRepository: &gardencorev1.OCIRepository{
+							Ref: new("example.com/manifests:v1.0.0"),
+						},
+					},
+				},
+			}
+
+			Expect(test()).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.deployment.extension.manifests"),
+			}))))
+		})
+
+		It("should return an error when admission runtime deployment has invalid manifests config", func() {
+			extension().Spec.Deployment.AdmissionDeployment = &operatorv1alpha1.AdmissionDeploymentSpec{
+				RuntimeCluster: &operatorv1alpha1.DeploymentSpec{
+					Manifests: &operatorv1alpha1.ExtensionManifests{
+						Path: new("../deploy"),
+					},
+				},
+			}
+
+			Expect(test()).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.deployment.admission.runtimeCluster.manifests.ociRepository"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.deployment.admission.runtimeCluster.manifests.path"),
+				})),
+			))
+		})
 	})
 
 	Context("Admission Deployment", func() {
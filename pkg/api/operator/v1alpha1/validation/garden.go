@@ -25,11 +25,13 @@ import (
 	"k8s.io/utils/ptr"
 
 	admissioncontrollervalidation "github.com/gardener/gardener/pkg/api/config/admissioncontroller/v1alpha1/validation"
+	schedulervalidation "github.com/gardener/gardener/pkg/api/config/scheduler/v1alpha1/validation"
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorevalidation "github.com/gardener/gardener/pkg/api/core/validation"
 	operatorv1alpha1conversion "github.com/gardener/gardener/pkg/api/operator/v1alpha1/conversion"
 	"github.com/gardener/gardener/pkg/api/operator/v1alpha1/helper"
 	admissioncontrollerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/admissioncontroller/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencoreinstall "github.com/gardener/gardener/pkg/apis/core/install"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -641,6 +643,13 @@ func validateGardenerSchedulerConfig(config *operatorv1alpha1.GardenerSchedulerC
 
 	allErrs = append(allErrs, validateGardenerFeatureGates(config.FeatureGates, fldPath.Child("featureGates"))...)
 
+	if config.Scoring != nil {
+		if strategy := ptr.Deref(config.Strategy, string(schedulerconfigv1alpha1.Default)); strategy != string(schedulerconfigv1alpha1.Scored) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("scoring"), fmt.Sprintf("scoring can only be configured for the %q strategy", schedulerconfigv1alpha1.Scored)))
+		}
+		allErrs = append(allErrs, schedulervalidation.ValidateScoring(operatorv1alpha1conversion.ConvertToSchedulerScoringConfiguration(config.Scoring), fldPath.Child("scoring"))...)
+	}
+
 	return allErrs
 }
 
@@ -2483,6 +2483,57 @@ var _ = Describe("Validation Tests", func() {
 							}))))
 						})
 					})
+
+					Context("Scoring", func() {
+						It("should allow valid scoring configurations for the Scored strategy", func() {
+							garden.Spec.VirtualCluster.Gardener.Scheduler = &operatorv1alpha1.GardenerSchedulerConfig{
+								Strategy: new("Scored"),
+								Scoring: &operatorv1alpha1.GardenerSchedulerScoring{
+									Plugins: []operatorv1alpha1.GardenerSchedulerScorePlugin{{Name: "CapacityHeadroom", Weight: 2}, {Name: "LabelAffinity", Weight: 1}},
+									LabelAffinity: &operatorv1alpha1.GardenerSchedulerLabelAffinity{
+										PreferredSeedSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"foo": "bar"}}},
+									},
+								},
+							}
+
+							Expect(ValidateGarden(garden, extensions)).To(BeEmpty())
+						})
+
+						It("should forbid scoring configurations for other strategies", func() {
+							garden.Spec.VirtualCluster.Gardener.Scheduler = &operatorv1alpha1.GardenerSchedulerConfig{
+								Scoring: &operatorv1alpha1.GardenerSchedulerScoring{},
+							}
+
+							Expect(ValidateGarden(garden, extensions)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerScheduler.scoring"),
+							}))))
+						})
+
+						It("should complain about invalid scoring configurations", func() {
+							garden.Spec.VirtualCluster.Gardener.Scheduler = &operatorv1alpha1.GardenerSchedulerConfig{
+								Strategy: new("Scored"),
+								Scoring: &operatorv1alpha1.GardenerSchedulerScoring{
+									Plugins: []operatorv1alpha1.GardenerSchedulerScorePlugin{{Name: "Foo", Weight: 1}, {Name: "SeedAge", Weight: -1}, {Name: "SeedAge", Weight: 1}},
+								},
+							}
+
+							Expect(ValidateGarden(garden, extensions)).To(ConsistOf(
+								PointTo(MatchFields(IgnoreExtras, Fields{
+									"Type":  Equal(field.ErrorTypeNotSupported),
+									"Field": Equal("spec.virtualCluster.gardener.gardenerScheduler.scoring.plugins[0].name"),
+								})),
+								PointTo(MatchFields(IgnoreExtras, Fields{
+									"Type":  Equal(field.ErrorTypeInvalid),
+									"Field": Equal("spec.virtualCluster.gardener.gardenerScheduler.scoring.plugins[1].weight"),
+								})),
+								PointTo(MatchFields(IgnoreExtras, Fields{
+									"Type":  Equal(field.ErrorTypeDuplicate),
+									"Field": Equal("spec.virtualCluster.gardener.gardenerScheduler.scoring.plugins[2].name"),
+								})),
+							))
+						})
+					})
 				})
 
 				Context("Dashboard", func() {
@@ -11,6 +11,7 @@ import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 
 	"github.com/gardener/gardener/pkg/apis/config"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 )
 
 // SetDefaults_ControllerManagerConfiguration sets defaults for the configuration of the Gardener controller manager.
@@ -305,6 +306,39 @@ func SetDefaults_ShootMigrationControllerConfiguration(obj *ShootMigrationContro
 	}
 }
 
+// SetDefaults_ShootRebalancingControllerConfiguration sets defaults for the ShootRebalancingControllerConfiguration.
+func SetDefaults_ShootRebalancingControllerConfiguration(obj *ShootRebalancingControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = new(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.Policy == nil {
+		obj.Policy = new(ShootRebalancingPolicyRecommend)
+	}
+	if obj.MinShootCountDifference == nil {
+		obj.MinShootCountDifference = new(int32(10))
+	}
+	if obj.Strategy == "" {
+		obj.Strategy = schedulerconfigv1alpha1.Default
+	}
+
+	if obj.Strategy == schedulerconfigv1alpha1.Scored {
+		if obj.Scoring == nil {
+			obj.Scoring = &schedulerconfigv1alpha1.ScoringConfiguration{}
+		}
+
+		if len(obj.Scoring.Plugins) == 0 {
+			obj.Scoring.Plugins = []schedulerconfigv1alpha1.ScorePlugin{
+				{Name: schedulerconfigv1alpha1.ScorePluginCapacityHeadroom, Weight: 2},
+				{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: 2},
+				{Name: schedulerconfigv1alpha1.ScorePluginZoneMatch, Weight: 1},
+			}
+		}
+	}
+}
+
 // SetDefaults_ManagedSeedSetControllerConfiguration sets defaults for the ManagedSeedSetControllerConfiguration.
 func SetDefaults_ManagedSeedSetControllerConfiguration(obj *ManagedSeedSetControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -15,6 +15,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/apis/config"
 	. "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 )
 
 var _ = Describe("Defaults", func() {
@@ -774,6 +775,72 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootRebalancingControllerConfiguration defaulting", func() {
+		It("should default ShootRebalancingControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootRebalancing: &ShootRebalancingControllerConfiguration{},
+				},
+			}
+			expected := &ShootRebalancingControllerConfiguration{
+				ConcurrentSyncs:         new(DefaultControllerConcurrentSyncs),
+				SyncPeriod:              &metav1.Duration{Duration: time.Hour},
+				Policy:                  new(ShootRebalancingPolicyRecommend),
+				MinShootCountDifference: new(int32(10)),
+				Strategy:                schedulerconfigv1alpha1.SameRegion,
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootRebalancing).To(Equal(expected))
+		})
+
+		It("should not default ShootRebalancingControllerConfiguration if not set", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootRebalancing).To(BeNil())
+		})
+
+		It("should default the score plugins for the Scored strategy", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootRebalancing: &ShootRebalancingControllerConfiguration{
+						Strategy: schedulerconfigv1alpha1.Scored,
+					},
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootRebalancing.Scoring).To(Equal(&schedulerconfigv1alpha1.ScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginCapacityHeadroom, Weight: 2},
+					{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: 2},
+					{Name: schedulerconfigv1alpha1.ScorePluginZoneMatch, Weight: 1},
+				},
+			}))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootRebalancing: &ShootRebalancingControllerConfiguration{
+						ConcurrentSyncs:         new(10),
+						SyncPeriod:              &metav1.Duration{Duration: 2 * time.Hour},
+						Policy:                  new(ShootRebalancingPolicyMigrate),
+						MinShootCountDifference: new(int32(3)),
+						Strategy:                schedulerconfigv1alpha1.Scored,
+						Scoring: &schedulerconfigv1alpha1.ScoringConfiguration{
+							Plugins: []schedulerconfigv1alpha1.ScorePlugin{{Name: schedulerconfigv1alpha1.ScorePluginSeedAge, Weight: 1}},
+						},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootRebalancing.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootRebalancing).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootStatusLabelControllerConfiguration defaulting", func() {
 		It("should default ShootStatusLabelControllerConfiguration correctly", func() {
 			expected := &ShootStatusLabelControllerConfiguration{
@@ -8,6 +8,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -116,6 +118,10 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootMigration defines the configuration of the ShootMigration controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	ShootMigration *ShootMigrationControllerConfiguration `json:"shootMigration,omitempty"`
+	// ShootRebalancing defines the configuration of the ShootRebalancing controller. If unset, the controller will be
+	// disabled.
+	// +optional
+	ShootRebalancing *ShootRebalancingControllerConfiguration `json:"shootRebalancing,omitempty"`
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	// +optional
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration `json:"managedSeedSet,omitempty"`
@@ -433,6 +439,44 @@ type ShootMigrationControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootRebalancingControllerConfiguration defines the configuration of the
+// ShootRebalancing controller.
+type ShootRebalancingControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the placement of a shoot is re-evaluated (defaults to 1h).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Policy defines what the controller does when it found a better seed for a shoot (defaults to `Recommend`).
+	// +optional
+	Policy *ShootRebalancingPolicy `json:"policy,omitempty"`
+	// MinShootCountDifference is the minimum difference between the number of shoots on the current seed and the number
+	// of shoots on the better seed (including the shoot to move) for which a migration is proposed. It prevents moving
+	// shoots back and forth between similarly loaded seeds (defaults to 10).
+	// +optional
+	MinShootCountDifference *int32 `json:"minShootCountDifference,omitempty"`
+	// Strategy defines how seeds for shoots are determined, see the configuration of the gardener-scheduler (defaults
+	// to `SameRegion`). It should match the strategy configured for the gardener-scheduler.
+	// +optional
+	Strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy `json:"strategy,omitempty"`
+	// Scoring contains the configuration of the score plugins used by the `Scored` strategy.
+	// +optional
+	Scoring *schedulerconfigv1alpha1.ScoringConfiguration `json:"scoring,omitempty"`
+}
+
+// ShootRebalancingPolicy is a type for policies of the ShootRebalancing controller.
+type ShootRebalancingPolicy string
+
+const (
+	// ShootRebalancingPolicyRecommend only annotates the shoot with the recommended seed and emits an event.
+	ShootRebalancingPolicyRecommend ShootRebalancingPolicy = "Recommend"
+	// ShootRebalancingPolicyMigrate additionally triggers the control plane migration to the recommended seed within
+	// the maintenance time window of the shoot.
+	ShootRebalancingPolicyMigrate ShootRebalancingPolicy = "Migrate"
+)
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
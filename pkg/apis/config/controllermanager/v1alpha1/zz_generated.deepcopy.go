@@ -10,6 +10,7 @@
 package v1alpha1
 
 import (
+	schedulerv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -307,6 +308,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootMigrationControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootRebalancing != nil {
+		in, out := &in.ShootRebalancing, &out.ShootRebalancing
+		*out = new(ShootRebalancingControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedSeedSet != nil {
 		in, out := &in.ManagedSeedSet, &out.ManagedSeedSet
 		*out = new(ManagedSeedSetControllerConfiguration)
@@ -870,6 +876,47 @@ func (in *ShootQuotaControllerConfiguration) DeepCopy() *ShootQuotaControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRebalancingControllerConfiguration) DeepCopyInto(out *ShootRebalancingControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(ShootRebalancingPolicy)
+		**out = **in
+	}
+	if in.MinShootCountDifference != nil {
+		in, out := &in.MinShootCountDifference, &out.MinShootCountDifference
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Scoring != nil {
+		in, out := &in.Scoring, &out.Scoring
+		*out = new(schedulerv1alpha1.ScoringConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRebalancingControllerConfiguration.
+func (in *ShootRebalancingControllerConfiguration) DeepCopy() *ShootRebalancingControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRebalancingControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootReferenceControllerConfiguration) DeepCopyInto(out *ShootReferenceControllerConfiguration) {
 	*out = *in
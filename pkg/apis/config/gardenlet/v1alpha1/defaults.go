@@ -62,6 +62,10 @@ func SetDefaults_GardenletConfiguration(obj *GardenletConfiguration) {
 		obj.ETCDConfig = &ETCDConfig{}
 	}
 
+	if obj.HelmChartCache == nil {
+		obj.HelmChartCache = &HelmChartCacheConfiguration{}
+	}
+
 	SetDefaults_ExposureClassHandler(obj.ExposureClassHandlers)
 }
 
@@ -170,6 +174,9 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.ManagedSeed == nil {
 		obj.ManagedSeed = &ManagedSeedControllerConfiguration{}
 	}
+	if obj.ManagedResourceRollout == nil {
+		obj.ManagedResourceRollout = &ManagedResourceRolloutControllerConfiguration{}
+	}
 	if obj.TokenRequestorServiceAccount == nil {
 		obj.TokenRequestorServiceAccount = &TokenRequestorServiceAccountControllerConfiguration{}
 	}
@@ -421,6 +428,21 @@ func SetDefaults_ManagedSeedControllerConfiguration(obj *ManagedSeedControllerCo
 	}
 }
 
+// SetDefaults_ManagedResourceRolloutControllerConfiguration sets defaults for the ManagedResourceRollout controller.
+func SetDefaults_ManagedResourceRolloutControllerConfiguration(obj *ManagedResourceRolloutControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = new(5)
+	}
+
+	if len(obj.Waves) == 0 {
+		obj.Waves = []int32{10, 50, 100}
+	}
+
+	if obj.SoakDuration == nil {
+		obj.SoakDuration = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
+
 // SetDefaults_TokenRequestorServiceAccountControllerConfiguration sets defaults for the TokenRequestorServiceAccount controller.
 func SetDefaults_TokenRequestorServiceAccountControllerConfiguration(obj *TokenRequestorServiceAccountControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -513,6 +535,20 @@ func SetDefaults_Logging(obj *Logging) {
 	}
 }
 
+// SetDefaults_HelmChartCacheConfiguration sets defaults for the configuration of the Helm chart cache.
+func SetDefaults_HelmChartCacheConfiguration(obj *HelmChartCacheConfiguration) {
+	if obj.MaxSize == nil {
+		obj.MaxSize = &DefaultHelmChartCacheMaxSize
+	}
+}
+
+// SetDefaults_VaultTransitKeyBackend sets defaults for the Vault transit key backend.
+func SetDefaults_VaultTransitKeyBackend(obj *VaultTransitKeyBackend) {
+	if obj.MountPath == nil {
+		obj.MountPath = new("transit")
+	}
+}
+
 // SetDefaults_ETCDConfig sets defaults for the ETCD.
 func SetDefaults_ETCDConfig(obj *ETCDConfig) {
 	if obj.ETCDController == nil {
@@ -52,6 +52,7 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.SNI).NotTo(BeNil())
 			Expect(obj.Monitoring).NotTo(BeNil())
 			Expect(obj.ETCDConfig).NotTo(BeNil())
+			Expect(obj.HelmChartCache).NotTo(BeNil())
 		})
 
 		It("should not overwrite already set values for the gardenlet configuration", func() {
@@ -550,6 +551,32 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ManagedResourceRolloutControllerConfiguration defaulting", func() {
+		It("should default the managed resource rollout controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ManagedResourceRollout.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ManagedResourceRollout.ManagedResourceNames).To(BeEmpty())
+			Expect(obj.Controllers.ManagedResourceRollout.Waves).To(Equal([]int32{10, 50, 100}))
+			Expect(obj.Controllers.ManagedResourceRollout.SoakDuration).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+		})
+
+		It("should not overwrite already set values for the managed resource rollout controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ManagedResourceRollout: &ManagedResourceRolloutControllerConfiguration{
+					ConcurrentSyncs: new(10),
+					Waves:           []int32{25, 100},
+					SoakDuration:    &metav1.Duration{Duration: time.Minute},
+				},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ManagedResourceRollout.ConcurrentSyncs).To(PointTo(Equal(10)))
+			Expect(obj.Controllers.ManagedResourceRollout.Waves).To(Equal([]int32{25, 100}))
+			Expect(obj.Controllers.ManagedResourceRollout.SoakDuration).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+		})
+	})
+
 	Describe("VPAEvictionRequirementsControllerConfiguration defaulting", func() {
 		It("should default the VPA eviction requirements controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -866,6 +893,42 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("HelmChartCacheConfiguration defaulting", func() {
+		It("should default the Helm chart cache configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.HelmChartCache.Directory).To(BeNil())
+			Expect(obj.HelmChartCache.MaxSize).To(PointTo(Equal(resource.MustParse("256Mi"))))
+		})
+
+		It("should not overwrite already set values for the Helm chart cache configuration", func() {
+			obj.HelmChartCache = &HelmChartCacheConfiguration{
+				Directory: new("/var/cache/charts"),
+				MaxSize:   new(resource.MustParse("1Gi")),
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.HelmChartCache.Directory).To(PointTo(Equal("/var/cache/charts")))
+			Expect(obj.HelmChartCache.MaxSize).To(PointTo(Equal(resource.MustParse("1Gi"))))
+		})
+	})
+
+	Describe("VaultTransitKeyBackend defaulting", func() {
+		It("should default the mount path", func() {
+			obj.SecretsManagement = &SecretsManagementConfiguration{KeyBackend: &KeyBackendConfiguration{VaultTransit: &VaultTransitKeyBackend{}}}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.SecretsManagement.KeyBackend.VaultTransit.MountPath).To(PointTo(Equal("transit")))
+		})
+
+		It("should not overwrite an already set mount path", func() {
+			obj.SecretsManagement = &SecretsManagementConfiguration{KeyBackend: &KeyBackendConfiguration{VaultTransit: &VaultTransitKeyBackend{MountPath: new("keys")}}}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.SecretsManagement.KeyBackend.VaultTransit.MountPath).To(PointTo(Equal("keys")))
+		})
+	})
+
 	Describe("MonitoringConfig defaulting", func() {
 		It("should default the monitoring configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -92,6 +92,15 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// HelmChartCache contains the configuration for the cache of Helm charts pulled from OCI registries.
+	// +optional
+	HelmChartCache *HelmChartCacheConfiguration `json:"helmChartCache,omitempty"`
+	// SecretsManagement contains configuration for the management of secrets by gardenlet.
+	// +optional
+	SecretsManagement *SecretsManagementConfiguration `json:"secretsManagement,omitempty"`
+	// NodeAgent contains configuration for the gardener-node-agent running on the worker nodes of shoot clusters.
+	// +optional
+	NodeAgent *NodeAgentConfiguration `json:"nodeAgent,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -202,6 +211,9 @@ type GardenletControllerConfiguration struct {
 	// ManagedSeed defines the configuration of the ManagedSeed controller.
 	// +optional
 	ManagedSeed *ManagedSeedControllerConfiguration `json:"managedSeed,omitempty"`
+	// ManagedResourceRollout defines the configuration of the ManagedResourceRollout controller.
+	// +optional
+	ManagedResourceRollout *ManagedResourceRolloutControllerConfiguration `json:"managedResourceRollout,omitempty"`
 	// TokenRequestorServiceAccount defines the configuration of the TokenRequestorServiceAccount controller.
 	// +optional
 	TokenRequestorServiceAccount *TokenRequestorServiceAccountControllerConfiguration `json:"tokenRequestor,omitempty"` // The name of the field differs from the json property in order to not introduce incompatible changes when it was changed after its first introduction.
@@ -448,6 +460,26 @@ type ManagedSeedControllerConfiguration struct {
 	JitterUpdates *bool `json:"jitterUpdates,omitempty"`
 }
 
+// ManagedResourceRolloutControllerConfiguration defines the configuration of the ManagedResourceRollout controller.
+type ManagedResourceRolloutControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// ManagedResourceNames is a list of names of ManagedResources in the control plane namespaces of shoots whose
+	// changes shall be rolled out progressively. gardenlet labels them with the `resources.gardener.cloud/rollout-group`
+	// label when deploying them, using their name as rollout group.
+	// +optional
+	ManagedResourceNames []string `json:"managedResourceNames,omitempty"`
+	// Waves is a list of cumulative percentages of the ManagedResources of a rollout group to which changes are rolled
+	// out in subsequent waves. The values must be increasing and the last value must be 100. Defaults to [10, 50, 100].
+	// +optional
+	Waves []int32 `json:"waves,omitempty"`
+	// SoakDuration is the duration for which the ManagedResources of a wave must be healthy before the next wave is
+	// started. Defaults to 5m.
+	// +optional
+	SoakDuration *metav1.Duration `json:"soakDuration,omitempty"`
+}
+
 // TokenRequestorServiceAccountControllerConfiguration defines the configuration of the TokenRequestorServiceAccount controller.
 type TokenRequestorServiceAccountControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on events.
@@ -609,6 +641,93 @@ type SNIIngress struct {
 	Labels map[string]string `json:"labels,omitempty"`
 }
 
+// HelmChartCacheConfiguration contains the configuration for the cache of Helm charts pulled from OCI registries.
+type HelmChartCacheConfiguration struct {
+	// Directory is the directory in which the pulled Helm charts are persisted, so that they survive restarts. If not
+	// set, the Helm charts are only cached in memory.
+	// +optional
+	Directory *string `json:"directory,omitempty"`
+	// MaxSize is the maximum total size of all cached Helm charts. When it is exceeded, the least recently used Helm
+	// charts are evicted.
+	// Defaults to 256Mi.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+}
+
+// NodeAgentConfiguration contains configuration for the gardener-node-agent running on the worker nodes of shoot
+// clusters.
+type NodeAgentConfiguration struct {
+	// ImageVerification configures the verification of the cosign signatures of the images from which
+	// gardener-node-agent extracts files, e.g., its own binary or the kubelet. If set, the configuration is added to the
+	// OperatingSystemConfigs of all shoots and files are only extracted from images signed by any of the trusted keys.
+	// +optional
+	ImageVerification *NodeAgentImageVerification `json:"imageVerification,omitempty"`
+	// DriftDetectionMode determines how gardener-node-agent handles drift of the files and units managed by the
+	// OperatingSystemConfigs of all shoots. Possible values are "Report" and "Restore". In mode "Report", drift is only
+	// reported, in mode "Restore", the applied state is restored in addition. If not set, drift is only reported.
+	// +optional
+	DriftDetectionMode *string `json:"driftDetectionMode,omitempty"`
+}
+
+// NodeAgentImageVerification contains the configuration for verifying the signatures of images.
+type NodeAgentImageVerification struct {
+	// PublicKeys is a list of PEM-encoded public keys (ECDSA, RSA, or Ed25519) which are trusted for signing images.
+	PublicKeys []string `json:"publicKeys"`
+}
+
+// SecretsManagementConfiguration contains configuration for the management of secrets by gardenlet.
+type SecretsManagementConfiguration struct {
+	// KeyBackend configures an external backend managing the private keys of those shoot CAs whose private keys are not
+	// read by any component. If not set, the private keys are stored in the CA secrets.
+	// +optional
+	KeyBackend *KeyBackendConfiguration `json:"keyBackend,omitempty"`
+	// KeyAlgorithm is the algorithm of the private keys generated for the CAs, certificates and service account signing
+	// keys of shoots. Possible values are "RSA", "ECDSA-P256" and "ECDSA-P384". Existing secrets are migrated to the
+	// algorithm the next time they are rotated. If not set, RSA keys are generated.
+	// +optional
+	KeyAlgorithm *string `json:"keyAlgorithm,omitempty"`
+	// InventoryMetrics configures the export of metrics about the secrets managed by secrets managers in the seed cluster.
+	// +optional
+	InventoryMetrics *SecretsInventoryMetricsConfiguration `json:"inventoryMetrics,omitempty"`
+}
+
+// SecretsInventoryMetricsConfiguration contains the configuration of the secrets inventory metrics.
+type SecretsInventoryMetricsConfiguration struct {
+	// Enabled controls whether the metrics about the age and validity of the secrets managed by secrets managers are
+	// exported. The secrets are read from the API server on every scrape.
+	// Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// KeyBackendConfiguration contains the configuration of an external backend managing private keys.
+type KeyBackendConfiguration struct {
+	// VaultTransit configures the transit secrets engine of HashiCorp Vault (or OpenBao) as key backend.
+	// +optional
+	VaultTransit *VaultTransitKeyBackend `json:"vaultTransit,omitempty"`
+}
+
+// VaultTransitKeyBackend contains the configuration for using the transit secrets engine of HashiCorp Vault (or
+// OpenBao) as key backend.
+type VaultTransitKeyBackend struct {
+	// Address is the address of the Vault server, e.g. https://vault.example.com:8200.
+	Address string `json:"address"`
+	// MountPath is the path the transit secrets engine is mounted at.
+	// Defaults to "transit".
+	// +optional
+	MountPath *string `json:"mountPath,omitempty"`
+	// Namespace is the Vault namespace (Vault Enterprise only).
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+	// TokenFile is the path to a file containing the Vault token. The file is read for every request, so a rotated
+	// token is picked up without restarting gardenlet.
+	TokenFile string `json:"tokenFile"`
+	// CAFile is the path to a file containing the CA bundle for verifying the serving certificate of the Vault server.
+	// If not set, the system's trust store is used.
+	// +optional
+	CAFile *string `json:"caFile,omitempty"`
+}
+
 // ETCDConfig contains ETCD related configs
 type ETCDConfig struct {
 	// ETCDController contains config specific to ETCD controller
@@ -763,6 +882,9 @@ var DefaultCentralValiStorage = resource.MustParse("100Gi")
 // DefaultCentralVictoriaLogsStorage is a default value for garden/victoria-logs's storage.
 var DefaultCentralVictoriaLogsStorage = resource.MustParse("100Gi")
 
+// DefaultHelmChartCacheMaxSize is a default value for the maximum size of the Helm chart cache.
+var DefaultHelmChartCacheMaxSize = resource.MustParse("256Mi")
+
 // NodeToleration contains information about node toleration options.
 type NodeToleration struct {
 	// DefaultNotReadyTolerationSeconds specifies the seconds for the `node.kubernetes.io/not-ready` toleration that
@@ -517,6 +517,21 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HelmChartCache != nil {
+		in, out := &in.HelmChartCache, &out.HelmChartCache
+		*out = new(HelmChartCacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretsManagement != nil {
+		in, out := &in.SecretsManagement, &out.SecretsManagement
+		*out = new(SecretsManagementConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeAgent != nil {
+		in, out := &in.NodeAgent, &out.NodeAgent
+		*out = new(NodeAgentConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -621,6 +636,11 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(ManagedSeedControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManagedResourceRollout != nil {
+		in, out := &in.ManagedResourceRollout, &out.ManagedResourceRollout
+		*out = new(ManagedResourceRolloutControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TokenRequestorServiceAccount != nil {
 		in, out := &in.TokenRequestorServiceAccount, &out.TokenRequestorServiceAccount
 		*out = new(TokenRequestorServiceAccountControllerConfiguration)
@@ -670,6 +690,53 @@ func (in *GardenletObjectControllerConfiguration) DeepCopy() *GardenletObjectCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartCacheConfiguration) DeepCopyInto(out *HelmChartCacheConfiguration) {
+	*out = *in
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartCacheConfiguration.
+func (in *HelmChartCacheConfiguration) DeepCopy() *HelmChartCacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartCacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyBackendConfiguration) DeepCopyInto(out *KeyBackendConfiguration) {
+	*out = *in
+	if in.VaultTransit != nil {
+		in, out := &in.VaultTransit, &out.VaultTransit
+		*out = new(VaultTransitKeyBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyBackendConfiguration.
+func (in *KeyBackendConfiguration) DeepCopy() *KeyBackendConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyBackendConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeconfigValidity) DeepCopyInto(out *KubeconfigValidity) {
 	*out = *in
@@ -770,6 +837,42 @@ func (in *Logging) DeepCopy() *Logging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedResourceRolloutControllerConfiguration) DeepCopyInto(out *ManagedResourceRolloutControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.ManagedResourceNames != nil {
+		in, out := &in.ManagedResourceNames, &out.ManagedResourceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.SoakDuration != nil {
+		in, out := &in.SoakDuration, &out.SoakDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedResourceRolloutControllerConfiguration.
+func (in *ManagedResourceRolloutControllerConfiguration) DeepCopy() *ManagedResourceRolloutControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedResourceRolloutControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedSeedControllerConfiguration) DeepCopyInto(out *ManagedSeedControllerConfiguration) {
 	*out = *in
@@ -860,6 +963,53 @@ func (in *NetworkPolicyControllerConfiguration) DeepCopy() *NetworkPolicyControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
+	*out = *in
+	if in.ImageVerification != nil {
+		in, out := &in.ImageVerification, &out.ImageVerification
+		*out = new(NodeAgentImageVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DriftDetectionMode != nil {
+		in, out := &in.DriftDetectionMode, &out.DriftDetectionMode
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAgentConfiguration.
+func (in *NodeAgentConfiguration) DeepCopy() *NodeAgentConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAgentConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAgentImageVerification) DeepCopyInto(out *NodeAgentImageVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAgentImageVerification.
+func (in *NodeAgentImageVerification) DeepCopy() *NodeAgentImageVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAgentImageVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeToleration) DeepCopyInto(out *NodeToleration) {
 	*out = *in
@@ -996,6 +1146,58 @@ func (in *SNIIngress) DeepCopy() *SNIIngress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsInventoryMetricsConfiguration) DeepCopyInto(out *SecretsInventoryMetricsConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsInventoryMetricsConfiguration.
+func (in *SecretsInventoryMetricsConfiguration) DeepCopy() *SecretsInventoryMetricsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsInventoryMetricsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsManagementConfiguration) DeepCopyInto(out *SecretsManagementConfiguration) {
+	*out = *in
+	if in.KeyBackend != nil {
+		in, out := &in.KeyBackend, &out.KeyBackend
+		*out = new(KeyBackendConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyAlgorithm != nil {
+		in, out := &in.KeyAlgorithm, &out.KeyAlgorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.InventoryMetrics != nil {
+		in, out := &in.InventoryMetrics, &out.InventoryMetrics
+		*out = new(SecretsInventoryMetricsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsManagementConfiguration.
+func (in *SecretsManagementConfiguration) DeepCopy() *SecretsManagementConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsManagementConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedCareControllerConfiguration) DeepCopyInto(out *SeedCareControllerConfiguration) {
 	*out = *in
@@ -1480,6 +1682,37 @@ func (in *Vali) DeepCopy() *Vali {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTransitKeyBackend) DeepCopyInto(out *VaultTransitKeyBackend) {
+	*out = *in
+	if in.MountPath != nil {
+		in, out := &in.MountPath, &out.MountPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.CAFile != nil {
+		in, out := &in.CAFile, &out.CAFile
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTransitKeyBackend.
+func (in *VaultTransitKeyBackend) DeepCopy() *VaultTransitKeyBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTransitKeyBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VictoriaLogs) DeepCopyInto(out *VictoriaLogs) {
 	*out = *in
@@ -83,6 +83,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		if in.Controllers.ManagedSeed != nil {
 			SetDefaults_ManagedSeedControllerConfiguration(in.Controllers.ManagedSeed)
 		}
+		if in.Controllers.ManagedResourceRollout != nil {
+			SetDefaults_ManagedResourceRolloutControllerConfiguration(in.Controllers.ManagedResourceRollout)
+		}
 		if in.Controllers.TokenRequestorServiceAccount != nil {
 			SetDefaults_TokenRequestorServiceAccountControllerConfiguration(in.Controllers.TokenRequestorServiceAccount)
 		}
@@ -133,4 +136,14 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 			SetDefaults_ShootMonitoringConfig(in.Monitoring.Shoot)
 		}
 	}
+	if in.HelmChartCache != nil {
+		SetDefaults_HelmChartCacheConfiguration(in.HelmChartCache)
+	}
+	if in.SecretsManagement != nil {
+		if in.SecretsManagement.KeyBackend != nil {
+			if in.SecretsManagement.KeyBackend.VaultTransit != nil {
+				SetDefaults_VaultTransitKeyBackend(in.SecretsManagement.KeyBackend.VaultTransit)
+			}
+		}
+	}
 }
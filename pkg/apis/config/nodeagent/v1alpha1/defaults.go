@@ -52,6 +52,16 @@ func SetDefaults_SystemdUnitCheckControllerConfig(obj *SystemdUnitCheckControlle
 	}
 }
 
+// SetDefaults_DriftDetectionControllerConfig sets defaults for the DriftDetectionControllerConfig object.
+func SetDefaults_DriftDetectionControllerConfig(obj *DriftDetectionControllerConfig) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.Mode == nil {
+		obj.Mode = new(DriftDetectionModeReport)
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	componentbaseconfigv1alpha1.RecommendedDefaultClientConnectionConfiguration(obj)
@@ -72,4 +82,14 @@ func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	if obj.Metrics.Port == 0 {
 		obj.Metrics.Port = 2752
 	}
+
+	if obj.Status == nil {
+		obj.Status = &Server{}
+	}
+	if obj.Status.BindAddress == "" {
+		obj.Status.BindAddress = "127.0.0.1"
+	}
+	if obj.Status.Port == 0 {
+		obj.Status.Port = 2753
+	}
 }
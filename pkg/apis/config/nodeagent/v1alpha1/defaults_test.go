@@ -109,6 +109,29 @@ var _ = Describe("Defaults", func() {
 					Expect(obj.StuckThreshold).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
 				})
 			})
+
+			Describe("Drift Detection controller", func() {
+				It("should default the object", func() {
+					obj := &DriftDetectionControllerConfig{}
+
+					SetDefaults_DriftDetectionControllerConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+					Expect(obj.Mode).To(PointTo(Equal(DriftDetectionModeReport)))
+				})
+
+				It("should not overwrite existing values", func() {
+					obj := &DriftDetectionControllerConfig{
+						SyncPeriod: &metav1.Duration{Duration: time.Minute},
+						Mode:       new(DriftDetectionModeRestore),
+					}
+
+					SetDefaults_DriftDetectionControllerConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					Expect(obj.Mode).To(PointTo(Equal(DriftDetectionModeRestore)))
+				})
+			})
 		})
 
 		Describe("Server configuration", func() {
@@ -121,12 +144,15 @@ var _ = Describe("Defaults", func() {
 				Expect(obj.HealthProbes.Port).To(Equal(2751))
 				Expect(obj.Metrics.BindAddress).To(BeEmpty())
 				Expect(obj.Metrics.Port).To(Equal(2752))
+				Expect(obj.Status.BindAddress).To(Equal("127.0.0.1"))
+				Expect(obj.Status.Port).To(Equal(2753))
 			})
 
 			It("should not overwrite existing values", func() {
 				obj := &ServerConfiguration{
 					HealthProbes: &Server{BindAddress: "1", Port: 2345},
 					Metrics:      &Server{BindAddress: "6", Port: 7890},
+					Status:       &Server{BindAddress: "::1", Port: 1234},
 				}
 
 				SetDefaults_ServerConfiguration(obj)
@@ -135,6 +161,8 @@ var _ = Describe("Defaults", func() {
 				Expect(obj.HealthProbes.Port).To(Equal(2345))
 				Expect(obj.Metrics.BindAddress).To(Equal("6"))
 				Expect(obj.Metrics.Port).To(Equal(7890))
+				Expect(obj.Status.BindAddress).To(Equal("::1"))
+				Expect(obj.Status.Port).To(Equal(1234))
 			})
 		})
 	})
@@ -37,6 +37,9 @@ const (
 	ZoneFilePath = BaseDir + "/zone"
 	// LastAppliedOperatingSystemConfigFilePath is the file path on the worker node that contains the last applied OSC information.
 	LastAppliedOperatingSystemConfigFilePath = BaseDir + "/last-applied-osc.yaml"
+	// AppliedFileChecksumsFilePath is the file path on the worker node that contains the checksums of all files and
+	// units written for the last applied OSC.
+	AppliedFileChecksumsFilePath = BaseDir + "/applied-file-checksums.yaml"
 
 	// UnitName is the name of the gardener-node-agent systemd service.
 	UnitName = "gardener-node-agent.service"
@@ -55,6 +58,20 @@ const (
 
 	// ConditionTypeSystemdUnitsReady is the node condition type indicating whether all managed systemd units are healthy.
 	ConditionTypeSystemdUnitsReady corev1.NodeConditionType = "SystemdUnitsReady"
+	// ConditionTypeImagesVerified is the node condition type indicating whether the container images from which files
+	// are extracted have been verified successfully.
+	ConditionTypeImagesVerified corev1.NodeConditionType = "ImagesVerified"
+	// ConditionTypeOperatingSystemConfigApplied is the node condition type indicating whether the last operating system
+	// config has been applied successfully or whether it has been rolled back.
+	ConditionTypeOperatingSystemConfigApplied corev1.NodeConditionType = "OperatingSystemConfigApplied"
+	// ConditionTypeConfigurationDriftFree is the node condition type indicating whether the files and units managed by
+	// the operating system config still match the applied state.
+	ConditionTypeConfigurationDriftFree corev1.NodeConditionType = "ConfigurationDriftFree"
+
+	// ConditionReasonOperatingSystemConfigChangesHeld is the reason of the OperatingSystemConfigApplied node condition
+	// indicating that the changes of the current operating system config are held back until the next maintenance
+	// time window.
+	ConditionReasonOperatingSystemConfigChangesHeld = "OSCChangesHeld"
 )
 
 // OSVersionRegex is a regular expression to match operating system versions.
@@ -116,6 +133,8 @@ type ControllerConfiguration struct {
 	Token TokenControllerConfig `json:"token"`
 	// SystemdUnitCheck is the configuration for the systemd unit check controller.
 	SystemdUnitCheck SystemdUnitCheckControllerConfig `json:"systemdUnitCheck"`
+	// DriftDetection is the configuration for the drift detection controller.
+	DriftDetection DriftDetectionControllerConfig `json:"driftDetection"`
 }
 
 // OperatingSystemConfigControllerConfig defines the configuration of the operating system config controller.
@@ -161,6 +180,28 @@ type SystemdUnitCheckControllerConfig struct {
 	StuckThreshold *metav1.Duration `json:"stuckThreshold,omitempty"`
 }
 
+// DriftDetectionControllerConfig defines the configuration of the drift detection controller.
+type DriftDetectionControllerConfig struct {
+	// SyncPeriod determines how frequent the files and units managed by the operating system config are checked for
+	// drift.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Mode determines how detected drift is handled. In mode `Report`, drift is only reported via the node condition and
+	// metrics. In mode `Restore`, the applied state is restored in addition. Defaults to `Report`.
+	// +optional
+	Mode *DriftDetectionMode `json:"mode,omitempty"`
+}
+
+// DriftDetectionMode is a type for the mode of the drift detection controller.
+type DriftDetectionMode string
+
+const (
+	// DriftDetectionModeReport is the mode in which drift is only reported.
+	DriftDetectionModeReport DriftDetectionMode = "Report"
+	// DriftDetectionModeRestore is the mode in which drift is reported and the applied state is restored.
+	DriftDetectionModeRestore DriftDetectionMode = "Restore"
+)
+
 // ServerConfiguration contains details for the HTTP(S) servers.
 type ServerConfiguration struct {
 	// HealthProbes is the configuration for serving the healthz and readyz endpoints.
@@ -169,6 +210,10 @@ type ServerConfiguration struct {
 	// Metrics is the configuration for serving the metrics endpoint.
 	// +optional
 	Metrics *Server `json:"metrics,omitempty"`
+	// Status is the configuration for serving the local read-only status API. The bind address must be a loopback
+	// address.
+	// +optional
+	Status *Server `json:"status,omitempty"`
 }
 
 // Server contains information for HTTP(S) server configuration.
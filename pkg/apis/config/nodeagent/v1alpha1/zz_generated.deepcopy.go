@@ -59,6 +59,7 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	in.OperatingSystemConfig.DeepCopyInto(&out.OperatingSystemConfig)
 	in.Token.DeepCopyInto(&out.Token)
 	in.SystemdUnitCheck.DeepCopyInto(&out.SystemdUnitCheck)
+	in.DriftDetection.DeepCopyInto(&out.DriftDetection)
 	return
 }
 
@@ -72,6 +73,32 @@ func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionControllerConfig) DeepCopyInto(out *DriftDetectionControllerConfig) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(DriftDetectionMode)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionControllerConfig.
+func (in *DriftDetectionControllerConfig) DeepCopy() *DriftDetectionControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
 	*out = *in
@@ -173,6 +200,11 @@ func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 		*out = new(Server)
 		**out = **in
 	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(Server)
+		**out = **in
+	}
 	return
 }
 
@@ -28,4 +28,5 @@ func SetObjectDefaults_NodeAgentConfiguration(in *NodeAgentConfiguration) {
 	SetDefaults_OperatingSystemConfigControllerConfig(&in.Controllers.OperatingSystemConfig)
 	SetDefaults_TokenControllerConfig(&in.Controllers.Token)
 	SetDefaults_SystemdUnitCheckControllerConfig(&in.Controllers.SystemdUnitCheck)
+	SetDefaults_DriftDetectionControllerConfig(&in.Controllers.DriftDetection)
 }
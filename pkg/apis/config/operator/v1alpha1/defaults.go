@@ -22,6 +22,11 @@ func SetDefaults_OperatorConfiguration(obj *OperatorConfiguration) {
 	if obj.LogFormat == "" {
 		obj.LogFormat = config.LogFormatJSON
 	}
+	if obj.HelmChartCache == nil {
+		obj.HelmChartCache = &gardenletconfigv1alpha1.HelmChartCacheConfiguration{}
+	}
+
+	gardenletconfigv1alpha1.SetDefaults_HelmChartCacheConfiguration(obj.HelmChartCache)
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 
@@ -31,6 +32,7 @@ var _ = Describe("Defaults", func() {
 
 			Expect(obj.LogLevel).To(Equal(config.LogLevelInfo))
 			Expect(obj.LogFormat).To(Equal(config.LogFormatJSON))
+			Expect(obj.HelmChartCache).To(Equal(&v1alpha1.HelmChartCacheConfiguration{MaxSize: new(resource.MustParse("256Mi"))}))
 		})
 
 		It("should not overwrite already set values for OperatorConfiguration", func() {
@@ -41,11 +43,13 @@ var _ = Describe("Defaults", func() {
 
 			obj.LogLevel = expectedLogLevel
 			obj.LogFormat = expectedLogFormat
+			obj.HelmChartCache = &v1alpha1.HelmChartCacheConfiguration{MaxSize: new(resource.MustParse("1Gi"))}
 
 			SetObjectDefaults_OperatorConfiguration(obj)
 
 			Expect(obj.LogLevel).To(Equal(expectedLogLevel))
 			Expect(obj.LogFormat).To(Equal(expectedLogFormat))
+			Expect(obj.HelmChartCache.MaxSize).To(PointTo(Equal(resource.MustParse("1Gi"))))
 		})
 	})
 
@@ -44,6 +44,9 @@ type OperatorConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeTolerationConfiguration `json:"nodeToleration,omitempty"`
+	// HelmChartCache contains the configuration for the cache of Helm charts pulled from OCI registries.
+	// +optional
+	HelmChartCache *gardenletconfigv1alpha1.HelmChartCacheConfiguration `json:"helmChartCache,omitempty"`
 }
 
 // ConditionThreshold defines the threshold of the given condition type.
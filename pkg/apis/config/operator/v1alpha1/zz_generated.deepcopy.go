@@ -332,6 +332,11 @@ func (in *OperatorConfiguration) DeepCopyInto(out *OperatorConfiguration) {
 		*out = new(NodeTolerationConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HelmChartCache != nil {
+		in, out := &in.HelmChartCache, &out.HelmChartCache
+		*out = new(gardenletv1alpha1.HelmChartCacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -46,6 +46,20 @@ func SetDefaults_SchedulerControllerConfiguration(obj *SchedulerControllerConfig
 	if obj.Shoot.ConcurrentSyncs == 0 {
 		obj.Shoot.ConcurrentSyncs = 5
 	}
+
+	if obj.Shoot.Strategy == Scored {
+		if obj.Shoot.Scoring == nil {
+			obj.Shoot.Scoring = &ScoringConfiguration{}
+		}
+
+		if len(obj.Shoot.Scoring.Plugins) == 0 {
+			obj.Shoot.Scoring.Plugins = []ScorePlugin{
+				{Name: ScorePluginCapacityHeadroom, Weight: 2},
+				{Name: ScorePluginRegionDistance, Weight: 2},
+				{Name: ScorePluginZoneMatch, Weight: 1},
+			}
+		}
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
@@ -87,6 +87,34 @@ var _ = Describe("Defaults", func() {
 				},
 			}))
 		})
+
+		It("should default the score plugins for the Scored strategy", func() {
+			obj.Schedulers.Shoot = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy: schedulerconfigv1alpha1.Scored,
+			}
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.Scoring).To(Equal(&schedulerconfigv1alpha1.ScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginCapacityHeadroom, Weight: 2},
+					{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: 2},
+					{Name: schedulerconfigv1alpha1.ScorePluginZoneMatch, Weight: 1},
+				},
+			}))
+		})
+
+		It("should not overwrite already set score plugins for the Scored strategy", func() {
+			plugins := []schedulerconfigv1alpha1.ScorePlugin{{Name: schedulerconfigv1alpha1.ScorePluginSeedAge, Weight: 1}}
+			obj.Schedulers.Shoot = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Strategy: schedulerconfigv1alpha1.Scored,
+				Scoring:  &schedulerconfigv1alpha1.ScoringConfiguration{Plugins: plugins},
+			}
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.Scoring.Plugins).To(Equal(plugins))
+		})
 	})
 
 	Describe("ServerConfiguration defaulting", func() {
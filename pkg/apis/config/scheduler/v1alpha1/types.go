@@ -14,6 +14,9 @@ const (
 	SameRegion CandidateDeterminationStrategy = "SameRegion"
 	// MinimalDistance Strategy determines a seed candidate for a shoot if the cloud profile are identical. Then chooses the seed with the minimal distance to the shoot.
 	MinimalDistance CandidateDeterminationStrategy = "MinimalDistance"
+	// Scored Strategy determines all seed candidates for a shoot if the cloud profile are identical. Then scores every
+	// candidate with the configured score plugins and chooses the seed with the highest weighted score.
+	Scored CandidateDeterminationStrategy = "Scored"
 	// Default Strategy is the default strategy to use when there is no configuration provided
 	Default = SameRegion
 	// SchedulerDefaultLockObjectNamespace is the default lock namespace for leader election.
@@ -23,7 +26,7 @@ const (
 )
 
 // Strategies defines all currently implemented SeedCandidateDeterminationStrategies
-var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
+var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance, Scored}
 
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
@@ -86,6 +89,66 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// Scoring defines the configuration of the score plugins used by the Scored strategy.
+	// +optional
+	Scoring *ScoringConfiguration `json:"scoring,omitempty"`
+	// ExplainPlacement defines the configuration of the debug endpoint which explains the seed determination for a
+	// given Shoot manifest.
+	// +optional
+	ExplainPlacement *ExplainPlacementConfiguration `json:"explainPlacement,omitempty"`
+}
+
+// ExplainPlacementConfiguration defines the configuration of the explain placement debug endpoint.
+type ExplainPlacementConfiguration struct {
+	// Enabled controls whether the endpoint is served on the metrics server. Only enable it if access to the metrics
+	// server is restricted, since the response reveals information about all seeds.
+	Enabled bool `json:"enabled"`
+}
+
+// ScorePluginName is the name of a score plugin used by the Scored strategy.
+type ScorePluginName string
+
+const (
+	// ScorePluginCapacityHeadroom prefers seeds with more free capacity for shoots.
+	ScorePluginCapacityHeadroom ScorePluginName = "CapacityHeadroom"
+	// ScorePluginRegionDistance prefers seeds with a smaller distance to the shoot's region.
+	ScorePluginRegionDistance ScorePluginName = "RegionDistance"
+	// ScorePluginZoneMatch prefers seeds whose zones overlap with the zones of the shoot's worker pools.
+	ScorePluginZoneMatch ScorePluginName = "ZoneMatch"
+	// ScorePluginLabelAffinity prefers seeds matching the configured preferred label selectors.
+	ScorePluginLabelAffinity ScorePluginName = "LabelAffinity"
+	// ScorePluginSeedAge prefers seeds which exist for a longer time.
+	ScorePluginSeedAge ScorePluginName = "SeedAge"
+)
+
+// ScorePlugins defines all currently implemented score plugins.
+var ScorePlugins = []ScorePluginName{ScorePluginCapacityHeadroom, ScorePluginRegionDistance, ScorePluginZoneMatch, ScorePluginLabelAffinity, ScorePluginSeedAge}
+
+// ScoringConfiguration defines the configuration of the Scored strategy.
+type ScoringConfiguration struct {
+	// Plugins is the list of score plugins and their weights. Each plugin scores a seed candidate between 0 and 100,
+	// the final score of a seed is the sum of all plugin scores multiplied with their weights.
+	// +optional
+	Plugins []ScorePlugin `json:"plugins,omitempty"`
+	// LabelAffinity defines the arguments of the LabelAffinity score plugin.
+	// +optional
+	LabelAffinity *LabelAffinityArgs `json:"labelAffinity,omitempty"`
+}
+
+// ScorePlugin defines a score plugin and its weight.
+type ScorePlugin struct {
+	// Name is the name of the score plugin.
+	Name ScorePluginName `json:"name"`
+	// Weight is the weight of the score plugin. A weight of 0 disables the plugin.
+	Weight int32 `json:"weight"`
+}
+
+// LabelAffinityArgs defines the arguments of the LabelAffinity score plugin.
+type LabelAffinityArgs struct {
+	// PreferredSeedSelectors is a list of label selectors. A seed gets the higher score the more selectors match its
+	// labels.
+	// +optional
+	PreferredSeedSelectors []metav1.LabelSelector `json:"preferredSeedSelectors,omitempty"`
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
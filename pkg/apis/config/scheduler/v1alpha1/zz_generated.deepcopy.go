@@ -10,6 +10,7 @@
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -30,6 +31,45 @@ func (in *BackupBucketSchedulerConfiguration) DeepCopy() *BackupBucketSchedulerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExplainPlacementConfiguration) DeepCopyInto(out *ExplainPlacementConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExplainPlacementConfiguration.
+func (in *ExplainPlacementConfiguration) DeepCopy() *ExplainPlacementConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExplainPlacementConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelAffinityArgs) DeepCopyInto(out *LabelAffinityArgs) {
+	*out = *in
+	if in.PreferredSeedSelectors != nil {
+		in, out := &in.PreferredSeedSelectors, &out.PreferredSeedSelectors
+		*out = make([]v1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelAffinityArgs.
+func (in *LabelAffinityArgs) DeepCopy() *LabelAffinityArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelAffinityArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulerConfiguration) DeepCopyInto(out *SchedulerConfiguration) {
 	*out = *in
@@ -86,7 +126,7 @@ func (in *SchedulerControllerConfiguration) DeepCopyInto(out *SchedulerControlle
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootSchedulerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -101,6 +141,48 @@ func (in *SchedulerControllerConfiguration) DeepCopy() *SchedulerControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScorePlugin) DeepCopyInto(out *ScorePlugin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScorePlugin.
+func (in *ScorePlugin) DeepCopy() *ScorePlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ScorePlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScoringConfiguration) DeepCopyInto(out *ScoringConfiguration) {
+	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]ScorePlugin, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelAffinity != nil {
+		in, out := &in.LabelAffinity, &out.LabelAffinity
+		*out = new(LabelAffinityArgs)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScoringConfiguration.
+func (in *ScoringConfiguration) DeepCopy() *ScoringConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ScoringConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -146,6 +228,16 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSchedulerConfiguration) DeepCopyInto(out *ShootSchedulerConfiguration) {
 	*out = *in
+	if in.Scoring != nil {
+		in, out := &in.Scoring, &out.Scoring
+		*out = new(ScoringConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExplainPlacement != nil {
+		in, out := &in.ExplainPlacement, &out.ExplainPlacement
+		*out = new(ExplainPlacementConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -25,24 +25,28 @@ type ControllerDeployment struct {
 
 	// Type is the deployment type.
 	// This field correlates with the Type field in the v1beta1 API version.
-	// It is only set if a custom type (other than helm) is configured in the v1beta1 API version and the object is
-	// converted to the internal version. If the helm type is used in v1beta1, the Helm section will be set in the
-	// internal API version instead of this field. In the v1 API version, the value is represented using an annotation.
+	// It is only set if a custom type (other than helm or manifests) is configured in the v1beta1 API version and the
+	// object is converted to the internal version. If the helm or manifests type is used in v1beta1, the Helm or
+	// Manifests section will be set in the internal API version instead of this field. In the v1 API version, the value
+	// is represented using an annotation.
 	Type string
 	// ProviderConfig contains type-specific configuration. It contains assets that deploy the controller.
 	// This field correlates with the ProviderConfig field in the v1beta1 API version.
-	// It is only set if a custom type (other than helm) is configured in the v1beta1 API version and the object is
-	// converted to the internal version. If the helm type is used in v1beta1, the Helm section will be set in the
-	// internal API version instead of this field. In the v1 API version, the value is represented using an annotation.
+	// It is only set if a custom type (other than helm or manifests) is configured in the v1beta1 API version and the
+	// object is converted to the internal version. If the helm or manifests type is used in v1beta1, the Helm or
+	// Manifests section will be set in the internal API version instead of this field. In the v1 API version, the value
+	// is represented using an annotation.
 	ProviderConfig runtime.Object
 	// Helm configures that an extension controller is deployed using helm.
 	Helm *HelmControllerDeployment
+	// Manifests configures that an extension controller is deployed using plain manifests or a kustomization.
+	Manifests *ManifestsControllerDeployment
 	// InjectGardenKubeconfig controls whether a kubeconfig to the garden cluster should be injected into workload
 	// resources.
 	InjectGardenKubeconfig *bool
-	// Resources is a list of named resource references that can be referenced in the Helm chart values via Go template
-	// syntax (e.g. `{{ .resources.<name>.data.<key> }}`). Only resources of kind `Secret` and `ConfigMap` (apiVersion `v1`)
-	// are supported. The referenced resources must reside in the garden namespace.
+	// Resources is a list of named resource references that can be referenced in the Helm chart or manifests values via
+	// Go template syntax (e.g. `{{ .resources.<name>.data.<key> }}`). Only resources of kind `Secret` and `ConfigMap`
+	// (apiVersion `v1`) are supported. The referenced resources must reside in the garden namespace.
 	Resources []NamedResourceReference
 }
 
@@ -68,6 +72,19 @@ type HelmControllerDeployment struct {
 	OCIRepository *OCIRepository
 }
 
+// ManifestsControllerDeployment configures how an extension controller is deployed using plain manifests or a
+// kustomization.
+type ManifestsControllerDeployment struct {
+	// OCIRepository defines where to pull the artifact containing the manifests or the kustomization.
+	OCIRepository *OCIRepository
+	// Path is the path of the directory in the artifact which contains the manifests or the kustomization. It defaults
+	// to the root directory of the artifact.
+	Path *string
+	// Values are the values which can be referenced in the templates (`*.tpl.yaml` files) via Go template syntax
+	// (e.g. `{{ .Values.<key> }}`).
+	Values *apiextensionsv1.JSON
+}
+
 // OCIRepository configures where to pull an OCI Artifact, that could contain for example a Helm Chart.
 type OCIRepository struct {
 	// Ref is the full artifact Ref and takes precedence over all other fields.
@@ -88,6 +105,44 @@ type OCIRepository struct {
 	// For usage in the gardenlet, the secret must have the label `gardener.cloud/role=oci-ca-bundle`.
 	// If not provided, the system's default certificate pool is used.
 	CABundleSecretRef *corev1.LocalObjectReference
+	// Verification is the policy for verifying the cosign signature of the artifact. If set, the artifact is only used
+	// if it has a valid signature which matches the policy.
+	Verification *OCIRepositoryVerification
+}
+
+// OCIRepositoryVerification is the policy for verifying the cosign signature of an OCI artifact. A signature is accepted
+// if it was created with any of the public keys or by any of the keyless identities.
+type OCIRepositoryVerification struct {
+	// PublicKeys is a list of PEM-encoded public keys (ECDSA, RSA, or Ed25519) which are trusted for signing the artifact.
+	PublicKeys []string
+	// Keyless configures the verification of signatures which were created with short-lived certificates (keyless
+	// signing).
+	Keyless *KeylessVerification
+}
+
+// KeylessVerification configures the verification of signatures which were created with short-lived certificates.
+type KeylessVerification struct {
+	// Identities is a list of identities which are trusted for signing the artifact. The signing certificate must have
+	// been issued for any of them.
+	Identities []KeylessIdentity
+	// CertificateAuthorities is a PEM-encoded bundle of the root and intermediate certificates of the certificate
+	// authority (e.g., Fulcio) which issues the signing certificates.
+	CertificateAuthorities string
+	// TransparencyLogPublicKeys is a list of PEM-encoded public keys of the transparency log (e.g., Rekor). The signature
+	// must have been recorded in the transparency log while the signing certificate was valid.
+	TransparencyLogPublicKeys []string
+	// CertificateTransparencyLogPublicKeys is a list of PEM-encoded public keys of the certificate transparency log
+	// (e.g., the CT log of Fulcio). The signing certificate must contain a signed certificate timestamp of the
+	// certificate transparency log, i.e., it must have been published in it.
+	CertificateTransparencyLogPublicKeys []string
+}
+
+// KeylessIdentity is an identity for which signing certificates are issued.
+type KeylessIdentity struct {
+	// Issuer is the OIDC issuer which authenticated the identity, e.g., `https://token.actions.githubusercontent.com`.
+	Issuer string
+	// Subject is the subject of the identity, i.e., the email address or URI contained in the signing certificate.
+	Subject string
 }
 
 // GetURL returns the fully-qualified OCIRepository URL of the artifact.
@@ -1375,6 +1375,11 @@ type Worker struct {
 	// ControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
 	// This is only relevant for self-hosted shoot clusters.
 	ControlPlane *WorkerControlPlane
+	// OperatingSystemConfigUpdatePolicy specifies when changes of the operating system configuration (e.g., files or
+	// systemd units) are applied by gardener-node-agent on existing nodes of this worker pool. If not set, changes are
+	// applied immediately. Changes required for the cluster to function (e.g., during credentials rotations) are always
+	// applied immediately. Holding changes is not supported for worker pools with in-place update strategies.
+	OperatingSystemConfigUpdatePolicy *OperatingSystemConfigUpdatePolicy
 }
 
 // WorkerControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
@@ -1424,6 +1429,18 @@ const (
 	ManualInPlaceUpdate MachineUpdateStrategy = "ManualInPlaceUpdate"
 )
 
+// OperatingSystemConfigUpdatePolicy specifies when changes of the operating system configuration are applied on
+// existing nodes of a worker pool.
+type OperatingSystemConfigUpdatePolicy string
+
+const (
+	// OperatingSystemConfigUpdatePolicyImmediate is a policy where changes are applied on existing nodes right away.
+	OperatingSystemConfigUpdatePolicyImmediate OperatingSystemConfigUpdatePolicy = "Immediate"
+	// OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow is a policy where non-critical changes are held back on
+	// existing nodes until the next maintenance time window of the Shoot.
+	OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow OperatingSystemConfigUpdatePolicy = "MaintenanceTimeWindow"
+)
+
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
 type ClusterAutoscalerOptions struct {
 	// ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed.
@@ -1643,6 +1660,10 @@ const (
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventRebalancingRecommended indicates that the control plane should be migrated to a seed with less load.
+	ShootEventRebalancingRecommended = "RebalancingRecommended"
+	// ShootEventRebalancingTriggered indicates that the control plane migration to a seed with less load was triggered.
+	ShootEventRebalancingTriggered = "RebalancingTriggered"
 )
 
 const (
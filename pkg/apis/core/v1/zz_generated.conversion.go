@@ -46,6 +46,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KeylessIdentity)(nil), (*core.KeylessIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_KeylessIdentity_To_core_KeylessIdentity(a.(*KeylessIdentity), b.(*core.KeylessIdentity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KeylessIdentity)(nil), (*KeylessIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KeylessIdentity_To_v1_KeylessIdentity(a.(*core.KeylessIdentity), b.(*KeylessIdentity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KeylessVerification)(nil), (*core.KeylessVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_KeylessVerification_To_core_KeylessVerification(a.(*KeylessVerification), b.(*core.KeylessVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KeylessVerification)(nil), (*KeylessVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KeylessVerification_To_v1_KeylessVerification(a.(*core.KeylessVerification), b.(*KeylessVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ManifestsControllerDeployment)(nil), (*core.ManifestsControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ManifestsControllerDeployment_To_core_ManifestsControllerDeployment(a.(*ManifestsControllerDeployment), b.(*core.ManifestsControllerDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ManifestsControllerDeployment)(nil), (*ManifestsControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ManifestsControllerDeployment_To_v1_ManifestsControllerDeployment(a.(*core.ManifestsControllerDeployment), b.(*ManifestsControllerDeployment), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamedResourceReference)(nil), (*core.NamedResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_NamedResourceReference_To_core_NamedResourceReference(a.(*NamedResourceReference), b.(*core.NamedResourceReference), scope)
 	}); err != nil {
@@ -66,6 +96,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OCIRepositoryVerification)(nil), (*core.OCIRepositoryVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OCIRepositoryVerification_To_core_OCIRepositoryVerification(a.(*OCIRepositoryVerification), b.(*core.OCIRepositoryVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.OCIRepositoryVerification)(nil), (*OCIRepositoryVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_OCIRepositoryVerification_To_v1_OCIRepositoryVerification(a.(*core.OCIRepositoryVerification), b.(*OCIRepositoryVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*core.ControllerDeployment)(nil), (*ControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_core_ControllerDeployment_To_v1_ControllerDeployment(a.(*core.ControllerDeployment), b.(*ControllerDeployment), scope)
 	}); err != nil {
@@ -84,6 +124,7 @@ func autoConvert_v1_ControllerDeployment_To_core_ControllerDeployment(in *Contro
 	out.Helm = (*core.HelmControllerDeployment)(unsafe.Pointer(in.Helm))
 	out.InjectGardenKubeconfig = (*bool)(unsafe.Pointer(in.InjectGardenKubeconfig))
 	out.Resources = *(*[]core.NamedResourceReference)(unsafe.Pointer(&in.Resources))
+	out.Manifests = (*core.ManifestsControllerDeployment)(unsafe.Pointer(in.Manifests))
 	return nil
 }
 
@@ -92,6 +133,7 @@ func autoConvert_core_ControllerDeployment_To_v1_ControllerDeployment(in *core.C
 	// WARNING: in.Type requires manual conversion: does not exist in peer-type
 	// WARNING: in.ProviderConfig requires manual conversion: does not exist in peer-type
 	out.Helm = (*HelmControllerDeployment)(unsafe.Pointer(in.Helm))
+	out.Manifests = (*ManifestsControllerDeployment)(unsafe.Pointer(in.Manifests))
 	out.InjectGardenKubeconfig = (*bool)(unsafe.Pointer(in.InjectGardenKubeconfig))
 	out.Resources = *(*[]NamedResourceReference)(unsafe.Pointer(&in.Resources))
 	return nil
@@ -163,6 +205,78 @@ func Convert_core_HelmControllerDeployment_To_v1_HelmControllerDeployment(in *co
 	return autoConvert_core_HelmControllerDeployment_To_v1_HelmControllerDeployment(in, out, s)
 }
 
+func autoConvert_v1_KeylessIdentity_To_core_KeylessIdentity(in *KeylessIdentity, out *core.KeylessIdentity, s conversion.Scope) error {
+	out.Issuer = in.Issuer
+	out.Subject = in.Subject
+	return nil
+}
+
+// Convert_v1_KeylessIdentity_To_core_KeylessIdentity is an autogenerated conversion function.
+func Convert_v1_KeylessIdentity_To_core_KeylessIdentity(in *KeylessIdentity, out *core.KeylessIdentity, s conversion.Scope) error {
+	return autoConvert_v1_KeylessIdentity_To_core_KeylessIdentity(in, out, s)
+}
+
+func autoConvert_core_KeylessIdentity_To_v1_KeylessIdentity(in *core.KeylessIdentity, out *KeylessIdentity, s conversion.Scope) error {
+	out.Issuer = in.Issuer
+	out.Subject = in.Subject
+	return nil
+}
+
+// Convert_core_KeylessIdentity_To_v1_KeylessIdentity is an autogenerated conversion function.
+func Convert_core_KeylessIdentity_To_v1_KeylessIdentity(in *core.KeylessIdentity, out *KeylessIdentity, s conversion.Scope) error {
+	return autoConvert_core_KeylessIdentity_To_v1_KeylessIdentity(in, out, s)
+}
+
+func autoConvert_v1_KeylessVerification_To_core_KeylessVerification(in *KeylessVerification, out *core.KeylessVerification, s conversion.Scope) error {
+	out.Identities = *(*[]core.KeylessIdentity)(unsafe.Pointer(&in.Identities))
+	out.CertificateAuthorities = in.CertificateAuthorities
+	out.TransparencyLogPublicKeys = *(*[]string)(unsafe.Pointer(&in.TransparencyLogPublicKeys))
+	out.CertificateTransparencyLogPublicKeys = *(*[]string)(unsafe.Pointer(&in.CertificateTransparencyLogPublicKeys))
+	return nil
+}
+
+// Convert_v1_KeylessVerification_To_core_KeylessVerification is an autogenerated conversion function.
+func Convert_v1_KeylessVerification_To_core_KeylessVerification(in *KeylessVerification, out *core.KeylessVerification, s conversion.Scope) error {
+	return autoConvert_v1_KeylessVerification_To_core_KeylessVerification(in, out, s)
+}
+
+func autoConvert_core_KeylessVerification_To_v1_KeylessVerification(in *core.KeylessVerification, out *KeylessVerification, s conversion.Scope) error {
+	out.Identities = *(*[]KeylessIdentity)(unsafe.Pointer(&in.Identities))
+	out.CertificateAuthorities = in.CertificateAuthorities
+	out.TransparencyLogPublicKeys = *(*[]string)(unsafe.Pointer(&in.TransparencyLogPublicKeys))
+	out.CertificateTransparencyLogPublicKeys = *(*[]string)(unsafe.Pointer(&in.CertificateTransparencyLogPublicKeys))
+	return nil
+}
+
+// Convert_core_KeylessVerification_To_v1_KeylessVerification is an autogenerated conversion function.
+func Convert_core_KeylessVerification_To_v1_KeylessVerification(in *core.KeylessVerification, out *KeylessVerification, s conversion.Scope) error {
+	return autoConvert_core_KeylessVerification_To_v1_KeylessVerification(in, out, s)
+}
+
+func autoConvert_v1_ManifestsControllerDeployment_To_core_ManifestsControllerDeployment(in *ManifestsControllerDeployment, out *core.ManifestsControllerDeployment, s conversion.Scope) error {
+	out.OCIRepository = (*core.OCIRepository)(unsafe.Pointer(in.OCIRepository))
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
+	return nil
+}
+
+// Convert_v1_ManifestsControllerDeployment_To_core_ManifestsControllerDeployment is an autogenerated conversion function.
+func Convert_v1_ManifestsControllerDeployment_To_core_ManifestsControllerDeployment(in *ManifestsControllerDeployment, out *core.ManifestsControllerDeployment, s conversion.Scope) error {
+	return autoConvert_v1_ManifestsControllerDeployment_To_core_ManifestsControllerDeployment(in, out, s)
+}
+
+func autoConvert_core_ManifestsControllerDeployment_To_v1_ManifestsControllerDeployment(in *core.ManifestsControllerDeployment, out *ManifestsControllerDeployment, s conversion.Scope) error {
+	out.OCIRepository = (*OCIRepository)(unsafe.Pointer(in.OCIRepository))
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
+	return nil
+}
+
+// Convert_core_ManifestsControllerDeployment_To_v1_ManifestsControllerDeployment is an autogenerated conversion function.
+func Convert_core_ManifestsControllerDeployment_To_v1_ManifestsControllerDeployment(in *core.ManifestsControllerDeployment, out *ManifestsControllerDeployment, s conversion.Scope) error {
+	return autoConvert_core_ManifestsControllerDeployment_To_v1_ManifestsControllerDeployment(in, out, s)
+}
+
 func autoConvert_v1_NamedResourceReference_To_core_NamedResourceReference(in *NamedResourceReference, out *core.NamedResourceReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ResourceRef = in.ResourceRef
@@ -192,6 +306,7 @@ func autoConvert_v1_OCIRepository_To_core_OCIRepository(in *OCIRepository, out *
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
 	out.PullSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
 	out.CABundleSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.CABundleSecretRef))
+	out.Verification = (*core.OCIRepositoryVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -207,6 +322,7 @@ func autoConvert_core_OCIRepository_To_v1_OCIRepository(in *core.OCIRepository,
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
 	out.PullSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
 	out.CABundleSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.CABundleSecretRef))
+	out.Verification = (*OCIRepositoryVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -214,3 +330,25 @@ func autoConvert_core_OCIRepository_To_v1_OCIRepository(in *core.OCIRepository,
 func Convert_core_OCIRepository_To_v1_OCIRepository(in *core.OCIRepository, out *OCIRepository, s conversion.Scope) error {
 	return autoConvert_core_OCIRepository_To_v1_OCIRepository(in, out, s)
 }
+
+func autoConvert_v1_OCIRepositoryVerification_To_core_OCIRepositoryVerification(in *OCIRepositoryVerification, out *core.OCIRepositoryVerification, s conversion.Scope) error {
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	out.Keyless = (*core.KeylessVerification)(unsafe.Pointer(in.Keyless))
+	return nil
+}
+
+// Convert_v1_OCIRepositoryVerification_To_core_OCIRepositoryVerification is an autogenerated conversion function.
+func Convert_v1_OCIRepositoryVerification_To_core_OCIRepositoryVerification(in *OCIRepositoryVerification, out *core.OCIRepositoryVerification, s conversion.Scope) error {
+	return autoConvert_v1_OCIRepositoryVerification_To_core_OCIRepositoryVerification(in, out, s)
+}
+
+func autoConvert_core_OCIRepositoryVerification_To_v1_OCIRepositoryVerification(in *core.OCIRepositoryVerification, out *OCIRepositoryVerification, s conversion.Scope) error {
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	out.Keyless = (*KeylessVerification)(unsafe.Pointer(in.Keyless))
+	return nil
+}
+
+// Convert_core_OCIRepositoryVerification_To_v1_OCIRepositoryVerification is an autogenerated conversion function.
+func Convert_core_OCIRepositoryVerification_To_v1_OCIRepositoryVerification(in *core.OCIRepositoryVerification, out *OCIRepositoryVerification, s conversion.Scope) error {
+	return autoConvert_core_OCIRepositoryVerification_To_v1_OCIRepositoryVerification(in, out, s)
+}
@@ -24,6 +24,21 @@ func (in HelmControllerDeployment) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1.HelmControllerDeployment"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in KeylessIdentity) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1.KeylessIdentity"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in KeylessVerification) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1.KeylessVerification"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ManifestsControllerDeployment) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1.ManifestsControllerDeployment"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NamedResourceReference) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1.NamedResourceReference"
@@ -33,3 +48,8 @@ func (in NamedResourceReference) OpenAPIModelName() string {
 func (in OCIRepository) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1.OCIRepository"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in OCIRepositoryVerification) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1.OCIRepositoryVerification"
+}
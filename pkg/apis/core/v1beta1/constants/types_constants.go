@@ -711,7 +711,8 @@ const (
 	// EvictionRequirementNever is a constant to be used as a value for the annotation AnnotationVPAEvictionRequirementDownscaleRestriction,
 	// indicating that downscaling should never be allowed.
 	EvictionRequirementNever = "never"
-	// AnnotationShootMaintenanceWindow is a constant for an annotation key used on VPA objects to hold the Shoot's maintenance window start and end.
+	// AnnotationShootMaintenanceWindow is a constant for an annotation key used on VPA objects and gardener-node-agent
+	// Secrets to hold the Shoot's maintenance window start and end.
 	AnnotationShootMaintenanceWindow = "shoot.gardener.cloud/maintenance-window"
 
 	// GardenNamespace is the namespace in which the configuration and secrets for
@@ -760,6 +761,10 @@ const (
 	// be set to "true" to allow live control plane migration between seeds in distant regions despite exceeding the
 	// configured distance threshold.
 	AnnotationMigrationAllowDistantRegions = "migration.gardener.cloud/allow-distant-regions"
+	// AnnotationMigrationRecommendedSeed is a constant for an annotation key on a Shoot resource which is maintained by
+	// the shoot rebalancing controller. Its value is the name of the seed the control plane should be migrated to in
+	// order to balance the load across seeds.
+	AnnotationMigrationRecommendedSeed = "migration.gardener.cloud/recommended-seed"
 
 	// AnnotationConfirmationForceDeletion is a constant for an annotation on a Shoot resource whose value must be set to "true" in order to
 	// trigger force-deletion of the cluster. It can only be set if the Shoot has a deletion timestamp and contains an ErrorCode in the Shoot Status.
@@ -1139,6 +1144,14 @@ const (
 	// If they have the lock, they reconcile and release the Lease at the end. If they don't have the lock, they
 	// wait until it is removed again.
 	AnnotationNodeAgentSerialOSCReconciliation = "reconciliation.osc.node-agent.gardener.cloud/serial"
+	// AnnotationNodeAgentHoldOSCChanges is an annotation key on the gardener-node-agent Secret containing the
+	// OperatingSystemConfig. When set to "true", gardener-node-agent instances on nodes which already applied an
+	// OperatingSystemConfig hold back new changes until the maintenance time window stored in the
+	// AnnotationShootMaintenanceWindow annotation of the same Secret begins.
+	AnnotationNodeAgentHoldOSCChanges = "reconciliation.osc.node-agent.gardener.cloud/hold-until-maintenance-window"
+	// AnnotationNodeAgentApplyHeldOSCChanges is an annotation key on a Node. When set to "true", gardener-node-agent
+	// applies held OperatingSystemConfig changes immediately. The annotation is removed once the changes are applied.
+	AnnotationNodeAgentApplyHeldOSCChanges = "node-agent.gardener.cloud/apply-held-osc-changes"
 	// NodeAgentsGroup is the identity group for gardener-node-agents when authenticating to the API server.
 	NodeAgentsGroup = "gardener.cloud:node-agents"
 	// NodeAgentUserNamePrefix is the identity username prefix for gardener-node-agent when authenticating to the API server.
@@ -258,6 +258,18 @@ func Convert_v1beta1_ControllerDeployment_To_core_ControllerDeployment(in *Contr
 		if err := Convert_v1beta1_HelmControllerDeployment_To_core_HelmControllerDeployment(helmDeployment, out.Helm, s); err != nil {
 			return err
 		}
+	case ControllerDeploymentTypeManifests:
+		manifestsDeployment := &ManifestsControllerDeployment{}
+		if len(in.ProviderConfig.Raw) > 0 {
+			if err := json.Unmarshal(in.ProviderConfig.Raw, manifestsDeployment); err != nil {
+				return err
+			}
+		}
+
+		out.Manifests = &core.ManifestsControllerDeployment{}
+		if err := Convert_v1beta1_ManifestsControllerDeployment_To_core_ManifestsControllerDeployment(manifestsDeployment, out.Manifests, s); err != nil {
+			return err
+		}
 	default:
 		customType = true
 	}
@@ -292,6 +304,21 @@ func Convert_core_ControllerDeployment_To_v1beta1_ControllerDeployment(in *core.
 		}
 	}
 
+	if in.Manifests != nil {
+		out.Type = ControllerDeploymentTypeManifests
+
+		manifestsDeployment := &ManifestsControllerDeployment{}
+		if err := Convert_core_ManifestsControllerDeployment_To_v1beta1_ManifestsControllerDeployment(in.Manifests, manifestsDeployment, s); err != nil {
+			return err
+		}
+
+		var err error
+		out.ProviderConfig.Raw, err = json.Marshal(manifestsDeployment)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -568,6 +568,30 @@ var _ = Describe("Conversion", func() {
 				})
 			})
 
+			Context("manifests type", func() {
+				BeforeEach(func() {
+					in.Type = "manifests"
+					in.ProviderConfig = runtime.RawExtension{
+						Raw: []byte(`{"ociRepository":{"ref":"url:1.0.0"},"path":"overlay","values":{"foo":"bar"}}`),
+					}
+				})
+
+				It("should convert legacy manifests deployment to new structure", func() {
+					Expect(scheme.Convert(in, out, nil)).To(Succeed())
+
+					Expect(out.Type).To(BeEmpty(), "type is empty for non-custom type")
+					Expect(out.ProviderConfig).To(BeNil(), "providerConfig is empty for non-custom type")
+					Expect(out.Helm).To(BeNil())
+					Expect(out.Manifests).To(Equal(&core.ManifestsControllerDeployment{
+						OCIRepository: &core.OCIRepository{Ref: new("url:1.0.0")},
+						Path:          new("overlay"),
+						Values: &apiextensionsv1.JSON{
+							Raw: []byte(`{"foo":"bar"}`),
+						},
+					}))
+				})
+			})
+
 			Context("custom type", func() {
 				BeforeEach(func() {
 					in.Type = "custom"
@@ -644,6 +668,27 @@ var _ = Describe("Conversion", func() {
 				})
 			})
 
+			Context("manifests type", func() {
+				BeforeEach(func() {
+					in.Manifests = &core.ManifestsControllerDeployment{
+						OCIRepository: &core.OCIRepository{Ref: new("url:1.0.0")},
+						Path:          new("overlay"),
+						Values: &apiextensionsv1.JSON{
+							Raw: []byte(`{"foo":"bar"}`),
+						},
+					}
+				})
+
+				It("should convert new manifests deployment to legacy structure", func() {
+					Expect(scheme.Convert(in, out, nil)).To(Succeed())
+
+					Expect(out.Type).To(Equal("manifests"))
+					Expect(out.ProviderConfig).To(Equal(runtime.RawExtension{
+						Raw: []byte(`{"ociRepository":{"ref":"url:1.0.0"},"path":"overlay","values":{"foo":"bar"}}`),
+					}))
+				})
+			})
+
 			Context("custom type", func() {
 				BeforeEach(func() {
 					in.Type = "custom"
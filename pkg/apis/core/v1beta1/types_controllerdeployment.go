@@ -48,6 +48,10 @@ const (
 	// ControllerDeploymentTypeHelm is the type for instructing the extension controller deployment using helm.
 	// The ControllerDeployment.ProviderConfig is expected to hold a HelmControllerDeployment object.
 	ControllerDeploymentTypeHelm = "helm"
+	// ControllerDeploymentTypeManifests is the type for instructing the extension controller deployment using plain
+	// manifests or a kustomization.
+	// The ControllerDeployment.ProviderConfig is expected to hold a ManifestsControllerDeployment object.
+	ControllerDeploymentTypeManifests = "manifests"
 )
 
 // HelmControllerDeployment configures how an extension controller is deployed using helm.
@@ -65,6 +69,23 @@ type HelmControllerDeployment struct {
 	OCIRepository *OCIRepository `json:"ociRepository,omitempty" protobuf:"bytes,3,opt,name=ociRepository"`
 }
 
+// ManifestsControllerDeployment configures how an extension controller is deployed using plain manifests or a
+// kustomization.
+// While this is not a proper API type, we need to define the structure in the API package so that we can convert it
+// to the internal API version in the new representation.
+type ManifestsControllerDeployment struct {
+	// OCIRepository defines where to pull the artifact containing the manifests or the kustomization.
+	OCIRepository *OCIRepository `json:"ociRepository" protobuf:"bytes,1,opt,name=ociRepository"`
+	// Path is the path of the directory in the artifact which contains the manifests or the kustomization. It defaults
+	// to the root directory of the artifact.
+	// +optional
+	Path *string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+	// Values are the values which can be referenced in the templates (`*.tpl.yaml` files) via Go template syntax
+	// (e.g. `{{ .Values.<key> }}`).
+	// +optional
+	Values *apiextensionsv1.JSON `json:"values,omitempty" protobuf:"bytes,3,opt,name=values"`
+}
+
 // OCIRepository configures where to pull an OCI Artifact, that could contain for example a Helm Chart.
 type OCIRepository struct {
 	// Ref is the full artifact Ref and takes precedence over all other fields.
@@ -90,4 +111,49 @@ type OCIRepository struct {
 	// If not provided, the system's default certificate pool is used.
 	// +optional
 	CABundleSecretRef *corev1.LocalObjectReference `json:"caBundleSecretRef,omitempty" protobuf:"bytes,6,opt,name=caBundleSecretRef"`
+	// Verification is the policy for verifying the cosign signature of the artifact. If set, the artifact is only used
+	// if it has a valid signature which matches the policy.
+	// +optional
+	Verification *OCIRepositoryVerification `json:"verification,omitempty" protobuf:"bytes,7,opt,name=verification"`
+}
+
+// OCIRepositoryVerification is the policy for verifying the cosign signature of an OCI artifact. A signature is accepted
+// if it was created with any of the public keys or by any of the keyless identities.
+type OCIRepositoryVerification struct {
+	// PublicKeys is a list of PEM-encoded public keys (ECDSA, RSA, or Ed25519) which are trusted for signing the artifact.
+	// +optional
+	// +listType=atomic
+	PublicKeys []string `json:"publicKeys,omitempty" protobuf:"bytes,1,rep,name=publicKeys"`
+	// Keyless configures the verification of signatures which were created with short-lived certificates (keyless
+	// signing).
+	// +optional
+	Keyless *KeylessVerification `json:"keyless,omitempty" protobuf:"bytes,2,opt,name=keyless"`
+}
+
+// KeylessVerification configures the verification of signatures which were created with short-lived certificates.
+type KeylessVerification struct {
+	// Identities is a list of identities which are trusted for signing the artifact. The signing certificate must have
+	// been issued for any of them.
+	// +listType=atomic
+	Identities []KeylessIdentity `json:"identities" protobuf:"bytes,1,rep,name=identities"`
+	// CertificateAuthorities is a PEM-encoded bundle of the root and intermediate certificates of the certificate
+	// authority (e.g., Fulcio) which issues the signing certificates.
+	CertificateAuthorities string `json:"certificateAuthorities" protobuf:"bytes,2,opt,name=certificateAuthorities"`
+	// TransparencyLogPublicKeys is a list of PEM-encoded public keys of the transparency log (e.g., Rekor). The signature
+	// must have been recorded in the transparency log while the signing certificate was valid.
+	// +listType=atomic
+	TransparencyLogPublicKeys []string `json:"transparencyLogPublicKeys" protobuf:"bytes,3,rep,name=transparencyLogPublicKeys"`
+	// CertificateTransparencyLogPublicKeys is a list of PEM-encoded public keys of the certificate transparency log
+	// (e.g., the CT log of Fulcio). The signing certificate must contain a signed certificate timestamp of the
+	// certificate transparency log, i.e., it must have been published in it.
+	// +listType=atomic
+	CertificateTransparencyLogPublicKeys []string `json:"certificateTransparencyLogPublicKeys" protobuf:"bytes,4,rep,name=certificateTransparencyLogPublicKeys"`
+}
+
+// KeylessIdentity is an identity for which signing certificates are issued.
+type KeylessIdentity struct {
+	// Issuer is the OIDC issuer which authenticated the identity, e.g., `https://token.actions.githubusercontent.com`.
+	Issuer string `json:"issuer" protobuf:"bytes,1,opt,name=issuer"`
+	// Subject is the subject of the identity, i.e., the email address or URI contained in the signing certificate.
+	Subject string `json:"subject" protobuf:"bytes,2,opt,name=subject"`
 }
@@ -927,6 +927,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KeylessIdentity)(nil), (*core.KeylessIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KeylessIdentity_To_core_KeylessIdentity(a.(*KeylessIdentity), b.(*core.KeylessIdentity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KeylessIdentity)(nil), (*KeylessIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KeylessIdentity_To_v1beta1_KeylessIdentity(a.(*core.KeylessIdentity), b.(*KeylessIdentity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KeylessVerification)(nil), (*core.KeylessVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KeylessVerification_To_core_KeylessVerification(a.(*KeylessVerification), b.(*core.KeylessVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KeylessVerification)(nil), (*KeylessVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KeylessVerification_To_v1beta1_KeylessVerification(a.(*core.KeylessVerification), b.(*KeylessVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeAPIServerConfig)(nil), (*core.KubeAPIServerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(a.(*KubeAPIServerConfig), b.(*core.KubeAPIServerConfig), scope)
 	}); err != nil {
@@ -1277,6 +1297,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManifestsControllerDeployment)(nil), (*core.ManifestsControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManifestsControllerDeployment_To_core_ManifestsControllerDeployment(a.(*ManifestsControllerDeployment), b.(*core.ManifestsControllerDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ManifestsControllerDeployment)(nil), (*ManifestsControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ManifestsControllerDeployment_To_v1beta1_ManifestsControllerDeployment(a.(*core.ManifestsControllerDeployment), b.(*ManifestsControllerDeployment), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ManualWorkerPoolRollout)(nil), (*core.ManualWorkerPoolRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ManualWorkerPoolRollout_To_core_ManualWorkerPoolRollout(a.(*ManualWorkerPoolRollout), b.(*core.ManualWorkerPoolRollout), scope)
 	}); err != nil {
@@ -1407,6 +1437,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OCIRepositoryVerification)(nil), (*core.OCIRepositoryVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OCIRepositoryVerification_To_core_OCIRepositoryVerification(a.(*OCIRepositoryVerification), b.(*core.OCIRepositoryVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.OCIRepositoryVerification)(nil), (*OCIRepositoryVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_OCIRepositoryVerification_To_v1beta1_OCIRepositoryVerification(a.(*core.OCIRepositoryVerification), b.(*OCIRepositoryVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ObservabilityRotation)(nil), (*core.ObservabilityRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ObservabilityRotation_To_core_ObservabilityRotation(a.(*ObservabilityRotation), b.(*core.ObservabilityRotation), scope)
 	}); err != nil {
@@ -3254,6 +3294,7 @@ func autoConvert_core_ControllerDeployment_To_v1beta1_ControllerDeployment(in *c
 		return err
 	}
 	// WARNING: in.Helm requires manual conversion: does not exist in peer-type
+	// WARNING: in.Manifests requires manual conversion: does not exist in peer-type
 	out.InjectGardenKubeconfig = (*bool)(unsafe.Pointer(in.InjectGardenKubeconfig))
 	// WARNING: in.Resources requires manual conversion: does not exist in peer-type
 	return nil
@@ -4495,6 +4536,54 @@ func Convert_core_InternalSecretList_To_v1beta1_InternalSecretList(in *core.Inte
 	return autoConvert_core_InternalSecretList_To_v1beta1_InternalSecretList(in, out, s)
 }
 
+func autoConvert_v1beta1_KeylessIdentity_To_core_KeylessIdentity(in *KeylessIdentity, out *core.KeylessIdentity, s conversion.Scope) error {
+	out.Issuer = in.Issuer
+	out.Subject = in.Subject
+	return nil
+}
+
+// Convert_v1beta1_KeylessIdentity_To_core_KeylessIdentity is an autogenerated conversion function.
+func Convert_v1beta1_KeylessIdentity_To_core_KeylessIdentity(in *KeylessIdentity, out *core.KeylessIdentity, s conversion.Scope) error {
+	return autoConvert_v1beta1_KeylessIdentity_To_core_KeylessIdentity(in, out, s)
+}
+
+func autoConvert_core_KeylessIdentity_To_v1beta1_KeylessIdentity(in *core.KeylessIdentity, out *KeylessIdentity, s conversion.Scope) error {
+	out.Issuer = in.Issuer
+	out.Subject = in.Subject
+	return nil
+}
+
+// Convert_core_KeylessIdentity_To_v1beta1_KeylessIdentity is an autogenerated conversion function.
+func Convert_core_KeylessIdentity_To_v1beta1_KeylessIdentity(in *core.KeylessIdentity, out *KeylessIdentity, s conversion.Scope) error {
+	return autoConvert_core_KeylessIdentity_To_v1beta1_KeylessIdentity(in, out, s)
+}
+
+func autoConvert_v1beta1_KeylessVerification_To_core_KeylessVerification(in *KeylessVerification, out *core.KeylessVerification, s conversion.Scope) error {
+	out.Identities = *(*[]core.KeylessIdentity)(unsafe.Pointer(&in.Identities))
+	out.CertificateAuthorities = in.CertificateAuthorities
+	out.TransparencyLogPublicKeys = *(*[]string)(unsafe.Pointer(&in.TransparencyLogPublicKeys))
+	out.CertificateTransparencyLogPublicKeys = *(*[]string)(unsafe.Pointer(&in.CertificateTransparencyLogPublicKeys))
+	return nil
+}
+
+// Convert_v1beta1_KeylessVerification_To_core_KeylessVerification is an autogenerated conversion function.
+func Convert_v1beta1_KeylessVerification_To_core_KeylessVerification(in *KeylessVerification, out *core.KeylessVerification, s conversion.Scope) error {
+	return autoConvert_v1beta1_KeylessVerification_To_core_KeylessVerification(in, out, s)
+}
+
+func autoConvert_core_KeylessVerification_To_v1beta1_KeylessVerification(in *core.KeylessVerification, out *KeylessVerification, s conversion.Scope) error {
+	out.Identities = *(*[]KeylessIdentity)(unsafe.Pointer(&in.Identities))
+	out.CertificateAuthorities = in.CertificateAuthorities
+	out.TransparencyLogPublicKeys = *(*[]string)(unsafe.Pointer(&in.TransparencyLogPublicKeys))
+	out.CertificateTransparencyLogPublicKeys = *(*[]string)(unsafe.Pointer(&in.CertificateTransparencyLogPublicKeys))
+	return nil
+}
+
+// Convert_core_KeylessVerification_To_v1beta1_KeylessVerification is an autogenerated conversion function.
+func Convert_core_KeylessVerification_To_v1beta1_KeylessVerification(in *core.KeylessVerification, out *KeylessVerification, s conversion.Scope) error {
+	return autoConvert_core_KeylessVerification_To_v1beta1_KeylessVerification(in, out, s)
+}
+
 func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *KubeAPIServerConfig, out *core.KubeAPIServerConfig, s conversion.Scope) error {
 	if err := Convert_v1beta1_KubernetesConfig_To_core_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -5569,6 +5658,30 @@ func Convert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in *cor
 	return autoConvert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in, out, s)
 }
 
+func autoConvert_v1beta1_ManifestsControllerDeployment_To_core_ManifestsControllerDeployment(in *ManifestsControllerDeployment, out *core.ManifestsControllerDeployment, s conversion.Scope) error {
+	out.OCIRepository = (*core.OCIRepository)(unsafe.Pointer(in.OCIRepository))
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
+	return nil
+}
+
+// Convert_v1beta1_ManifestsControllerDeployment_To_core_ManifestsControllerDeployment is an autogenerated conversion function.
+func Convert_v1beta1_ManifestsControllerDeployment_To_core_ManifestsControllerDeployment(in *ManifestsControllerDeployment, out *core.ManifestsControllerDeployment, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManifestsControllerDeployment_To_core_ManifestsControllerDeployment(in, out, s)
+}
+
+func autoConvert_core_ManifestsControllerDeployment_To_v1beta1_ManifestsControllerDeployment(in *core.ManifestsControllerDeployment, out *ManifestsControllerDeployment, s conversion.Scope) error {
+	out.OCIRepository = (*OCIRepository)(unsafe.Pointer(in.OCIRepository))
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.Values = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Values))
+	return nil
+}
+
+// Convert_core_ManifestsControllerDeployment_To_v1beta1_ManifestsControllerDeployment is an autogenerated conversion function.
+func Convert_core_ManifestsControllerDeployment_To_v1beta1_ManifestsControllerDeployment(in *core.ManifestsControllerDeployment, out *ManifestsControllerDeployment, s conversion.Scope) error {
+	return autoConvert_core_ManifestsControllerDeployment_To_v1beta1_ManifestsControllerDeployment(in, out, s)
+}
+
 func autoConvert_v1beta1_ManualWorkerPoolRollout_To_core_ManualWorkerPoolRollout(in *ManualWorkerPoolRollout, out *core.ManualWorkerPoolRollout, s conversion.Scope) error {
 	out.PendingWorkersRollouts = *(*[]core.PendingWorkersRollout)(unsafe.Pointer(&in.PendingWorkersRollouts))
 	return nil
@@ -5888,6 +6001,7 @@ func autoConvert_v1beta1_OCIRepository_To_core_OCIRepository(in *OCIRepository,
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
 	out.PullSecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
 	out.CABundleSecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.CABundleSecretRef))
+	out.Verification = (*core.OCIRepositoryVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -5903,6 +6017,7 @@ func autoConvert_core_OCIRepository_To_v1beta1_OCIRepository(in *core.OCIReposit
 	out.Digest = (*string)(unsafe.Pointer(in.Digest))
 	out.PullSecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
 	out.CABundleSecretRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.CABundleSecretRef))
+	out.Verification = (*OCIRepositoryVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -5911,6 +6026,28 @@ func Convert_core_OCIRepository_To_v1beta1_OCIRepository(in *core.OCIRepository,
 	return autoConvert_core_OCIRepository_To_v1beta1_OCIRepository(in, out, s)
 }
 
+func autoConvert_v1beta1_OCIRepositoryVerification_To_core_OCIRepositoryVerification(in *OCIRepositoryVerification, out *core.OCIRepositoryVerification, s conversion.Scope) error {
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	out.Keyless = (*core.KeylessVerification)(unsafe.Pointer(in.Keyless))
+	return nil
+}
+
+// Convert_v1beta1_OCIRepositoryVerification_To_core_OCIRepositoryVerification is an autogenerated conversion function.
+func Convert_v1beta1_OCIRepositoryVerification_To_core_OCIRepositoryVerification(in *OCIRepositoryVerification, out *core.OCIRepositoryVerification, s conversion.Scope) error {
+	return autoConvert_v1beta1_OCIRepositoryVerification_To_core_OCIRepositoryVerification(in, out, s)
+}
+
+func autoConvert_core_OCIRepositoryVerification_To_v1beta1_OCIRepositoryVerification(in *core.OCIRepositoryVerification, out *OCIRepositoryVerification, s conversion.Scope) error {
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	out.Keyless = (*KeylessVerification)(unsafe.Pointer(in.Keyless))
+	return nil
+}
+
+// Convert_core_OCIRepositoryVerification_To_v1beta1_OCIRepositoryVerification is an autogenerated conversion function.
+func Convert_core_OCIRepositoryVerification_To_v1beta1_OCIRepositoryVerification(in *core.OCIRepositoryVerification, out *OCIRepositoryVerification, s conversion.Scope) error {
+	return autoConvert_core_OCIRepositoryVerification_To_v1beta1_OCIRepositoryVerification(in, out, s)
+}
+
 func autoConvert_v1beta1_ObservabilityRotation_To_core_ObservabilityRotation(in *ObservabilityRotation, out *core.ObservabilityRotation, s conversion.Scope) error {
 	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
@@ -7971,6 +8108,7 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.UpdateStrategy = (*core.MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.ControlPlane = (*core.WorkerControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.OperatingSystemConfigUpdatePolicy = (*core.OperatingSystemConfigUpdatePolicy)(unsafe.Pointer(in.OperatingSystemConfigUpdatePolicy))
 	return nil
 }
 
@@ -8014,6 +8152,7 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.UpdateStrategy = (*MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.ControlPlane = (*WorkerControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.OperatingSystemConfigUpdatePolicy = (*OperatingSystemConfigUpdatePolicy)(unsafe.Pointer(in.OperatingSystemConfigUpdatePolicy))
 	return nil
 }
 
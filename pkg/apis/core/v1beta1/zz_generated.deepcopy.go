@@ -2680,6 +2680,53 @@ func (in *InternalSecretList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeylessIdentity) DeepCopyInto(out *KeylessIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeylessIdentity.
+func (in *KeylessIdentity) DeepCopy() *KeylessIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(KeylessIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeylessVerification) DeepCopyInto(out *KeylessVerification) {
+	*out = *in
+	if in.Identities != nil {
+		in, out := &in.Identities, &out.Identities
+		*out = make([]KeylessIdentity, len(*in))
+		copy(*out, *in)
+	}
+	if in.TransparencyLogPublicKeys != nil {
+		in, out := &in.TransparencyLogPublicKeys, &out.TransparencyLogPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CertificateTransparencyLogPublicKeys != nil {
+		in, out := &in.CertificateTransparencyLogPublicKeys, &out.CertificateTransparencyLogPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeylessVerification.
+func (in *KeylessVerification) DeepCopy() *KeylessVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(KeylessVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 	*out = *in
@@ -3921,6 +3968,37 @@ func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestsControllerDeployment) DeepCopyInto(out *ManifestsControllerDeployment) {
+	*out = *in
+	if in.OCIRepository != nil {
+		in, out := &in.OCIRepository, &out.OCIRepository
+		*out = new(OCIRepository)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestsControllerDeployment.
+func (in *ManifestsControllerDeployment) DeepCopy() *ManifestsControllerDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestsControllerDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManualWorkerPoolRollout) DeepCopyInto(out *ManualWorkerPoolRollout) {
 	*out = *in
@@ -4319,6 +4397,11 @@ func (in *OCIRepository) DeepCopyInto(out *OCIRepository) {
 		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(OCIRepositoryVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4332,6 +4415,32 @@ func (in *OCIRepository) DeepCopy() *OCIRepository {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIRepositoryVerification) DeepCopyInto(out *OCIRepositoryVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keyless != nil {
+		in, out := &in.Keyless, &out.Keyless
+		*out = new(KeylessVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIRepositoryVerification.
+func (in *OCIRepositoryVerification) DeepCopy() *OCIRepositoryVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIRepositoryVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObservabilityRotation) DeepCopyInto(out *ObservabilityRotation) {
 	*out = *in
@@ -6789,6 +6898,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(WorkerControlPlane)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OperatingSystemConfigUpdatePolicy != nil {
+		in, out := &in.OperatingSystemConfigUpdatePolicy, &out.OperatingSystemConfigUpdatePolicy
+		*out = new(OperatingSystemConfigUpdatePolicy)
+		**out = **in
+	}
 	return
 }
 
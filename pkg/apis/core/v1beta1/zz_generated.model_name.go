@@ -469,6 +469,16 @@ func (in InternalSecretList) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.InternalSecretList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in KeylessIdentity) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.KeylessIdentity"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in KeylessVerification) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.KeylessVerification"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in KubeAPIServerConfig) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.KubeAPIServerConfig"
@@ -644,6 +654,11 @@ func (in MaintenanceTimeWindow) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceTimeWindow"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ManifestsControllerDeployment) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ManifestsControllerDeployment"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ManualWorkerPoolRollout) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ManualWorkerPoolRollout"
@@ -709,6 +724,11 @@ func (in OCIRepository) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.OCIRepository"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in OCIRepositoryVerification) OpenAPIModelName() string {
+	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.OCIRepositoryVerification"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ObservabilityRotation) OpenAPIModelName() string {
 	return "com.github.gardener.gardener.pkg.apis.core.v1beta1.ObservabilityRotation"
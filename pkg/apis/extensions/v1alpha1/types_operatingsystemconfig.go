@@ -90,6 +90,10 @@ type OperatingSystemConfigSpec struct {
 	// InPlaceUpdates contains the configuration for in-place updates.
 	// +optional
 	InPlaceUpdates *InPlaceUpdates `json:"inPlaceUpdates,omitempty"`
+	// ImageVerification contains the configuration for verifying the signatures of container images from which files
+	// are extracted (see `.spec.files[].content.imageRef`).
+	// +optional
+	ImageVerification *ImageVerification `json:"imageVerification,omitempty"`
 }
 
 // Unit is a unit for the operating system configuration (usually, a systemd unit).
@@ -192,6 +196,17 @@ type FileContentImageRef struct {
 	Image string `json:"image"`
 	// FilePathInImage contains the path in the image to the file that should be extracted.
 	FilePathInImage string `json:"filePathInImage"`
+	// Digest is the expected digest of the image manifest, e.g. `sha256:...`. If set, the file is only extracted if the
+	// image resolves to this digest.
+	// +optional
+	Digest *string `json:"digest,omitempty"`
+}
+
+// ImageVerification contains the configuration for verifying the signatures of container images.
+type ImageVerification struct {
+	// PublicKeys is a list of PEM-encoded public keys (ECDSA, RSA, or Ed25519) which are trusted for signing images. If
+	// set, files are only extracted from images with a valid cosign signature created by any of these keys.
+	PublicKeys []string `json:"publicKeys"`
 }
 
 // OperatingSystemConfigStatus is the status for a OperatingSystemConfig resource.
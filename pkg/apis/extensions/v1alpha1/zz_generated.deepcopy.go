@@ -1206,7 +1206,7 @@ func (in *FileContent) DeepCopyInto(out *FileContent) {
 	if in.ImageRef != nil {
 		in, out := &in.ImageRef, &out.ImageRef
 		*out = new(FileContentImageRef)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1224,6 +1224,11 @@ func (in *FileContent) DeepCopy() *FileContent {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileContentImageRef) DeepCopyInto(out *FileContentImageRef) {
 	*out = *in
+	if in.Digest != nil {
+		in, out := &in.Digest, &out.Digest
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -1269,6 +1274,27 @@ func (in *FileContentSecretRef) DeepCopy() *FileContentSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerification) DeepCopyInto(out *ImageVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerification.
+func (in *ImageVerification) DeepCopy() *ImageVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InPlaceUpdates) DeepCopyInto(out *InPlaceUpdates) {
 	*out = *in
@@ -1768,6 +1794,11 @@ func (in *OperatingSystemConfigSpec) DeepCopyInto(out *OperatingSystemConfigSpec
 		*out = new(InPlaceUpdates)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageVerification != nil {
+		in, out := &in.ImageVerification, &out.ImageVerification
+		*out = new(ImageVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -65,8 +65,8 @@ type Deployment struct {
 	// AdmissionDeployment contains the deployment configuration for an admission controller.
 	// +optional
 	AdmissionDeployment *AdmissionDeploymentSpec `json:"admission,omitempty"`
-	// Resources is a list of named resource references that can be referenced in the Helm chart values via Go
-	// template syntax (e.g. `{{ .resources.<name>.data.<key> }}`). Only resources of kind `Secret` and `ConfigMap`
+	// Resources is a list of named resource references that can be referenced in the Helm chart or manifests values via
+	// Go template syntax (e.g. `{{ .resources.<name>.data.<key> }}`). Only resources of kind `Secret` and `ConfigMap`
 	// (apiVersion `v1`) are supported. The referenced resources must reside in the garden namespace.
 	// References can be used in `spec.deployment.extension.values`, `spec.deployment.extension.runtimeClusterValues`
 	// and `spec.deployment.admission.values`.
@@ -121,6 +121,9 @@ type AdmissionDeploymentSpec struct {
 type DeploymentSpec struct {
 	// Helm contains the specification for a Helm deployment.
 	Helm *ExtensionHelm `json:"helm,omitempty"`
+	// Manifests contains the specification for a deployment using plain manifests or a kustomization.
+	// +optional
+	Manifests *ExtensionManifests `json:"manifests,omitempty"`
 }
 
 // ExtensionHelm is the configuration for a helm deployment.
@@ -130,6 +133,16 @@ type ExtensionHelm struct {
 	OCIRepository *gardencorev1.OCIRepository `json:"ociRepository,omitempty"`
 }
 
+// ExtensionManifests is the configuration for a deployment using plain manifests or a kustomization.
+type ExtensionManifests struct {
+	// OCIRepository defines where to pull the artifact containing the manifests or the kustomization from.
+	OCIRepository *gardencorev1.OCIRepository `json:"ociRepository"`
+	// Path is the path of the directory in the artifact which contains the manifests or the kustomization. It defaults
+	// to the root directory of the artifact.
+	// +optional
+	Path *string `json:"path,omitempty"`
+}
+
 // ExtensionStatus is the status of a Gardener extension.
 type ExtensionStatus struct {
 	// ObservedGeneration is the most recent generation observed for this resource.
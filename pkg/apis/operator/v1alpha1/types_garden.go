@@ -642,12 +642,16 @@ type GardenerSchedulerConfig struct {
 	gardencorev1beta1.KubernetesConfig `json:",inline"`
 
 	// Strategy is the candidate determination strategy that defines how seeds for shoots that do not
-	// specify a seed explicitly are determined. Must be one of "SameRegion" or "MinimalDistance".
+	// specify a seed explicitly are determined. Must be one of "SameRegion", "MinimalDistance" or "Scored".
 	// Defaults to "SameRegion".
-	// +kubebuilder:validation:Enum=SameRegion;MinimalDistance
+	// +kubebuilder:validation:Enum=SameRegion;MinimalDistance;Scored
 	// +kubebuilder:default=SameRegion
 	// +optional
 	Strategy *string `json:"strategy,omitempty"`
+	// Scoring contains the configuration of the score plugins used by the "Scored" strategy. If not set, the default
+	// score plugins and weights of the gardener-scheduler are used.
+	// +optional
+	Scoring *GardenerSchedulerScoring `json:"scoring,omitempty"`
 	// LogLevel is the configured log level for the gardener-scheduler. Must be one of [info,debug,error].
 	// Defaults to info.
 	// +kubebuilder:validation:Enum=info;debug;error
@@ -656,6 +660,35 @@ type GardenerSchedulerConfig struct {
 	LogLevel *string `json:"logLevel,omitempty"`
 }
 
+// GardenerSchedulerScoring contains the configuration of the score plugins used by the "Scored" strategy.
+type GardenerSchedulerScoring struct {
+	// Plugins is the list of score plugins and their weights. Each plugin scores a seed candidate between 0 and 100,
+	// the final score of a seed is the sum of all plugin scores multiplied with their weights.
+	// +optional
+	Plugins []GardenerSchedulerScorePlugin `json:"plugins,omitempty"`
+	// LabelAffinity contains the arguments of the LabelAffinity score plugin.
+	// +optional
+	LabelAffinity *GardenerSchedulerLabelAffinity `json:"labelAffinity,omitempty"`
+}
+
+// GardenerSchedulerScorePlugin contains a score plugin and its weight.
+type GardenerSchedulerScorePlugin struct {
+	// Name is the name of the score plugin.
+	// +kubebuilder:validation:Enum=CapacityHeadroom;RegionDistance;ZoneMatch;LabelAffinity;SeedAge
+	Name string `json:"name"`
+	// Weight is the weight of the score plugin. A weight of 0 disables the plugin.
+	// +kubebuilder:validation:Minimum=0
+	Weight int32 `json:"weight"`
+}
+
+// GardenerSchedulerLabelAffinity contains the arguments of the LabelAffinity score plugin.
+type GardenerSchedulerLabelAffinity struct {
+	// PreferredSeedSelectors is a list of label selectors. A seed gets the higher score the more selectors match its
+	// labels.
+	// +optional
+	PreferredSeedSelectors []metav1.LabelSelector `json:"preferredSeedSelectors,omitempty"`
+}
+
 // GardenerDashboardConfig contains configuration settings for the gardener-dashboard.
 type GardenerDashboardConfig struct {
 	// EnableTokenLogin specifies whether it is possible to log into the dashboard with a JWT token. If disabled, OIDC
@@ -515,6 +515,11 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 		*out = new(ExtensionHelm)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = new(ExtensionManifests)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -773,6 +778,32 @@ func (in *ExtensionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtensionManifests) DeepCopyInto(out *ExtensionManifests) {
+	*out = *in
+	if in.OCIRepository != nil {
+		in, out := &in.OCIRepository, &out.OCIRepository
+		*out = new(apiscorev1.OCIRepository)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtensionManifests.
+func (in *ExtensionManifests) DeepCopy() *ExtensionManifests {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtensionManifests)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtensionSpec) DeepCopyInto(out *ExtensionSpec) {
 	*out = *in
@@ -1286,6 +1317,11 @@ func (in *GardenerSchedulerConfig) DeepCopyInto(out *GardenerSchedulerConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Scoring != nil {
+		in, out := &in.Scoring, &out.Scoring
+		*out = new(GardenerSchedulerScoring)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LogLevel != nil {
 		in, out := &in.LogLevel, &out.LogLevel
 		*out = new(string)
@@ -1304,6 +1340,71 @@ func (in *GardenerSchedulerConfig) DeepCopy() *GardenerSchedulerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenerSchedulerLabelAffinity) DeepCopyInto(out *GardenerSchedulerLabelAffinity) {
+	*out = *in
+	if in.PreferredSeedSelectors != nil {
+		in, out := &in.PreferredSeedSelectors, &out.PreferredSeedSelectors
+		*out = make([]metav1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenerSchedulerLabelAffinity.
+func (in *GardenerSchedulerLabelAffinity) DeepCopy() *GardenerSchedulerLabelAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenerSchedulerLabelAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenerSchedulerScorePlugin) DeepCopyInto(out *GardenerSchedulerScorePlugin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenerSchedulerScorePlugin.
+func (in *GardenerSchedulerScorePlugin) DeepCopy() *GardenerSchedulerScorePlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenerSchedulerScorePlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenerSchedulerScoring) DeepCopyInto(out *GardenerSchedulerScoring) {
+	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]GardenerSchedulerScorePlugin, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelAffinity != nil {
+		in, out := &in.LabelAffinity, &out.LabelAffinity
+		*out = new(GardenerSchedulerLabelAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenerSchedulerScoring.
+func (in *GardenerSchedulerScoring) DeepCopy() *GardenerSchedulerScoring {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenerSchedulerScoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupResource) DeepCopyInto(out *GroupResource) {
 	*out = *in
@@ -49,6 +49,28 @@ const (
 	// FinalizeDeletionAfter is an annotation on an object part of a ManagedResource that whose value states the
 	// duration after which a deletion should be finalized (i.e., removal of `.metadata.finalizers[]`).
 	FinalizeDeletionAfter = "resources.gardener.cloud/finalize-deletion-after"
+	// Preview is a constant for an annotation on a ManagedResource. If set to true then the controller does not apply
+	// the resources but only computes which objects would be created, updated, or deleted in the target cluster, and
+	// reports the result in the `.status.preview` field of the ManagedResource.
+	Preview = "resources.gardener.cloud/preview"
+	// RolloutGroup is a constant for a label on a ManagedResource. If set then changes of the referenced secrets data are
+	// not applied before they are approved via the `resources.gardener.cloud/rollout-approved-checksum` annotation. The
+	// approvals are given by a coordinator (e.g., gardenlet) which rolls out the changes to all ManagedResources of the
+	// same rollout group in waves.
+	RolloutGroup = "resources.gardener.cloud/rollout-group"
+	// RolloutApprovedChecksum is a constant for an annotation on a ManagedResource with a rollout group label. Its value
+	// is the checksum of the referenced secrets data which is approved to be applied.
+	RolloutApprovedChecksum = "resources.gardener.cloud/rollout-approved-checksum"
+	// RolloutOperation is a constant for an annotation on a ManagedResource with a rollout group label which halts the
+	// rollout because it is degraded after applying the approved changes. Its value decides how the rollout continues,
+	// see RolloutOperationResume and RolloutOperationAbort.
+	RolloutOperation = "resources.gardener.cloud/rollout-operation"
+	// RolloutOperationResume is a value for the RolloutOperation annotation. The rollout is resumed although the
+	// ManagedResource is degraded.
+	RolloutOperationResume = "resume"
+	// RolloutOperationAbort is a value for the RolloutOperation annotation. The rollout is aborted, i.e., no further
+	// changes are approved, even if the ManagedResource becomes healthy again.
+	RolloutOperationAbort = "abort"
 	// BrotliCompressionSuffix is the common suffix used for Brotli compression.
 	BrotliCompressionSuffix = ".br"
 	// CompressedDataKey is the name of a data key containing Brotli compressed YAML manifests.
@@ -211,6 +233,7 @@ const (
 // +kubebuilder:printcolumn:name="Applied",type=string,JSONPath=`.status.conditions[?(@.type=="ResourcesApplied")].status`,description=" Indicates whether all resources have been applied."
 // +kubebuilder:printcolumn:name="Healthy",type=string,JSONPath=`.status.conditions[?(@.type=="ResourcesHealthy")].status`,description="Indicates whether all resources are healthy."
 // +kubebuilder:printcolumn:name="Progressing",type=string,JSONPath=`.status.conditions[?(@.type=="ResourcesProgressing")].status`,description="Indicates whether some resources are still progressing to be rolled out."
+// +kubebuilder:printcolumn:name="Preview",type=string,JSONPath=`.status.preview.summary`,description="Summarizes the changes which would be performed when applying the resources.",priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,description="creation timestamp"
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -278,8 +301,56 @@ type ManagedResourceStatus struct {
 	// SecretsDataChecksum is the checksum of referenced secrets data.
 	// +optional
 	SecretsDataChecksum *string `json:"secretsDataChecksum,omitempty"`
+	// Preview contains the changes which would be performed in the target cluster when applying the resources of the
+	// referenced secrets. It is only set if the ManagedResource is annotated with `resources.gardener.cloud/preview=true`.
+	// +optional
+	Preview *ManagedResourcePreview `json:"preview,omitempty"`
+	// PendingSecretsDataChecksum is the checksum of the referenced secrets data which is not yet applied because its
+	// rollout was not approved yet. It is only set if the ManagedResource has the `resources.gardener.cloud/rollout-group`
+	// label.
+	// +optional
+	PendingSecretsDataChecksum *string `json:"pendingSecretsDataChecksum,omitempty"`
+}
+
+// ManagedResourcePreview contains the result of a dry-run reconciliation of a managed resource.
+type ManagedResourcePreview struct {
+	// SecretsDataChecksum is the checksum of the referenced secrets data the preview was computed for.
+	SecretsDataChecksum string `json:"secretsDataChecksum"`
+	// LastUpdateTime is the time when the preview was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// Summary is a human-readable summary of the changes.
+	Summary string `json:"summary"`
+	// Changes is a list of objects which would be created, updated, or deleted.
+	// +optional
+	Changes []ResourceChange `json:"changes,omitempty"`
 }
 
+// ResourceChange describes a change of an object in the target cluster.
+type ResourceChange struct {
+	corev1.ObjectReference `json:",inline"`
+
+	// Operation is the operation which would be performed for the object.
+	Operation ResourceChangeOperation `json:"operation"`
+	// Fields is a list of paths of the fields which would be changed. It is only set for updates.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+	// Error is the error which is expected when performing the operation, e.g., if the object is invalid.
+	// +optional
+	Error *string `json:"error,omitempty"`
+}
+
+// ResourceChangeOperation is the operation which would be performed for an object in the target cluster.
+type ResourceChangeOperation string
+
+const (
+	// ResourceChangeOperationCreate indicates that the object would be created.
+	ResourceChangeOperationCreate ResourceChangeOperation = "Create"
+	// ResourceChangeOperationUpdate indicates that the object would be updated.
+	ResourceChangeOperationUpdate ResourceChangeOperation = "Update"
+	// ResourceChangeOperationDelete indicates that the object would be deleted.
+	ResourceChangeOperationDelete ResourceChangeOperation = "Delete"
+)
+
 // ObjectReference is a reference to another object.
 type ObjectReference struct {
 	corev1.ObjectReference `json:",inline"`
@@ -328,4 +399,7 @@ const (
 	// ConditionChecksPending indicates that the `ResourcesProgressing` condition is `Unknown`,
 	// because the condition checks have not been completely executed yet for the current set of resources.
 	ConditionChecksPending = "ChecksPending"
+	// ConditionRolloutPending indicates that the `ResourcesApplied` condition is `True` for the previously applied
+	// resources, but changes of the resources are not applied yet because their rollout was not approved yet.
+	ConditionRolloutPending = "RolloutPending"
 )
@@ -77,6 +77,30 @@ func (in *ManagedResourceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedResourcePreview) DeepCopyInto(out *ManagedResourcePreview) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]ResourceChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedResourcePreview.
+func (in *ManagedResourcePreview) DeepCopy() *ManagedResourcePreview {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedResourcePreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedResourceSpec) DeepCopyInto(out *ManagedResourceSpec) {
 	*out = *in
@@ -163,6 +187,16 @@ func (in *ManagedResourceStatus) DeepCopyInto(out *ManagedResourceStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Preview != nil {
+		in, out := &in.Preview, &out.Preview
+		*out = new(ManagedResourcePreview)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingSecretsDataChecksum != nil {
+		in, out := &in.PendingSecretsDataChecksum, &out.PendingSecretsDataChecksum
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -206,3 +240,30 @@ func (in *ObjectReference) DeepCopy() *ObjectReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceChange) DeepCopyInto(out *ResourceChange) {
+	*out = *in
+	out.ObjectReference = in.ObjectReference
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceChange.
+func (in *ResourceChange) DeepCopy() *ResourceChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceChange)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -41,8 +41,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1.ControllerDeployment{}.OpenAPIModelName():                              schema_pkg_apis_core_v1_ControllerDeployment(ref),
 		v1.ControllerDeploymentList{}.OpenAPIModelName():                          schema_pkg_apis_core_v1_ControllerDeploymentList(ref),
 		v1.HelmControllerDeployment{}.OpenAPIModelName():                          schema_pkg_apis_core_v1_HelmControllerDeployment(ref),
+		v1.KeylessIdentity{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1_KeylessIdentity(ref),
+		v1.KeylessVerification{}.OpenAPIModelName():                               schema_pkg_apis_core_v1_KeylessVerification(ref),
+		v1.ManifestsControllerDeployment{}.OpenAPIModelName():                     schema_pkg_apis_core_v1_ManifestsControllerDeployment(ref),
 		v1.NamedResourceReference{}.OpenAPIModelName():                            schema_pkg_apis_core_v1_NamedResourceReference(ref),
 		v1.OCIRepository{}.OpenAPIModelName():                                     schema_pkg_apis_core_v1_OCIRepository(ref),
+		v1.OCIRepositoryVerification{}.OpenAPIModelName():                         schema_pkg_apis_core_v1_OCIRepositoryVerification(ref),
 		v1beta1.APIServerLogging{}.OpenAPIModelName():                             schema_pkg_apis_core_v1beta1_APIServerLogging(ref),
 		v1beta1.APIServerRequests{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_APIServerRequests(ref),
 		v1beta1.AccessRestriction{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_AccessRestriction(ref),
@@ -135,6 +139,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.IngressController{}.OpenAPIModelName():                            schema_pkg_apis_core_v1beta1_IngressController(ref),
 		v1beta1.InternalSecret{}.OpenAPIModelName():                               schema_pkg_apis_core_v1beta1_InternalSecret(ref),
 		v1beta1.InternalSecretList{}.OpenAPIModelName():                           schema_pkg_apis_core_v1beta1_InternalSecretList(ref),
+		v1beta1.KeylessIdentity{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_KeylessIdentity(ref),
+		v1beta1.KeylessVerification{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_KeylessVerification(ref),
 		v1beta1.KubeAPIServerConfig{}.OpenAPIModelName():                          schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref),
 		v1beta1.KubeControllerManagerConfig{}.OpenAPIModelName():                  schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref),
 		v1beta1.KubeProxyConfig{}.OpenAPIModelName():                              schema_pkg_apis_core_v1beta1_KubeProxyConfig(ref),
@@ -170,6 +176,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.MaintenanceCredentialsAutoRotation{}.OpenAPIModelName():           schema_pkg_apis_core_v1beta1_MaintenanceCredentialsAutoRotation(ref),
 		v1beta1.MaintenanceRotationConfig{}.OpenAPIModelName():                    schema_pkg_apis_core_v1beta1_MaintenanceRotationConfig(ref),
 		v1beta1.MaintenanceTimeWindow{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
+		v1beta1.ManifestsControllerDeployment{}.OpenAPIModelName():                schema_pkg_apis_core_v1beta1_ManifestsControllerDeployment(ref),
 		v1beta1.ManualWorkerPoolRollout{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_ManualWorkerPoolRollout(ref),
 		v1beta1.MemorySwapConfiguration{}.OpenAPIModelName():                      schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
 		v1beta1.Monitoring{}.OpenAPIModelName():                                   schema_pkg_apis_core_v1beta1_Monitoring(ref),
@@ -183,6 +190,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		v1beta1.NginxIngress{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_NginxIngress(ref),
 		v1beta1.NodeLocalDNS{}.OpenAPIModelName():                                 schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref),
 		v1beta1.OCIRepository{}.OpenAPIModelName():                                schema_pkg_apis_core_v1beta1_OCIRepository(ref),
+		v1beta1.OCIRepositoryVerification{}.OpenAPIModelName():                    schema_pkg_apis_core_v1beta1_OCIRepositoryVerification(ref),
 		v1beta1.ObservabilityRotation{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_ObservabilityRotation(ref),
 		v1beta1.PendingWorkerUpdates{}.OpenAPIModelName():                         schema_pkg_apis_core_v1beta1_PendingWorkerUpdates(ref),
 		v1beta1.PendingWorkersRollout{}.OpenAPIModelName():                        schema_pkg_apis_core_v1beta1_PendingWorkersRollout(ref),
@@ -943,7 +951,7 @@ func schema_pkg_apis_core_v1_ControllerDeployment(ref common.ReferenceCallback)
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources is a list of named resource references that can be referenced in the Helm chart values via Go template syntax (e.g. `{{ .resources.<name>.data.<key> }}`). Only resources of kind `Secret` and `ConfigMap` (apiVersion `v1`) are supported. The referenced resources must reside in the garden namespace.",
+							Description: "Resources is a list of named resource references that can be referenced in the Helm chart or manifests values via Go template syntax (e.g. `{{ .resources.<name>.data.<key> }}`). Only resources of kind `Secret` and `ConfigMap` (apiVersion `v1`) are supported. The referenced resources must reside in the garden namespace.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -954,11 +962,17 @@ func schema_pkg_apis_core_v1_ControllerDeployment(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"manifests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manifests configures that an extension controller is deployed using plain manifests or a kustomization.",
+							Ref:         ref(v1.ManifestsControllerDeployment{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			v1.HelmControllerDeployment{}.OpenAPIModelName(), v1.NamedResourceReference{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
+			v1.HelmControllerDeployment{}.OpenAPIModelName(), v1.ManifestsControllerDeployment{}.OpenAPIModelName(), v1.NamedResourceReference{}.OpenAPIModelName(), metav1.ObjectMeta{}.OpenAPIModelName()},
 	}
 }
 
@@ -1046,6 +1060,151 @@ func schema_pkg_apis_core_v1_HelmControllerDeployment(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_core_v1_KeylessIdentity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KeylessIdentity is an identity for which signing certificates are issued.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"issuer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Issuer is the OIDC issuer which authenticated the identity, e.g., `https://token.actions.githubusercontent.com`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject is the subject of the identity, i.e., the email address or URI contained in the signing certificate.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"issuer", "subject"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1_KeylessVerification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KeylessVerification configures the verification of signatures which were created with short-lived certificates.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"identities": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Identities is a list of identities which are trusted for signing the artifact. The signing certificate must have been issued for any of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(v1.KeylessIdentity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"certificateAuthorities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthorities is a PEM-encoded bundle of the root and intermediate certificates of the certificate authority (e.g., Fulcio) which issues the signing certificates.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"transparencyLogPublicKeys": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TransparencyLogPublicKeys is a list of PEM-encoded public keys of the transparency log (e.g., Rekor). The signature must have been recorded in the transparency log while the signing certificate was valid.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"certificateTransparencyLogPublicKeys": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateTransparencyLogPublicKeys is a list of PEM-encoded public keys of the certificate transparency log (e.g., the CT log of Fulcio). The signing certificate must contain a signed certificate timestamp of the certificate transparency log, i.e., it must have been published in it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"identities", "certificateAuthorities", "transparencyLogPublicKeys", "certificateTransparencyLogPublicKeys"},
+			},
+		},
+		Dependencies: []string{
+			v1.KeylessIdentity{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1_ManifestsControllerDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManifestsControllerDeployment configures how an extension controller is deployed using plain manifests or a kustomization.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ociRepository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCIRepository defines where to pull the artifact containing the manifests or the kustomization.",
+							Ref:         ref(v1.OCIRepository{}.OpenAPIModelName()),
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the directory in the artifact which contains the manifests or the kustomization. It defaults to the root directory of the artifact.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values are the values which can be referenced in the templates (`*.tpl.yaml` files) via Go template syntax (e.g. `{{ .Values.<key> }}`).",
+							Ref:         ref(apiextensionsv1.JSON{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"ociRepository"},
+			},
+		},
+		Dependencies: []string{
+			v1.OCIRepository{}.OpenAPIModelName(), apiextensionsv1.JSON{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1_NamedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1124,11 +1283,57 @@ func schema_pkg_apis_core_v1_OCIRepository(ref common.ReferenceCallback) common.
 							Ref:         ref(corev1.LocalObjectReference{}.OpenAPIModelName()),
 						},
 					},
+					"verification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verification is the policy for verifying the cosign signature of the artifact. If set, the artifact is only used if it has a valid signature which matches the policy.",
+							Ref:         ref(v1.OCIRepositoryVerification{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			corev1.LocalObjectReference{}.OpenAPIModelName()},
+			v1.OCIRepositoryVerification{}.OpenAPIModelName(), corev1.LocalObjectReference{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1_OCIRepositoryVerification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OCIRepositoryVerification is the policy for verifying the cosign signature of an OCI artifact. A signature is accepted if it was created with any of the public keys or by any of the keyless identities.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"publicKeys": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicKeys is a list of PEM-encoded public keys (ECDSA, RSA, or Ed25519) which are trusted for signing the artifact.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"keyless": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Keyless configures the verification of signatures which were created with short-lived certificates (keyless signing).",
+							Ref:         ref(v1.KeylessVerification{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1.KeylessVerification{}.OpenAPIModelName()},
 	}
 }
 
@@ -4913,6 +5118,116 @@ func schema_pkg_apis_core_v1beta1_InternalSecretList(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_KeylessIdentity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KeylessIdentity is an identity for which signing certificates are issued.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"issuer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Issuer is the OIDC issuer which authenticated the identity, e.g., `https://token.actions.githubusercontent.com`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject is the subject of the identity, i.e., the email address or URI contained in the signing certificate.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"issuer", "subject"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KeylessVerification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KeylessVerification configures the verification of signatures which were created with short-lived certificates.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"identities": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Identities is a list of identities which are trusted for signing the artifact. The signing certificate must have been issued for any of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(v1beta1.KeylessIdentity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"certificateAuthorities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthorities is a PEM-encoded bundle of the root and intermediate certificates of the certificate authority (e.g., Fulcio) which issues the signing certificates.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"transparencyLogPublicKeys": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TransparencyLogPublicKeys is a list of PEM-encoded public keys of the transparency log (e.g., Rekor). The signature must have been recorded in the transparency log while the signing certificate was valid.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"certificateTransparencyLogPublicKeys": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateTransparencyLogPublicKeys is a list of PEM-encoded public keys of the certificate transparency log (e.g., the CT log of Fulcio). The signing certificate must contain a signed certificate timestamp of the certificate transparency log, i.e., it must have been published in it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"identities", "certificateAuthorities", "transparencyLogPublicKeys", "certificateTransparencyLogPublicKeys"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.KeylessIdentity{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6674,6 +6989,41 @@ func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ManifestsControllerDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManifestsControllerDeployment configures how an extension controller is deployed using plain manifests or a kustomization. While this is not a proper API type, we need to define the structure in the API package so that we can convert it to the internal API version in the new representation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ociRepository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCIRepository defines where to pull the artifact containing the manifests or the kustomization.",
+							Ref:         ref(v1beta1.OCIRepository{}.OpenAPIModelName()),
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the directory in the artifact which contains the manifests or the kustomization. It defaults to the root directory of the artifact.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values are the values which can be referenced in the templates (`*.tpl.yaml` files) via Go template syntax (e.g. `{{ .Values.<key> }}`).",
+							Ref:         ref(apiextensionsv1.JSON{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"ociRepository"},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.OCIRepository{}.OpenAPIModelName(), apiextensionsv1.JSON{}.OpenAPIModelName()},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ManualWorkerPoolRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7289,11 +7639,57 @@ func schema_pkg_apis_core_v1beta1_OCIRepository(ref common.ReferenceCallback) co
 							Ref:         ref(corev1.LocalObjectReference{}.OpenAPIModelName()),
 						},
 					},
+					"verification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verification is the policy for verifying the cosign signature of the artifact. If set, the artifact is only used if it has a valid signature which matches the policy.",
+							Ref:         ref(v1beta1.OCIRepositoryVerification{}.OpenAPIModelName()),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			corev1.LocalObjectReference{}.OpenAPIModelName()},
+			v1beta1.OCIRepositoryVerification{}.OpenAPIModelName(), corev1.LocalObjectReference{}.OpenAPIModelName()},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_OCIRepositoryVerification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OCIRepositoryVerification is the policy for verifying the cosign signature of an OCI artifact. A signature is accepted if it was created with any of the public keys or by any of the keyless identities.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"publicKeys": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicKeys is a list of PEM-encoded public keys (ECDSA, RSA, or Ed25519) which are trusted for signing the artifact.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"keyless": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Keyless configures the verification of signatures which were created with short-lived certificates (keyless signing).",
+							Ref:         ref(v1beta1.KeylessVerification{}.OpenAPIModelName()),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			v1beta1.KeylessVerification{}.OpenAPIModelName()},
 	}
 }
 
@@ -11168,6 +11564,13 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							Ref:         ref(v1beta1.WorkerControlPlane{}.OpenAPIModelName()),
 						},
 					},
+					"operatingSystemConfigUpdatePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperatingSystemConfigUpdatePolicy specifies when changes of the operating system configuration (e.g., files or systemd units) are applied by gardener-node-agent on existing nodes of this worker pool. If not set, changes are applied immediately. Changes required for the cluster to function (e.g., during credentials rotations) are always applied immediately. Holding changes is not supported for worker pools with in-place update strategies.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
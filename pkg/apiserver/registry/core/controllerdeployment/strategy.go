@@ -49,6 +49,7 @@ func mustIncreaseGeneration(oldControllerDeployment, newControllerDeployment *co
 	if !apiequality.Semantic.DeepEqual(oldControllerDeployment.ProviderConfig, newControllerDeployment.ProviderConfig) ||
 		!apiequality.Semantic.DeepEqual(oldControllerDeployment.Type, newControllerDeployment.Type) ||
 		!apiequality.Semantic.DeepEqual(oldControllerDeployment.Helm, newControllerDeployment.Helm) ||
+		!apiequality.Semantic.DeepEqual(oldControllerDeployment.Manifests, newControllerDeployment.Manifests) ||
 		!apiequality.Semantic.DeepEqual(oldControllerDeployment.Resources, newControllerDeployment.Resources) {
 		return true
 	}
@@ -31,6 +31,7 @@ import (
 	"github.com/gardener/gardener/pkg/api/core/validation"
 	"github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
@@ -64,6 +65,8 @@ func (shootStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
 	gardenerutils.SyncCloudProfileFields(nil, newShoot)
 
 	SyncDNSProviderCredentials(newShoot)
+
+	dropDisabledFields(newShoot, nil)
 }
 
 func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
@@ -86,6 +89,28 @@ func (shootStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object
 	}
 
 	gardenerutils.SyncCloudProfileFields(oldShoot, newShoot)
+
+	dropDisabledFields(newShoot, oldShoot)
+}
+
+// dropDisabledFields removes fields which are guarded by disabled feature gates from the new Shoot. Fields which are
+// already used by the old Shoot are kept so that existing Shoots can still be updated after a feature gate was disabled.
+func dropDisabledFields(newShoot, oldShoot *core.Shoot) {
+	if !features.DefaultFeatureGate.Enabled(features.OperatingSystemConfigUpdatePolicy) && !operatingSystemConfigUpdatePolicyInUse(oldShoot) {
+		for i := range newShoot.Spec.Provider.Workers {
+			newShoot.Spec.Provider.Workers[i].OperatingSystemConfigUpdatePolicy = nil
+		}
+	}
+}
+
+func operatingSystemConfigUpdatePolicyInUse(shoot *core.Shoot) bool {
+	if shoot == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(shoot.Spec.Provider.Workers, func(worker core.Worker) bool {
+		return worker.OperatingSystemConfigUpdatePolicy != nil
+	})
 }
 
 func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
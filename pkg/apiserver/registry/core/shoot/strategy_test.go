@@ -10,6 +10,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -19,7 +20,9 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	. "github.com/gardener/gardener/pkg/apiserver/registry/core/shoot"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("Strategy", func() {
@@ -166,6 +169,30 @@ var _ = Describe("Strategy", func() {
 				Expect(shoot.Spec.DNS.Providers[0].SecretName).To(BeNil())
 			})
 		})
+
+		Context("operatingSystemConfigUpdatePolicy", func() {
+			var shoot *core.Shoot
+
+			BeforeEach(func() {
+				shoot = &core.Shoot{Spec: core.ShootSpec{Provider: core.Provider{Workers: []core.Worker{
+					{Name: "worker", OperatingSystemConfigUpdatePolicy: new(core.OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow)},
+				}}}}
+			})
+
+			It("should keep the field if the feature gate is enabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.OperatingSystemConfigUpdatePolicy, true))
+
+				strategy.PrepareForCreate(ctx, shoot)
+				Expect(shoot.Spec.Provider.Workers[0].OperatingSystemConfigUpdatePolicy).To(PointTo(Equal(core.OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow)))
+			})
+
+			It("should drop the field if the feature gate is disabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.OperatingSystemConfigUpdatePolicy, false))
+
+				strategy.PrepareForCreate(ctx, shoot)
+				Expect(shoot.Spec.Provider.Workers[0].OperatingSystemConfigUpdatePolicy).To(BeNil())
+			})
+		})
 	})
 
 	Describe("#PrepareForUpdate", func() {
@@ -712,6 +739,39 @@ var _ = Describe("Strategy", func() {
 				Expect(newShoot.Generation).To(Equal(oldShoot.Generation))
 			})
 		})
+
+		Context("operatingSystemConfigUpdatePolicy", func() {
+			BeforeEach(func() {
+				oldShoot.Spec.Provider.Workers = []core.Worker{{Name: "worker"}}
+				newShoot = oldShoot.DeepCopy()
+				newShoot.Spec.Provider.Workers[0].OperatingSystemConfigUpdatePolicy = new(core.OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow)
+			})
+
+			It("should keep the field if the feature gate is enabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.OperatingSystemConfigUpdatePolicy, true))
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+				Expect(newShoot.Spec.Provider.Workers[0].OperatingSystemConfigUpdatePolicy).To(PointTo(Equal(core.OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow)))
+			})
+
+			It("should drop the field if the feature gate is disabled and the old Shoot does not use it", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.OperatingSystemConfigUpdatePolicy, false))
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+				Expect(newShoot.Spec.Provider.Workers[0].OperatingSystemConfigUpdatePolicy).To(BeNil())
+			})
+
+			It("should keep the field if the feature gate is disabled but the old Shoot already uses it", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.OperatingSystemConfigUpdatePolicy, false))
+
+				oldShoot.Spec.Provider.Workers[0].OperatingSystemConfigUpdatePolicy = new(core.OperatingSystemConfigUpdatePolicyImmediate)
+				newShoot.Spec.Provider.Workers = append(newShoot.Spec.Provider.Workers, core.Worker{Name: "worker2", OperatingSystemConfigUpdatePolicy: new(core.OperatingSystemConfigUpdatePolicyImmediate)})
+
+				strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+				Expect(newShoot.Spec.Provider.Workers[0].OperatingSystemConfigUpdatePolicy).To(PointTo(Equal(core.OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow)))
+				Expect(newShoot.Spec.Provider.Workers[1].OperatingSystemConfigUpdatePolicy).To(PointTo(Equal(core.OperatingSystemConfigUpdatePolicyImmediate)))
+			})
+		})
 	})
 
 	Describe("#Canonicalize", func() {
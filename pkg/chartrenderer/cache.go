@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartrenderer
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sync"
+
+	releaseutil "helm.sh/helm/v4/pkg/release/v1/util"
+)
+
+// DefaultRenderCacheSize is the default maximum number of rendered charts kept in the render cache of a chart renderer.
+const DefaultRenderCacheSize = 64
+
+// cachingRenderer is a chart renderer which caches the charts rendered by another chart renderer.
+type cachingRenderer struct {
+	renderer Interface
+	cache    *renderCache
+}
+
+// NewWithRenderCache returns a chart renderer which caches up to the given number of charts rendered by the given
+// renderer, so that rendering the same chart with the same release name, namespace and values again does not parse and
+// render the chart again. Since the cached result is returned, templates producing random output (e.g., with
+// `randAlphaNum` or `genCA`) are not rendered again as long as the chart is cached. Hence, only use it for charts which
+// are rendered repeatedly and whose output is applied as a whole.
+func NewWithRenderCache(renderer Interface, maxSize int) Interface {
+	return &cachingRenderer{
+		renderer: renderer,
+		cache:    newRenderCache(maxSize),
+	}
+}
+
+// RenderEmbeddedFS implements Interface.
+func (r *cachingRenderer) RenderEmbeddedFS(embeddedFS embed.FS, chartPath, releaseName, namespace string, values any) (*RenderedChart, error) {
+	return r.render(renderCacheKey{embeddedFS: embeddedFS, chartPath: chartPath}, releaseName, namespace, values, func() (*RenderedChart, error) {
+		return r.renderer.RenderEmbeddedFS(embeddedFS, chartPath, releaseName, namespace, values)
+	})
+}
+
+// RenderArchive implements Interface.
+func (r *cachingRenderer) RenderArchive(archive []byte, releaseName, namespace string, values any) (*RenderedChart, error) {
+	digest := sha256.Sum256(archive)
+
+	return r.render(renderCacheKey{chartDigest: hex.EncodeToString(digest[:])}, releaseName, namespace, values, func() (*RenderedChart, error) {
+		return r.renderer.RenderArchive(archive, releaseName, namespace, values)
+	})
+}
+
+// RenderManifestsArchive implements Interface.
+func (r *cachingRenderer) RenderManifestsArchive(archive []byte, manifestsPath, releaseName, namespace string, values any) (*RenderedChart, error) {
+	digest := sha256.Sum256(archive)
+
+	return r.render(renderCacheKey{chartDigest: hex.EncodeToString(digest[:]), chartPath: manifestsPath, manifests: true}, releaseName, namespace, values, func() (*RenderedChart, error) {
+		return r.renderer.RenderManifestsArchive(archive, manifestsPath, releaseName, namespace, values)
+	})
+}
+
+// render returns the cached rendered chart for the given chart, release and values. If it is not cached, the chart is
+// rendered with the given function.
+func (r *cachingRenderer) render(key renderCacheKey, releaseName, namespace string, values any, renderFunc func() (*RenderedChart, error)) (*RenderedChart, error) {
+	parsedValues, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse values for release %s: %w", releaseName, err)
+	}
+
+	valuesHash := sha256.Sum256(parsedValues)
+	key.releaseName, key.namespace, key.valuesHash = releaseName, namespace, hex.EncodeToString(valuesHash[:])
+
+	if rendered, found := r.cache.get(key); found {
+		return rendered, nil
+	}
+
+	rendered, err := renderFunc()
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.set(key, rendered)
+	return rendered, nil
+}
+
+// renderCacheKey identifies a rendered chart. The chart is either identified by the digest of its archive or by the
+// embedded file system and the path of the chart in it. embed.FS values are comparable and identify the file system
+// which is immutable for the lifetime of the process. For rendered manifests, the chart path is the path of the
+// manifests in the archive.
+type renderCacheKey struct {
+	chartDigest string
+	embeddedFS  embed.FS
+	chartPath   string
+	manifests   bool
+
+	releaseName string
+	namespace   string
+	valuesHash  string
+}
+
+// renderCache is a size-bounded cache for rendered charts which evicts the least recently used items.
+type renderCache struct {
+	maxSize int
+
+	mu sync.Mutex
+	// lru contains the cached items, the most recently used item is at the front.
+	lru     *list.List
+	entries map[renderCacheKey]*list.Element
+}
+
+type renderCacheEntry struct {
+	key   renderCacheKey
+	chart *RenderedChart
+}
+
+func newRenderCache(maxSize int) *renderCache {
+	return &renderCache{
+		maxSize: maxSize,
+		lru:     list.New(),
+		entries: map[renderCacheKey]*list.Element{},
+	}
+}
+
+// get returns a copy of the cached chart for the given key, so that callers can modify it without affecting the cache.
+func (c *renderCache) get(key renderCacheKey) (*RenderedChart, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, found := c.entries[key]
+	if !found {
+		return nil, false
+	}
+
+	c.lru.MoveToFront(element)
+	return element.Value.(*renderCacheEntry).chart.copy(), true
+}
+
+// set adds a copy of the given chart to the cache.
+func (c *renderCache) set(key renderCacheKey, chart *RenderedChart) {
+	if c.maxSize <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, found := c.entries[key]; found {
+		element.Value.(*renderCacheEntry).chart = chart.copy()
+		c.lru.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&renderCacheEntry{key: key, chart: chart.copy()})
+	for c.lru.Len() > c.maxSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*renderCacheEntry).key)
+	}
+}
+
+// copy returns a deep copy of the rendered chart.
+func (c *RenderedChart) copy() *RenderedChart {
+	manifests := make([]releaseutil.Manifest, 0, len(c.Manifests))
+	for _, manifest := range c.Manifests {
+		manifests = append(manifests, releaseutil.Manifest{
+			Name:    manifest.Name,
+			Content: manifest.Content,
+			Head:    copySimpleHead(manifest.Head),
+		})
+	}
+
+	return &RenderedChart{
+		ChartName: c.ChartName,
+		Manifests: manifests,
+	}
+}
+
+func copySimpleHead(head *releaseutil.SimpleHead) *releaseutil.SimpleHead {
+	if head == nil {
+		return nil
+	}
+
+	out := &releaseutil.SimpleHead{Version: head.Version, Kind: head.Kind}
+	if head.Metadata != nil {
+		out.Metadata = &struct {
+			Name        string            `json:"name"`
+			Annotations map[string]string `json:"annotations"`
+		}{Name: head.Metadata.Name, Annotations: maps.Clone(head.Metadata.Annotations)}
+	}
+	return out
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartrenderer_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	releaseutil "helm.sh/helm/v4/pkg/release/v1/util"
+
+	. "github.com/gardener/gardener/pkg/chartrenderer"
+	mockchartrenderer "github.com/gardener/gardener/pkg/chartrenderer/mock"
+)
+
+var _ = Describe("Render cache", func() {
+	var (
+		ctrl         *gomock.Controller
+		mockRenderer *mockchartrenderer.MockInterface
+		renderer     Interface
+
+		chartPath = "charts/alpine"
+		chart     *RenderedChart
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockRenderer = mockchartrenderer.NewMockInterface(ctrl)
+		renderer = NewWithRenderCache(mockRenderer, DefaultRenderCacheSize)
+
+		chart = &RenderedChart{ChartName: "alpine", Manifests: []releaseutil.Manifest{{
+			Name:    "alpine/templates/pod.yaml",
+			Content: "kind: Pod",
+			Head: &releaseutil.SimpleHead{Kind: "Pod", Metadata: &struct {
+				Name        string            `json:"name"`
+				Annotations map[string]string `json:"annotations"`
+			}{Name: "alpine", Annotations: map[string]string{"foo": "bar"}}},
+		}}}
+	})
+
+	Describe("#RenderEmbeddedFS", func() {
+		It("should return the cached chart if the chart is rendered again with the same values", func() {
+			mockRenderer.EXPECT().RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", map[string]string{"image": "alpine:3.4"}).Return(chart, nil)
+
+			first, err := renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", map[string]string{"image": "alpine:3.4"})
+			Expect(err).NotTo(HaveOccurred())
+			second, err := renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", map[string]string{"image": "alpine:3.4"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(first).To(Equal(chart))
+			Expect(second).To(Equal(chart))
+		})
+
+		It("should render the chart again if the values differ", func() {
+			mockRenderer.EXPECT().RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", map[string]string{"image": "alpine:3.4"}).Return(chart, nil)
+			mockRenderer.EXPECT().RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", map[string]string{"image": "alpine:3.5"}).Return(chart, nil)
+
+			_, err := renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", map[string]string{"image": "alpine:3.4"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", map[string]string{"image": "alpine:3.5"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should render the chart again if the namespace differs", func() {
+			mockRenderer.EXPECT().RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", nil).Return(chart, nil)
+			mockRenderer.EXPECT().RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "other", nil).Return(chart, nil)
+
+			_, err := renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "other", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not affect the cache if the rendered or returned chart is modified", func() {
+			mockRenderer.EXPECT().RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", nil).Return(chart, nil)
+
+			first, err := renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+			expected := &RenderedChart{ChartName: "alpine", Manifests: []releaseutil.Manifest{{
+				Name:    first.Manifests[0].Name,
+				Content: first.Manifests[0].Content,
+				Head: &releaseutil.SimpleHead{Kind: "Pod", Metadata: &struct {
+					Name        string            `json:"name"`
+					Annotations map[string]string `json:"annotations"`
+				}{Name: "alpine", Annotations: map[string]string{"foo": "bar"}}},
+			}}}
+
+			chart.Manifests[0].Head.Metadata.Name = "modified"
+			first.Manifests[0].Content = "modified"
+			first.Manifests[0].Head.Kind = "Modified"
+			first.Manifests[0].Head.Metadata.Annotations["foo"] = "modified"
+
+			second, err := renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(second).To(Equal(expected))
+		})
+
+		It("should not cache the chart if the cache is disabled", func() {
+			renderer = NewWithRenderCache(mockRenderer, 0)
+			mockRenderer.EXPECT().RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", nil).Return(chart, nil).Times(2)
+
+			_, err := renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should evict the least recently used chart", func() {
+			renderer = NewWithRenderCache(mockRenderer, 1)
+			mockRenderer.EXPECT().RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", nil).Return(chart, nil).Times(2)
+			mockRenderer.EXPECT().RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "other", nil).Return(chart, nil)
+
+			_, err := renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "other", nil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = renderer.RenderEmbeddedFS(embeddedFS, chartPath, "alpine", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#RenderArchive", func() {
+		archive := []byte("archive")
+
+		It("should return the cached chart if an archive with the same content is rendered again with the same values", func() {
+			mockRenderer.EXPECT().RenderArchive(archive, "alpine", "default", nil).Return(chart, nil)
+
+			_, err := renderer.RenderArchive(archive, "alpine", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+			second, err := renderer.RenderArchive(append([]byte(nil), archive...), "alpine", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(second).To(Equal(chart))
+		})
+
+		It("should not cache charts which cannot be rendered", func() {
+			mockRenderer.EXPECT().RenderArchive(archive, "alpine", "default", nil).Return(nil, errors.New("fake")).Times(2)
+
+			_, err := renderer.RenderArchive(archive, "alpine", "default", nil)
+			Expect(err).To(MatchError("fake"))
+			_, err = renderer.RenderArchive(archive, "alpine", "default", nil)
+			Expect(err).To(MatchError("fake"))
+		})
+	})
+
+	Describe("#RenderManifestsArchive", func() {
+		archive := []byte("archive")
+
+		It("should cache the rendered manifests per path", func() {
+			mockRenderer.EXPECT().RenderManifestsArchive(archive, "foo", "alpine", "default", nil).Return(chart, nil)
+			mockRenderer.EXPECT().RenderManifestsArchive(archive, "bar", "alpine", "default", nil).Return(chart, nil)
+			mockRenderer.EXPECT().RenderArchive(archive, "alpine", "default", nil).Return(chart, nil)
+
+			for range 2 {
+				_, err := renderer.RenderManifestsArchive(archive, "foo", "alpine", "default", nil)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = renderer.RenderManifestsArchive(archive, "bar", "alpine", "default", nil)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = renderer.RenderArchive(archive, "alpine", "default", nil)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	})
+})
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartrenderer
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+)
+
+// ChangeType is the type of a change of an object between two rendered charts.
+type ChangeType string
+
+const (
+	// ChangeTypeAdded is the type of changes of objects which are only contained in the new rendered chart.
+	ChangeTypeAdded ChangeType = "Added"
+	// ChangeTypeRemoved is the type of changes of objects which are only contained in the old rendered chart.
+	ChangeTypeRemoved ChangeType = "Removed"
+	// ChangeTypeModified is the type of changes of objects whose manifests differ between the rendered charts.
+	ChangeTypeModified ChangeType = "Modified"
+)
+
+// Change is a change of an object between two rendered charts.
+type Change struct {
+	// Type is the type of the change.
+	Type ChangeType
+	// Object identifies the changed object in the format strings.ToLower(kind + "/" + name), see RenderedChart.Files.
+	Object string
+}
+
+// String returns a human-readable representation of the change.
+func (c Change) String() string {
+	return strings.ToLower(string(c.Type)) + " " + c.Object
+}
+
+// Diff returns the changes of the objects between the old and the new rendered chart, sorted by object. Objects are
+// identified by their kind and name, and they are considered modified if their manifests differ (ignoring leading and
+// trailing whitespace). A nil chart is treated like a chart without objects. Manifests which cannot be identified, e.g.,
+// because they are empty, are ignored.
+func Diff(oldChart, newChart *RenderedChart) []Change {
+	return DiffObjectChecksums(ObjectChecksums(oldChart), ObjectChecksums(newChart))
+}
+
+// ObjectChecksums returns the checksums of the manifests (ignoring leading and trailing whitespace) of the objects in
+// the given rendered chart, keyed by the objects in the format of Change.Object. They can be kept instead of the
+// rendered chart for computing the changes with DiffObjectChecksums later on.
+func ObjectChecksums(chart *RenderedChart) map[string]string {
+	checksums := make(map[string]string)
+	if chart == nil {
+		return checksums
+	}
+
+	for _, manifest := range chart.Manifests {
+		if object := getResourceName(manifest); object != "" {
+			checksum := sha256.Sum256([]byte(strings.TrimSpace(manifest.Content)))
+			checksums[object] = hex.EncodeToString(checksum[:])
+		}
+	}
+	return checksums
+}
+
+// DiffObjectChecksums returns the changes of the objects between the old and the new object checksums (see
+// ObjectChecksums), sorted by object.
+func DiffObjectChecksums(oldChecksums, newChecksums map[string]string) []Change {
+	var changes []Change
+
+	for object, newChecksum := range newChecksums {
+		oldChecksum, found := oldChecksums[object]
+		switch {
+		case !found:
+			changes = append(changes, Change{Type: ChangeTypeAdded, Object: object})
+		case oldChecksum != newChecksum:
+			changes = append(changes, Change{Type: ChangeTypeModified, Object: object})
+		}
+	}
+
+	for object := range oldChecksums {
+		if _, found := newChecksums[object]; !found {
+			changes = append(changes, Change{Type: ChangeTypeRemoved, Object: object})
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b Change) int {
+		return cmp.Or(strings.Compare(a.Object, b.Object), strings.Compare(string(a.Type), string(b.Type)))
+	})
+	return changes
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartrenderer_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	releaseutil "helm.sh/helm/v4/pkg/release/v1/util"
+
+	. "github.com/gardener/gardener/pkg/chartrenderer"
+)
+
+var _ = Describe("Diff", func() {
+	manifest := func(kind, name, content string) releaseutil.Manifest {
+		return releaseutil.Manifest{
+			Name:    "test/templates/" + name + ".yaml",
+			Content: content,
+			Head: &releaseutil.SimpleHead{Kind: kind, Metadata: &struct {
+				Name        string            `json:"name"`
+				Annotations map[string]string `json:"annotations"`
+			}{Name: name}},
+		}
+	}
+
+	var oldChart, newChart *RenderedChart
+
+	BeforeEach(func() {
+		oldChart = &RenderedChart{ChartName: "test", Manifests: []releaseutil.Manifest{
+			manifest("Deployment", "foo", "replicas: 1"),
+			manifest("ConfigMap", "bar", "data: {}"),
+			manifest("Secret", "baz", "data: {}"),
+		}}
+		newChart = &RenderedChart{ChartName: "test", Manifests: []releaseutil.Manifest{
+			manifest("Deployment", "foo", "replicas: 2"),
+			manifest("ConfigMap", "bar", "data: {}\n"),
+			manifest("Service", "qux", "spec: {}"),
+		}}
+	})
+
+	It("should return the changed objects", func() {
+		Expect(Diff(oldChart, newChart)).To(Equal([]Change{
+			{Type: ChangeTypeModified, Object: "deployment/foo"},
+			{Type: ChangeTypeRemoved, Object: "secret/baz"},
+			{Type: ChangeTypeAdded, Object: "service/qux"},
+		}))
+	})
+
+	It("should return no changes for equal charts", func() {
+		Expect(Diff(oldChart, oldChart)).To(BeEmpty())
+	})
+
+	It("should treat a nil chart like a chart without objects", func() {
+		Expect(Diff(nil, oldChart)).To(Equal([]Change{
+			{Type: ChangeTypeAdded, Object: "configmap/bar"},
+			{Type: ChangeTypeAdded, Object: "deployment/foo"},
+			{Type: ChangeTypeAdded, Object: "secret/baz"},
+		}))
+		Expect(Diff(oldChart, nil)).To(HaveLen(3))
+	})
+
+	It("should ignore manifests which cannot be identified", func() {
+		newChart = &RenderedChart{ChartName: "test", Manifests: append(oldChart.Manifests, releaseutil.Manifest{Name: "test/templates/empty.yaml"})}
+		Expect(Diff(oldChart, newChart)).To(BeEmpty())
+	})
+
+	Describe("#DiffObjectChecksums", func() {
+		It("should return the changed objects", func() {
+			Expect(DiffObjectChecksums(ObjectChecksums(oldChart), ObjectChecksums(newChart))).To(Equal(Diff(oldChart, newChart)))
+		})
+
+		It("should treat nil checksums like a chart without objects", func() {
+			Expect(DiffObjectChecksums(nil, ObjectChecksums(oldChart))).To(HaveLen(3))
+		})
+	})
+
+	Describe("#ObjectChecksums", func() {
+		It("should return the checksums of the identifiable objects", func() {
+			newChart.Manifests = append(newChart.Manifests, releaseutil.Manifest{Name: "test/templates/empty.yaml"})
+
+			checksums := ObjectChecksums(newChart)
+			Expect(checksums).To(HaveLen(3))
+			Expect(checksums).To(HaveKeyWithValue("configmap/bar", ObjectChecksums(oldChart)["configmap/bar"]))
+			Expect(checksums["deployment/foo"]).NotTo(Equal(ObjectChecksums(oldChart)["deployment/foo"]))
+		})
+	})
+
+	Describe("Change#String", func() {
+		It("should return a human-readable representation", func() {
+			Expect(Change{Type: ChangeTypeModified, Object: "deployment/foo"}.String()).To(Equal("modified deployment/foo"))
+		})
+	})
+})
@@ -0,0 +1,395 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartrenderer
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/Masterminds/sprig/v3"
+	"helm.sh/helm/v4/pkg/chart/common"
+	"helm.sh/helm/v4/pkg/chart/loader/archive"
+	releaseutil "helm.sh/helm/v4/pkg/release/v1/util"
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/yaml"
+)
+
+// RenderManifestsArchive loads the files from the given gzip'ed tar archive and renders all templates (`*.tpl.yaml` files)
+// in the directory with the given path as Go templates (with the sprig functions and `toYaml`). The rendered templates
+// replace the files without the `.tpl` infix. If the directory with the given path in the archive contains a kustomization,
+// it is built with kustomize afterwards. Plugins and Helm chart inflation of kustomize are disabled, and kustomizations
+// referencing remote resources are rejected. Otherwise, all YAML files in the directory and its subdirectories are used
+// as plain manifests.
+func (r *chartRenderer) RenderManifestsArchive(archive []byte, manifestsPath, releaseName, namespace string, values any) (*RenderedChart, error) {
+	parsedValues, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse values for release %s: %w", releaseName, err)
+	}
+
+	files, err := loadManifestsArchive(archive)
+	if err != nil {
+		return nil, fmt.Errorf("can't load manifests from archive: %w", err)
+	}
+	return renderManifests(files, strings.TrimPrefix(path.Clean("/"+manifestsPath), "/"), releaseName, namespace, parsedValues)
+}
+
+func renderManifests(files map[string][]byte, dir, releaseName, namespace string, parsedValues []byte) (*RenderedChart, error) {
+	var values map[string]any
+	if err := json.Unmarshal(parsedValues, &values); err != nil {
+		return nil, fmt.Errorf("failed to read values for release %s: %w", releaseName, err)
+	}
+
+	data := map[string]any{
+		"Values": values,
+		"Release": map[string]any{
+			"Name":      releaseName,
+			"Namespace": namespace,
+		},
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		// Only templates are rendered, all other files are used as they are, so that plain manifests can contain literal
+		// template expressions (e.g., in alerting rules). Templates outside of the directory (e.g., in kustomize bases
+		// in sibling directories) are not rendered either.
+		target, ok := renderedTemplateName(name)
+		if !ok || !isInDirectory(name, dir) {
+			continue
+		}
+		if _, ok := files[target]; ok {
+			return nil, fmt.Errorf("template %s conflicts with file %s", name, target)
+		}
+
+		rendered, err := renderTemplate(name, files[name], data)
+		if err != nil {
+			return nil, err
+		}
+		delete(files, name)
+		files[target] = rendered
+	}
+
+	var manifestFiles map[string]string
+	if kustomizationFile, ok := findKustomizationFile(files, dir); ok {
+		manifest, err := buildKustomization(files, dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build kustomization %s: %w", kustomizationFile, err)
+		}
+		manifestFiles = map[string]string{path.Join(releaseName, kustomizationFile): manifest}
+	} else {
+		manifestFiles = make(map[string]string)
+		for name, content := range files {
+			if isYAMLFile(name) && !isKustomizationFile(name) && isInDirectory(name, dir) {
+				manifestFiles[path.Join(releaseName, name)] = string(content)
+			}
+		}
+	}
+
+	if len(manifestFiles) == 0 {
+		return nil, fmt.Errorf("no manifests or kustomization found in directory %q of the archive", dir)
+	}
+
+	_, manifests, err := releaseutil.SortManifests(manifestFiles, common.DefaultVersionSet, releaseutil.InstallOrder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sort manifests: %w", err)
+	}
+
+	return &RenderedChart{
+		ChartName: releaseName,
+		Manifests: manifests,
+	}, nil
+}
+
+// templateFuncs returns the functions which can be used in the manifest templates.
+func templateFuncs() template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	funcs["toYaml"] = func(v any) (string, error) {
+		out, err := yaml.Marshal(v)
+		return strings.TrimSuffix(string(out), "\n"), err
+	}
+	return funcs
+}
+
+// emptyIfNilFuncName is the name of the function which is appended to all printed pipelines of the manifest templates.
+const emptyIfNilFuncName = "_emptyIfNil"
+
+func renderTemplate(name string, content []byte, data map[string]any) ([]byte, error) {
+	tmpl, err := template.New(name).
+		Option("missingkey=zero").
+		Funcs(templateFuncs()).
+		Funcs(template.FuncMap{emptyIfNilFuncName: emptyIfNil}).
+		Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", name, err)
+	}
+
+	// Like Helm, render missing values as empty strings. Even with 'missingkey=zero', text/template prints missing
+	// values as '<no value>' since the values are maps with interface elements, hence nil values are replaced before
+	// printing.
+	for _, t := range tmpl.Templates() {
+		appendEmptyIfNil(t.Tree, t.Root)
+	}
+
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		return nil, fmt.Errorf("failed to render template %s: %w", name, err)
+	}
+
+	return out.Bytes(), nil
+}
+
+// appendEmptyIfNil appends the emptyIfNil function to the pipelines of all actions in the given node which print their
+// value.
+func appendEmptyIfNil(tree *parse.Tree, node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			appendEmptyIfNil(tree, child)
+		}
+	case *parse.ActionNode:
+		if len(n.Pipe.Decl) == 0 {
+			n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{
+				NodeType: parse.NodeCommand,
+				Pos:      n.Pos,
+				Args:     []parse.Node{parse.NewIdentifier(emptyIfNilFuncName).SetTree(tree).SetPos(n.Pos)},
+			})
+		}
+	case *parse.IfNode:
+		appendEmptyIfNil(tree, n.List)
+		appendEmptyIfNil(tree, n.ElseList)
+	case *parse.RangeNode:
+		appendEmptyIfNil(tree, n.List)
+		appendEmptyIfNil(tree, n.ElseList)
+	case *parse.WithNode:
+		appendEmptyIfNil(tree, n.List)
+		appendEmptyIfNil(tree, n.ElseList)
+	}
+}
+
+func emptyIfNil(value any) any {
+	if value == nil {
+		return ""
+	}
+	return value
+}
+
+func buildKustomization(files map[string][]byte, dir string) (string, error) {
+	// krusty does not allow replacing its loader which fetches remote files via HTTP and clones remote bases with git.
+	// Hence, remote references are rejected before building so that only files in the archive are used.
+	if err := checkNoRemoteReferences(files); err != nil {
+		return "", err
+	}
+
+	fSys := filesys.MakeFsInMemory()
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		if err := fSys.WriteFile("/"+name, files[name]); err != nil {
+			return "", err
+		}
+	}
+
+	resMap, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fSys, "/"+dir)
+	if err != nil {
+		return "", err
+	}
+
+	manifest, err := resMap.AsYaml()
+	if err != nil {
+		return "", err
+	}
+	return string(manifest), nil
+}
+
+func checkNoRemoteReferences(files map[string][]byte) error {
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		if !isKustomizationFile(name) {
+			continue
+		}
+
+		kustomization := &types.Kustomization{}
+		if err := yaml.Unmarshal(files[name], kustomization); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+
+		for _, reference := range kustomizationReferences(kustomization) {
+			if isRemoteReference(reference) {
+				return fmt.Errorf("%s references the remote resource %q, only files in the archive can be referenced", name, reference)
+			}
+		}
+	}
+	return nil
+}
+
+// kustomizationReferences returns all paths of files and directories which are loaded by kustomize when building the
+// given kustomization.
+func kustomizationReferences(kustomization *types.Kustomization) []string {
+	references := slices.Concat(
+		kustomization.Resources,
+		kustomization.Bases,
+		kustomization.Components,
+		kustomization.Crds,
+		kustomization.Configurations,
+		kustomization.Generators,
+		kustomization.Transformers,
+		kustomization.Validators,
+	)
+
+	for _, patch := range kustomization.PatchesStrategicMerge {
+		references = append(references, string(patch))
+	}
+	for _, patch := range slices.Concat(kustomization.Patches, kustomization.PatchesJson6902) {
+		references = append(references, patch.Path)
+	}
+	for _, replacement := range kustomization.Replacements {
+		references = append(references, replacement.Path)
+	}
+	if openAPIPath, ok := kustomization.OpenAPI["path"]; ok {
+		references = append(references, openAPIPath)
+	}
+
+	sources := make([]types.KvPairSources, 0, len(kustomization.ConfigMapGenerator)+len(kustomization.SecretGenerator))
+	for _, generator := range kustomization.ConfigMapGenerator {
+		sources = append(sources, generator.KvPairSources)
+	}
+	for _, generator := range kustomization.SecretGenerator {
+		sources = append(sources, generator.KvPairSources)
+	}
+	for _, source := range sources {
+		for _, fileSource := range source.FileSources {
+			// File sources have the format '[key=]path'.
+			_, filePath, found := strings.Cut(fileSource, "=")
+			if !found {
+				filePath = fileSource
+			}
+			references = append(references, filePath)
+		}
+		references = append(references, source.EnvSources...)
+		references = append(references, source.EnvSource)
+	}
+
+	// Inline patches and configurations are not loaded from anywhere.
+	return slices.DeleteFunc(references, func(reference string) bool {
+		return len(reference) == 0 || strings.Contains(reference, "\n")
+	})
+}
+
+// isRemoteReference returns whether kustomize treats the given reference as remote file (URL) or as remote git
+// repository (URL, SCP-like address, or GitHub path), see
+// https://github.com/kubernetes-sigs/kustomize/blob/master/examples/remoteBuild.md.
+func isRemoteReference(reference string) bool {
+	reference = strings.ToLower(strings.TrimSpace(reference))
+	host, _, _ := strings.Cut(reference, "/")
+
+	return strings.Contains(reference, "://") ||
+		strings.HasPrefix(reference, "git::") ||
+		strings.HasPrefix(reference, "github.com") ||
+		strings.Contains(host, "@")
+}
+
+func findKustomizationFile(files map[string][]byte, dir string) (string, bool) {
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		if _, ok := files[path.Join(dir, name)]; ok {
+			return path.Join(dir, name), true
+		}
+	}
+	return "", false
+}
+
+// templateInfix is the infix of the names of YAML files which are rendered as Go templates, e.g., `deployment.tpl.yaml`.
+const templateInfix = ".tpl"
+
+// renderedTemplateName returns the name of the file which the template with the given name is rendered to, i.e., the
+// name without the template infix. It returns false if the file with the given name is not a template.
+func renderedTemplateName(name string) (string, bool) {
+	if !isYAMLFile(name) {
+		return "", false
+	}
+
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	if path.Ext(base) != templateInfix {
+		return "", false
+	}
+	return strings.TrimSuffix(base, templateInfix) + ext, true
+}
+
+func isYAMLFile(name string) bool {
+	return path.Ext(name) == ".yaml" || path.Ext(name) == ".yml"
+}
+
+func isKustomizationFile(name string) bool {
+	return slices.Contains(konfig.RecognizedKustomizationFileNames(), path.Base(name))
+}
+
+func isInDirectory(name, dir string) bool {
+	return dir == "" || strings.HasPrefix(name, dir+"/")
+}
+
+// loadManifestsArchive returns the regular files of the given gzip'ed tar archive keyed by their cleaned path. Like for
+// Helm chart archives, the size of the decompressed files is limited.
+func loadManifestsArchive(data []byte) (map[string][]byte, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = gzipReader.Close() }()
+
+	var (
+		tarReader     = tar.NewReader(gzipReader)
+		files         = make(map[string][]byte)
+		remainingSize = archive.MaxDecompressedChartSize
+	)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Skip directories as well as irregular files and links which might point outside of the archive.
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean(header.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, fmt.Errorf("file %q is outside of the archive", header.Name)
+		}
+
+		if header.Size > archive.MaxDecompressedFileSize {
+			return nil, fmt.Errorf("decompressed file %q is larger than the maximum file size %d", header.Name, archive.MaxDecompressedFileSize)
+		}
+		if header.Size > remainingSize {
+			return nil, fmt.Errorf("decompressed archive is larger than the maximum size %d", archive.MaxDecompressedChartSize)
+		}
+
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading file %q: %w", header.Name, err)
+		}
+		remainingSize -= int64(len(content))
+
+		files[name] = bytes.TrimPrefix(content, utf8bom)
+	}
+
+	return files, nil
+}
@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartrenderer_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"maps"
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/version"
+
+	"github.com/gardener/gardener/pkg/chartrenderer"
+)
+
+var _ = Describe("Manifests", func() {
+	var renderer chartrenderer.Interface
+
+	BeforeEach(func() {
+		renderer = chartrenderer.NewWithServerVersion(&version.Info{})
+	})
+
+	Describe("#RenderManifestsArchive", func() {
+		It("should render the templates with the values", func() {
+			archive := newArchive(map[string]string{
+				"deployment.tpl.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Release.Namespace }}
+spec:
+  replicas: {{ .Values.replicas }}`,
+				"rbac/serviceaccount.tpl.yml": `apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: foo
+  namespace: {{ .Release.Namespace }}
+  labels:
+    {{- toYaml .Values.labels | nindent 4 }}`,
+				"README.md": "{{ .Invalid",
+			})
+
+			rendered, err := renderer.RenderManifestsArchive(archive, "", "extension-foo", "extension-foo-ns", map[string]any{
+				"replicas": 2,
+				"labels":   map[string]any{"app": "foo"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rendered.ChartName).To(Equal("extension-foo"))
+			Expect(rendered.AsSecretData()).To(Equal(map[string][]byte{
+				"extension-foo_deployment.yaml": []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: extension-foo
+  namespace: extension-foo-ns
+spec:
+  replicas: 2`),
+				"extension-foo_rbac_serviceaccount.yml": []byte(`apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: foo
+  namespace: extension-foo-ns
+  labels:
+    app: foo`),
+			}))
+		})
+
+		It("should use plain manifests as they are", func() {
+			manifest := `apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: foo
+spec:
+  groups:
+  - name: foo
+    rules:
+    - alert: Foo
+      annotations:
+        description: '{{ $labels.pod }} is {{ .Invalid'`
+			archive := newArchive(map[string]string{"prometheusrule.yaml": manifest})
+
+			rendered, err := renderer.RenderManifestsArchive(archive, "", "extension-foo", "default", map[string]any{"foo": "bar"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rendered.AsSecretData()).To(Equal(map[string][]byte{"extension-foo_prometheusrule.yaml": []byte(manifest)}))
+		})
+
+		It("should only render the manifests in the given directory", func() {
+			archive := newArchive(map[string]string{
+				"foo/configmap.yaml": configMap("foo"),
+				"bar/configmap.yaml": configMap("bar"),
+			})
+
+			rendered, err := renderer.RenderManifestsArchive(archive, "./foo/", "extension-foo", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rendered.AsSecretData()).To(HaveKey("extension-foo_foo_configmap.yaml"))
+			Expect(rendered.AsSecretData()).To(HaveLen(1))
+		})
+
+		It("should not render templates outside of the given directory", func() {
+			archive := newArchive(map[string]string{
+				"foo/configmap.yaml":     configMap("foo"),
+				"bar/configmap.tpl.yaml": "{{ .Invalid",
+			})
+
+			rendered, err := renderer.RenderManifestsArchive(archive, "foo", "extension-foo", "default", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rendered.AsSecretData()).To(HaveKey("extension-foo_foo_configmap.yaml"))
+		})
+
+		It("should render missing values as empty strings", func() {
+			archive := newArchive(map[string]string{"configmap.tpl.yaml": configMap("foo") + `
+data:
+  value: "{{ .Values.missing }}"
+  nested: "{{ .Values.foo.missing }}"
+  {{- if true }}
+  conditional: "{{ .Values.missing }}"
+  {{- end }}
+  {{- $value := .Values.missing }}
+  variable: "{{ $value }}"
+  literal: "<no value>"`})
+
+			rendered, err := renderer.RenderManifestsArchive(archive, "", "extension-foo", "default", map[string]any{"foo": map[string]any{}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(rendered.AsSecretData()["extension-foo_configmap.yaml"])).To(HaveSuffix(`
+data:
+  value: ""
+  nested: ""
+  conditional: ""
+  variable: ""
+  literal: "<no value>"`))
+		})
+
+		It("should build the kustomization with the values", func() {
+			archive := newArchive(map[string]string{
+				"base/kustomization.yaml": `resources:
+- configmap.yaml`,
+				"base/configmap.yaml": configMap("config"),
+				"overlay/kustomization.tpl.yaml": `namespace: {{ .Release.Namespace }}
+namePrefix: {{ .Values.prefix }}-
+resources:
+- ../base
+- secret.yaml`,
+				"overlay/secret.tpl.yaml": `apiVersion: v1
+kind: Secret
+metadata:
+  name: secret
+stringData:
+  seed: {{ .Values.gardener.seed.name }}`,
+			})
+
+			rendered, err := renderer.RenderManifestsArchive(archive, "overlay", "extension-foo", "extension-foo-ns", map[string]any{
+				"prefix":   "foo",
+				"gardener": map[string]any{"seed": map[string]any{"name": "seed"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rendered.AsSecretData()).To(Equal(map[string][]byte{
+				"extension-foo_overlay_kustomization_configmap_foo-config.yaml": []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo-config
+  namespace: extension-foo-ns`),
+				"extension-foo_overlay_kustomization_secret_foo-secret.yaml": []byte(`apiVersion: v1
+kind: Secret
+metadata:
+  name: foo-secret
+  namespace: extension-foo-ns
+stringData:
+  seed: seed
+`),
+			}))
+		})
+
+		It("should fail if the directory does not contain manifests", func() {
+			archive := newArchive(map[string]string{"foo/configmap.yaml": configMap("foo")})
+
+			_, err := renderer.RenderManifestsArchive(archive, "bar", "extension-foo", "default", nil)
+			Expect(err).To(MatchError(`no manifests or kustomization found in directory "bar" of the archive`))
+		})
+
+		It("should fail if a template is invalid", func() {
+			archive := newArchive(map[string]string{"configmap.tpl.yaml": "{{ .Values.foo"})
+
+			_, err := renderer.RenderManifestsArchive(archive, "", "extension-foo", "default", nil)
+			Expect(err).To(MatchError(ContainSubstring("failed to parse template configmap.tpl.yaml")))
+		})
+
+		It("should fail if a template conflicts with a plain manifest", func() {
+			archive := newArchive(map[string]string{
+				"configmap.yaml":     configMap("foo"),
+				"configmap.tpl.yaml": configMap("bar"),
+			})
+
+			_, err := renderer.RenderManifestsArchive(archive, "", "extension-foo", "default", nil)
+			Expect(err).To(MatchError("template configmap.tpl.yaml conflicts with file configmap.yaml"))
+		})
+
+		It("should fail if the kustomization cannot be built", func() {
+			archive := newArchive(map[string]string{"kustomization.yaml": `resources:
+- missing.yaml`})
+
+			_, err := renderer.RenderManifestsArchive(archive, "", "extension-foo", "default", nil)
+			Expect(err).To(MatchError(ContainSubstring("failed to build kustomization kustomization.yaml")))
+		})
+
+		DescribeTable("should fail if the kustomization references remote resources",
+			func(kustomization, reference string) {
+				archive := newArchive(map[string]string{
+					"base/kustomization.yaml": kustomization,
+					"kustomization.yaml": `resources:
+- base`,
+				})
+
+				_, err := renderer.RenderManifestsArchive(archive, "", "extension-foo", "default", nil)
+				Expect(err).To(MatchError(ContainSubstring(`base/kustomization.yaml references the remote resource %q, only files in the archive can be referenced`, reference)))
+			},
+
+			Entry("remote base via https", `resources:
+- https://github.com/gardener/gardener//example?ref=v1.0.0`, "https://github.com/gardener/gardener//example?ref=v1.0.0"),
+			Entry("remote base via GitHub path", `resources:
+- github.com/gardener/gardener/example`, "github.com/gardener/gardener/example"),
+			Entry("remote base via SCP-like address", `components:
+- git@example.com:gardener/gardener.git/example`, "git@example.com:gardener/gardener.git/example"),
+			Entry("remote base via local git repository", `bases:
+- file:///tmp/repo`, "file:///tmp/repo"),
+			Entry("remote patch", `patches:
+- path: https://example.com/patch.yaml`, "https://example.com/patch.yaml"),
+			Entry("remote generator file", `configMapGenerator:
+- name: foo
+  files:
+  - config=http://example.com/config`, "http://example.com/config"),
+		)
+
+		It("should fail if the archive contains files outside of its root", func() {
+			archive := newArchive(map[string]string{"../configmap.yaml": configMap("foo")})
+
+			_, err := renderer.RenderManifestsArchive(archive, "", "extension-foo", "default", nil)
+			Expect(err).To(MatchError(ContainSubstring(`file "../configmap.yaml" is outside of the archive`)))
+		})
+
+		It("should fail if the archive is not a gzip'ed tar archive", func() {
+			_, err := renderer.RenderManifestsArchive([]byte("foo"), "", "extension-foo", "default", nil)
+			Expect(err).To(MatchError(ContainSubstring("can't load manifests from archive")))
+		})
+	})
+})
+
+func configMap(name string) string {
+	return `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ` + name
+}
+
+func newArchive(files map[string]string) []byte {
+	var (
+		buffer     bytes.Buffer
+		gzipWriter = gzip.NewWriter(&buffer)
+		tarWriter  = tar.NewWriter(gzipWriter)
+	)
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		Expect(tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(files[name])), Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tarWriter.Write([]byte(files[name]))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzipWriter.Close()).To(Succeed())
+	return buffer.Bytes()
+}
@@ -72,6 +72,21 @@ func (mr *MockInterfaceMockRecorder) RenderEmbeddedFS(embeddedFS, chartPath, rel
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderEmbeddedFS", reflect.TypeOf((*MockInterface)(nil).RenderEmbeddedFS), embeddedFS, chartPath, releaseName, namespace, values)
 }
 
+// RenderManifestsArchive mocks base method.
+func (m *MockInterface) RenderManifestsArchive(archive []byte, manifestsPath, releaseName, namespace string, values any) (*chartrenderer.RenderedChart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderManifestsArchive", archive, manifestsPath, releaseName, namespace, values)
+	ret0, _ := ret[0].(*chartrenderer.RenderedChart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderManifestsArchive indicates an expected call of RenderManifestsArchive.
+func (mr *MockInterfaceMockRecorder) RenderManifestsArchive(archive, manifestsPath, releaseName, namespace, values any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderManifestsArchive", reflect.TypeOf((*MockInterface)(nil).RenderManifestsArchive), archive, manifestsPath, releaseName, namespace, values)
+}
+
 // MockFactory is a mock of Factory interface.
 type MockFactory struct {
 	ctrl     *gomock.Controller
@@ -14,6 +14,11 @@ import (
 type Interface interface {
 	RenderEmbeddedFS(embeddedFS embed.FS, chartPath, releaseName, namespace string, values any) (*RenderedChart, error)
 	RenderArchive(archive []byte, releaseName, namespace string, values any) (*RenderedChart, error)
+	// RenderManifestsArchive renders the plain manifests or the kustomization in the directory with the given path in
+	// the gzip'ed tar archive. Before, all templates (`*.tpl.yaml` files) in this directory are rendered as Go templates
+	// which can reference the values (`.Values`) as well as the release name and namespace (`.Release.Name`,
+	// `.Release.Namespace`). All other files are used as they are.
+	RenderManifestsArchive(archive []byte, manifestsPath, releaseName, namespace string, values any) (*RenderedChart, error)
 }
 
 // RenderedChart holds a map of rendered templates file with template file name as key and
@@ -148,3 +148,18 @@ func (mr *MockChartApplierMockRecorder) RenderEmbeddedFS(embeddedFS, chartPath,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderEmbeddedFS", reflect.TypeOf((*MockChartApplier)(nil).RenderEmbeddedFS), embeddedFS, chartPath, releaseName, namespace, values)
 }
+
+// RenderManifestsArchive mocks base method.
+func (m *MockChartApplier) RenderManifestsArchive(archive []byte, manifestsPath, releaseName, namespace string, values any) (*chartrenderer.RenderedChart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderManifestsArchive", archive, manifestsPath, releaseName, namespace, values)
+	ret0, _ := ret[0].(*chartrenderer.RenderedChart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderManifestsArchive indicates an expected call of RenderManifestsArchive.
+func (mr *MockChartApplierMockRecorder) RenderManifestsArchive(archive, manifestsPath, releaseName, namespace, values any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderManifestsArchive", reflect.TypeOf((*MockChartApplier)(nil).RenderManifestsArchive), archive, manifestsPath, releaseName, namespace, values)
+}
@@ -38,7 +38,7 @@ var _ = Describe("Init", func() {
 
 		BeforeEach(func() {
 			worker = gardencorev1beta1.Worker{}
-			config = nodeagentcomponent.ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, nil)
+			config = nodeagentcomponent.ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, nil, nil)
 		})
 
 		When("kubelet data volume is not configured", func() {
@@ -96,6 +96,7 @@ clientConnection:
   kubeconfig: ""
   qps: 0
 controllers:
+  driftDetection: {}
   operatingSystemConfig:
     kubernetesVersion: ` + kubernetesVersion.String() + `
     secretName: ` + oscSecretName + `
@@ -210,6 +211,7 @@ clientConnection:
   kubeconfig: ""
   qps: 0
 controllers:
+  driftDetection: {}
   operatingSystemConfig:
     kubernetesVersion: ` + kubernetesVersion.String() + `
     secretName: ` + oscSecretName + `
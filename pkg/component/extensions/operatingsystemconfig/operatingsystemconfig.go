@@ -25,6 +25,7 @@ import (
 
 	"github.com/gardener/gardener/imagevector"
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -148,6 +149,12 @@ type OriginalValues struct {
 	KubeProxyConfig *gardencorev1beta1.KubeProxyConfig
 	// Region is the name of the region specified in the Shoot spec.
 	Region *string
+	// ImageVerification is the configuration for verifying the signatures of the images from which gardener-node-agent
+	// extracts files.
+	ImageVerification *extensionsv1alpha1.ImageVerification
+	// NodeAgentDriftDetectionMode is the mode in which gardener-node-agent handles drift of the files and units managed
+	// by the operating system config.
+	NodeAgentDriftDetectionMode *nodeagentconfigv1alpha1.DriftDetectionMode
 }
 
 // New creates a new instance of Interface.
@@ -627,7 +634,9 @@ func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSys
 		taints:                                  taints,
 		caRotationLastInitiationTime:            caRotationLastInitiationTime,
 		serviceAccountKeyRotationLastInitiationTime: serviceAccountKeyRotationLastInitiationTime,
-		region: o.values.Region,
+		region:                      o.values.Region,
+		imageVerification:           o.values.ImageVerification,
+		nodeAgentDriftDetectionMode: o.values.NodeAgentDriftDetectionMode,
 	}, nil
 }
 
@@ -700,6 +709,8 @@ type deployer struct {
 	caRotationLastInitiationTime                *metav1.Time
 	serviceAccountKeyRotationLastInitiationTime *metav1.Time
 	region                                      *string
+	imageVerification                           *extensionsv1alpha1.ImageVerification
+	nodeAgentDriftDetectionMode                 *nodeagentconfigv1alpha1.DriftDetectionMode
 }
 
 // exposed for testing
@@ -742,6 +753,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		Sysctls:                                 d.worker.Sysctls,
 		PreferIPv6:                              d.primaryIPFamily == gardencorev1beta1.IPFamilyIPv6,
 		Taints:                                  d.taints,
+		NodeAgentDriftDetectionMode:             d.nodeAgentDriftDetectionMode,
 	}
 
 	switch d.purpose {
@@ -749,7 +761,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		units, files, err = InitConfigFn(
 			d.worker,
 			d.images[imagevector.ContainerImageNameGardenerNodeAgent].String(),
-			nodeagent.ComponentConfig(d.key, d.kubernetesVersion, d.apiServerURL, nil),
+			nodeagent.ComponentConfig(d.key, d.kubernetesVersion, d.apiServerURL, nil, d.nodeAgentDriftDetectionMode),
 			d.clusterCABundle,
 		)
 		if err != nil {
@@ -796,6 +808,10 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		d.osc.Spec.Units = units
 		d.osc.Spec.Files = files
 
+		if d.purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+			d.osc.Spec.ImageVerification = d.imageVerification
+		}
+
 		if v1beta1helper.IsUpdateStrategyInPlace(d.worker.UpdateStrategy) && d.purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
 			d.osc.Spec.InPlaceUpdates = &extensionsv1alpha1.InPlaceUpdates{
 				KubeletVersion: d.kubernetesVersion.String(),
@@ -10,6 +10,7 @@ import (
 	"maps"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -527,6 +528,62 @@ var _ = Describe("OperatingSystemConfig", func() {
 				}
 			})
 
+			It("should add the image verification configuration to the OperatingSystemConfigs with purpose reconcile", func() {
+				imageVerification := &extensionsv1alpha1.ImageVerification{PublicKeys: []string{"public-key"}}
+
+				DeferCleanup(test.WithVars(
+					&TimeNow, fakeClock.Now,
+					&InitConfigFn, initConfigFn,
+					&OriginalConfigFn, originalConfigFn,
+					&values.ImageVerification, imageVerification,
+				))
+
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				for _, e := range computeExpectedOperatingSystemConfigs(false, workers, false) {
+					actual := &extensionsv1alpha1.OperatingSystemConfig{}
+					Expect(c.Get(ctx, client.ObjectKey{Name: e.Name, Namespace: e.Namespace}, actual)).To(Succeed())
+
+					if e.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+						Expect(actual.Spec.ImageVerification).To(Equal(imageVerification))
+					} else {
+						Expect(actual.Spec.ImageVerification).To(BeNil())
+					}
+				}
+			})
+
+			It("should pass the drift detection mode to the gardener-node-agent configuration", func() {
+				var (
+					mu            sync.Mutex
+					initModes     []*nodeagentconfigv1alpha1.DriftDetectionMode
+					originalModes []*nodeagentconfigv1alpha1.DriftDetectionMode
+				)
+
+				DeferCleanup(test.WithVars(
+					&TimeNow, fakeClock.Now,
+					&InitConfigFn, func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentconfigv1alpha1.NodeAgentConfiguration, caBundle []byte) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+						mu.Lock()
+						initModes = append(initModes, config.Controllers.DriftDetection.Mode)
+						mu.Unlock()
+						return initConfigFn(worker, nodeAgentImage, config, caBundle)
+					},
+					&OriginalConfigFn, func(cctx components.Context) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+						mu.Lock()
+						originalModes = append(originalModes, cctx.NodeAgentDriftDetectionMode)
+						mu.Unlock()
+						return originalConfigFn(cctx)
+					},
+					&values.NodeAgentDriftDetectionMode, new(nodeagentconfigv1alpha1.DriftDetectionModeRestore),
+				))
+
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				Expect(initModes).NotTo(BeEmpty())
+				Expect(initModes).To(HaveEach(Equal(new(nodeagentconfigv1alpha1.DriftDetectionModeRestore))))
+				Expect(originalModes).NotTo(BeEmpty())
+				Expect(originalModes).To(HaveEach(Equal(new(nodeagentconfigv1alpha1.DriftDetectionModeRestore))))
+			})
+
 			Context("In-place update", func() {
 				BeforeEach(func() {
 					values = &Values{
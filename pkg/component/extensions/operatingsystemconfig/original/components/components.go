@@ -9,6 +9,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 )
@@ -47,4 +48,5 @@ type Context struct {
 	Sysctls                                 map[string]string
 	PreferIPv6                              bool
 	Taints                                  []corev1.Taint
+	NodeAgentDriftDetectionMode             *nodeagentconfigv1alpha1.DriftDetectionMode
 }
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package components
+
+import (
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+// ImageRef returns the imageRef content for extracting the file at the given path from the given image. If the image
+// is pinned to a digest, the digest is set so that gardener-node-agent verifies it before extracting the file.
+func ImageRef(image *imagevector.Image, filePathInImage string) *extensionsv1alpha1.FileContentImageRef {
+	imageRef := &extensionsv1alpha1.FileContentImageRef{
+		Image:           image.String(),
+		FilePathInImage: filePathInImage,
+	}
+
+	if _, digest, found := strings.Cut(imageRef.Image, "@"); found && strings.HasPrefix(digest, imagevector.SHA256TagPrefix) {
+		imageRef.Digest = &digest
+	}
+
+	return imageRef
+}
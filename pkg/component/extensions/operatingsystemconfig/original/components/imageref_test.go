@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package components_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+)
+
+var _ = Describe("#ImageRef", func() {
+	const digest = "sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"
+
+	It("should not set a digest for an image with a tag", func() {
+		Expect(components.ImageRef(&imagevector.Image{Repository: new("example.com/kubelet"), Tag: new("v1.34.0")}, "/kubelet")).To(Equal(&extensionsv1alpha1.FileContentImageRef{
+			Image:           "example.com/kubelet:v1.34.0",
+			FilePathInImage: "/kubelet",
+		}))
+	})
+
+	It("should set the digest for an image with a digest tag", func() {
+		Expect(components.ImageRef(&imagevector.Image{Repository: new("example.com/kubelet"), Tag: new(digest)}, "/kubelet")).To(Equal(&extensionsv1alpha1.FileContentImageRef{
+			Image:           "example.com/kubelet@" + digest,
+			FilePathInImage: "/kubelet",
+			Digest:          new(digest),
+		}))
+	})
+
+	It("should set the digest for an image reference with tag and digest", func() {
+		Expect(components.ImageRef(&imagevector.Image{Ref: new("example.com/kubelet:v1.34.0@" + digest)}, "/kubelet")).To(Equal(&extensionsv1alpha1.FileContentImageRef{
+			Image:           "example.com/kubelet:v1.34.0@" + digest,
+			FilePathInImage: "/kubelet",
+			Digest:          new(digest),
+		}))
+	})
+})
@@ -80,10 +80,7 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 			Path:        v1beta1constants.OperatingSystemConfigFilePathBinaries + "/kubelet",
 			Permissions: new(uint32(0755)),
 			Content: extensionsv1alpha1.FileContent{
-				ImageRef: &extensionsv1alpha1.FileContentImageRef{
-					Image:           ctx.Images[imagevector.ContainerImageNameHyperkube].String(),
-					FilePathInImage: "/kubelet",
-				},
+				ImageRef: components.ImageRef(ctx.Images[imagevector.ContainerImageNameHyperkube], "/kubelet"),
 			},
 		},
 	}
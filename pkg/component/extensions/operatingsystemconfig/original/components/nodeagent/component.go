@@ -73,7 +73,7 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		})
 	}
 
-	files, err := Files(ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, additionalTokenSyncConfigs))
+	files, err := Files(ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, additionalTokenSyncConfigs, ctx.NodeAgentDriftDetectionMode))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed generating files: %w", err)
 	}
@@ -93,10 +93,7 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 			Path:        PathBinary,
 			Permissions: new(uint32(0755)),
 			Content: extensionsv1alpha1.FileContent{
-				ImageRef: &extensionsv1alpha1.FileContentImageRef{
-					Image:           ctx.Images[imagevector.ContainerImageNameGardenerNodeAgent].String(),
-					FilePathInImage: "/gardener-node-agent",
-				},
+				ImageRef: components.ImageRef(ctx.Images[imagevector.ContainerImageNameGardenerNodeAgent], "/gardener-node-agent"),
 			},
 		})
 
@@ -134,6 +131,7 @@ func ComponentConfig(
 	kubernetesVersion *semver.Version,
 	apiServerURL string,
 	additionalTokenSyncConfigs []nodeagentconfigv1alpha1.TokenSecretSyncConfig,
+	driftDetectionMode *nodeagentconfigv1alpha1.DriftDetectionMode,
 ) *nodeagentconfigv1alpha1.NodeAgentConfiguration {
 	return &nodeagentconfigv1alpha1.NodeAgentConfiguration{
 		APIServer: nodeagentconfigv1alpha1.APIServer{
@@ -152,6 +150,9 @@ func ComponentConfig(
 				// token.
 				SyncPeriod: &metav1.Duration{Duration: 12 * time.Hour},
 			},
+			DriftDetection: nodeagentconfigv1alpha1.DriftDetectionControllerConfig{
+				Mode: driftDetectionMode,
+			},
 		},
 	}
 }
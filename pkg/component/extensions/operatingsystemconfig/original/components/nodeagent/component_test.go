@@ -44,7 +44,7 @@ var _ = Describe("Component", func() {
 		It("should return the expected units and files", func() {
 			key := "key"
 
-			expectedFiles, err := Files(ComponentConfig(key, kubernetesVersion, apiServerURL, nil))
+			expectedFiles, err := Files(ComponentConfig(key, kubernetesVersion, apiServerURL, nil, new(nodeagentconfigv1alpha1.DriftDetectionModeRestore)))
 			Expect(err).NotTo(HaveOccurred())
 			expectedFiles = append(expectedFiles, extensionsv1alpha1.File{
 				Path:        nodeagentconfigv1alpha1.ClusterCAFilePath,
@@ -63,6 +63,8 @@ var _ = Describe("Component", func() {
 				APIServerURL:      apiServerURL,
 				CABundle:          string(caBundle),
 				Images:            map[string]*imagevectorutils.Image{"gardener-node-agent": {Repository: new("gardener-node-agent"), Tag: new("v1")}},
+
+				NodeAgentDriftDetectionMode: new(nodeagentconfigv1alpha1.DriftDetectionModeRestore),
 			})
 
 			Expect(err).NotTo(HaveOccurred())
@@ -121,7 +123,7 @@ WantedBy=multi-user.target`))
 
 	Describe("#ComponentConfig", func() {
 		It("should return the expected result", func() {
-			Expect(ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, additionalTokenSyncConfigs)).To(Equal(&nodeagentconfigv1alpha1.NodeAgentConfiguration{
+			Expect(ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, additionalTokenSyncConfigs, new(nodeagentconfigv1alpha1.DriftDetectionModeRestore))).To(Equal(&nodeagentconfigv1alpha1.NodeAgentConfiguration{
 				APIServer: nodeagentconfigv1alpha1.APIServer{
 					Server: apiServerURL,
 					CAFile: nodeagentconfigv1alpha1.ClusterCAFilePath,
@@ -140,6 +142,9 @@ WantedBy=multi-user.target`))
 						},
 						SyncPeriod: &metav1.Duration{Duration: 12 * time.Hour},
 					},
+					DriftDetection: nodeagentconfigv1alpha1.DriftDetectionControllerConfig{
+						Mode: new(nodeagentconfigv1alpha1.DriftDetectionModeRestore),
+					},
 				},
 			}))
 		})
@@ -147,7 +152,7 @@ WantedBy=multi-user.target`))
 
 	Describe("#Files", func() {
 		It("should return the expected files", func() {
-			config := ComponentConfig(oscSecretName, nil, apiServerURL, additionalTokenSyncConfigs)
+			config := ComponentConfig(oscSecretName, nil, apiServerURL, additionalTokenSyncConfigs, nil)
 
 			Expect(Files(config)).To(ConsistOf(extensionsv1alpha1.File{
 				Path:        fmt.Sprintf("/var/lib/gardener-node-agent/config-%s.yaml", version.Get().GitVersion),
@@ -163,6 +168,7 @@ clientConnection:
   kubeconfig: ""
   qps: 0
 controllers:
+  driftDetection: {}
   operatingSystemConfig:
     kubernetesVersion: null
     secretName: ` + oscSecretName + `
@@ -36,10 +36,11 @@ func OperatingSystemConfigSecret(
 	// prevent undesired changes of the computed checksum of this object.
 	operatingSystemConfig := &extensionsv1alpha1.OperatingSystemConfig{
 		Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
-			Units:          osc.Spec.Units,
-			Files:          osc.Spec.Files,
-			CRIConfig:      osc.Spec.CRIConfig,
-			InPlaceUpdates: osc.Spec.InPlaceUpdates,
+			Units:             osc.Spec.Units,
+			Files:             osc.Spec.Files,
+			CRIConfig:         osc.Spec.CRIConfig,
+			InPlaceUpdates:    osc.Spec.InPlaceUpdates,
+			ImageVerification: osc.Spec.ImageVerification,
 		},
 		Status: extensionsv1alpha1.OperatingSystemConfigStatus{
 			ExtensionUnits: osc.Status.ExtensionUnits,
@@ -156,6 +156,17 @@ status:
 			Expect(secret.Annotations).To(HaveKeyWithValue("checksum/data-script", utils.ComputeSHA256Hex(secret.Data["osc.yaml"])))
 		})
 
+		It("should include the image verification configuration", func() {
+			osc.Spec.ImageVerification = &extensionsv1alpha1.ImageVerification{PublicKeys: []string{"public-key"}}
+
+			secret, err := OperatingSystemConfigSecret(ctx, fakeClient, osc, secretName, workerPoolName, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(secret.Data["osc.yaml"])).To(ContainSubstring(`  imageVerification:
+    publicKeys:
+    - public-key
+`))
+		})
+
 		It("should return an error because a referenced secret cannot be found", func() {
 			osc.Spec.Files = append(osc.Spec.Files, extensionsv1alpha1.File{
 				Path: "/non/existing/path",
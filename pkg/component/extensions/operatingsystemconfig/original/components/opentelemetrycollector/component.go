@@ -92,10 +92,7 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		Path:        openTelemetryCollectorBinaryPath,
 		Permissions: new(uint32(0700)),
 		Content: extensionsv1alpha1.FileContent{
-			ImageRef: &extensionsv1alpha1.FileContentImageRef{
-				Image:           ctx.Images[imagevector.ContainerImageNameOpentelemetryCollector].String(),
-				FilePathInImage: "/bin/otelcol",
-			},
+			ImageRef: components.ImageRef(ctx.Images[imagevector.ContainerImageNameOpentelemetryCollector], "/bin/otelcol"),
 		},
 	}, extensionsv1alpha1.File{
 		Path:        openTelemetryCollectorKubeconfigPath,
@@ -56,10 +56,7 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 			Path:        valitailBinaryPath,
 			Permissions: new(uint32(0755)),
 			Content: extensionsv1alpha1.FileContent{
-				ImageRef: &extensionsv1alpha1.FileContentImageRef{
-					Image:           ctx.Images[imagevector.ContainerImageNameValitail].String(),
-					FilePathInImage: "/usr/bin/valitail",
-				},
+				ImageRef: components.ImageRef(ctx.Images[imagevector.ContainerImageNameValitail], "/usr/bin/valitail"),
 			},
 		})
 	}
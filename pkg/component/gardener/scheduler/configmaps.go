@@ -66,6 +66,7 @@ func (g *gardenerScheduler) configMapSchedulerConfig() (*corev1.ConfigMap, error
 		Schedulers: schedulerconfigv1alpha1.SchedulerControllerConfiguration{
 			Shoot: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
 				Strategy: g.values.Strategy,
+				Scoring:  g.values.Scoring,
 			},
 		},
 		FeatureGates: g.values.FeatureGates,
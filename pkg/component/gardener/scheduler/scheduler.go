@@ -51,6 +51,9 @@ type Values struct {
 	// Strategy is the candidate determination strategy used to assign a seed to shoots.
 	// If empty, the scheduler binary's defaulting will apply.
 	Strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy
+	// Scoring is the configuration of the score plugins used by the Scored strategy.
+	// If nil, the scheduler binary's defaulting will apply.
+	Scoring *schedulerconfigv1alpha1.ScoringConfiguration
 }
 
 // New creates a new instance of DeployWaiter for the gardener-scheduler.
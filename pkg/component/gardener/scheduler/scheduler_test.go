@@ -480,6 +480,27 @@ var _ = Describe("GardenerScheduler", func() {
 				Expect(ok).To(BeTrue())
 				Expect(renderedYAML).To(ContainSubstring("candidateDeterminationStrategy: MinimalDistance"))
 			})
+
+			It("should pass the scoring configuration when set", func() {
+				values.Strategy = schedulerconfigv1alpha1.Scored
+				values.Scoring = &schedulerconfigv1alpha1.ScoringConfiguration{
+					Plugins: []schedulerconfigv1alpha1.ScorePlugin{{Name: schedulerconfigv1alpha1.ScorePluginSeedAge, Weight: 3}},
+				}
+				deployer = New(fakeClient, namespace, fakeSecretManager, values)
+
+				Expect(deployer.Deploy(ctx)).To(Succeed())
+
+				expectedConfigMap := configMap(namespace, values)
+				renderedYAML, ok := expectedConfigMap.Data["schedulerconfiguration.yaml"]
+				Expect(ok).To(BeTrue())
+				Expect(renderedYAML).To(ContainSubstring("candidateDeterminationStrategy: Scored"))
+				Expect(renderedYAML).To(ContainSubstring("scoring:"))
+				Expect(renderedYAML).To(ContainSubstring("name: SeedAge"))
+				Expect(renderedYAML).To(ContainSubstring("weight: 3"))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(Succeed())
+				Expect(managedResourceRuntime).To(NewManagedResourceContainsObjectsMatcher(fakeClient)(expectedConfigMap))
+			})
 		})
 	})
 
@@ -795,6 +816,7 @@ func configMap(namespace string, testValues Values) *corev1.ConfigMap {
 		Schedulers: schedulerconfigv1alpha1.SchedulerControllerConfiguration{
 			Shoot: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
 				Strategy: testValues.Strategy,
+				Scoring:  testValues.Scoring,
 			},
 		},
 		FeatureGates: testValues.FeatureGates,
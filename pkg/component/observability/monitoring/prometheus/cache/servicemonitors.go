@@ -8,12 +8,32 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
 )
 
 // CentralServiceMonitors returns the central ServiceMonitor resources for the cache prometheus.
 func CentralServiceMonitors(seedIsShoot bool) []*monitoringv1.ServiceMonitor {
-	var serviceMonitors []*monitoringv1.ServiceMonitor
+	serviceMonitors := []*monitoringv1.ServiceMonitor{
+		// The gardenlet exports metrics about the secrets managed by the secrets managers in all shoot namespaces. They
+		// are federated by the shoot Prometheus instances.
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardenlet",
+				Namespace: v1beta1constants.GardenNamespace,
+			},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Selector: metav1.LabelSelector{MatchLabels: map[string]string{
+					v1beta1constants.LabelApp:  v1beta1constants.LabelGardener,
+					v1beta1constants.LabelRole: "gardenlet",
+				}},
+				Endpoints: []monitoringv1.Endpoint{{
+					Port:                 "metrics",
+					MetricRelabelConfigs: monitoringutils.StandardMetricRelabelConfig("gardenlet_secrets_manager_.+"),
+				}},
+			},
+		},
+	}
 
 	if seedIsShoot {
 		// add cache-node-exporter ServiceMonitor only to ManagedSeeds.
@@ -16,8 +16,22 @@ import (
 
 var _ = Describe("ServiceMonitors", func() {
 	Describe("#CentralServiceMonitors", func() {
+		gardenletServiceMonitor := &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardenlet",
+				Namespace: "garden",
+			},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "gardener", "role": "gardenlet"}},
+				Endpoints: []monitoringv1.Endpoint{{
+					Port:                 "metrics",
+					MetricRelabelConfigs: monitoringutils.StandardMetricRelabelConfig("gardenlet_secrets_manager_.+"),
+				}},
+			},
+		}
+
 		It("should return the service monitors for ManagedSeeds", func() {
-			Expect(cache.CentralServiceMonitors(true)).To(HaveExactElements(&monitoringv1.ServiceMonitor{
+			Expect(cache.CentralServiceMonitors(true)).To(HaveExactElements(gardenletServiceMonitor, &monitoringv1.ServiceMonitor{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "node-exporter",
 					Namespace: "kube-system",
@@ -59,8 +73,8 @@ var _ = Describe("ServiceMonitors", func() {
 			}))
 		})
 
-		It("should not return the service monitors for unmanaged Seeds", func() {
-			Expect(cache.CentralServiceMonitors(false)).To(BeEmpty())
+		It("should only return the gardenlet service monitor for unmanaged Seeds", func() {
+			Expect(cache.CentralServiceMonitors(false)).To(HaveExactElements(gardenletServiceMonitor))
 		})
 	})
 })
@@ -22,6 +22,9 @@ var (
 	//go:embed assets/prometheusrules/verticalpodautoscaler.yaml
 	vpaYAML []byte
 	vpa     *monitoringv1.PrometheusRule
+	//go:embed assets/prometheusrules/secrets-manager.yaml
+	secretsManagerYAML []byte
+	secretsManager     *monitoringv1.PrometheusRule
 
 	// optional rules
 	//go:embed assets/prometheusrules/optional/alertmanager.yaml
@@ -60,6 +63,8 @@ func init() {
 	utilruntime.Must(runtime.DecodeInto(monitoringutils.Decoder, prometheusYAML, prometheus))
 	vpa = &monitoringv1.PrometheusRule{}
 	utilruntime.Must(runtime.DecodeInto(monitoringutils.Decoder, vpaYAML, vpa))
+	secretsManager = &monitoringv1.PrometheusRule{}
+	utilruntime.Must(runtime.DecodeInto(monitoringutils.Decoder, secretsManagerYAML, secretsManager))
 
 	// optional rules
 	alertManager = &monitoringv1.PrometheusRule{}
@@ -89,6 +94,7 @@ func CentralPrometheusRules(isWorkerless, wantsAlertmanager bool) []*monitoringv
 	out := []*monitoringv1.PrometheusRule{
 		prometheus.DeepCopy(),
 		vpa.DeepCopy(),
+		secretsManager.DeepCopy(),
 	}
 
 	if isWorkerless {
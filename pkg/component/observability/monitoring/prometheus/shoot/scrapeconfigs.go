@@ -43,6 +43,7 @@ func CentralScrapeConfigs(namespace, clusterCASecretName string, isWorkerless bo
 						`{__name__=~"container_.+",job="cadvisor",namespace="` + namespace + `"}`,
 						`{__name__=~"kube_.+",job="kube-state-metrics",namespace="` + namespace + `"}`,
 						`{__name__=~"etcddruid_.+",job="etcd-druid",etcd_namespace="` + namespace + `"}`,
+						`{__name__=~"gardenlet_secrets_manager_.+",job="gardenlet",secret_namespace="` + namespace + `"}`,
 					},
 				},
 				RelabelConfigs: []monitoringv1.RelabelConfig{
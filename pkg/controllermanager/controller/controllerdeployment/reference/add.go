@@ -52,9 +52,7 @@ func getReferencedSecretNames(obj client.Object) []string {
 	}
 
 	secretNames := namesForReferencedResources(controllerDeployment, corev1.SchemeGroupVersion.String(), "Secret")
-	if controllerDeployment.Helm != nil {
-		secretNames = append(secretNames, v1helper.GetSecretsForOCIRepository(controllerDeployment.Helm.OCIRepository)...)
-	}
+	secretNames = append(secretNames, v1helper.GetSecretsForOCIRepository(v1helper.GetOCIRepositoryForControllerDeployment(controllerDeployment))...)
 
 	return secretNames
 }
@@ -714,6 +714,10 @@ func deployNeededInstallation(
 				"providerConfig": controllerDeployment.Annotations[gardencorev1.MigrationControllerDeploymentProviderConfig],
 				"helm":           controllerDeployment.Helm,
 			}
+			// Only add the manifests if they are set to keep the hash stable for all other `ControllerDeployment`s.
+			if controllerDeployment.Manifests != nil {
+				hashFields["manifests"] = controllerDeployment.Manifests
+			}
 
 			deploymentMap, err := convertObjToMap(hashFields)
 			if err != nil {
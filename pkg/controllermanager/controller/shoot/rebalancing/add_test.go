@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rebalancing"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var (
+			predicate predicate.Predicate
+			shoot     *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			predicate = reconciler.ShootPredicate()
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					SeedName: new("seed-1"),
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					SeedName: new("seed-1"),
+				},
+			}
+		})
+
+		It("should return true for create events", func() {
+			Expect(predicate.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for updates not changing the seed the shoot is running on", func() {
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should return true for updates changing the seed the shoot is running on", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.SeedName = new("seed-2")
+			shoot.Status.SeedName = new("seed-2")
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(predicate.Delete(event.DeleteEvent{Object: shoot})).To(BeFalse())
+			Expect(predicate.Generic(event.GenericEvent{Object: shoot})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	schedulershoot "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler periodically re-evaluates the placement of scheduled shoots. If the shoot could run on a seed with
+// considerably less load, it recommends (or, depending on the policy, triggers) the migration of the control plane to
+// this seed.
+type Reconciler struct {
+	Client          client.Client
+	Config          controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration
+	Clock           clock.Clock
+	Recorder        events.EventRecorder
+	GardenNamespace string
+}
+
+// Reconcile re-evaluates the placement of the shoot and recommends or triggers a control plane migration.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil || shoot.Spec.SeedName == nil {
+		log.V(1).Info("Shoot is being deleted or not scheduled, stop reconciling")
+		return reconcile.Result{}, nil
+	}
+
+	if reason := skipReason(shoot); reason != "" {
+		log.V(1).Info("Skipping re-evaluation of shoot placement", "reason", reason)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	targetSeed, evaluated, err := r.recommendSeed(ctx, log, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !evaluated {
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	if targetSeed == nil {
+		if err := r.setRecommendation(ctx, shoot, ""); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	if shoot.Annotations[v1beta1constants.AnnotationMigrationRecommendedSeed] != targetSeed.Name {
+		log.Info("Recommending control plane migration", "sourceSeed", *shoot.Spec.SeedName, "destinationSeed", targetSeed.Name)
+		r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.ShootEventRebalancingRecommended, gardencorev1beta1.EventActionMigrate, "Control plane should be migrated from seed %q to seed %q to balance the load across seeds", *shoot.Spec.SeedName, targetSeed.Name)
+	}
+
+	if err := r.setRecommendation(ctx, shoot, targetSeed.Name); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if ptr.Deref(r.Config.Policy, controllermanagerconfigv1alpha1.ShootRebalancingPolicyRecommend) != controllermanagerconfigv1alpha1.ShootRebalancingPolicyMigrate {
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	if !gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, r.Clock) {
+		log.V(1).Info("Postponing control plane migration to the maintenance time window", "destinationSeed", targetSeed.Name)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	sourceSeedName := *shoot.Spec.SeedName
+	log.Info("Triggering control plane migration", "sourceSeed", sourceSeedName, "destinationSeed", targetSeed.Name)
+
+	shoot.Spec.SeedName = &targetSeed.Name
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed binding shoot to seed %q: %w", targetSeed.Name, err)
+	}
+
+	r.Recorder.Eventf(shoot, nil, corev1.EventTypeNormal, gardencorev1beta1.ShootEventRebalancingTriggered, gardencorev1beta1.EventActionMigrate, "Triggered control plane migration from seed %q to seed %q to balance the load across seeds", sourceSeedName, targetSeed.Name)
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// skipReason returns why the placement of the given shoot must not be re-evaluated. It returns an empty string if the
+// placement can be re-evaluated.
+func skipReason(shoot *gardencorev1beta1.Shoot) string {
+	switch {
+	case ptr.Deref(shoot.Spec.SchedulerName, v1beta1constants.DefaultSchedulerName) != v1beta1constants.DefaultSchedulerName:
+		return "shoot is not managed by the default scheduler"
+	case v1beta1helper.IsShootSelfHosted(shoot.Spec.Provider.Workers):
+		return "shoot is self-hosted"
+	case shoot.Status.SeedName == nil || *shoot.Status.SeedName != *shoot.Spec.SeedName:
+		return "control plane migration is in progress"
+	case shoot.Status.LastOperation == nil || shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded:
+		return "last operation did not succeed"
+	}
+
+	return ""
+}
+
+// recommendSeed determines the seed the control plane of the given shoot should be migrated to. It returns nil if the
+// shoot should stay on its current seed. The returned bool is false if the placement could not be evaluated, e.g.,
+// because no seed candidate was found at all.
+func (r *Reconciler) recommendSeed(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, bool, error) {
+	currentSeedName := *shoot.Spec.SeedName
+
+	// Evaluate the shoot like the gardener-scheduler would do for an unscheduled shoot. This ensures that the same
+	// filters are applied, e.g., the seed scheduling settings, seed selectors, taints and access restrictions.
+	unscheduledShoot := shoot.DeepCopy()
+	unscheduledShoot.Spec.SeedName = nil
+
+	scheduler := &schedulershoot.Reconciler{
+		Client: r.Client,
+		Config: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+			Strategy: r.Config.Strategy,
+			Scoring:  r.Config.Scoring,
+		},
+		GardenNamespace: r.GardenNamespace,
+	}
+
+	explanation := scheduler.ExplainPlacement(ctx, log, unscheduledShoot)
+	if explanation.Error != "" {
+		log.Info("Could not evaluate placement of shoot", "reason", explanation.Error)
+		return nil, false, nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return nil, false, fmt.Errorf("failed listing shoots: %w", err)
+	}
+	seedUsage := v1beta1helper.CalculateSeedUsage(v1beta1helper.ConvertShootList(shootList.Items))
+
+	currentSeed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: currentSeedName}, currentSeed); err != nil {
+		return nil, false, fmt.Errorf("failed reading current seed %q: %w", currentSeedName, err)
+	}
+
+	for _, candidate := range explanation.Ranking {
+		// The ranking is ordered best candidate first. If the current seed is ranked better than all candidates the
+		// shoot could be migrated to, it should stay where it is.
+		if candidate.SeedName == currentSeedName {
+			return nil, true, nil
+		}
+
+		if seedUsage[currentSeedName]-(seedUsage[candidate.SeedName]+1) < int(ptr.Deref(r.Config.MinShootCountDifference, 0)) {
+			log.V(1).Info("Load difference to seed candidate is too small", "seed", candidate.SeedName)
+			continue
+		}
+
+		seed := &gardencorev1beta1.Seed{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: candidate.SeedName}, seed); err != nil {
+			return nil, false, fmt.Errorf("failed reading seed candidate %q: %w", candidate.SeedName, err)
+		}
+
+		if reason := migrationImpossibleReason(currentSeed, seed); reason != "" {
+			log.V(1).Info("Control plane cannot be migrated to seed candidate", "seed", candidate.SeedName, "reason", reason)
+			continue
+		}
+
+		return seed, true, nil
+	}
+
+	return nil, true, nil
+}
+
+// migrationImpossibleReason returns why the control plane cannot be migrated from the source seed to the destination
+// seed. It mirrors the checks done by the admission plugin for changes of the shoot's seed name.
+func migrationImpossibleReason(sourceSeed, destinationSeed *gardencorev1beta1.Seed) string {
+	if sourceSeed.Spec.Backup == nil || destinationSeed.Spec.Backup == nil {
+		return "backup is not configured for both seeds"
+	}
+
+	var sourceDomain, destinationDomain string
+	if sourceSeed.Spec.DNS.Internal != nil {
+		sourceDomain = sourceSeed.Spec.DNS.Internal.Domain
+	}
+	if destinationSeed.Spec.DNS.Internal != nil {
+		destinationDomain = destinationSeed.Spec.DNS.Internal.Domain
+	}
+	if sourceDomain != destinationDomain {
+		return "internal domains of the seeds differ"
+	}
+
+	return ""
+}
+
+func (r *Reconciler) setRecommendation(ctx context.Context, shoot *gardencorev1beta1.Shoot, seedName string) error {
+	if shoot.Annotations[v1beta1constants.AnnotationMigrationRecommendedSeed] == seedName {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	if seedName == "" {
+		delete(shoot.Annotations, v1beta1constants.AnnotationMigrationRecommendedSeed)
+	} else {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationMigrationRecommendedSeed, seedName)
+	}
+
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return fmt.Errorf("failed patching recommended seed annotation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/controllermanager/v1alpha1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rebalancing"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *events.FakeRecorder
+		reconciler *Reconciler
+
+		boundSeedName *string
+
+		shoot        *gardencorev1beta1.Shoot
+		seed1, seed2 *gardencorev1beta1.Seed
+	)
+
+	newSeed := func(name string) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Backup:   &gardencorev1beta1.Backup{Provider: "foo"},
+				Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: "europe"},
+				Networks: gardencorev1beta1.SeedNetworks{
+					Nodes:    new("10.10.0.0/16"),
+					Pods:     "10.20.0.0/16",
+					Services: "10.30.0.0/16",
+				},
+				Settings: &gardencorev1beta1.SeedSettings{
+					Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+				},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.GardenletReady, Status: gardencorev1beta1.ConditionTrue},
+					{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue},
+				},
+				LastOperation: &gardencorev1beta1.LastOperation{},
+			},
+		}
+	}
+
+	createShootsOnSeed := func(seedName string, count int) {
+		for i := range count {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-shoot-%d", seedName, i), Namespace: "garden-dev"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: new(seedName)},
+				Status:     gardencorev1beta1.ShootStatus{SeedName: new(seedName)},
+			})).To(Succeed())
+		}
+	}
+
+	reconcileShoot := func() reconcile.Result {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		return result
+	}
+
+	BeforeEach(func() {
+		boundSeedName = nil
+		fakeClient = fakeclient.
+			NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, gardencore.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(_ context.Context, _ client.Client, subResourceName string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					if subResourceName != "binding" {
+						return fmt.Errorf("unexpected subresource %q", subResourceName)
+					}
+					boundSeedName = obj.(*gardencorev1beta1.Shoot).Spec.SeedName
+					return nil
+				},
+			}).
+			Build()
+
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 22, 10, 0, 0, time.UTC))
+		recorder = events.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: time.Hour},
+				Policy:                  new(controllermanagerconfigv1alpha1.ShootRebalancingPolicyRecommend),
+				MinShootCountDifference: new(int32(5)),
+				Strategy:                schedulerconfigv1alpha1.SameRegion,
+			},
+			Clock:           fakeClock,
+			Recorder:        recorder,
+			GardenNamespace: "garden",
+		}
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: new("garden-dev")},
+		})).To(Succeed())
+
+		seed1 = newSeed("seed-1")
+		seed2 = newSeed("seed-2")
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: new("cloudprofile"),
+				Region:           "europe",
+				Provider: gardencorev1beta1.Provider{
+					Type:    "foo",
+					Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+				},
+				Networking: &gardencorev1beta1.Networking{
+					Type:     new("calico"),
+					Nodes:    new("10.40.0.0/16"),
+					Pods:     new("10.50.0.0/16"),
+					Services: new("10.60.0.0/16"),
+				},
+				Maintenance: &gardencorev1beta1.Maintenance{
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+				},
+				SeedName: new("seed-1"),
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName:      new("seed-1"),
+				LastOperation: &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, seed1)).To(Succeed())
+		Expect(fakeClient.Create(ctx, seed2)).To(Succeed())
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	Context("seed-1 is considerably more loaded than seed-2", func() {
+		JustBeforeEach(func() {
+			createShootsOnSeed("seed-1", 9)
+			createShootsOnSeed("seed-2", 2)
+		})
+
+		It("should recommend the migration to the less loaded seed", func() {
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationMigrationRecommendedSeed, "seed-2"))
+			Expect(recorder.Events).To(Receive(ContainSubstring(`RebalancingRecommended Control plane should be migrated from seed "seed-1" to seed "seed-2"`)))
+			Expect(boundSeedName).To(BeNil())
+		})
+
+		It("should not emit the event again if the recommendation did not change", func() {
+			reconcileShoot()
+			Expect(recorder.Events).To(Receive())
+
+			reconcileShoot()
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationMigrationRecommendedSeed, "seed-2"))
+			Expect(recorder.Events).NotTo(Receive())
+		})
+
+		It("should not recommend a seed the control plane cannot be migrated to", func() {
+			seed2.Spec.Backup = nil
+			Expect(fakeClient.Update(ctx, seed2)).To(Succeed())
+
+			reconcileShoot()
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationMigrationRecommendedSeed))
+		})
+
+		It("should not recommend a seed which is not visible for scheduling", func() {
+			seed2.Spec.Settings.Scheduling.Visible = false
+			Expect(fakeClient.Update(ctx, seed2)).To(Succeed())
+
+			reconcileShoot()
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationMigrationRecommendedSeed))
+		})
+
+		It("should not re-evaluate shoots whose last operation did not succeed", func() {
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationMigrationRecommendedSeed))
+		})
+
+		It("should not re-evaluate shoots which are being migrated", func() {
+			shoot.Spec.SeedName = new("seed-2")
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationMigrationRecommendedSeed))
+		})
+
+		Context("Migrate policy", func() {
+			BeforeEach(func() {
+				reconciler.Config.Policy = new(controllermanagerconfigv1alpha1.ShootRebalancingPolicyMigrate)
+			})
+
+			It("should trigger the migration within the maintenance time window", func() {
+				reconcileShoot()
+
+				Expect(boundSeedName).To(PointTo(Equal("seed-2")))
+				Expect(recorder.Events).To(Receive(ContainSubstring("RebalancingRecommended")))
+				Expect(recorder.Events).To(Receive(ContainSubstring(`RebalancingTriggered Triggered control plane migration from seed "seed-1" to seed "seed-2"`)))
+			})
+
+			It("should not trigger the migration outside of the maintenance time window", func() {
+				fakeClock.SetTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+				reconcileShoot()
+
+				Expect(boundSeedName).To(BeNil())
+				Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.AnnotationMigrationRecommendedSeed, "seed-2"))
+			})
+		})
+	})
+
+	Context("seeds are similarly loaded", func() {
+		JustBeforeEach(func() {
+			createShootsOnSeed("seed-1", 5)
+			createShootsOnSeed("seed-2", 2)
+		})
+
+		It("should not recommend a migration if the load difference is too small", func() {
+			reconcileShoot()
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationMigrationRecommendedSeed))
+		})
+
+		It("should remove an outdated recommendation", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationMigrationRecommendedSeed, "seed-2")
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			reconcileShoot()
+			Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationMigrationRecommendedSeed))
+		})
+	})
+
+	It("should not recommend a migration if the current seed is the best candidate", func() {
+		createShootsOnSeed("seed-2", 10)
+
+		reconcileShoot()
+		Expect(shoot.Annotations).NotTo(HaveKey(v1beta1constants.AnnotationMigrationRecommendedSeed))
+	})
+})
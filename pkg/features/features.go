@@ -127,6 +127,12 @@ const (
 	// alpha: v1.142.0
 	// beta: v1.147.0
 	BackupEntryForGarden featuregate.Feature = "BackupEntryForGarden"
+
+	// OperatingSystemConfigUpdatePolicy enables setting the `operatingSystemConfigUpdatePolicy` of worker pools in the
+	// Shoot API. If disabled, the field is dropped from new Shoots and from Shoots which do not use it yet.
+	// owner: @rfranzke
+	// alpha: v1.148.0
+	OperatingSystemConfigUpdatePolicy featuregate.Feature = "OperatingSystemConfigUpdatePolicy"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -154,23 +160,24 @@ var DefaultFeatureGate = utilfeature.DefaultMutableFeatureGate
 
 // AllFeatureGates is the list of all feature gates.
 var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
-	DefaultSeccompProfile:          {Default: false, PreRelease: featuregate.Alpha},
-	InPlaceNodeUpdates:             {Default: false, PreRelease: featuregate.Alpha},
-	IstioTLSTermination:            {Default: true, PreRelease: featuregate.Beta},
-	CloudProfileCapabilities:       {Default: true, PreRelease: featuregate.Beta},
-	DoNotCopyBackupCredentials:     {Default: true, PreRelease: featuregate.GA, LockToDefault: true},
-	OpenTelemetryCollector:         {Default: true, PreRelease: featuregate.Beta},
-	VictoriaLogsBackend:            {Default: false, PreRelease: featuregate.Alpha},
-	CustomDNSServerInNodeLocalDNS:  {Default: true, PreRelease: featuregate.Beta},
-	VPNBondingModeRoundRobin:       {Default: false, PreRelease: featuregate.Alpha},
-	PrometheusHealthChecks:         {Default: false, PreRelease: featuregate.Alpha},
-	VersionClassificationLifecycle: {Default: false, PreRelease: featuregate.Alpha},
-	RemoveVali:                     {Default: false, PreRelease: featuregate.Alpha},
-	DisableNginxIngressInGarden:    {Default: false, PreRelease: featuregate.Alpha},
-	DisableNginxIngressInSeed:      {Default: false, PreRelease: featuregate.Alpha},
-	DisableNginxIngressInShoot:     {Default: false, PreRelease: featuregate.Alpha},
-	LiveControlPlaneMigration:      {Default: false, PreRelease: featuregate.Alpha},
-	BackupEntryForGarden:           {Default: true, PreRelease: featuregate.Beta},
+	DefaultSeccompProfile:             {Default: false, PreRelease: featuregate.Alpha},
+	InPlaceNodeUpdates:                {Default: false, PreRelease: featuregate.Alpha},
+	IstioTLSTermination:               {Default: true, PreRelease: featuregate.Beta},
+	CloudProfileCapabilities:          {Default: true, PreRelease: featuregate.Beta},
+	DoNotCopyBackupCredentials:        {Default: true, PreRelease: featuregate.GA, LockToDefault: true},
+	OpenTelemetryCollector:            {Default: true, PreRelease: featuregate.Beta},
+	VictoriaLogsBackend:               {Default: false, PreRelease: featuregate.Alpha},
+	CustomDNSServerInNodeLocalDNS:     {Default: true, PreRelease: featuregate.Beta},
+	VPNBondingModeRoundRobin:          {Default: false, PreRelease: featuregate.Alpha},
+	PrometheusHealthChecks:            {Default: false, PreRelease: featuregate.Alpha},
+	VersionClassificationLifecycle:    {Default: false, PreRelease: featuregate.Alpha},
+	RemoveVali:                        {Default: false, PreRelease: featuregate.Alpha},
+	DisableNginxIngressInGarden:       {Default: false, PreRelease: featuregate.Alpha},
+	DisableNginxIngressInSeed:         {Default: false, PreRelease: featuregate.Alpha},
+	DisableNginxIngressInShoot:        {Default: false, PreRelease: featuregate.Alpha},
+	LiveControlPlaneMigration:         {Default: false, PreRelease: featuregate.Alpha},
+	BackupEntryForGarden:              {Default: true, PreRelease: featuregate.Beta},
+	OperatingSystemConfigUpdatePolicy: {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
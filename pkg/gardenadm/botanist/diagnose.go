@@ -0,0 +1,291 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubeletcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+)
+
+// ExecCommand executes the given command with the given arguments and returns the combined output.
+// Exposed for testing.
+var ExecCommand = func(ctx context.Context, command string, args ...string) ([]byte, error) {
+	return exec.CommandContext(ctx, command, args...).CombinedOutput() // #nosec: G204 -- Command and args are controlled internally.
+}
+
+// diagnosticsUnitNames are the units whose status and journal logs are collected.
+var diagnosticsUnitNames = []string{
+	nodeagentconfigv1alpha1.InitUnitName,
+	nodeagentconfigv1alpha1.UnitName,
+	v1beta1constants.OperatingSystemConfigUnitNameKubeletService,
+	v1beta1constants.OperatingSystemConfigUnitNameContainerDService,
+}
+
+var (
+	redactedValue = "<redacted>"
+
+	privateKeyRegex     = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)
+	bootstrapTokenRegex = regexp.MustCompile(`\b[a-z0-9]{6}\.[a-z0-9]{16}\b`)
+	bearerTokenRegex    = regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9._~+/=-]+`)
+	sensitiveKeyRegex   = regexp.MustCompile(`(?im)^(\s*-?\s*"?[\w.-]*(?:token|password|secret|key-data|private-key)"?\s*:\s*).+$`)
+	sensitiveFlagRegex  = regexp.MustCompile(`(?i)(--[\w-]*(?:token|password)=)\S+`)
+)
+
+// Redact removes secret information like private keys, tokens, and passwords from the given data.
+func Redact(data []byte) []byte {
+	data = privateKeyRegex.ReplaceAll(data, []byte(redactedValue))
+	data = sensitiveKeyRegex.ReplaceAll(data, []byte("${1}"+redactedValue))
+	data = sensitiveFlagRegex.ReplaceAll(data, []byte("${1}"+redactedValue))
+	data = bearerTokenRegex.ReplaceAll(data, []byte("${1}"+redactedValue))
+	return bootstrapTokenRegex.ReplaceAll(data, []byte(redactedValue))
+}
+
+// DiagnosticsArchive is a gzip-compressed tar archive containing the diagnostics collected on a machine. The content of
+// all added files is redacted.
+type DiagnosticsArchive struct {
+	gzipWriter *gzip.Writer
+	tarWriter  *tar.Writer
+	modTime    time.Time
+	files      []string
+}
+
+// NewDiagnosticsArchive returns a new DiagnosticsArchive writing to the given writer. Close must be called to flush
+// the archive.
+func NewDiagnosticsArchive(w io.Writer, modTime time.Time) *DiagnosticsArchive {
+	gzipWriter := gzip.NewWriter(w)
+
+	return &DiagnosticsArchive{
+		gzipWriter: gzipWriter,
+		tarWriter:  tar.NewWriter(gzipWriter),
+		modTime:    modTime,
+	}
+}
+
+// Add adds a file with the given name and the redacted data to the archive.
+func (a *DiagnosticsArchive) Add(name string, data []byte) error {
+	data = Redact(data)
+
+	if err := a.tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: a.modTime,
+	}); err != nil {
+		return fmt.Errorf("failed writing header of %s to archive: %w", name, err)
+	}
+
+	if _, err := a.tarWriter.Write(data); err != nil {
+		return fmt.Errorf("failed writing %s to archive: %w", name, err)
+	}
+
+	a.files = append(a.files, name)
+	return nil
+}
+
+// Files returns the names of the files added to the archive.
+func (a *DiagnosticsArchive) Files() []string {
+	return a.files
+}
+
+// Close flushes and closes the archive. It does not close the underlying writer.
+func (a *DiagnosticsArchive) Close() error {
+	if err := a.tarWriter.Close(); err != nil {
+		return err
+	}
+	return a.gzipWriter.Close()
+}
+
+// CollectDiagnostics collects the journal logs and status of the node units, the static pod manifests, the
+// configuration of gardener-node-agent, the last-applied OperatingSystemConfig, and the status of containerd into the
+// given archive. If a client set is given, the Nodes as well as the Pods and Events in the kube-system namespace are
+// collected as well. Failures of individual collectors don't abort the collection but are returned.
+func (b *GardenadmBotanist) CollectDiagnostics(ctx context.Context, archive *DiagnosticsArchive, clientSet kubernetes.Interface, journalLines int) []error {
+	errs := slices.Concat(
+		b.collectUnits(ctx, archive, journalLines),
+		b.collectStaticPodManifests(archive),
+		b.collectNodeAgentFiles(archive),
+		b.collectContainerdStatus(ctx, archive),
+	)
+
+	if clientSet != nil {
+		errs = append(errs, b.collectClusterResources(ctx, archive, clientSet.Client())...)
+	}
+
+	return slices.DeleteFunc(errs, func(err error) bool { return err == nil })
+}
+
+func (b *GardenadmBotanist) collectUnits(ctx context.Context, archive *DiagnosticsArchive, journalLines int) []error {
+	var errs []error
+
+	unitStatuses, err := b.DBus.ListByNames(ctx, diagnosticsUnitNames)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed listing units: %w", err))
+	} else {
+		var status strings.Builder
+		for _, unit := range unitStatuses {
+			fmt.Fprintf(&status, "%s\tload=%s\tactive=%s\tsub=%s\n", unit.Name, unit.LoadState, unit.ActiveState, unit.SubState)
+		}
+		errs = append(errs, archive.Add("systemd/units.txt", []byte(status.String())))
+	}
+
+	for _, unitName := range diagnosticsUnitNames {
+		output, err := ExecCommand(ctx, "journalctl", "--unit", unitName, "--no-pager", "--lines", fmt.Sprintf("%d", journalLines))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed reading journal logs of unit %s: %w", unitName, err))
+		}
+		if len(output) > 0 {
+			errs = append(errs, archive.Add(path.Join("journal", unitName+".log"), output))
+		}
+	}
+
+	return errs
+}
+
+func (b *GardenadmBotanist) collectStaticPodManifests(archive *DiagnosticsArchive) []error {
+	entries, err := b.FS.ReadDir(kubeletcomponent.FilePathKubernetesManifests)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil
+		}
+		return []error{fmt.Errorf("failed reading static pod manifests directory %s: %w", kubeletcomponent.FilePathKubernetesManifests, err)}
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		manifestPath := path.Join(kubeletcomponent.FilePathKubernetesManifests, entry.Name())
+		data, err := b.FS.ReadFile(manifestPath)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed reading static pod manifest %s: %w", manifestPath, err))
+			continue
+		}
+		errs = append(errs, archive.Add(path.Join("static-pods", entry.Name()), data))
+	}
+
+	return errs
+}
+
+func (b *GardenadmBotanist) collectNodeAgentFiles(archive *DiagnosticsArchive) []error {
+	var errs []error
+
+	// The name of the configuration file contains the version of gardener-node-agent.
+	configFiles, err := afero.Glob(b.FS, path.Join(nodeagentconfigv1alpha1.BaseDir, "config-*.yaml"))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed finding gardener-node-agent config: %w", err))
+	}
+	for _, configFile := range configFiles {
+		data, err := b.FS.ReadFile(configFile)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed reading gardener-node-agent config %s: %w", configFile, err))
+			continue
+		}
+		errs = append(errs, archive.Add(path.Join("gardener-node-agent", path.Base(configFile)), data))
+	}
+
+	osc, err := b.LastAppliedOperatingSystemConfig()
+	if err != nil {
+		return append(errs, err)
+	}
+	if osc == nil {
+		return errs
+	}
+
+	// The contents of files which are not world-readable are not collected since they might contain credentials.
+	for i, file := range osc.Spec.Files {
+		if file.Content.Inline != nil && (file.Permissions == nil || *file.Permissions&0004 == 0) {
+			osc.Spec.Files[i].Content.Inline.Data = redactedValue
+		}
+	}
+	for i, file := range osc.Status.ExtensionFiles {
+		if file.Content.Inline != nil && (file.Permissions == nil || *file.Permissions&0004 == 0) {
+			osc.Status.ExtensionFiles[i].Content.Inline.Data = redactedValue
+		}
+	}
+
+	data, err := yaml.Marshal(osc)
+	if err != nil {
+		return append(errs, fmt.Errorf("failed encoding last-applied OperatingSystemConfig: %w", err))
+	}
+
+	return append(errs, archive.Add("gardener-node-agent/last-applied-osc.yaml", data))
+}
+
+func (b *GardenadmBotanist) collectContainerdStatus(ctx context.Context, archive *DiagnosticsArchive) []error {
+	var errs []error
+
+	for _, command := range []struct {
+		name string
+		args []string
+	}{
+		{name: "containerd/version.txt", args: []string{"version"}},
+		{name: "containerd/tasks.txt", args: []string{"--namespace", "k8s.io", "tasks", "list"}},
+	} {
+		output, err := ExecCommand(ctx, "ctr", command.args...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed running 'ctr %s': %w", strings.Join(command.args, " "), err))
+		}
+		if len(output) > 0 {
+			errs = append(errs, archive.Add(command.name, output))
+		}
+	}
+
+	return errs
+}
+
+func (b *GardenadmBotanist) collectClusterResources(ctx context.Context, archive *DiagnosticsArchive, c client.Client) []error {
+	var errs []error
+
+	for _, obj := range []struct {
+		name string
+		list client.ObjectList
+		opts []client.ListOption
+	}{
+		{name: "cluster/nodes.yaml", list: &corev1.NodeList{}},
+		{name: "cluster/kube-system-pods.yaml", list: &corev1.PodList{}, opts: []client.ListOption{client.InNamespace(metav1.NamespaceSystem)}},
+		{name: "cluster/kube-system-events.yaml", list: &corev1.EventList{}, opts: []client.ListOption{client.InNamespace(metav1.NamespaceSystem)}},
+	} {
+		name := obj.name
+		if err := c.List(ctx, obj.list, obj.opts...); err != nil {
+			errs = append(errs, fmt.Errorf("failed listing resources for %s: %w", name, err))
+			continue
+		}
+
+		data, err := yaml.Marshal(obj.list)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed encoding resources for %s: %w", name, err))
+			continue
+		}
+
+		if err := archive.Add(name, data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
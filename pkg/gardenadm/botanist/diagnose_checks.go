@@ -0,0 +1,330 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubeletcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// DiagnosticCheckStatus is the status of a diagnostic check.
+type DiagnosticCheckStatus string
+
+const (
+	// DiagnosticCheckStatusOK indicates that the check did not find any issues.
+	DiagnosticCheckStatusOK DiagnosticCheckStatus = "OK"
+	// DiagnosticCheckStatusWarning indicates that the check found a potential issue or could not be performed.
+	DiagnosticCheckStatusWarning DiagnosticCheckStatus = "Warning"
+	// DiagnosticCheckStatusFailed indicates that the check found an issue.
+	DiagnosticCheckStatusFailed DiagnosticCheckStatus = "Failed"
+)
+
+// DiagnosticCheckResult is the result of a diagnostic check.
+type DiagnosticCheckResult struct {
+	// Name is the name of the check.
+	Name string
+	// Status is the status of the check.
+	Status DiagnosticCheckStatus
+	// Message describes the result of the check.
+	Message string
+}
+
+const kubeletPort = 10250
+
+var (
+	// DialContext is used for checking whether the ports of the node components are reachable.
+	// Exposed for testing.
+	DialContext = (&net.Dialer{Timeout: time.Second}).DialContext
+
+	pathContainerdConfig = "/etc/containerd/config.toml"
+	pathSwaps            = "/proc/swaps"
+	pathCgroupV2         = "/sys/fs/cgroup/cgroup.controllers"
+
+	containerdSystemdCgroupRegex = regexp.MustCompile(`(?m)^\s*SystemdCgroup\s*=\s*(true|false)\s*$`)
+)
+
+// RunDiagnosticChecks checks the machine for common issues: unreachable ports of the kubelet and the static pods,
+// mismatching cgroup drivers of kubelet and containerd, enabled swap, unsynchronized time, and certificates which expire
+// within the given threshold. If a client set is given, the certificates managed by the secrets manager in the
+// kube-system namespace are checked, otherwise the certificates mounted into the static pods are checked.
+func (b *GardenadmBotanist) RunDiagnosticChecks(ctx context.Context, clientSet kubernetes.Interface, certificateExpiryThreshold time.Duration) []DiagnosticCheckResult {
+	return []DiagnosticCheckResult{
+		b.checkPorts(ctx),
+		b.checkCgroupDriver(),
+		b.checkSwap(),
+		b.checkTimeSync(ctx),
+		b.checkCertificateExpiry(ctx, clientSet, certificateExpiryThreshold),
+	}
+}
+
+func (b *GardenadmBotanist) checkPorts(ctx context.Context) DiagnosticCheckResult {
+	result := DiagnosticCheckResult{Name: "Ports"}
+
+	ports := map[int32]string{kubeletPort: "kubelet"}
+
+	pods, err := b.readStaticPods()
+	if err != nil {
+		result.Status, result.Message = DiagnosticCheckStatusWarning, err.Error()
+		return result
+	}
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			for _, port := range container.Ports {
+				if port.Protocol == "" || port.Protocol == corev1.ProtocolTCP {
+					ports[port.ContainerPort] = pod.Name
+				}
+			}
+		}
+	}
+
+	var unreachable []string
+	for _, port := range slices.Sorted(maps.Keys(ports)) {
+		conn, err := DialContext(ctx, "tcp", net.JoinHostPort("localhost", strconv.Itoa(int(port))))
+		if err != nil {
+			unreachable = append(unreachable, fmt.Sprintf("%d (%s)", port, ports[port]))
+			continue
+		}
+		_ = conn.Close()
+	}
+
+	if len(unreachable) > 0 {
+		result.Status, result.Message = DiagnosticCheckStatusFailed, "ports not reachable: "+strings.Join(unreachable, ", ")
+		return result
+	}
+
+	result.Status, result.Message = DiagnosticCheckStatusOK, fmt.Sprintf("%d ports reachable", len(ports))
+	return result
+}
+
+func (b *GardenadmBotanist) readStaticPods() ([]*corev1.Pod, error) {
+	entries, err := b.FS.ReadDir(kubeletcomponent.FilePathKubernetesManifests)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading static pod manifests directory %s: %w", kubeletcomponent.FilePathKubernetesManifests, err)
+	}
+
+	var pods []*corev1.Pod
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		data, err := b.FS.ReadFile(filepath.Join(kubeletcomponent.FilePathKubernetesManifests, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed reading static pod manifest %s: %w", entry.Name(), err)
+		}
+
+		pod := &corev1.Pod{}
+		if err := runtime.DecodeInto(kubernetes.SeedCodec.UniversalDeserializer(), data, pod); err != nil {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
+
+func (b *GardenadmBotanist) checkCgroupDriver() DiagnosticCheckResult {
+	result := DiagnosticCheckResult{Name: "Cgroup driver"}
+
+	cgroupVersion := "v1"
+	if exists, err := b.FS.Exists(pathCgroupV2); err == nil && exists {
+		cgroupVersion = "v2"
+	}
+
+	kubeletConfigData, err := b.FS.ReadFile(kubeletcomponent.PathKubeletConfig)
+	if err != nil {
+		result.Status, result.Message = DiagnosticCheckStatusWarning, fmt.Sprintf("cannot read kubelet config: %s", err.Error())
+		return result
+	}
+	kubeletConfig := &kubeletconfigv1beta1.KubeletConfiguration{}
+	if err := runtime.DecodeInto(nodeagent.OSCDecoder, kubeletConfigData, kubeletConfig); err != nil {
+		result.Status, result.Message = DiagnosticCheckStatusWarning, fmt.Sprintf("cannot decode kubelet config: %s", err.Error())
+		return result
+	}
+
+	containerdConfigData, err := b.FS.ReadFile(pathContainerdConfig)
+	if err != nil {
+		result.Status, result.Message = DiagnosticCheckStatusWarning, fmt.Sprintf("cannot read containerd config: %s", err.Error())
+		return result
+	}
+	containerdCgroupDriver := "cgroupfs"
+	if match := containerdSystemdCgroupRegex.FindSubmatch(containerdConfigData); match != nil && string(match[1]) == "true" {
+		containerdCgroupDriver = "systemd"
+	}
+
+	if kubeletConfig.CgroupDriver != containerdCgroupDriver {
+		result.Status, result.Message = DiagnosticCheckStatusFailed, fmt.Sprintf("kubelet uses cgroup driver %q but containerd uses %q (cgroup %s)", kubeletConfig.CgroupDriver, containerdCgroupDriver, cgroupVersion)
+		return result
+	}
+
+	result.Status, result.Message = DiagnosticCheckStatusOK, fmt.Sprintf("kubelet and containerd use cgroup driver %q (cgroup %s)", containerdCgroupDriver, cgroupVersion)
+	if cgroupVersion == "v1" {
+		result.Status = DiagnosticCheckStatusWarning
+		result.Message += ", cgroup v1 is deprecated"
+	}
+	return result
+}
+
+func (b *GardenadmBotanist) checkSwap() DiagnosticCheckResult {
+	result := DiagnosticCheckResult{Name: "Swap"}
+
+	data, err := b.FS.ReadFile(pathSwaps)
+	if err != nil {
+		result.Status, result.Message = DiagnosticCheckStatusWarning, fmt.Sprintf("cannot read %s: %s", pathSwaps, err.Error())
+		return result
+	}
+
+	// The first line of /proc/swaps is a header.
+	if devices := len(strings.Split(strings.TrimSpace(string(data)), "\n")) - 1; devices > 0 {
+		result.Status, result.Message = DiagnosticCheckStatusWarning, fmt.Sprintf("swap is enabled on %d device(s)", devices)
+		return result
+	}
+
+	result.Status, result.Message = DiagnosticCheckStatusOK, "swap is disabled"
+	return result
+}
+
+func (b *GardenadmBotanist) checkTimeSync(ctx context.Context) DiagnosticCheckResult {
+	result := DiagnosticCheckResult{Name: "Time synchronization"}
+
+	output, err := ExecCommand(ctx, "timedatectl", "show", "--property", "NTPSynchronized", "--value")
+	if err != nil {
+		result.Status, result.Message = DiagnosticCheckStatusWarning, fmt.Sprintf("cannot determine time synchronization status: %s", err.Error())
+		return result
+	}
+
+	if strings.TrimSpace(string(output)) != "yes" {
+		result.Status, result.Message = DiagnosticCheckStatusFailed, "system clock is not synchronized"
+		return result
+	}
+
+	result.Status, result.Message = DiagnosticCheckStatusOK, "system clock is synchronized"
+	return result
+}
+
+func (b *GardenadmBotanist) checkCertificateExpiry(ctx context.Context, clientSet kubernetes.Interface, threshold time.Duration) DiagnosticCheckResult {
+	result := DiagnosticCheckResult{Name: "Certificate expiry"}
+
+	var (
+		certificates map[string][]byte
+		err          error
+	)
+
+	if clientSet != nil {
+		certificates, err = secretsManagerCertificates(ctx, clientSet.Client())
+	} else {
+		certificates, err = b.staticPodCertificates()
+	}
+	if err != nil {
+		result.Status, result.Message = DiagnosticCheckStatusWarning, err.Error()
+		return result
+	}
+
+	var (
+		now              = b.Clock.Now()
+		expired, expires []string
+	)
+
+	for _, source := range slices.Sorted(maps.Keys(certificates)) {
+		certificate, err := utils.DecodeCertificate(certificates[source])
+		if err != nil {
+			continue
+		}
+
+		switch {
+		case now.After(certificate.NotAfter):
+			expired = append(expired, fmt.Sprintf("%s (expired at %s)", source, certificate.NotAfter.UTC().Format(time.RFC3339)))
+		case now.Add(threshold).After(certificate.NotAfter):
+			expires = append(expires, fmt.Sprintf("%s (expires at %s)", source, certificate.NotAfter.UTC().Format(time.RFC3339)))
+		}
+	}
+
+	switch {
+	case len(expired) > 0:
+		result.Status, result.Message = DiagnosticCheckStatusFailed, "expired certificates: "+strings.Join(append(expired, expires...), ", ")
+	case len(expires) > 0:
+		result.Status, result.Message = DiagnosticCheckStatusWarning, "certificates expiring soon: "+strings.Join(expires, ", ")
+	default:
+		result.Status, result.Message = DiagnosticCheckStatusOK, fmt.Sprintf("%d certificates valid for more than %s", len(certificates), threshold)
+	}
+
+	return result
+}
+
+// secretsManagerCertificates returns the certificates of the secrets managed by the secrets manager in the kube-system
+// namespace keyed by the secret name.
+func secretsManagerCertificates(ctx context.Context, c client.Client) (map[string][]byte, error) {
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager}); err != nil {
+		return nil, fmt.Errorf("failed listing secrets managed by the secrets manager: %w", err)
+	}
+
+	certificates := make(map[string][]byte)
+	for _, secret := range secretList.Items {
+		for _, key := range []string{secretsutils.DataKeyCertificate, secretsutils.DataKeyCertificateCA} {
+			if data, ok := secret.Data[key]; ok {
+				certificates["secret "+secret.Name+"/"+key] = data
+				break
+			}
+		}
+	}
+
+	return certificates, nil
+}
+
+// staticPodCertificates returns the certificates mounted into the static pods keyed by the file path.
+func (b *GardenadmBotanist) staticPodCertificates() (map[string][]byte, error) {
+	certificates := make(map[string][]byte)
+
+	if err := b.FS.Walk(PathStaticPodsDirectory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if errors.Is(err, afero.ErrFileNotFound) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".crt") {
+			return nil
+		}
+
+		data, err := b.FS.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		certificates["file "+path] = data
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed reading certificates of static pods: %w", err)
+	}
+
+	return certificates, nil
+}
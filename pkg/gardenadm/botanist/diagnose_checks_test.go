@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Diagnostic checks", func() {
+	var (
+		ctx context.Context
+
+		fakeClient client.Client
+		fs         afero.Afero
+		fakeClock  *testclock.FakeClock
+
+		b *GardenadmBotanist
+
+		reachablePorts  []string
+		timeSyncOutput  string
+		timeSyncErr     error
+		expiryThreshold = 30 * 24 * time.Hour
+
+		generateCertificate = func(validity time.Duration) []byte {
+			certificate, err := (&secretsutils.CertificateSecretConfig{
+				Name:       "ca",
+				CommonName: "ca",
+				CertType:   secretsutils.CACert,
+				Validity:   &validity,
+			}).GenerateCertificate()
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return certificate.CertificatePEM
+		}
+		resultOf = func(results []DiagnosticCheckResult, name string) DiagnosticCheckResult {
+			for _, result := range results {
+				if result.Name == name {
+					return result
+				}
+			}
+			Fail("no result for check " + name)
+			return DiagnosticCheckResult{}
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger: logr.Discard(),
+					Clock:  fakeClock,
+				},
+			},
+			FS: fs,
+		}
+
+		reachablePorts = []string{"localhost:10250", "localhost:443"}
+		timeSyncOutput, timeSyncErr = "yes\n", nil
+
+		DeferCleanup(test.WithVars(
+			&DialContext, func(_ context.Context, _, address string) (net.Conn, error) {
+				for _, port := range reachablePorts {
+					if port == address {
+						client, server := net.Pipe()
+						DeferCleanup(server.Close)
+						return client, nil
+					}
+				}
+				return nil, fmt.Errorf("connection refused")
+			},
+			&ExecCommand, func(_ context.Context, command string, _ ...string) ([]byte, error) {
+				Expect(command).To(Equal("timedatectl"))
+				return []byte(timeSyncOutput), timeSyncErr
+			},
+		))
+
+		Expect(fs.WriteFile("/etc/kubernetes/manifests/kube-apiserver.yaml", []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-apiserver
+spec:
+  containers:
+  - name: kube-apiserver
+    ports:
+    - containerPort: 443
+`), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/var/lib/kubelet/config/kubelet", []byte(`apiVersion: kubelet.config.k8s.io/v1beta1
+kind: KubeletConfiguration
+cgroupDriver: systemd
+`), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/containerd/config.toml", []byte(`[plugins."io.containerd.cri.v1.runtime".containerd.runtimes.runc.options]
+  SystemdCgroup = true
+`), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/sys/fs/cgroup/cgroup.controllers", []byte("cpu memory"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/proc/swaps", []byte("Filename\tType\tSize\tUsed\tPriority\n"), 0600)).To(Succeed())
+		Expect(fs.WriteFile(PathStaticPodsDirectory+"/kube-apiserver/ca/ca.crt", generateCertificate(365*24*time.Hour), 0600)).To(Succeed())
+	})
+
+	It("should report that all checks succeeded", func() {
+		Expect(b.RunDiagnosticChecks(ctx, nil, expiryThreshold)).To(ConsistOf(
+			DiagnosticCheckResult{Name: "Ports", Status: DiagnosticCheckStatusOK, Message: "2 ports reachable"},
+			DiagnosticCheckResult{Name: "Cgroup driver", Status: DiagnosticCheckStatusOK, Message: `kubelet and containerd use cgroup driver "systemd" (cgroup v2)`},
+			DiagnosticCheckResult{Name: "Swap", Status: DiagnosticCheckStatusOK, Message: "swap is disabled"},
+			DiagnosticCheckResult{Name: "Time synchronization", Status: DiagnosticCheckStatusOK, Message: "system clock is synchronized"},
+			DiagnosticCheckResult{Name: "Certificate expiry", Status: DiagnosticCheckStatusOK, Message: "1 certificates valid for more than 720h0m0s"},
+		))
+	})
+
+	It("should report unreachable ports", func() {
+		reachablePorts = []string{"localhost:10250"}
+
+		Expect(resultOf(b.RunDiagnosticChecks(ctx, nil, expiryThreshold), "Ports")).To(Equal(
+			DiagnosticCheckResult{Name: "Ports", Status: DiagnosticCheckStatusFailed, Message: "ports not reachable: 443 (kube-apiserver)"},
+		))
+	})
+
+	It("should report mismatching cgroup drivers", func() {
+		Expect(fs.WriteFile("/etc/containerd/config.toml", []byte("SystemdCgroup = false\n"), 0600)).To(Succeed())
+
+		Expect(resultOf(b.RunDiagnosticChecks(ctx, nil, expiryThreshold), "Cgroup driver")).To(Equal(
+			DiagnosticCheckResult{Name: "Cgroup driver", Status: DiagnosticCheckStatusFailed, Message: `kubelet uses cgroup driver "systemd" but containerd uses "cgroupfs" (cgroup v2)`},
+		))
+	})
+
+	It("should warn if the kubelet config does not exist", func() {
+		Expect(fs.Remove("/var/lib/kubelet/config/kubelet")).To(Succeed())
+
+		Expect(resultOf(b.RunDiagnosticChecks(ctx, nil, expiryThreshold), "Cgroup driver")).To(And(
+			HaveField("Status", DiagnosticCheckStatusWarning),
+			HaveField("Message", ContainSubstring("cannot read kubelet config")),
+		))
+	})
+
+	It("should warn if swap is enabled", func() {
+		Expect(fs.WriteFile("/proc/swaps", []byte("Filename\tType\tSize\tUsed\tPriority\n/swapfile\tfile\t1024\t0\t-2\n"), 0600)).To(Succeed())
+
+		Expect(resultOf(b.RunDiagnosticChecks(ctx, nil, expiryThreshold), "Swap")).To(Equal(
+			DiagnosticCheckResult{Name: "Swap", Status: DiagnosticCheckStatusWarning, Message: "swap is enabled on 1 device(s)"},
+		))
+	})
+
+	It("should report an unsynchronized clock", func() {
+		timeSyncOutput = "no\n"
+
+		Expect(resultOf(b.RunDiagnosticChecks(ctx, nil, expiryThreshold), "Time synchronization")).To(Equal(
+			DiagnosticCheckResult{Name: "Time synchronization", Status: DiagnosticCheckStatusFailed, Message: "system clock is not synchronized"},
+		))
+	})
+
+	It("should warn if the time synchronization status cannot be determined", func() {
+		timeSyncErr = fmt.Errorf("timedatectl not found")
+
+		Expect(resultOf(b.RunDiagnosticChecks(ctx, nil, expiryThreshold), "Time synchronization")).To(HaveField("Status", DiagnosticCheckStatusWarning))
+	})
+
+	Context("certificate expiry", func() {
+		It("should warn about certificates of static pods expiring soon", func() {
+			fakeClock.Step(360 * 24 * time.Hour)
+
+			Expect(resultOf(b.RunDiagnosticChecks(ctx, nil, expiryThreshold), "Certificate expiry")).To(And(
+				HaveField("Status", DiagnosticCheckStatusWarning),
+				HaveField("Message", ContainSubstring("certificates expiring soon: file "+PathStaticPodsDirectory+"/kube-apiserver/ca/ca.crt (expires at ")),
+			))
+		})
+
+		It("should report expired certificates of static pods", func() {
+			fakeClock.Step(400 * 24 * time.Hour)
+
+			Expect(resultOf(b.RunDiagnosticChecks(ctx, nil, expiryThreshold), "Certificate expiry")).To(And(
+				HaveField("Status", DiagnosticCheckStatusFailed),
+				HaveField("Message", ContainSubstring("expired certificates: file "+PathStaticPodsDirectory+"/kube-apiserver/ca/ca.crt (expired at ")),
+			))
+		})
+
+		It("should check the certificates managed by the secrets manager if the cluster is reachable", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca-etcd", Namespace: "kube-system", Labels: map[string]string{"managed-by": "secrets-manager"}},
+				Data:       map[string][]byte{"ca.crt": generateCertificate(10 * 24 * time.Hour)},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "unmanaged", Namespace: "kube-system"},
+				Data:       map[string][]byte{"tls.crt": generateCertificate(time.Hour)},
+			})).To(Succeed())
+
+			Expect(resultOf(b.RunDiagnosticChecks(ctx, fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(), expiryThreshold), "Certificate expiry")).To(And(
+				HaveField("Status", DiagnosticCheckStatusWarning),
+				HaveField("Message", And(
+					ContainSubstring("secret ca-etcd/ca.crt"),
+					Not(ContainSubstring("unmanaged")),
+					Not(ContainSubstring("file ")),
+				)),
+			))
+		})
+	})
+})
@@ -168,7 +168,8 @@ func (b *GardenadmBotanist) ReconcileExtensionControllerInstallations(ctx contex
 		reconciler = controllerinstallation.Reconciler{
 			GardenClient:              b.GardenClient,
 			SeedClientSet:             b.SeedClientSet,
-			HelmRegistry:              oci.NewHelmRegistry(b.SeedClientSet.Client()),
+			ChartRenderer:             b.SeedClientSet.ChartRenderer(),
+			HelmRegistry:              oci.NewHelmRegistry(b.SeedClientSet.Client(), nil),
 			Clock:                     b.Clock,
 			Identity:                  &shoot.Status.Gardener,
 			GardenNamespace:           b.Shoot.ControlPlaneNamespace,
@@ -103,6 +103,8 @@ func (b *GardenadmBotanist) ApplyOperatingSystemConfig(ctx context.Context) erro
 		CancelContext:         cancelFunc,
 		Recorder:              &events.FakeRecorder{},
 		Extractor:             registry.NewExtractor(),
+		Verifier:              registry.NewVerifier(),
+		Clock:                 b.Clock,
 		HostName:              b.HostName,
 		NodeName:              ptr.Deref(node, corev1.Node{}).Name,
 		DBus:                  b.DBus,
@@ -144,7 +146,7 @@ func (b *GardenadmBotanist) generateGardenerNodeInitOperatingSystemConfig(secret
 	units, files, err := nodeinit.Config(
 		gardencorev1beta1.Worker{},
 		image.String(),
-		nodeagentcomponent.ComponentConfig(secretName, b.Shoot.KubernetesVersion, controlPlaneAddress, nil),
+		nodeagentcomponent.ComponentConfig(secretName, b.Shoot.KubernetesVersion, controlPlaneAddress, nil, nil),
 		caBundle,
 	)
 	if err != nil {
@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubeletcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	staticpodtranslator "github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const pathSystemdUnits = "/etc/systemd/system"
+
+var (
+	// PathStaticPodsDirectory is the directory containing the host paths of the volumes of static pods translated by
+	// gardenadm.
+	PathStaticPodsDirectory = filepath.Dir(filepath.Dir(staticpodtranslator.HostPath("pod", "volume")))
+	// PathsContainerdState are the directories containing the state of containerd.
+	PathsContainerdState = []string{"/var/lib/containerd", "/run/containerd"}
+	// PathsKubeletCredentials are the paths of the kubelet's credentials.
+	PathsKubeletCredentials = []string{
+		kubeletcomponent.PathKubeconfigBootstrap,
+		kubeletcomponent.PathKubeconfigReal,
+		kubeletcomponent.PathKubeletCACert,
+		path.Join(kubeletcomponent.PathKubeletDirectory, "pki"),
+	}
+
+	// resetUnitNames are the units which are stopped and disabled when the node is reset. The order matters: the
+	// gardener-node-agent must be stopped before the other units to prevent it from restarting them.
+	resetUnitNames = []string{
+		nodeagentconfigv1alpha1.InitUnitName,
+		nodeagentconfigv1alpha1.UnitName,
+		v1beta1constants.OperatingSystemConfigUnitNameKubeletService,
+		v1beta1constants.OperatingSystemConfigUnitNameContainerDService,
+	}
+
+	statefulSetVolumeHostPathRegex = regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(staticpodtranslator.StatefulSetVolumeClaimTemplateHostPath("NAME")), "NAME", "[^/]+") + "$")
+)
+
+// DrainNode cordons the node and evicts all pods which are neither managed by a DaemonSet nor static pods. It waits
+// until the evicted pods are gone or the given timeout expires.
+func (b *GardenadmBotanist) DrainNode(ctx context.Context, c client.Client, node *corev1.Node, timeout time.Duration) error {
+	if !node.Spec.Unschedulable {
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Unschedulable = true
+		if err := c.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed cordoning node %s: %w", node.Name, err)
+		}
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return retry.Until(timeoutCtx, time.Second, func(ctx context.Context) (bool, error) {
+		podList := &corev1.PodList{}
+		if err := c.List(ctx, podList, client.MatchingFields{"spec.nodeName": node.Name}); err != nil {
+			return retry.SevereError(fmt.Errorf("failed listing pods on node %s: %w", node.Name, err))
+		}
+
+		var remaining []string
+		for _, pod := range podList.Items {
+			if !podMustBeEvicted(pod) {
+				continue
+			}
+			remaining = append(remaining, client.ObjectKeyFromObject(&pod).String())
+
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+
+			if err := c.SubResource("eviction").Create(ctx, &pod, &policyv1.Eviction{}); err != nil && !apierrors.IsNotFound(err) {
+				// Evictions are rejected with 429 if they would violate a PodDisruptionBudget, retry them later.
+				b.Logger.Info("Pod cannot be evicted yet", "pod", client.ObjectKeyFromObject(&pod), "reason", err.Error())
+			}
+		}
+
+		if len(remaining) > 0 {
+			return retry.MinorError(fmt.Errorf("pods are still running on node %s: %s", node.Name, strings.Join(remaining, ", ")))
+		}
+		return retry.Ok()
+	})
+}
+
+func podMustBeEvicted(pod corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if owner := metav1.GetControllerOf(&pod); owner != nil && owner.Kind == "DaemonSet" {
+		return false
+	}
+	return true
+}
+
+// DeleteNode deletes the given node object.
+func (b *GardenadmBotanist) DeleteNode(ctx context.Context, c client.Client, node *corev1.Node) error {
+	if err := c.Delete(ctx, node); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed deleting node %s: %w", node.Name, err)
+	}
+	return nil
+}
+
+// StopNodeUnits stops and disables the gardener-node-agent, kubelet and containerd units. Failures are only logged
+// since the units might not exist, e.g., if a previous 'gardenadm init' or 'gardenadm join' failed early or if the node
+// was already reset partially.
+func (b *GardenadmBotanist) StopNodeUnits(ctx context.Context) {
+	for _, unitName := range resetUnitNames {
+		b.Logger.Info("Stopping unit", "unitName", unitName)
+		if err := b.DBus.Stop(ctx, nil, nil, unitName); err != nil {
+			b.Logger.Info("Failed stopping unit, continuing", "unitName", unitName, "reason", err.Error())
+		}
+
+		// containerd is usually provided by the operating system, hence it is only stopped but not disabled.
+		if unitName == v1beta1constants.OperatingSystemConfigUnitNameContainerDService {
+			continue
+		}
+
+		if err := b.DBus.Disable(ctx, unitName); err != nil {
+			b.Logger.Info("Failed disabling unit, continuing", "unitName", unitName, "reason", err.Error())
+		}
+	}
+}
+
+// LastAppliedOperatingSystemConfig reads the OperatingSystemConfig which was last applied by gardener-node-agent. It
+// returns nil if there is no such file.
+func (b *GardenadmBotanist) LastAppliedOperatingSystemConfig() (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	data, err := b.FS.ReadFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading last-applied OperatingSystemConfig: %w", err)
+	}
+
+	obj, _, err := nodeagent.OSCDecoder.Decode(data, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding last-applied OperatingSystemConfig: %w", err)
+	}
+
+	osc, ok := obj.(*extensionsv1alpha1.OperatingSystemConfig)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T in last-applied OperatingSystemConfig file", obj)
+	}
+	return osc, nil
+}
+
+// RemoveOperatingSystemConfigFiles removes the files and units which were placed from the given OperatingSystemConfig.
+// Units which were not created by gardener-node-agent (i.e., units without content, e.g., default units of the
+// operating system) are kept, only their drop-ins are removed. The node units which are stopped in StopNodeUnits are
+// always removed.
+func (b *GardenadmBotanist) RemoveOperatingSystemConfigFiles(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	var (
+		files []extensionsv1alpha1.File
+		units []extensionsv1alpha1.Unit
+	)
+
+	if osc != nil {
+		files = append(slices.Clone(osc.Spec.Files), osc.Status.ExtensionFiles...)
+		units = append(slices.Clone(osc.Spec.Units), osc.Status.ExtensionUnits...)
+	}
+
+	for _, file := range files {
+		if err := b.removePath(file.Path); err != nil {
+			return err
+		}
+	}
+
+	unitFilesToRemove := sets.New(nodeagentconfigv1alpha1.InitUnitName, nodeagentconfigv1alpha1.UnitName, v1beta1constants.OperatingSystemConfigUnitNameKubeletService)
+	for _, unit := range units {
+		unitFilePath := path.Join(pathSystemdUnits, unit.Name)
+
+		for _, dropIn := range unit.DropIns {
+			if err := b.removePath(path.Join(unitFilePath+".d", dropIn.Name)); err != nil {
+				return err
+			}
+		}
+		if empty, err := b.FS.IsEmpty(unitFilePath + ".d"); err == nil && empty {
+			if err := b.removePath(unitFilePath + ".d"); err != nil {
+				return err
+			}
+		}
+
+		if unit.Content != nil {
+			unitFilesToRemove.Insert(unit.Name)
+		}
+	}
+
+	for _, unitName := range sets.List(unitFilesToRemove) {
+		if err := b.removePath(path.Join(pathSystemdUnits, unitName)); err != nil {
+			return err
+		}
+	}
+
+	return b.DBus.DaemonReload(ctx)
+}
+
+// RemoveStaticPods removes the manifests of the static pods translated by gardenadm as well as the host paths of their
+// volumes, e.g., the data directories of etcd.
+func (b *GardenadmBotanist) RemoveStaticPods() error {
+	entries, err := b.FS.ReadDir(kubeletcomponent.FilePathKubernetesManifests)
+	if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed reading static pod manifests directory %s: %w", kubeletcomponent.FilePathKubernetesManifests, err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		manifestPath := filepath.Join(kubeletcomponent.FilePathKubernetesManifests, entry.Name())
+		data, err := b.FS.ReadFile(manifestPath)
+		if err != nil {
+			return fmt.Errorf("failed reading static pod manifest %s: %w", manifestPath, err)
+		}
+
+		pod := &corev1.Pod{}
+		if err := runtime.DecodeInto(kubernetes.SeedCodec.UniversalDeserializer(), data, pod); err != nil || pod.Labels[staticpodtranslator.LabelKeyIsStaticPod] != staticpodtranslator.LabelValueIsStaticPod {
+			b.Logger.Info("Skipping file which is not a static pod manifest written by gardenadm", "path", manifestPath)
+			continue
+		}
+
+		for _, volume := range pod.Spec.Volumes {
+			if volume.HostPath != nil && statefulSetVolumeHostPathRegex.MatchString(volume.HostPath.Path) {
+				if err := b.removePath(filepath.Dir(volume.HostPath.Path)); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := b.removePath(manifestPath); err != nil {
+			return err
+		}
+	}
+
+	return b.removePath(PathStaticPodsDirectory)
+}
+
+// RemoveNodeState removes the state of containerd, gardener-node-agent and the credentials of the kubelet.
+func (b *GardenadmBotanist) RemoveNodeState() error {
+	for _, p := range slices.Concat(PathsContainerdState, PathsKubeletCredentials, []string{nodeagentconfigv1alpha1.BaseDir}) {
+		if err := b.removePath(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *GardenadmBotanist) removePath(p string) error {
+	if err := b.FS.RemoveAll(p); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed removing %s: %w", p, err)
+	}
+	b.Logger.V(1).Info("Removed path", "path", p)
+	return nil
+}
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reset", func() {
+	var (
+		ctx context.Context
+
+		fakeClient client.Client
+		fakeDBus   *fakedbus.DBus
+		fs         afero.Afero
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithIndex(&corev1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			Build()
+		fakeDBus = fakedbus.New()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger: logr.Discard(),
+				},
+			},
+			FS:   fs,
+			DBus: fakeDBus,
+		}
+	})
+
+	Describe("#DrainNode", func() {
+		var (
+			node *corev1.Node
+
+			newPod = func(name string, mutate func(*corev1.Pod)) *corev1.Pod {
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+					Spec:       corev1.PodSpec{NodeName: node.Name},
+				}
+				if mutate != nil {
+					mutate(pod)
+				}
+				ExpectWithOffset(1, fakeClient.Create(ctx, pod)).To(Succeed())
+				return pod
+			}
+		)
+
+		BeforeEach(func() {
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		})
+
+		It("should cordon the node and evict all pods except static and DaemonSet pods", func() {
+			regularPod := newPod("regular", nil)
+			otherNodePod := newPod("other-node", func(pod *corev1.Pod) { pod.Spec.NodeName = "other" })
+			mirrorPod := newPod("mirror", func(pod *corev1.Pod) {
+				metav1.SetMetaDataAnnotation(&pod.ObjectMeta, corev1.MirrorPodAnnotationKey, "foo")
+			})
+			daemonSetPod := newPod("daemonset", func(pod *corev1.Pod) {
+				pod.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "ds"}}, appsv1.SchemeGroupVersion.WithKind("DaemonSet"))}
+			})
+
+			Expect(b.DrainNode(ctx, fakeClient, node, 5*time.Second)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(regularPod), regularPod)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(otherNodePod), otherNodePod)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(mirrorPod), mirrorPod)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(daemonSetPod), daemonSetPod)).To(Succeed())
+		})
+
+		It("should fail if pods are not gone before the timeout expires", func() {
+			newPod("terminating", func(pod *corev1.Pod) {
+				pod.Finalizers = []string{"foo"}
+			})
+
+			Expect(b.DrainNode(ctx, fakeClient, node, 100*time.Millisecond)).To(MatchError(ContainSubstring("pods are still running on node node: default/terminating")))
+		})
+	})
+
+	Describe("#DeleteNode", func() {
+		It("should delete the node", func() {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+			Expect(b.DeleteNode(ctx, fakeClient, node)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(BeNotFoundError())
+		})
+
+		It("should succeed if the node does not exist", func() {
+			Expect(b.DeleteNode(ctx, fakeClient, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}})).To(Succeed())
+		})
+	})
+
+	Describe("#StopNodeUnits", func() {
+		It("should stop all units and disable all but containerd", func() {
+			b.StopNodeUnits(ctx)
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionStop, UnitNames: []string{"gardener-node-init.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"gardener-node-init.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"containerd.service"}},
+			}))
+		})
+	})
+
+	Describe("#LastAppliedOperatingSystemConfig", func() {
+		It("should return nil if the file does not exist", func() {
+			Expect(b.LastAppliedOperatingSystemConfig()).To(BeNil())
+		})
+
+		It("should decode the last-applied OperatingSystemConfig", func() {
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+metadata:
+  name: osc
+spec:
+  files:
+  - path: /etc/foo
+`), 0600)).To(Succeed())
+
+			osc, err := b.LastAppliedOperatingSystemConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(osc.Spec.Files).To(ConsistOf(HaveField("Path", "/etc/foo")))
+		})
+
+		It("should fail if the file cannot be decoded", func() {
+			Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte(`{`), 0600)).To(Succeed())
+
+			Expect(b.LastAppliedOperatingSystemConfig()).Error().To(MatchError(ContainSubstring("failed decoding last-applied OperatingSystemConfig")))
+		})
+	})
+
+	Describe("#RemoveOperatingSystemConfigFiles", func() {
+		It("should remove files, units with content and drop-ins", func() {
+			for _, path := range []string{
+				"/etc/foo",
+				"/opt/bin/bar",
+				"/etc/systemd/system/kubelet.service",
+				"/etc/systemd/system/gardener-node-agent.service",
+				"/etc/systemd/system/custom.service",
+				"/etc/systemd/system/containerd.service.d/10-drop-in.conf",
+				"/etc/systemd/system/containerd.service.d/99-other.conf",
+				"/etc/systemd/system/ssh.service.d/10-drop-in.conf",
+			} {
+				Expect(fs.WriteFile(path, []byte("content"), 0600)).To(Succeed())
+			}
+
+			osc := &extensionsv1alpha1.OperatingSystemConfig{
+				Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+					Files: []extensionsv1alpha1.File{{Path: "/etc/foo"}},
+					Units: []extensionsv1alpha1.Unit{
+						{Name: "custom.service", Content: new("content")},
+						{Name: "containerd.service", DropIns: []extensionsv1alpha1.DropIn{{Name: "10-drop-in.conf"}}},
+					},
+				},
+				Status: extensionsv1alpha1.OperatingSystemConfigStatus{
+					ExtensionFiles: []extensionsv1alpha1.File{{Path: "/opt/bin/bar"}},
+					ExtensionUnits: []extensionsv1alpha1.Unit{{Name: "ssh.service", DropIns: []extensionsv1alpha1.DropIn{{Name: "10-drop-in.conf"}}}},
+				},
+			}
+
+			Expect(b.RemoveOperatingSystemConfigFiles(ctx, osc)).To(Succeed())
+
+			for _, path := range []string{
+				"/etc/foo",
+				"/opt/bin/bar",
+				"/etc/systemd/system/kubelet.service",
+				"/etc/systemd/system/gardener-node-agent.service",
+				"/etc/systemd/system/custom.service",
+				"/etc/systemd/system/containerd.service.d/10-drop-in.conf",
+				"/etc/systemd/system/ssh.service.d",
+			} {
+				Expect(fs.Exists(path)).To(BeFalse(), path)
+			}
+			Expect(fs.Exists("/etc/systemd/system/containerd.service.d/99-other.conf")).To(BeTrue())
+
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionDaemonReload}))
+		})
+
+		It("should remove the node units if there is no OperatingSystemConfig", func() {
+			Expect(fs.WriteFile("/etc/systemd/system/gardener-node-init.service", []byte("content"), 0600)).To(Succeed())
+
+			Expect(b.RemoveOperatingSystemConfigFiles(ctx, nil)).To(Succeed())
+			Expect(fs.Exists("/etc/systemd/system/gardener-node-init.service")).To(BeFalse())
+		})
+	})
+
+	Describe("#RemoveStaticPods", func() {
+		It("should remove the static pods translated by gardenadm and the host paths of their volumes", func() {
+			Expect(fs.WriteFile("/etc/kubernetes/manifests/etcd-main.yaml", []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: etcd-main
+  namespace: kube-system
+  labels:
+    static-pod: "true"
+spec:
+  containers:
+  - name: etcd
+  volumes:
+  - name: main-etcd
+    hostPath:
+      path: /var/lib/main-etcd/data
+  - name: config
+    hostPath:
+      path: /var/lib/static-pods/etcd-main/config
+  - name: certs
+    hostPath:
+      path: /etc/ssl/certs
+`), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/kubernetes/manifests/other.yaml", []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: other
+`), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/main-etcd/data/member/snap", []byte("data"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/var/lib/static-pods/etcd-main/config/etcd.yaml", []byte("config"), 0600)).To(Succeed())
+			Expect(fs.WriteFile("/etc/ssl/certs/ca.crt", []byte("ca"), 0600)).To(Succeed())
+
+			Expect(b.RemoveStaticPods()).To(Succeed())
+
+			Expect(fs.Exists("/etc/kubernetes/manifests/etcd-main.yaml")).To(BeFalse())
+			Expect(fs.Exists("/var/lib/main-etcd")).To(BeFalse())
+			Expect(fs.Exists("/var/lib/static-pods")).To(BeFalse())
+			Expect(fs.Exists("/etc/kubernetes/manifests/other.yaml")).To(BeTrue())
+			Expect(fs.Exists("/etc/ssl/certs/ca.crt")).To(BeTrue())
+		})
+
+		It("should succeed if there are no static pods", func() {
+			Expect(b.RemoveStaticPods()).To(Succeed())
+		})
+	})
+
+	Describe("#RemoveNodeState", func() {
+		It("should remove the state of containerd, kubelet and gardener-node-agent", func() {
+			for _, path := range []string{
+				"/var/lib/containerd/io.containerd.content.v1.content/blob",
+				"/run/containerd/containerd.sock",
+				"/var/lib/kubelet/kubeconfig-real",
+				"/var/lib/kubelet/pki/kubelet-server-current.pem",
+				"/var/lib/kubelet/config/kubelet",
+				"/var/lib/gardener-node-agent/credentials/token",
+			} {
+				Expect(fs.WriteFile(path, []byte("content"), 0600)).To(Succeed())
+			}
+
+			Expect(b.RemoveNodeState()).To(Succeed())
+
+			for _, path := range []string{
+				"/var/lib/containerd",
+				"/run/containerd",
+				"/var/lib/kubelet/kubeconfig-real",
+				"/var/lib/kubelet/pki",
+				"/var/lib/gardener-node-agent",
+			} {
+				Expect(fs.Exists(path)).To(BeFalse(), path)
+			}
+			Expect(fs.Exists("/var/lib/kubelet/config/kubelet")).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,445 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/imagevector"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	staticpodtranslator "github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// staticControlPlaneComponentImageNames maps the names of the control plane components which run as static pods and
+// whose images depend on the Kubernetes version to the names of their images in the image vector. The container names
+// in the static pods are equal to the component names.
+var staticControlPlaneComponentImageNames = map[string]string{
+	v1beta1constants.DeploymentNameKubeAPIServer:         imagevector.ContainerImageNameKubeApiserver,
+	v1beta1constants.DeploymentNameKubeControllerManager: imagevector.ContainerImageNameKubeControllerManager,
+	v1beta1constants.DeploymentNameKubeScheduler:         imagevector.ContainerImageNameKubeScheduler,
+}
+
+// IntervalWaitUntilNodeUpgraded is the interval for checking whether a node was upgraded successfully.
+// Exposed for testing.
+var IntervalWaitUntilNodeUpgraded = 5 * time.Second
+
+// UpgradePlan describes the upgrade of a self-hosted shoot cluster to the Kubernetes version specified in the Shoot
+// manifest.
+type UpgradePlan struct {
+	// CurrentVersion is the Kubernetes version the control plane is currently running with.
+	CurrentVersion *semver.Version
+	// TargetVersion is the Kubernetes version specified in the Shoot manifest.
+	TargetVersion *semver.Version
+	// AvailableVersions are the active Kubernetes versions in the CloudProfile which are higher than the current
+	// version.
+	AvailableVersions []string
+	// Components are the images of the control plane components running as static pods on the nodes.
+	Components []ComponentUpgrade
+	// BlockingIssues are the reasons why the upgrade cannot be applied.
+	BlockingIssues []string
+}
+
+// ComponentUpgrade describes the image change of a control plane component running as static pod on a node.
+type ComponentUpgrade struct {
+	// Name is the name of the component.
+	Name string
+	// NodeName is the name of the node the static pod is running on.
+	NodeName string
+	// CurrentImage is the image the static pod is currently running with.
+	CurrentImage string
+	// TargetImage is the image from the image vector for the target version.
+	TargetImage string
+}
+
+// Blocked returns true if the upgrade plan has blocking issues.
+func (p *UpgradePlan) Blocked() bool {
+	return len(p.BlockingIssues) > 0
+}
+
+// UpToDate returns true if there is nothing to upgrade.
+func (p *UpgradePlan) UpToDate() bool {
+	return p.CurrentVersion.Equal(p.TargetVersion) && !slices.ContainsFunc(p.Components, func(c ComponentUpgrade) bool {
+		return c.CurrentImage != c.TargetImage
+	})
+}
+
+// ComputeUpgradePlan computes the plan for upgrading the self-hosted shoot cluster from the given current Kubernetes
+// version to the version in the Shoot manifest. It validates the target version against the CloudProfile, determines
+// the target images of the control plane components from the image vector, and checks the health of the nodes and
+// static pods.
+func (b *GardenadmBotanist) ComputeUpgradePlan(ctx context.Context, c client.Client, currentVersion *semver.Version) (*UpgradePlan, error) {
+	if b.Resources.Shoot == nil || b.Resources.CloudProfile == nil {
+		return nil, fmt.Errorf("the Shoot and CloudProfile manifests are required for computing an upgrade plan")
+	}
+
+	targetVersion, err := semver.NewVersion(b.Resources.Shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing Kubernetes version %q of Shoot: %w", b.Resources.Shoot.Spec.Kubernetes.Version, err)
+	}
+
+	plan := &UpgradePlan{
+		CurrentVersion: currentVersion,
+		TargetVersion:  targetVersion,
+	}
+
+	plan.AvailableVersions, err = availableKubernetesVersions(b.Resources.CloudProfile, currentVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.BlockingIssues = append(plan.BlockingIssues, checkTargetKubernetesVersion(b.Resources.CloudProfile, currentVersion, targetVersion)...)
+
+	if err := c.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceSystem, Name: v1beta1constants.DeploymentNameGardenlet}, &appsv1.Deployment{}); err == nil {
+		plan.BlockingIssues = append(plan.BlockingIssues, "the cluster is connected to Gardener (gardenlet is deployed), upgrade it by updating the Shoot in the garden cluster instead")
+	} else if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed checking whether gardenlet is deployed: %w", err)
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := c.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	for _, node := range nodeList.Items {
+		if err := health.CheckNode(&node); err != nil {
+			plan.BlockingIssues = append(plan.BlockingIssues, fmt.Sprintf("node %s is unhealthy: %s", node.Name, err.Error()))
+		}
+		if node.Labels[machinev1alpha1.LabelKeyNodeUpdateResult] == machinev1alpha1.LabelValueNodeUpdateFailed {
+			plan.BlockingIssues = append(plan.BlockingIssues, fmt.Sprintf("a previous in-place update of node %s failed: %s", node.Name, node.Annotations[machinev1alpha1.AnnotationKeyMachineUpdateFailedReason]))
+		}
+	}
+
+	staticPodList := &corev1.PodList{}
+	if err := c.List(ctx, staticPodList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{staticpodtranslator.LabelKeyIsStaticPod: staticpodtranslator.LabelValueIsStaticPod}); err != nil {
+		return nil, fmt.Errorf("failed listing static pods: %w", err)
+	}
+
+	for _, pod := range staticPodList.Items {
+		if !health.IsPodReady(&pod) {
+			plan.BlockingIssues = append(plan.BlockingIssues, fmt.Sprintf("static pod %s is not ready", client.ObjectKeyFromObject(&pod)))
+		}
+
+		for _, container := range pod.Spec.Containers {
+			imageName, ok := staticControlPlaneComponentImageNames[container.Name]
+			if !ok {
+				continue
+			}
+
+			image, err := imagevector.Containers().FindImage(imageName, imagevectorutils.RuntimeVersion(currentVersion.String()), imagevectorutils.TargetVersion(targetVersion.String()))
+			if err != nil {
+				return nil, fmt.Errorf("failed finding image %s for version %s: %w", imageName, targetVersion, err)
+			}
+
+			plan.Components = append(plan.Components, ComponentUpgrade{
+				Name:         container.Name,
+				NodeName:     pod.Spec.NodeName,
+				CurrentImage: container.Image,
+				TargetImage:  image.String(),
+			})
+		}
+	}
+
+	slices.SortFunc(plan.Components, func(a, b ComponentUpgrade) int {
+		if n := strings.Compare(a.NodeName, b.NodeName); n != 0 {
+			return n
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return plan, nil
+}
+
+func availableKubernetesVersions(cloudProfile *gardencorev1beta1.CloudProfile, currentVersion *semver.Version) ([]string, error) {
+	var versions []*semver.Version
+
+	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
+		if !v1beta1helper.VersionIsActive(version) {
+			continue
+		}
+
+		v, err := semver.NewVersion(version.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing Kubernetes version %q of CloudProfile: %w", version.Version, err)
+		}
+
+		if v.GreaterThan(currentVersion) {
+			versions = append(versions, v)
+		}
+	}
+
+	slices.SortFunc(versions, func(a, b *semver.Version) int { return a.Compare(b) })
+
+	out := make([]string, 0, len(versions))
+	for _, v := range versions {
+		out = append(out, v.String())
+	}
+	return out, nil
+}
+
+func checkTargetKubernetesVersion(cloudProfile *gardencorev1beta1.CloudProfile, currentVersion, targetVersion *semver.Version) []string {
+	var issues []string
+
+	if targetVersion.LessThan(currentVersion) {
+		issues = append(issues, fmt.Sprintf("downgrading the Kubernetes version from %s to %s is not supported", currentVersion, targetVersion))
+	} else if targetVersion.Major() != currentVersion.Major() || targetVersion.Minor() > currentVersion.Minor()+1 {
+		issues = append(issues, fmt.Sprintf("upgrading the Kubernetes version from %s to %s skips minor versions, upgrade to %d.%d first", currentVersion, targetVersion, currentVersion.Major(), currentVersion.Minor()+1))
+	}
+
+	if targetVersion.Equal(currentVersion) {
+		return issues
+	}
+
+	if ok, version, err := v1beta1helper.KubernetesVersionExistsInCloudProfile(cloudProfile, targetVersion.String()); err != nil || !ok {
+		issues = append(issues, fmt.Sprintf("Kubernetes version %s is not offered by CloudProfile %s", targetVersion, cloudProfile.Name))
+	} else if v1beta1helper.VersionIsExpired(version) {
+		issues = append(issues, fmt.Sprintf("Kubernetes version %s is expired in CloudProfile %s", targetVersion, cloudProfile.Name))
+	}
+
+	return issues
+}
+
+// UsesBootstrapEtcd returns whether the control plane of the cluster still runs the bootstrap etcd, i.e., whether it
+// was initialized with 'gardenadm init --use-bootstrap-etcd' and never transitioned to etcd-druid. This is the case if
+// there is no main Etcd resource managed by etcd-druid in the control plane namespace.
+func (b *GardenadmBotanist) UsesBootstrapEtcd(ctx context.Context, c client.Client) (bool, error) {
+	etcd := &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ETCDMain, Namespace: b.Shoot.ControlPlaneNamespace}}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(etcd), etcd); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed reading Etcd %s: %w", client.ObjectKeyFromObject(etcd), err)
+	}
+
+	return false, nil
+}
+
+// SetSerialOperatingSystemConfigReconciliation enables or disables the serial reconciliation of the
+// OperatingSystemConfig for all worker pools which do not use an in-place update strategy. With serial reconciliation,
+// gardener-node-agent instances of a worker pool apply changes one node at a time and only release the lock after the
+// static pods on their node are ready again.
+// Worker pools with in-place update strategy are gated by UpgradeNode instead. Note that gardener-node-agent keeps the
+// lock while it waits for the node to become ready for an in-place update, hence both mechanisms must not be combined.
+func (b *GardenadmBotanist) SetSerialOperatingSystemConfigReconciliation(ctx context.Context, c client.Client, enabled bool) error {
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleOperatingSystemConfig}); err != nil {
+		return fmt.Errorf("failed listing gardener-node-agent secrets: %w", err)
+	}
+
+	inPlaceWorkerPools := b.inPlaceWorkerPoolNames()
+
+	for _, secret := range secretList.Items {
+		if inPlaceWorkerPools.Has(secret.Labels[v1beta1constants.LabelWorkerPool]) {
+			continue
+		}
+
+		patch := client.MergeFrom(secret.DeepCopy())
+		if enabled {
+			metav1.SetMetaDataAnnotation(&secret.ObjectMeta, v1beta1constants.AnnotationNodeAgentSerialOSCReconciliation, "true")
+		} else {
+			delete(secret.Annotations, v1beta1constants.AnnotationNodeAgentSerialOSCReconciliation)
+		}
+
+		if err := c.Patch(ctx, &secret, patch); err != nil {
+			return fmt.Errorf("failed patching gardener-node-agent secret %s: %w", client.ObjectKeyFromObject(&secret), err)
+		}
+	}
+
+	return nil
+}
+
+// UpgradeNodes rolls out the current OperatingSystemConfigs of the worker pools node by node, starting with the nodes
+// of the control plane worker pool. See UpgradeNode for details.
+func (b *GardenadmBotanist) UpgradeNodes(ctx context.Context, c client.Client, drainTimeout, timeout time.Duration) error {
+	nodeList := &corev1.NodeList{}
+	if err := c.List(ctx, nodeList); err != nil {
+		return fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	var (
+		controlPlanePoolName string
+		inPlaceWorkerPools   = b.inPlaceWorkerPoolNames()
+	)
+
+	if pool := v1beta1helper.ControlPlaneWorkerPoolForShoot(b.Shoot.GetInfo().Spec.Provider.Workers); pool != nil {
+		controlPlanePoolName = pool.Name
+	}
+
+	slices.SortFunc(nodeList.Items, func(a, b corev1.Node) int {
+		aControlPlane, bControlPlane := a.Labels[v1beta1constants.LabelWorkerPool] == controlPlanePoolName, b.Labels[v1beta1constants.LabelWorkerPool] == controlPlanePoolName
+		if aControlPlane != bControlPlane {
+			if aControlPlane {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, node := range nodeList.Items {
+		if err := b.UpgradeNode(ctx, c, &node, inPlaceWorkerPools.Has(node.Labels[v1beta1constants.LabelWorkerPool]), drainTimeout, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpgradeNode waits until gardener-node-agent has applied the current OperatingSystemConfig of the node's worker pool
+// and until the node and its static pods are healthy again.
+// If the worker pool uses an in-place update strategy, the node is drained and marked as ready for the in-place update
+// first, since gardener-node-agent does not apply in-place updates (e.g., Kubernetes minor version upgrades) before.
+// This is usually done by machine-controller-manager, which does not run for self-hosted shoots with unmanaged
+// infrastructure. Afterwards, the markers are removed, and the node is uncordoned again.
+func (b *GardenadmBotanist) UpgradeNode(ctx context.Context, c client.Client, node *corev1.Node, inPlace bool, drainTimeout, timeout time.Duration) error {
+	log := b.Logger.WithValues("node", node.Name)
+
+	workerPoolToSecretMeta, err := botanist.WorkerPoolToOperatingSystemConfigSecretMetaMap(ctx, c, v1beta1constants.GardenRoleOperatingSystemConfig)
+	if err != nil {
+		return fmt.Errorf("failed listing gardener-node-agent secrets: %w", err)
+	}
+
+	poolName := node.Labels[v1beta1constants.LabelWorkerPool]
+	secretMeta, ok := workerPoolToSecretMeta[poolName]
+	if !ok {
+		log.Info("No gardener-node-agent secret found for worker pool of node, skipping", "workerPool", poolName)
+		return nil
+	}
+	checksum := secretMeta.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig]
+
+	if node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == checksum {
+		log.Info("Node is already up to date")
+		return nil
+	}
+
+	if inPlace {
+		if err := b.DrainNode(ctx, c, node, drainTimeout); err != nil {
+			log.Info("Failed draining node, continuing with in-place update", "reason", err.Error())
+		}
+
+		log.Info("Marking node as ready for in-place update")
+		patch := client.MergeFrom(node.DeepCopy())
+		setNodeCondition(node, corev1.NodeCondition{
+			Type:               machinev1alpha1.NodeInPlaceUpdate,
+			Status:             corev1.ConditionTrue,
+			Reason:             machinev1alpha1.ReadyForUpdate,
+			Message:            "Node is ready for in-place update by gardenadm upgrade",
+			LastTransitionTime: metav1.Now(),
+		})
+		if err := c.Status().Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed marking node %s as ready for in-place update: %w", node.Name, err)
+		}
+	}
+
+	log.Info("Waiting until node is upgraded and healthy", "checksum", checksum)
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := retry.Until(timeoutCtx, IntervalWaitUntilNodeUpgraded, func(ctx context.Context) (bool, error) {
+		// The kube-apiserver might be unavailable while the static pods are rolled, hence errors are only minor.
+		if err := c.Get(ctx, client.ObjectKeyFromObject(node), node); err != nil {
+			return retry.MinorError(fmt.Errorf("failed reading node %s: %w", node.Name, err))
+		}
+
+		if node.Labels[machinev1alpha1.LabelKeyNodeUpdateResult] == machinev1alpha1.LabelValueNodeUpdateFailed {
+			return retry.SevereError(fmt.Errorf("in-place update of node %s failed: %s", node.Name, node.Annotations[machinev1alpha1.AnnotationKeyMachineUpdateFailedReason]))
+		}
+
+		if applied := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]; applied != checksum {
+			return retry.MinorError(fmt.Errorf("node %s has not applied the latest OperatingSystemConfig yet", node.Name))
+		}
+
+		if err := health.CheckNode(node); err != nil {
+			return retry.MinorError(fmt.Errorf("node %s is unhealthy: %w", node.Name, err))
+		}
+
+		return checkStaticPodsReady(ctx, c, node.Name)
+	}); err != nil {
+		return err
+	}
+
+	if inPlace {
+		log.Info("Removing in-place update markers and uncordoning node")
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Status.Conditions = slices.DeleteFunc(node.Status.Conditions, func(condition corev1.NodeCondition) bool {
+			return condition.Type == machinev1alpha1.NodeInPlaceUpdate
+		})
+		if err := c.Status().Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed removing in-place update condition from node %s: %w", node.Name, err)
+		}
+
+		patch = client.MergeFrom(node.DeepCopy())
+		delete(node.Labels, machinev1alpha1.LabelKeyNodeUpdateResult)
+		delete(node.Labels, machinev1alpha1.LabelKeyNodeSelectedForUpdate)
+		delete(node.Labels, machinev1alpha1.LabelKeyNodeCandidateForUpdate)
+		node.Spec.Unschedulable = false
+		if err := c.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed uncordoning node %s: %w", node.Name, err)
+		}
+	}
+
+	log.Info("Node was upgraded successfully")
+	return nil
+}
+
+func checkStaticPodsReady(ctx context.Context, c client.Client, nodeName string) (bool, error) {
+	podList := &corev1.PodList{}
+	if err := c.List(ctx, podList,
+		client.InNamespace(metav1.NamespaceSystem),
+		client.MatchingLabels{staticpodtranslator.LabelKeyIsStaticPod: staticpodtranslator.LabelValueIsStaticPod},
+		client.MatchingFields{"spec.nodeName": nodeName},
+	); err != nil {
+		return retry.MinorError(fmt.Errorf("failed listing static pods on node %s: %w", nodeName, err))
+	}
+
+	for _, pod := range podList.Items {
+		if !health.IsPodReady(&pod) {
+			return retry.MinorError(fmt.Errorf("static pod %s on node %s is not ready yet", client.ObjectKeyFromObject(&pod), nodeName))
+		}
+	}
+
+	return retry.Ok()
+}
+
+func setNodeCondition(node *corev1.Node, condition corev1.NodeCondition) {
+	for i, c := range node.Status.Conditions {
+		if c.Type == condition.Type {
+			node.Status.Conditions[i] = condition
+			return
+		}
+	}
+	node.Status.Conditions = append(node.Status.Conditions, condition)
+}
+
+func (b *GardenadmBotanist) inPlaceWorkerPoolNames() sets.Set[string] {
+	names := sets.New[string]()
+	for _, worker := range b.Shoot.GetInfo().Spec.Provider.Workers {
+		if v1beta1helper.IsUpdateStrategyInPlace(worker.UpdateStrategy) {
+			names.Insert(worker.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,352 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Upgrade", func() {
+	var (
+		ctx context.Context
+
+		fakeClient client.Client
+		b          *GardenadmBotanist
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot        *gardencorev1beta1.Shoot
+
+		newNode = func(name, pool string) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{v1beta1constants.LabelWorkerPool: pool}},
+				Status:     corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}},
+			}
+		}
+		newStaticPod = func(name, nodeName, image string, ready bool) *corev1.Pod {
+			readyStatus := corev1.ConditionFalse
+			if ready {
+				readyStatus = corev1.ConditionTrue
+			}
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name + "-" + nodeName, Namespace: "kube-system", Labels: map[string]string{"static-pod": "true"}},
+				Spec:       corev1.PodSpec{NodeName: nodeName, Containers: []corev1.Container{{Name: name, Image: image}}},
+				Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}}},
+			}
+		}
+		newOSCSecret = func(pool, checksum string) *corev1.Secret {
+			return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name:        "gardener-node-agent-" + pool,
+				Namespace:   "kube-system",
+				Labels:      map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleOperatingSystemConfig, v1beta1constants.LabelWorkerPool: pool},
+				Annotations: map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig: checksum},
+			}}
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithIndex(&corev1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			WithStatusSubresource(&corev1.Node{}, &corev1.Pod{}).
+			Build()
+
+		DeferCleanup(test.WithVar(&IntervalWaitUntilNodeUpgraded, 10*time.Millisecond))
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "local"},
+			Spec: gardencorev1beta1.CloudProfileSpec{Kubernetes: gardencorev1beta1.KubernetesSettings{Versions: []gardencorev1beta1.ExpirableVersion{
+				{Version: "1.33.2"},
+				{Version: "1.34.1"},
+				{Version: "1.34.0", Lifecycle: []gardencorev1beta1.LifecycleStage{{Classification: gardencorev1beta1.ClassificationExpired}}},
+				{Version: "1.35.0"},
+				{Version: "1.33.1"},
+			}}},
+		}
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.34.1"},
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{
+					{Name: "worker", UpdateStrategy: new(gardencorev1beta1.AutoInPlaceUpdate)},
+					{Name: "control-plane", ControlPlane: &gardencorev1beta1.WorkerControlPlane{}},
+				}},
+			},
+		}
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger: logr.Discard(),
+					Shoot:  &shootpkg.Shoot{},
+				},
+			},
+			Resources: gardenadm.Resources{CloudProfile: cloudProfile, Shoot: shoot},
+		}
+		b.Shoot.SetInfo(shoot)
+	})
+
+	Describe("#UsesBootstrapEtcd", func() {
+		BeforeEach(func() {
+			b.Shoot.ControlPlaneNamespace = "kube-system"
+		})
+
+		It("should return true if there is no Etcd resource", func() {
+			Expect(b.UsesBootstrapEtcd(ctx, fakeClient)).To(BeTrue())
+		})
+
+		It("should return false if the main Etcd resource exists", func() {
+			Expect(fakeClient.Create(ctx, &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: "kube-system"}})).To(Succeed())
+
+			Expect(b.UsesBootstrapEtcd(ctx, fakeClient)).To(BeFalse())
+		})
+	})
+
+	Describe("#ComputeUpgradePlan", func() {
+		var currentVersion *semver.Version
+
+		BeforeEach(func() {
+			currentVersion = semver.MustParse("1.33.2")
+
+			Expect(fakeClient.Create(ctx, newNode("node-0", "control-plane"))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newStaticPod("kube-apiserver", "node-0", "registry.k8s.io/kube-apiserver:v1.33.2", true))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newStaticPod("kube-scheduler", "node-0", "registry.k8s.io/kube-scheduler:v1.33.2", true))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newStaticPod("etcd-main", "node-0", "etcd:v3", true))).To(Succeed())
+		})
+
+		It("should compute the plan without blocking issues", func() {
+			plan, err := b.ComputeUpgradePlan(ctx, fakeClient, currentVersion)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan.CurrentVersion.String()).To(Equal("1.33.2"))
+			Expect(plan.TargetVersion.String()).To(Equal("1.34.1"))
+			Expect(plan.AvailableVersions).To(Equal([]string{"1.34.1", "1.35.0"}))
+			Expect(plan.Components).To(Equal([]ComponentUpgrade{
+				{Name: "kube-apiserver", NodeName: "node-0", CurrentImage: "registry.k8s.io/kube-apiserver:v1.33.2", TargetImage: "registry.k8s.io/kube-apiserver:v1.34.1"},
+				{Name: "kube-scheduler", NodeName: "node-0", CurrentImage: "registry.k8s.io/kube-scheduler:v1.33.2", TargetImage: "registry.k8s.io/kube-scheduler:v1.34.1"},
+			}))
+			Expect(plan.BlockingIssues).To(BeEmpty())
+			Expect(plan.Blocked()).To(BeFalse())
+			Expect(plan.UpToDate()).To(BeFalse())
+		})
+
+		It("should report that the cluster is up to date", func() {
+			shoot.Spec.Kubernetes.Version = "1.33.2"
+			Expect(fakeClient.Delete(ctx, newStaticPod("kube-scheduler", "node-0", "", true))).To(Succeed())
+			Expect(fakeClient.Delete(ctx, newStaticPod("kube-apiserver", "node-0", "", true))).To(Succeed())
+
+			plan, err := b.ComputeUpgradePlan(ctx, fakeClient, currentVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Blocked()).To(BeFalse())
+			Expect(plan.UpToDate()).To(BeTrue())
+		})
+
+		It("should block downgrades", func() {
+			shoot.Spec.Kubernetes.Version = "1.33.1"
+
+			plan, err := b.ComputeUpgradePlan(ctx, fakeClient, currentVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.BlockingIssues).To(ConsistOf(ContainSubstring("downgrading the Kubernetes version from 1.33.2 to 1.33.1 is not supported")))
+		})
+
+		It("should block skipping minor versions", func() {
+			shoot.Spec.Kubernetes.Version = "1.35.0"
+
+			plan, err := b.ComputeUpgradePlan(ctx, fakeClient, currentVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.BlockingIssues).To(ConsistOf(ContainSubstring("skips minor versions, upgrade to 1.34 first")))
+		})
+
+		It("should block versions which are not offered by the CloudProfile", func() {
+			shoot.Spec.Kubernetes.Version = "1.34.2"
+
+			plan, err := b.ComputeUpgradePlan(ctx, fakeClient, currentVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.BlockingIssues).To(ConsistOf("Kubernetes version 1.34.2 is not offered by CloudProfile local"))
+		})
+
+		It("should block expired versions", func() {
+			shoot.Spec.Kubernetes.Version = "1.34.0"
+
+			plan, err := b.ComputeUpgradePlan(ctx, fakeClient, currentVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.BlockingIssues).To(ConsistOf("Kubernetes version 1.34.0 is expired in CloudProfile local"))
+		})
+
+		It("should block the upgrade if gardenlet is deployed", func() {
+			Expect(fakeClient.Create(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "gardenlet", Namespace: "kube-system"}})).To(Succeed())
+
+			plan, err := b.ComputeUpgradePlan(ctx, fakeClient, currentVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.BlockingIssues).To(ConsistOf(ContainSubstring("the cluster is connected to Gardener")))
+		})
+
+		It("should block the upgrade if nodes or static pods are unhealthy", func() {
+			node := newNode("node-1", "worker")
+			node.Status.Conditions[0].Status = corev1.ConditionFalse
+			node.Labels[machinev1alpha1.LabelKeyNodeUpdateResult] = machinev1alpha1.LabelValueNodeUpdateFailed
+			node.Annotations = map[string]string{machinev1alpha1.AnnotationKeyMachineUpdateFailedReason: "some reason"}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+			Expect(fakeClient.Create(ctx, newStaticPod("kube-controller-manager", "node-0", "registry.k8s.io/kube-controller-manager:v1.33.2", false))).To(Succeed())
+
+			plan, err := b.ComputeUpgradePlan(ctx, fakeClient, currentVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.BlockingIssues).To(ConsistOf(
+				ContainSubstring("node node-1 is unhealthy"),
+				"a previous in-place update of node node-1 failed: some reason",
+				"static pod kube-system/kube-controller-manager-node-0 is not ready",
+			))
+		})
+
+		It("should fail if the Shoot version cannot be parsed", func() {
+			shoot.Spec.Kubernetes.Version = "foo"
+
+			Expect(b.ComputeUpgradePlan(ctx, fakeClient, currentVersion)).Error().To(MatchError(ContainSubstring("failed parsing Kubernetes version")))
+		})
+	})
+
+	Describe("#SetSerialOperatingSystemConfigReconciliation", func() {
+		It("should enable and disable serial reconciliation for worker pools without in-place update strategy", func() {
+			controlPlaneSecret, workerSecret := newOSCSecret("control-plane", "a"), newOSCSecret("worker", "b")
+			Expect(fakeClient.Create(ctx, controlPlaneSecret)).To(Succeed())
+			Expect(fakeClient.Create(ctx, workerSecret)).To(Succeed())
+
+			Expect(b.SetSerialOperatingSystemConfigReconciliation(ctx, fakeClient, true)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(controlPlaneSecret), controlPlaneSecret)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(workerSecret), workerSecret)).To(Succeed())
+			Expect(controlPlaneSecret.Annotations).To(HaveKeyWithValue("reconciliation.osc.node-agent.gardener.cloud/serial", "true"))
+			Expect(workerSecret.Annotations).NotTo(HaveKey("reconciliation.osc.node-agent.gardener.cloud/serial"))
+
+			Expect(b.SetSerialOperatingSystemConfigReconciliation(ctx, fakeClient, false)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(controlPlaneSecret), controlPlaneSecret)).To(Succeed())
+			Expect(controlPlaneSecret.Annotations).NotTo(HaveKey("reconciliation.osc.node-agent.gardener.cloud/serial"))
+		})
+	})
+
+	Describe("#UpgradeNode", func() {
+		var node *corev1.Node
+
+		BeforeEach(func() {
+			Expect(fakeClient.Create(ctx, newOSCSecret("control-plane", "new"))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newOSCSecret("worker", "new"))).To(Succeed())
+		})
+
+		It("should return immediately if the node is up to date", func() {
+			node = newNode("node-0", "control-plane")
+			node.Annotations = map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig: "new"}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+			Expect(b.UpgradeNode(ctx, fakeClient, node, false, time.Second, time.Second)).To(Succeed())
+		})
+
+		It("should wait until the node has applied the OperatingSystemConfig and its static pods are ready", func() {
+			node = newNode("node-0", "control-plane")
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+			pod := newStaticPod("kube-apiserver", "node-0", "kube-apiserver:v1.34.1", false)
+			Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(100 * time.Millisecond)
+
+				updatedNode := &corev1.Node{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				metav1.SetMetaDataAnnotation(&updatedNode.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, "new")
+				Expect(fakeClient.Update(ctx, updatedNode)).To(Succeed())
+
+				pod.Status.Conditions[0].Status = corev1.ConditionTrue
+				Expect(fakeClient.Status().Update(ctx, pod)).To(Succeed())
+			}()
+
+			Expect(b.UpgradeNode(ctx, fakeClient, node, false, time.Second, 15*time.Second)).To(Succeed())
+			Expect(node.Status.Conditions).NotTo(ContainElement(HaveField("Type", machinev1alpha1.NodeInPlaceUpdate)))
+		})
+
+		It("should mark the node as ready for in-place update and clean up afterwards", func() {
+			node = newNode("node-1", "worker")
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+			go func() {
+				defer GinkgoRecover()
+
+				updatedNode := &corev1.Node{}
+				Eventually(func(g Gomega) {
+					g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+					g.Expect(updatedNode.Spec.Unschedulable).To(BeTrue())
+					g.Expect(updatedNode.Status.Conditions).To(ContainElement(And(
+						HaveField("Type", machinev1alpha1.NodeInPlaceUpdate),
+						HaveField("Reason", machinev1alpha1.ReadyForUpdate),
+					)))
+				}).Should(Succeed())
+
+				metav1.SetMetaDataAnnotation(&updatedNode.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, "new")
+				metav1.SetMetaDataLabel(&updatedNode.ObjectMeta, machinev1alpha1.LabelKeyNodeUpdateResult, machinev1alpha1.LabelValueNodeUpdateSuccessful)
+				Expect(fakeClient.Update(ctx, updatedNode)).To(Succeed())
+			}()
+
+			Expect(b.UpgradeNode(ctx, fakeClient, node, true, time.Second, 15*time.Second)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+			Expect(node.Labels).NotTo(HaveKey(machinev1alpha1.LabelKeyNodeUpdateResult))
+			Expect(node.Status.Conditions).NotTo(ContainElement(HaveField("Type", machinev1alpha1.NodeInPlaceUpdate)))
+		})
+
+		It("should fail if the in-place update failed", func() {
+			node = newNode("node-1", "worker")
+			node.Labels[machinev1alpha1.LabelKeyNodeUpdateResult] = machinev1alpha1.LabelValueNodeUpdateFailed
+			node.Annotations = map[string]string{machinev1alpha1.AnnotationKeyMachineUpdateFailedReason: "kubelet is unhealthy"}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+			Expect(b.UpgradeNode(ctx, fakeClient, node, true, time.Second, 15*time.Second)).To(MatchError(ContainSubstring("in-place update of node node-1 failed: kubelet is unhealthy")))
+		})
+	})
+
+	Describe("#UpgradeNodes", func() {
+		It("should upgrade the control plane nodes first", func() {
+			Expect(fakeClient.Create(ctx, newOSCSecret("control-plane", "new"))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newOSCSecret("worker", "new"))).To(Succeed())
+
+			workerNode := newNode("a-worker", "worker")
+			Expect(fakeClient.Create(ctx, workerNode)).To(Succeed())
+			controlPlaneNode := newNode("b-control-plane", "control-plane")
+			controlPlaneNode.Annotations = map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig: "old"}
+			Expect(fakeClient.Create(ctx, controlPlaneNode)).To(Succeed())
+
+			// The control plane node never gets updated, hence the worker node must not be marked for the in-place update.
+			Expect(b.UpgradeNodes(ctx, fakeClient, time.Second, time.Second)).To(MatchError(ContainSubstring("node b-control-plane has not applied the latest OperatingSystemConfig yet")))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(workerNode), workerNode)).To(Succeed())
+			Expect(workerNode.Spec.Unschedulable).To(BeFalse())
+			Expect(workerNode.Status.Conditions).NotTo(ContainElement(HaveField("Type", machinev1alpha1.NodeInPlaceUpdate)))
+		})
+	})
+})
@@ -6,7 +6,6 @@ package connect
 
 import (
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/spf13/pflag"
@@ -44,15 +43,8 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("must provide a bootstrap token")
 	}
 
-	if len(o.ConfigDir) == 0 {
-		// `gardenadm init` stores the path of the config directory in the cmd.ConfigDirLocation file on the machine's
-		// file system. Hence, we can default it to this location if the user does not explicitly provide us with the
-		// config directory.
-		data, err := os.ReadFile(cmd.ConfigDirLocation)
-		if err != nil {
-			return fmt.Errorf("error reading config dir location file %s: %w", cmd.ConfigDirLocation, err)
-		}
-		o.ConfigDir = string(data)
+	if err := o.DefaultConfigDir(); err != nil {
+		return err
 	}
 
 	return o.ManifestOptions.Validate()
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+)
+
+var (
+	// NewGardenadmBotanist creates a new GardenadmBotanist.
+	// Exposed for unit testing.
+	NewGardenadmBotanist = botanist.NewGardenadmBotanistWithoutResources
+	// CreateClientSet creates a new client set for the cluster the node is registered in.
+	// Exposed for unit testing.
+	CreateClientSet = func(ctx context.Context, log logr.Logger) (kubernetes.Interface, error) {
+		return (&botanist.GardenadmBotanist{Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: log}}}).CreateClientSet(ctx)
+	}
+	// Now computes the current time.
+	// Exposed for unit testing.
+	Now = time.Now
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Collect diagnostics of a node which was set up with 'gardenadm init' or 'gardenadm join'",
+		Long: `Collect diagnostics of a node which was set up with 'gardenadm init' or 'gardenadm join'.
+
+This command gathers the journal logs and status of the gardener-node-init, gardener-node-agent, kubelet and containerd
+units, the static pod manifests, the gardener-node-agent configuration, the last-applied OperatingSystemConfig, and the
+containerd status into a tarball. Private keys, tokens and passwords are redacted, and the contents of files from the
+OperatingSystemConfig which are not world-readable are omitted.
+
+Additionally, it checks the machine for common issues (unreachable ports, mismatching cgroup drivers, enabled swap,
+unsynchronized time, and expiring certificates) and prints a summary.
+
+If the cluster can be reached with the kubeconfig found in the KUBECONFIG environment variable or in
+/etc/kubernetes/admin.conf, the Nodes as well as the Pods and Events in the kube-system namespace are collected, and
+the certificates managed by the secrets manager are checked. Otherwise, only the machine is diagnosed, and the
+certificates mounted into the static pods are checked.`,
+		Example: `# Collect diagnostics into gardenadm-diagnose-<timestamp>.tar.gz in the current directory
+gardenadm diagnose
+
+# Collect diagnostics into the given file
+gardenadm diagnose --output /tmp/diagnostics.tar.gz`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	b, err := NewGardenadmBotanist(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	clientSet, err := CreateClientSet(ctx, b.Logger)
+	if err != nil {
+		b.Logger.Info("Cannot reach the cluster, only diagnosing the machine", "reason", err.Error())
+		clientSet = nil
+	}
+
+	file, err := b.FS.Create(opts.Output)
+	if err != nil {
+		return fmt.Errorf("failed creating diagnostics bundle %s: %w", opts.Output, err)
+	}
+	defer file.Close()
+
+	archive := botanist.NewDiagnosticsArchive(file, Now())
+
+	b.Logger.Info("Collecting diagnostics")
+	collectionErrs := b.CollectDiagnostics(ctx, archive, clientSet, opts.JournalLines)
+
+	b.Logger.Info("Running diagnostic checks")
+	results := b.RunDiagnosticChecks(ctx, clientSet, opts.CertificateExpiryThreshold)
+
+	summary := &bytes.Buffer{}
+	if err := printSummary(summary, results, collectionErrs, clientSet != nil); err != nil {
+		return fmt.Errorf("failed printing summary: %w", err)
+	}
+
+	if err := archive.Add("summary.txt", summary.Bytes()); err != nil {
+		return err
+	}
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed writing diagnostics bundle %s: %w", opts.Output, err)
+	}
+
+	if _, err := io.Copy(opts.Out, summary); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.Out, `
+The diagnostics bundle has been written to %s.
+Review it before sharing it, secrets are redacted on a best-effort basis.
+`, opts.Output)
+
+	return nil
+}
+
+func printSummary(w io.Writer, results []botanist.DiagnosticCheckResult, collectionErrs []error, clusterReachable bool) error {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "CHECK", Type: "string", Description: "Name of the diagnostic check"},
+			{Name: "STATUS", Type: "string", Description: "Status of the diagnostic check"},
+			{Name: "MESSAGE", Type: "string", Description: "Result of the diagnostic check"},
+		},
+		Rows: make([]metav1.TableRow, 0, len(results)),
+	}
+
+	for _, result := range results {
+		table.Rows = append(table.Rows, metav1.TableRow{Cells: []any{result.Name, string(result.Status), result.Message}})
+	}
+
+	if err := printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, w); err != nil {
+		return err
+	}
+
+	if !clusterReachable {
+		fmt.Fprintln(w, "\nThe cluster could not be reached, only the machine was diagnosed.")
+	}
+
+	if len(collectionErrs) > 0 {
+		fmt.Fprintln(w, "\nThe following diagnostics could not be collected:")
+		for _, err := range collectionErrs {
+			fmt.Fprintf(w, "  - %s\n", err.Error())
+		}
+	}
+
+	return nil
+}
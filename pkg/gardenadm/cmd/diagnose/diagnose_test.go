@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/clock"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/diagnose"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Diagnose", func() {
+	var (
+		ctx = context.Background()
+
+		globalOpts *cmd.Options
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fs        afero.Afero
+		clientSet kubernetes.Interface
+
+		archiveFiles = func(path string) []string {
+			file, err := fs.Open(path)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			defer file.Close()
+
+			gzipReader, err := gzip.NewReader(file)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			tarReader := tar.NewReader(gzipReader)
+
+			var names []string
+			for {
+				header, err := tarReader.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				ExpectWithOffset(1, err).NotTo(HaveOccurred())
+				names = append(names, header.Name)
+			}
+			return names
+		}
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, _, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+		command.SetContext(ctx)
+		Expect(command.Flags().Set("output", "/tmp/diagnostics.tar.gz")).To(Succeed())
+
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		clientSet = fakekubernetes.NewClientSetBuilder().WithClient(fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()).Build()
+
+		DeferCleanup(test.WithVars(
+			&NewGardenadmBotanist, func(log logr.Logger) (*botanist.GardenadmBotanist, error) {
+				return &botanist.GardenadmBotanist{
+					Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: log, Clock: clock.RealClock{}}},
+					HostName: "machine-0",
+					DBus:     fakedbus.New(),
+					FS:       fs,
+				}, nil
+			},
+			&CreateClientSet, func(context.Context, logr.Logger) (kubernetes.Interface, error) {
+				if clientSet == nil {
+					return nil, fmt.Errorf("no kubeconfig found")
+				}
+				return clientSet, nil
+			},
+			&botanist.DialContext, func(context.Context, string, string) (net.Conn, error) {
+				return nil, fmt.Errorf("connection refused")
+			},
+			&botanist.ExecCommand, func(_ context.Context, command string, _ ...string) ([]byte, error) {
+				return []byte("output of " + command), nil
+			},
+		))
+	})
+
+	Describe("#RunE", func() {
+		It("should write the diagnostics bundle and print the summary", func() {
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say(`CHECK\s+STATUS\s+MESSAGE`))
+			Eventually(stdOut).Should(Say(`Ports\s+Failed\s+ports not reachable`))
+			Eventually(stdOut).Should(Say(`Swap\s+Warning\s+cannot read /proc/swaps`))
+			Eventually(stdOut).Should(Say(`Time synchronization\s+Failed\s+system clock is not synchronized`))
+			Eventually(stdOut).Should(Say(`Certificate expiry\s+OK\s+0 certificates valid`))
+			Eventually(stdOut).Should(Say("The diagnostics bundle has been written to /tmp/diagnostics.tar.gz."))
+			Expect(string(stdOut.Contents())).NotTo(ContainSubstring("The cluster could not be reached"))
+
+			Expect(archiveFiles("/tmp/diagnostics.tar.gz")).To(ContainElements(
+				"systemd/units.txt",
+				"journal/kubelet.service.log",
+				"containerd/tasks.txt",
+				"cluster/nodes.yaml",
+				"summary.txt",
+			))
+		})
+
+		It("should only diagnose the machine if the cluster cannot be reached", func() {
+			clientSet = nil
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say("The cluster could not be reached, only the machine was diagnosed."))
+
+			files := archiveFiles("/tmp/diagnostics.tar.gz")
+			Expect(files).To(ContainElements("journal/kubelet.service.log", "summary.txt"))
+			Expect(files).NotTo(ContainElement("cluster/nodes.yaml"))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// Output is the path of the file to which the diagnostics bundle is written.
+	Output string
+	// JournalLines is the number of the most recent journal lines collected per unit.
+	JournalLines int
+	// CertificateExpiryThreshold is the duration before the expiry of a certificate from which on it is reported.
+	CertificateExpiryThreshold time.Duration
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if o.JournalLines <= 0 {
+		return fmt.Errorf("journal lines must be positive")
+	}
+
+	if o.CertificateExpiryThreshold < 0 {
+		return fmt.Errorf("certificate expiry threshold must not be negative")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error {
+	if len(o.Output) == 0 {
+		o.Output = fmt.Sprintf("gardenadm-diagnose-%s.tar.gz", Now().UTC().Format("20060102-150405"))
+	}
+
+	return nil
+}
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Output, "output", "o", "", "Path of the file to which the diagnostics bundle is written (default: gardenadm-diagnose-<timestamp>.tar.gz in the current directory)")
+	fs.IntVar(&o.JournalLines, "journal-lines", 1000, "Number of the most recent journal lines collected per unit")
+	fs.DurationVar(&o.CertificateExpiryThreshold, "certificate-expiry-threshold", 30*24*time.Hour, "Report certificates which expire within this duration")
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/diagnose"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{JournalLines: 100, CertificateExpiryThreshold: time.Hour}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should do nothing", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed when proper values were provided", func() {
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when the number of journal lines is not positive", func() {
+			options.JournalLines = 0
+			Expect(options.Validate()).To(MatchError(ContainSubstring("journal lines must be positive")))
+		})
+
+		It("should fail when the certificate expiry threshold is negative", func() {
+			options.CertificateExpiryThreshold = -time.Hour
+			Expect(options.Validate()).To(MatchError(ContainSubstring("certificate expiry threshold must not be negative")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should default the output file", func() {
+			DeferCleanup(test.WithVar(&Now, func() time.Time { return time.Date(2050, 5, 5, 5, 5, 5, 0, time.UTC) }))
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.Output).To(Equal("gardenadm-diagnose-20500505-050505.tar.gz"))
+		})
+
+		It("should not overwrite the output file", func() {
+			options.Output = "foo.tar.gz"
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.Output).To(Equal("foo.tar.gz"))
+		})
+	})
+})
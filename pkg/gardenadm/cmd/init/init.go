@@ -20,6 +20,7 @@ import (
 	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
 	gardenadmbotanist "github.com/gardener/gardener/pkg/gardenadm/botanist"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -531,6 +532,10 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*gardenadmbotani
 		b.Logger.Info("Found existing kubeconfig file, skipping initialization of control plane", "path", botanist.PathKubeconfig)
 	}
 
+	if err := opts.RunPreflightChecks(ctx, opts.Out, preflight.InitChecks(b.FS, kubeconfigFileExists)); err != nil {
+		return nil, err
+	}
+
 	var (
 		clientSet kubernetes.Interface
 		g         = flow.NewGraph("bootstrap")
@@ -20,6 +20,7 @@ import (
 type Options struct {
 	*cmd.Options
 	cmd.ManifestOptions
+	cmd.PreflightOptions
 
 	// UseBootstrapEtcd indicates whether to use the bootstrap etcd instead of transitioning to etcd-druid
 	// (default: false). This helps `gardenadm init` to run faster.
@@ -47,6 +48,10 @@ func (o *Options) Validate() error {
 		return err
 	}
 
+	if err := o.PreflightOptions.Validate(); err != nil {
+		return err
+	}
+
 	return o.validateZone()
 }
 
@@ -90,6 +95,7 @@ func (o *Options) Complete() error {
 
 func (o *Options) addFlags(fs *pflag.FlagSet) {
 	o.ManifestOptions.AddFlags(fs)
+	o.PreflightOptions.AddFlags(fs)
 	fs.BoolVar(&o.UseBootstrapEtcd, "use-bootstrap-etcd", false, "If set, the control plane continues using the bootstrap etcd instead of transitioning to etcd-druid. This can be useful for testing purposes to save time.")
 	fs.BoolVar(&o.UseHostNetwork, "use-host-network", false, "If set, gardener-resource-manager and extensions continue to run in host network instead of getting redeployed into the pod network after bootstrapping. This can be useful for testing purposes to save time.")
 	fs.StringVarP(&o.Zone, "zone", "z", "", "Availability zone for the new node. Required if the control plane worker pool in the Shoot has multiple zones configured. Optional if exactly one zone is configured (applied automatically). Must not be set if no zones are configured.")
@@ -31,6 +31,7 @@ var _ = Describe("Options", func() {
 			Options: &cmd.Options{},
 		}
 		options.ConfigDir = configDir
+		options.PreflightOutput = "table"
 
 		cloudProfileManifest := `apiVersion: core.gardener.cloud/v1beta1
 kind: CloudProfile
@@ -106,6 +107,11 @@ spec:`)
 			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a config directory")))
 		})
 
+		It("should fail because the preflight output format is not supported", func() {
+			options.PreflightOutput = "yaml"
+			Expect(options.Validate()).To(MatchError(ContainSubstring("preflight output format must be one of")))
+		})
+
 		It("should fail when config directory does not exist", func() {
 			options.ConfigDir = "non-existent-directory"
 
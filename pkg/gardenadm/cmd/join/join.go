@@ -30,6 +30,7 @@ import (
 	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/gardenadm/botanist"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
 	staticpodtranslator "github.com/gardener/gardener/pkg/gardenadm/staticpod"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/nodeagent"
@@ -144,6 +145,10 @@ func run(ctx context.Context, opts *Options) error {
 	}
 	nodeJoinedAlready := node != nil
 
+	if err := opts.RunPreflightChecks(ctx, opts.Out, preflight.JoinChecks(b.FS, opts.ControlPlane, nodeJoinedAlready)); err != nil {
+		return err
+	}
+
 	var (
 		g                       = flow.NewGraph("join")
 		reporter                = flow.NewCommandLineProgressReporter(opts.ErrOut)
@@ -16,6 +16,7 @@ import (
 // Options contains options for this command.
 type Options struct {
 	*cmd.Options
+	cmd.PreflightOptions
 
 	// ControlPlaneAddress is the address of the control plane to which the node should be joined.
 	ControlPlaneAddress string
@@ -55,7 +56,7 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("cannot provide a worker pool name when joining a control plane node")
 	}
 
-	return nil
+	return o.PreflightOptions.Validate()
 }
 
 // Complete completes the options.
@@ -66,5 +67,6 @@ func (o *Options) addFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.BootstrapToken, "bootstrap-token", "", "Bootstrap token for joining the cluster (create it with 'gardenadm token' on a control plane node)")
 	fs.StringVarP(&o.WorkerPoolName, "worker-pool-name", "w", "", "Name of the worker pool to assign the joining node.")
 	fs.BoolVar(&o.ControlPlane, "control-plane", false, "Create a new control plane instance on this node")
+	o.PreflightOptions.AddFlags(fs)
 	fs.StringVarP(&o.Zone, "zone", "z", "", "Availability zone for the new node. Required if the worker pool in the Shoot has multiple zones configured. Optional if exactly one zone is configured (applied automatically). Must not be set if no zones are configured.")
 }
@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/join"
 )
 
@@ -17,7 +18,7 @@ var _ = Describe("Options", func() {
 	)
 
 	BeforeEach(func() {
-		options = &Options{}
+		options = &Options{PreflightOptions: cmd.PreflightOptions{PreflightOutput: "table"}}
 	})
 
 	Describe("#ParseArgs", func() {
@@ -51,6 +52,13 @@ var _ = Describe("Options", func() {
 
 			Expect(options.Validate()).To(MatchError(ContainSubstring("cannot provide a worker pool name when joining a control plane node")))
 		})
+
+		It("should fail when the preflight output format is not supported", func() {
+			options.BootstrapToken = "some-token"
+			options.PreflightOutput = "yaml"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("preflight output format must be one of")))
+		})
 	})
 
 	Describe("#Complete", func() {
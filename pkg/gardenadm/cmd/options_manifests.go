@@ -6,6 +6,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/pflag"
 
@@ -34,6 +35,22 @@ func (o *ManifestOptions) Validate() error {
 // Complete completes the options.
 func (o *ManifestOptions) Complete() error { return nil }
 
+// DefaultConfigDir defaults the config directory to the path stored by `gardenadm init` in the ConfigDirLocation file
+// on the machine's file system if it was not provided explicitly.
+func (o *ManifestOptions) DefaultConfigDir() error {
+	if len(o.ConfigDir) > 0 {
+		return nil
+	}
+
+	data, err := os.ReadFile(ConfigDirLocation)
+	if err != nil {
+		return fmt.Errorf("error reading config dir location file %s: %w", ConfigDirLocation, err)
+	}
+	o.ConfigDir = string(data)
+
+	return nil
+}
+
 // AddFlags implements Flagger.AddFlags.
 func (o *ManifestOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVarP(&o.ConfigDir, "config-dir", "d", "", "Path to a directory containing "+
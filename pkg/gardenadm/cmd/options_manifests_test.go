@@ -44,4 +44,16 @@ var _ = Describe("ManifestOptions", func() {
 			Expect(options.Complete()).To(Succeed())
 		})
 	})
+
+	Describe("#DefaultConfigDir", func() {
+		It("should not change an explicitly provided config dir", func() {
+			Expect(options.DefaultConfigDir()).To(Succeed())
+			Expect(options.ConfigDir).To(Equal("some-path-to-config-dir"))
+		})
+
+		It("should fail when it cannot read the default config dir location file", func() {
+			options.ConfigDir = ""
+			Expect(options.DefaultConfigDir()).To(MatchError(ContainSubstring("error reading config dir location file")))
+		})
+	})
 })
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
+)
+
+// PreflightOptions contains options related to the preflight checks run before the machine is mutated.
+type PreflightOptions struct {
+	// IgnorePreflightErrors is a list of preflight checks whose errors are shown as warnings. The value 'all' ignores
+	// the errors of all checks.
+	IgnorePreflightErrors []string
+	// PreflightOutput is the output format of the preflight check results.
+	PreflightOutput string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *PreflightOptions) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *PreflightOptions) Validate() error {
+	if o.PreflightOutput != preflight.OutputFormatTable && o.PreflightOutput != preflight.OutputFormatJSON {
+		return fmt.Errorf("preflight output format must be one of %q or %q", preflight.OutputFormatTable, preflight.OutputFormatJSON)
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *PreflightOptions) Complete() error { return nil }
+
+// AddFlags implements Flagger.AddFlags.
+func (o *PreflightOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&o.IgnorePreflightErrors, "ignore-preflight-errors", nil, "List of preflight checks whose errors "+
+		"are ignored, e.g. 'Ports,DiskSpace'. The value 'all' ignores the errors of all checks.")
+	fs.StringVar(&o.PreflightOutput, "preflight-output", preflight.OutputFormatTable, "Output format of the preflight "+
+		"check results. One of: table, json.")
+}
+
+// RunPreflightChecks runs the given preflight checks, prints their results to the given writer and returns an error if
+// any of the checks failed and its errors were not ignored.
+func (o *PreflightOptions) RunPreflightChecks(ctx context.Context, w io.Writer, checks []preflight.Checker) error {
+	ignoredErrors := sets.New[string]()
+	for _, name := range o.IgnorePreflightErrors {
+		ignoredErrors.Insert(strings.ToLower(strings.TrimSpace(name)))
+	}
+
+	results := preflight.Run(ctx, checks, ignoredErrors)
+	if err := results.Print(w, o.PreflightOutput); err != nil {
+		return fmt.Errorf("failed printing preflight check results: %w", err)
+	}
+
+	return results.Err()
+}
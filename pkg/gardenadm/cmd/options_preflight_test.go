@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/preflight"
+)
+
+type fakeCheck struct {
+	name     string
+	warnings []error
+	errs     []error
+}
+
+func (f *fakeCheck) Name() string { return f.name }
+
+func (f *fakeCheck) Check(_ context.Context) ([]error, []error) { return f.warnings, f.errs }
+
+var _ = Describe("PreflightOptions", func() {
+	var (
+		options *PreflightOptions
+	)
+
+	BeforeEach(func() {
+		options = &PreflightOptions{
+			PreflightOutput: "table",
+		}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should pass for valid options", func() {
+			Expect(options.Validate()).To(Succeed())
+
+			options.PreflightOutput = "json"
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail because the output format is not supported", func() {
+			options.PreflightOutput = "yaml"
+			Expect(options.Validate()).To(MatchError(ContainSubstring("preflight output format must be one of")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+
+	Describe("#RunPreflightChecks", func() {
+		var (
+			ctx    = context.Background()
+			buffer *gbytes.Buffer
+			checks []preflight.Checker
+		)
+
+		BeforeEach(func() {
+			buffer = gbytes.NewBuffer()
+			checks = []preflight.Checker{
+				&fakeCheck{name: "Ports", errs: []error{fmt.Errorf("port 443 required by kube-apiserver is in use")}},
+				&fakeCheck{name: "DiskSpace", warnings: []error{fmt.Errorf("only 5.0GiB are available")}},
+			}
+		})
+
+		It("should print the results and fail because of the failed check", func() {
+			Expect(options.RunPreflightChecks(ctx, buffer, checks)).To(MatchError("preflight checks failed: Ports (use --ignore-preflight-errors=Ports to ignore them)"))
+			Eventually(buffer).Should(gbytes.Say(`Ports\s+Failed\s+port 443 required by kube-apiserver is in use`))
+			Eventually(buffer).Should(gbytes.Say(`DiskSpace\s+Warning\s+only 5.0GiB are available`))
+		})
+
+		It("should succeed if the errors of the failed check are ignored", func() {
+			options.IgnorePreflightErrors = []string{" ports"}
+
+			Expect(options.RunPreflightChecks(ctx, buffer, checks)).To(Succeed())
+			Eventually(buffer).Should(gbytes.Say(`Ports\s+Ignored`))
+		})
+
+		It("should print the results as JSON", func() {
+			options.PreflightOutput = "json"
+			options.IgnorePreflightErrors = []string{"all"}
+
+			Expect(options.RunPreflightChecks(ctx, buffer, checks)).To(Succeed())
+			Eventually(buffer).Should(gbytes.Say(`"name": "Ports",\s+"status": "Ignored"`))
+		})
+	})
+})
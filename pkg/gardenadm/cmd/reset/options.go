@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// SkipDrain indicates whether draining the node before deregistering it from the cluster should be skipped.
+	SkipDrain bool
+	// DrainTimeout is the maximum duration to wait for the pods to be evicted from the node.
+	DrainTimeout time.Duration
+	// SkipDeregistration indicates whether deregistering the node from the cluster should be skipped.
+	SkipDeregistration bool
+	// Force indicates whether the node should be reset without asking for confirmation.
+	Force bool
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if o.DrainTimeout <= 0 {
+		return fmt.Errorf("drain timeout must be positive")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.SkipDrain, "skip-drain", false, "Skip draining the node before deregistering it from the cluster")
+	fs.DurationVar(&o.DrainTimeout, "drain-timeout", 2*time.Minute, "Maximum duration to wait for the pods to be evicted from the node")
+	fs.BoolVar(&o.SkipDeregistration, "skip-deregistration", false, "Skip draining and deregistering the node from the cluster, i.e., only tear down the local machine")
+	fs.BoolVarP(&o.Force, "force", "f", false, "Reset the node without asking for confirmation")
+}
@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var (
+	// NewGardenadmBotanist creates a new GardenadmBotanist.
+	// Exposed for unit testing.
+	NewGardenadmBotanist = botanist.NewGardenadmBotanistWithoutResources
+	// CreateClientSet creates a new client set for the cluster the node is registered in.
+	// Exposed for unit testing.
+	CreateClientSet = func(ctx context.Context, log logr.Logger) (kubernetes.Interface, error) {
+		return (&botanist.GardenadmBotanist{Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: log}}}).CreateClientSet(ctx)
+	}
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "reset",
+		Short: "Tear down a node which was set up with 'gardenadm init' or 'gardenadm join'",
+		Long: `Tear down a node which was set up with 'gardenadm init' or 'gardenadm join'.
+
+This command drains the node and deregisters it from the cluster, stops and disables the gardener-node-agent, kubelet
+and containerd units, and removes the static pods, the containerd state, as well as the files and units placed from the
+OperatingSystemConfig. Afterwards, the machine can be set up again with 'gardenadm init' or 'gardenadm join'.
+
+The node is deregistered with the kubeconfig found in the KUBECONFIG environment variable or in
+/etc/kubernetes/admin.conf. If the cluster cannot be reached, deregistration is skipped, and the Node object must be
+deleted manually.
+
+Since the node state cannot be recovered, the command asks for confirmation before tearing down the node, unless
+--force is specified.`,
+		Example: `# Drain and deregister the node, then tear it down
+gardenadm reset
+
+# Tear down the node without draining it first
+gardenadm reset --skip-drain
+
+# Only tear down the local machine, e.g., after a failed 'gardenadm join'
+gardenadm reset --skip-deregistration
+
+# Tear down the node without asking for confirmation, e.g., in scripts
+gardenadm reset --force`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	if !opts.Force {
+		confirmed, err := confirm(opts)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Fprintln(opts.Out, "Aborted, the node was not reset.")
+			return nil
+		}
+	}
+
+	b, err := NewGardenadmBotanist(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	node, clientSet := fetchNode(ctx, opts, b)
+
+	var (
+		g        = flow.NewGraph("reset")
+		reporter = flow.NewCommandLineProgressReporter(opts.ErrOut)
+		osc      *extensionsv1alpha1.OperatingSystemConfig
+
+		drainNode = g.Add(flow.Task{
+			Name: "Draining node",
+			Fn: func(ctx context.Context) error {
+				if err := b.DrainNode(ctx, clientSet.Client(), node, opts.DrainTimeout); err != nil {
+					b.Logger.Info("Failed draining node, continuing with deregistration", "node", node.Name, "reason", err.Error())
+				}
+				return nil
+			},
+			SkipIf: node == nil || opts.SkipDrain,
+		})
+		deleteNode = g.Add(flow.Task{
+			Name: "Deregistering node from the cluster",
+			Fn: func(ctx context.Context) error {
+				return b.DeleteNode(ctx, clientSet.Client(), node)
+			},
+			SkipIf:       node == nil,
+			Dependencies: flow.NewTaskIDs(drainNode),
+		})
+		readOperatingSystemConfig = g.Add(flow.Task{
+			Name: "Reading last-applied OperatingSystemConfig",
+			Fn: func(_ context.Context) error {
+				var err error
+				osc, err = b.LastAppliedOperatingSystemConfig()
+				return err
+			},
+		})
+		stopUnits = g.Add(flow.Task{
+			Name: "Stopping gardener-node-agent, kubelet and containerd units",
+			Fn: func(ctx context.Context) error {
+				b.StopNodeUnits(ctx)
+				return nil
+			},
+			Dependencies: flow.NewTaskIDs(deleteNode),
+		})
+		removeStaticPods = g.Add(flow.Task{
+			Name: "Removing static pods",
+			Fn: func(_ context.Context) error {
+				return b.RemoveStaticPods()
+			},
+			Dependencies: flow.NewTaskIDs(stopUnits),
+		})
+		removeOperatingSystemConfigFiles = g.Add(flow.Task{
+			Name: "Removing files and units placed from OperatingSystemConfig",
+			Fn: func(ctx context.Context) error {
+				return b.RemoveOperatingSystemConfigFiles(ctx, osc)
+			},
+			Dependencies: flow.NewTaskIDs(stopUnits, readOperatingSystemConfig),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Removing containerd, kubelet and gardener-node-agent state",
+			Fn: func(_ context.Context) error {
+				return b.RemoveNodeState()
+			},
+			// The last-applied OperatingSystemConfig is stored in the gardener-node-agent's directory.
+			Dependencies: flow.NewTaskIDs(removeStaticPods, removeOperatingSystemConfigFiles),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log:              opts.Log,
+		ProgressReporter: reporter,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprintf(opts.Out, `
+Your node has been reset successfully!
+`)
+
+	if node == nil && !opts.SkipDeregistration {
+		fmt.Fprintf(opts.Out, `
+The node could not be deregistered from the cluster. If it was registered, delete
+the Node object for host %q manually by running the following command on any
+control plane node:
+
+  kubectl delete node <node-name>
+`, b.HostName)
+	}
+
+	fmt.Fprintf(opts.Out, `
+Network configuration (e.g., iptables rules or CNI interfaces) is not cleaned up.
+Reboot the machine or clean it up manually before reusing it.
+`)
+
+	return nil
+}
+
+// fetchNode returns the Node object of this machine and a client set for the cluster. It returns nil if the node should
+// not or cannot be deregistered.
+func fetchNode(ctx context.Context, opts *Options, b *botanist.GardenadmBotanist) (*corev1.Node, kubernetes.Interface) {
+	if opts.SkipDeregistration {
+		return nil, nil
+	}
+
+	clientSet, err := CreateClientSet(ctx, b.Logger)
+	if err != nil {
+		b.Logger.Info("Cannot reach the cluster, skipping deregistration of node", "reason", err.Error())
+		return nil, nil
+	}
+
+	node, err := nodeagent.FetchNodeByHostName(ctx, clientSet.Client(), b.HostName)
+	if err != nil {
+		b.Logger.Info("Failed fetching node, skipping deregistration", "hostName", b.HostName, "reason", err.Error())
+		return nil, nil
+	}
+	if node == nil {
+		b.Logger.Info("No node registered for host name, skipping deregistration", "hostName", b.HostName)
+	}
+
+	return node, clientSet
+}
+
+// confirm asks the user whether the node should really be reset. Only "y" and "yes" are accepted as confirmation.
+func confirm(opts *Options) (bool, error) {
+	if opts.In == nil {
+		return false, fmt.Errorf("cannot ask for confirmation, use --force to reset the node without confirmation")
+	}
+
+	action := "drain and deregister this node from the cluster and remove"
+	if opts.SkipDeregistration {
+		action = "remove"
+	}
+	fmt.Fprintf(opts.Out, "This will %s all of its state (containerd, kubelet, gardener-node-agent, static pods, and files\nof the OperatingSystemConfig). The node state cannot be recovered.\nDo you want to continue? [y/N]: ", action)
+
+	answer, err := bufio.NewReader(opts.In).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed reading confirmation: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
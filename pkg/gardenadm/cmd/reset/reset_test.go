@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reset", func() {
+	var (
+		ctx = context.Background()
+
+		globalOpts *cmd.Options
+		stdIn      *Buffer
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fakeClient client.Client
+		fakeDBus   *fakedbus.DBus
+		fs         afero.Afero
+
+		node *corev1.Node
+		pod  *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, stdIn, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+		command.SetContext(ctx)
+		Expect(command.Flags().Set("force", "true")).To(Succeed())
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithIndex(&corev1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			Build()
+		fakeDBus = fakedbus.New()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		DeferCleanup(test.WithVars(
+			&NewGardenadmBotanist, func(log logr.Logger) (*botanist.GardenadmBotanist, error) {
+				return &botanist.GardenadmBotanist{
+					Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: log}},
+					HostName: "machine-0",
+					DBus:     fakeDBus,
+					FS:       fs,
+				}, nil
+			},
+			&CreateClientSet, func(context.Context, logr.Logger) (kubernetes.Interface, error) {
+				return fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(), nil
+			},
+		))
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-0", Labels: map[string]string{corev1.LabelHostname: "machine-0"}}}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}, Spec: corev1.PodSpec{NodeName: node.Name}}
+		Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+
+		Expect(fs.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+metadata:
+  name: osc
+spec:
+  files:
+  - path: /etc/foo
+`), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/foo", []byte("foo"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/var/lib/containerd/state", []byte("state"), 0600)).To(Succeed())
+	})
+
+	Describe("#RunE", func() {
+		It("should drain and deregister the node and tear down the machine", func() {
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(BeNotFoundError())
+
+			Expect(fakeDBus.Actions).To(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{"kubelet.service"}}))
+			Expect(fs.Exists("/etc/foo")).To(BeFalse())
+			Expect(fs.Exists("/var/lib/containerd")).To(BeFalse())
+			Expect(fs.Exists("/var/lib/gardener-node-agent")).To(BeFalse())
+
+			Eventually(stdOut).Should(Say("Your node has been reset successfully!"))
+			Consistently(stdOut).ShouldNot(Say("could not be deregistered"))
+		})
+
+		It("should not drain the node if requested", func() {
+			Expect(command.Flags().Set("skip-drain", "true")).To(Succeed())
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(BeNotFoundError())
+		})
+
+		It("should not deregister the node if requested", func() {
+			Expect(command.Flags().Set("skip-deregistration", "true")).To(Succeed())
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(fs.Exists("/var/lib/containerd")).To(BeFalse())
+		})
+
+		It("should ask for confirmation and reset the node if confirmed", func() {
+			Expect(command.Flags().Set("force", "false")).To(Succeed())
+			_, err := stdIn.Write([]byte("yes\n"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say(`Do you want to continue\? \[y/N\]`))
+			Eventually(stdOut).Should(Say("Your node has been reset successfully!"))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(BeNotFoundError())
+			Expect(fs.Exists("/var/lib/containerd")).To(BeFalse())
+		})
+
+		It("should not reset the node if the confirmation was denied", func() {
+			Expect(command.Flags().Set("force", "false")).To(Succeed())
+			_, err := stdIn.Write([]byte("n\n"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say("Aborted, the node was not reset."))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(fs.Exists("/var/lib/containerd/state")).To(BeTrue())
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should not reset the node if no confirmation was given", func() {
+			Expect(command.Flags().Set("force", "false")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say("Aborted, the node was not reset."))
+			Expect(fs.Exists("/var/lib/containerd/state")).To(BeTrue())
+		})
+
+		It("should tear down the machine even if the cluster cannot be reached", func() {
+			DeferCleanup(test.WithVar(&CreateClientSet, func(context.Context, logr.Logger) (kubernetes.Interface, error) {
+				return nil, errors.New("fake")
+			}))
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(fs.Exists("/var/lib/containerd")).To(BeFalse())
+			Eventually(stdOut).Should(Say(`The node could not be deregistered from the cluster`))
+		})
+	})
+})
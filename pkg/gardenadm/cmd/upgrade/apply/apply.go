@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
+	gardenadmbotanist "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	upgradeutils "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Upgrade the self-hosted shoot cluster to the Kubernetes version in the Shoot manifest",
+		Long: `Upgrade the self-hosted shoot cluster to the Kubernetes version in the Shoot manifest.
+
+The upgrade is refused if 'gardenadm upgrade plan' reports blocking issues. Otherwise, gardener-resource-manager, the
+extensions and the control plane components are redeployed with the versions of this gardenadm binary and the target
+Kubernetes version. The updated OperatingSystemConfigs are rolled out node by node, starting with the control plane
+nodes: gardener-node-agent applies the changes on one node at a time, and the next node is only updated once the node
+and its static pods are healthy again. Nodes of worker pools with in-place update strategy are drained and marked as
+ready for the in-place update before.
+
+Control planes which were initialized with 'gardenadm init --use-bootstrap-etcd' keep using the bootstrap etcd, i.e.,
+they are not transitioned to etcd-druid by the upgrade.`,
+
+		Example: `# Upgrade the cluster using the config directory stored by 'gardenadm init'
+gardenadm upgrade apply
+
+# Upgrade the cluster using the manifests in the given config directory
+gardenadm upgrade apply --config-dir /path/to/manifests`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	clientSet, err := upgradeutils.CreateClientSet(ctx, opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating client set: %w", err)
+	}
+
+	b, err := gardenadmbotanist.NewGardenadmBotanistFromManifests(ctx, opts.Log, clientSet, opts.ConfigDir, true)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	currentVersion, err := b.DiscoverKubernetesVersion(clientSet)
+	if err != nil {
+		return fmt.Errorf("failed discovering Kubernetes version of the control plane: %w", err)
+	}
+
+	plan, err := b.ComputeUpgradePlan(ctx, clientSet.Client(), currentVersion)
+	if err != nil {
+		return fmt.Errorf("failed computing upgrade plan: %w", err)
+	}
+
+	if err := upgradeutils.PrintPlan(opts.Out, plan); err != nil {
+		return err
+	}
+
+	if plan.Blocked() {
+		return fmt.Errorf("upgrade is blocked, resolve the issues reported above and try again")
+	}
+
+	// If the self-hosted shoot is also the garden runtime cluster, then gardener-operator is taking over
+	// responsibility of the runtime gardener-resource-manager.
+	shootIsGarden, err := gardenletutils.ClusterIsGarden(ctx, clientSet.Client())
+	if err != nil {
+		return fmt.Errorf("failed checking whether shoot is garden: %w", err)
+	}
+
+	// The control plane keeps the etcd it currently uses, i.e., clusters initialized with '--use-bootstrap-etcd' are not
+	// transitioned to etcd-druid by an upgrade.
+	useBootstrapEtcd, err := b.UsesBootstrapEtcd(ctx, clientSet.Client())
+	if err != nil {
+		return fmt.Errorf("failed checking whether the control plane uses the bootstrap etcd: %w", err)
+	}
+
+	var (
+		g                = flow.NewGraph("upgrade")
+		reporter         = flow.NewCommandLineProgressReporter(opts.ErrOut)
+		kubeProxyEnabled = v1beta1helper.KubeProxyEnabled(b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy)
+
+		reconcileClusterResource = g.Add(flow.Task{
+			Name: "Reconciling extensions.gardener.cloud/v1alpha1.Cluster resource",
+			Fn: func(ctx context.Context) error {
+				return gardenerextensions.SyncClusterResourceToSeed(ctx, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.Shoot.GetInfo(), b.Shoot.CloudProfile, nil)
+			},
+		})
+		initializeSecretsManagement = g.Add(flow.Task{
+			Name:         "Initializing internal state of Gardener secrets manager",
+			Fn:           b.InitializeSecretsManagement,
+			Dependencies: flow.NewTaskIDs(reconcileClusterResource),
+		})
+		deployGardenerResourceManager = g.Add(flow.Task{
+			Name: "Deploying gardener-resource-manager",
+			Fn: func(ctx context.Context) error {
+				if shootIsGarden {
+					return b.Shoot.Components.ControlPlane.ResourceManager.Deploy(ctx)
+				}
+
+				return flow.Sequential(
+					b.Shoot.Components.ControlPlane.RuntimeResourceManager.Deploy,
+					b.Shoot.Components.ControlPlane.ResourceManager.Deploy,
+				)(ctx)
+			},
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
+		})
+		waitUntilGardenerResourceManagerReady = g.Add(flow.Task{
+			Name: "Waiting until gardener-resource-manager reports readiness",
+			Fn: func(ctx context.Context) error {
+				if shootIsGarden {
+					return b.Shoot.Components.ControlPlane.ResourceManager.Wait(ctx)
+				}
+
+				return flow.Parallel(
+					b.Shoot.Components.ControlPlane.RuntimeResourceManager.Wait,
+					b.Shoot.Components.ControlPlane.ResourceManager.Wait,
+				)(ctx)
+			},
+			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
+		})
+		deployExtensionControllers = g.Add(flow.Task{
+			Name: "Deploying extension controllers",
+			Fn: func(ctx context.Context) error {
+				return b.ReconcileExtensionControllerInstallations(ctx, false)
+			},
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
+		})
+		waitUntilExtensionControllersReady = g.Add(flow.Task{
+			Name:         "Waiting until extension controllers report readiness",
+			Fn:           b.WaitUntilExtensionControllerInstallationsHealthy,
+			Dependencies: flow.NewTaskIDs(deployExtensionControllers),
+		})
+		deployControlPlane = g.Add(flow.Task{
+			Name:         "Deploying shoot control plane components",
+			Fn:           b.DeployControlPlane,
+			Dependencies: flow.NewTaskIDs(waitUntilExtensionControllersReady),
+		})
+		waitUntilControlPlaneReady = g.Add(flow.Task{
+			Name:         "Waiting until shoot control plane has been reconciled",
+			Fn:           b.Shoot.Components.Extensions.ControlPlane.Wait,
+			Dependencies: flow.NewTaskIDs(deployControlPlane),
+		})
+		enableSerialOperatingSystemConfigReconciliation = g.Add(flow.Task{
+			Name: "Enabling serial reconciliation of OperatingSystemConfigs by gardener-node-agent",
+			Fn: func(ctx context.Context) error {
+				return b.SetSerialOperatingSystemConfigReconciliation(ctx, clientSet.Client(), true)
+			},
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
+		})
+		deployControlPlaneDeployments = g.Add(flow.Task{
+			Name: "Deploying control plane components as Deployments/StatefulSets and updating gardener-node-agent Secret",
+			Fn: func(ctx context.Context) error {
+				return b.DeployStaticControlPlaneDeployments(ctx, useBootstrapEtcd)
+			},
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneReady, enableSerialOperatingSystemConfigReconciliation),
+		})
+		upgradeNodes = g.Add(flow.Task{
+			Name: "Upgrading nodes one by one",
+			Fn: func(ctx context.Context) error {
+				return b.UpgradeNodes(ctx, clientSet.Client(), opts.DrainTimeout, opts.NodeTimeout)
+			},
+			Dependencies: flow.NewTaskIDs(deployControlPlaneDeployments),
+		})
+		waitUntilOperatingSystemConfigUpdated = g.Add(flow.Task{
+			Name: "Waiting until all nodes have applied the updated OperatingSystemConfigs",
+			Fn: func(ctx context.Context) error {
+				return b.WaitUntilOperatingSystemConfigUpdatedForAllWorkerPools(ctx, true)
+			},
+			Dependencies: flow.NewTaskIDs(upgradeNodes),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Disabling serial reconciliation of OperatingSystemConfigs by gardener-node-agent",
+			Fn: func(ctx context.Context) error {
+				return b.SetSerialOperatingSystemConfigReconciliation(ctx, clientSet.Client(), false)
+			},
+			Dependencies: flow.NewTaskIDs(waitUntilOperatingSystemConfigUpdated),
+		})
+		waitUntilKubeControllerManagerIsActive = g.Add(flow.Task{
+			Name: "Waiting until kube-controller-manager is active",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				b.Shoot.Components.ControlPlane.KubeControllerManager.SetShootClient(b.SeedClientSet.Client())
+				return b.Shoot.Components.ControlPlane.KubeControllerManager.WaitForControllerToBeActive(ctx)
+			}).RetryUntilTimeout(time.Second, 5*time.Minute),
+			Dependencies: flow.NewTaskIDs(waitUntilOperatingSystemConfigUpdated),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying kube-proxy system component",
+			Fn:           b.DeployKubeProxy,
+			SkipIf:       !kubeProxyEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilKubeControllerManagerIsActive),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying CoreDNS system component",
+			Fn:           b.DeployCoreDNS,
+			Dependencies: flow.NewTaskIDs(waitUntilKubeControllerManagerIsActive),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying shoot system resources",
+			Fn:           b.DeployShootSystem,
+			Dependencies: flow.NewTaskIDs(waitUntilKubeControllerManagerIsActive),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log:              opts.Log,
+		ProgressReporter: reporter,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprintf(opts.Out, `
+Your Shoot cluster has been upgraded successfully to Kubernetes version %s!
+`, plan.TargetVersion)
+
+	return nil
+}
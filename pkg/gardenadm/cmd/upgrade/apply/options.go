@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	cmd.ManifestOptions
+
+	// DrainTimeout is the maximum duration for draining a node before it is updated in-place.
+	DrainTimeout time.Duration
+	// NodeTimeout is the maximum duration for upgrading a single node until it is healthy again.
+	NodeTimeout time.Duration
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error {
+	return o.ManifestOptions.ParseArgs(args)
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if err := o.DefaultConfigDir(); err != nil {
+		return err
+	}
+
+	if o.DrainTimeout <= 0 {
+		return fmt.Errorf("drain timeout must be positive")
+	}
+
+	if o.NodeTimeout <= 0 {
+		return fmt.Errorf("node timeout must be positive")
+	}
+
+	return o.ManifestOptions.Validate()
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error {
+	return o.ManifestOptions.Complete()
+}
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.ManifestOptions.AddFlags(fs)
+	fs.DurationVar(&o.DrainTimeout, "drain-timeout", 2*time.Minute, "Maximum duration for draining a node of a worker pool with in-place update strategy before it is updated")
+	fs.DurationVar(&o.NodeTimeout, "node-timeout", 10*time.Minute, "Maximum duration for upgrading a single node until it and its static pods are healthy again")
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/apply"
+)
+
+var _ = Describe("Options", func() {
+	var options *Options
+
+	BeforeEach(func() {
+		options = &Options{
+			Options:      &cmd.Options{},
+			DrainTimeout: time.Minute,
+			NodeTimeout:  time.Minute,
+		}
+		options.ConfigDir = "some-path-to-config-dir"
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed when proper values were provided", func() {
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when the drain timeout is not positive", func() {
+			options.DrainTimeout = 0
+			Expect(options.Validate()).To(MatchError(ContainSubstring("drain timeout must be positive")))
+		})
+
+		It("should fail when the node timeout is not positive", func() {
+			options.NodeTimeout = -time.Second
+			Expect(options.Validate()).To(MatchError(ContainSubstring("node timeout must be positive")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	cmd.ManifestOptions
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error {
+	return o.ManifestOptions.ParseArgs(args)
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if err := o.DefaultConfigDir(); err != nil {
+		return err
+	}
+
+	return o.ManifestOptions.Validate()
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error {
+	return o.ManifestOptions.Complete()
+}
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.ManifestOptions.AddFlags(fs)
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	upgradeutils "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the planned upgrade of the self-hosted shoot cluster and issues blocking it",
+		Long: `Show the planned upgrade of the self-hosted shoot cluster and issues blocking it.
+
+The current Kubernetes version of the control plane is compared to the version in the Shoot manifest in the config
+directory, which is validated against the CloudProfile. For the control plane components running as static pods, the
+current images are compared to the images from the image vector of this gardenadm binary.`,
+
+		Example: `# Show the planned upgrade using the config directory stored by 'gardenadm init'
+gardenadm upgrade plan
+
+# Show the planned upgrade using the manifests in the given config directory
+gardenadm upgrade plan --config-dir /path/to/manifests`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	resources, err := gardenadm.ReadManifests(opts.Log, botanist.DirFS(opts.ConfigDir))
+	if err != nil {
+		return fmt.Errorf("failed reading Kubernetes resources from config directory %s: %w", opts.ConfigDir, err)
+	}
+
+	clientSet, err := upgradeutils.CreateClientSet(ctx, opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating client set: %w", err)
+	}
+
+	b := &botanist.GardenadmBotanist{
+		Botanist:  &botanistpkg.Botanist{Operation: &operation.Operation{Logger: opts.Log}},
+		Resources: resources,
+	}
+
+	currentVersion, err := b.DiscoverKubernetesVersion(clientSet)
+	if err != nil {
+		return fmt.Errorf("failed discovering Kubernetes version of the control plane: %w", err)
+	}
+
+	plan, err := b.ComputeUpgradePlan(ctx, clientSet.Client(), currentVersion)
+	if err != nil {
+		return fmt.Errorf("failed computing upgrade plan: %w", err)
+	}
+
+	return upgradeutils.PrintPlan(opts.Out, plan)
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan_test
+
+import (
+	"context"
+	"io/fs"
+	"testing/fstest"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/plan"
+	upgradeutils "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/utils"
+	"github.com/gardener/gardener/pkg/utils/test"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Plan", func() {
+	var (
+		ctx = context.Background()
+
+		globalOpts *cmd.Options
+		stdOut     *Buffer
+		command    *cobra.Command
+
+		fakeClient client.Client
+		clientSet  kubernetes.Interface
+		fsys       fstest.MapFS
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{Log: logr.Discard()}
+		globalOpts.IOStreams, _, stdOut, _ = clitest.NewTestIOStreams()
+		command = NewCommand(globalOpts)
+		Expect(command.Flags().Set("config-dir", "manifests")).To(Succeed())
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		clientSet = fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).WithVersion("1.33.2").Build()
+
+		fsys = fstest.MapFS{
+			"manifests/cloudprofile.yaml": &fstest.MapFile{Data: []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: CloudProfile
+metadata:
+  name: local
+spec:
+  type: local
+  kubernetes:
+    versions:
+    - version: 1.33.2
+    - version: 1.34.0
+`)},
+			"manifests/project.yaml": &fstest.MapFile{Data: []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: Project
+metadata:
+  name: local
+spec:
+  namespace: garden-local
+`)},
+			"manifests/shoot.yaml": &fstest.MapFile{Data: []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: local
+  namespace: garden-local
+spec:
+  cloudProfile:
+    name: local
+  kubernetes:
+    version: 1.34.0
+  provider:
+    type: local
+    workers:
+    - name: control-plane
+      controlPlane: {}
+`)},
+		}
+
+		DeferCleanup(test.WithVars(
+			&botanist.DirFS, func(dir string) fs.FS {
+				sub, err := fs.Sub(fsys, dir)
+				Expect(err).NotTo(HaveOccurred())
+				return sub
+			},
+			&upgradeutils.CreateClientSet, func(context.Context, logr.Logger) (kubernetes.Interface, error) { return clientSet, nil },
+		))
+	})
+
+	Describe("#RunE", func() {
+		It("should print the upgrade plan", func() {
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say(`Current Kubernetes version: 1.33.2
+Target Kubernetes version:  1.34.0
+Newer Kubernetes versions offered by the CloudProfile: 1.34.0
+`))
+			Eventually(stdOut).Should(Say("No blocking issues found. Run 'gardenadm upgrade apply' to upgrade the cluster."))
+		})
+
+		It("should print the issues blocking the upgrade", func() {
+			Expect(fakeClient.Create(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "gardenlet", Namespace: "kube-system"}})).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(Say("The upgrade is blocked by the following issues:"))
+			Eventually(stdOut).Should(Say("the cluster is connected to Gardener"))
+		})
+
+		It("should fail if the config directory does not exist", func() {
+			Expect(command.Flags().Set("config-dir", "does-not-exist")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("failed reading Kubernetes resources from config directory does-not-exist")))
+		})
+	})
+})
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/apply"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/plan"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the Kubernetes version and the Gardener components of a self-hosted shoot cluster",
+		Long: `Upgrade the Kubernetes version and the Gardener components of a self-hosted shoot cluster created with 'gardenadm init'.
+
+To upgrade the Kubernetes version, update the '.spec.kubernetes.version' field in the Shoot manifest in the config
+directory. Then, run 'gardenadm upgrade plan' to check the upgrade and 'gardenadm upgrade apply' to perform it.`,
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	cmd.AddCommand(plan.NewCommand(globalOpts))
+	cmd.AddCommand(apply.NewCommand(globalOpts))
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+)
+
+// PrintPlan prints the given upgrade plan in a human-readable format.
+func PrintPlan(w io.Writer, plan *botanist.UpgradePlan) error {
+	fmt.Fprintf(w, "Current Kubernetes version: %s\n", plan.CurrentVersion)
+	fmt.Fprintf(w, "Target Kubernetes version:  %s\n", plan.TargetVersion)
+
+	availableVersions := "none"
+	if len(plan.AvailableVersions) > 0 {
+		availableVersions = strings.Join(plan.AvailableVersions, ", ")
+	}
+	fmt.Fprintf(w, "Newer Kubernetes versions offered by the CloudProfile: %s\n\n", availableVersions)
+
+	if len(plan.Components) > 0 {
+		table := &metav1.Table{
+			ColumnDefinitions: []metav1.TableColumnDefinition{
+				{Name: "COMPONENT", Type: "string", Description: "Name of the control plane component"},
+				{Name: "NODE", Type: "string", Description: "Name of the node the static pod is running on"},
+				{Name: "CURRENT IMAGE", Type: "string", Description: "Image the static pod is currently running with"},
+				{Name: "TARGET IMAGE", Type: "string", Description: "Image from the image vector for the target version"},
+			},
+			Rows: make([]metav1.TableRow, 0, len(plan.Components)),
+		}
+
+		for _, component := range plan.Components {
+			table.Rows = append(table.Rows, metav1.TableRow{Cells: []any{component.Name, component.NodeName, component.CurrentImage, component.TargetImage}})
+		}
+
+		if err := printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, w); err != nil {
+			return err
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintln(w, "Note that etcd is not upgraded by 'gardenadm upgrade apply'.")
+	fmt.Fprintln(w)
+
+	if plan.Blocked() {
+		fmt.Fprintln(w, "The upgrade is blocked by the following issues:")
+		for _, issue := range plan.BlockingIssues {
+			fmt.Fprintf(w, "  - %s\n", issue)
+		}
+		return nil
+	}
+
+	if plan.UpToDate() {
+		fmt.Fprintln(w, "The Kubernetes version and the images of the control plane components are up to date.")
+		return nil
+	}
+
+	fmt.Fprintln(w, "No blocking issues found. Run 'gardenadm upgrade apply' to upgrade the cluster.")
+	return nil
+}
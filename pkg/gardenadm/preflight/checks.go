@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/nodeagent/containerd"
+)
+
+var (
+	// Listen is used for checking whether ports are free.
+	// Exposed for testing.
+	Listen = net.Listen
+	// ContainerdVersion returns the version of the containerd daemon.
+	// Exposed for testing.
+	ContainerdVersion = func(ctx context.Context) (string, error) {
+		client, err := containerd.NewClient()
+		if err != nil {
+			return "", err
+		}
+		defer client.Close()
+
+		version, err := client.Version(ctx)
+		if err != nil {
+			return "", err
+		}
+		return version.Version, nil
+	}
+	// FreeDiskSpace returns the number of bytes available to unprivileged users on the file system containing the
+	// given path.
+	// Exposed for testing.
+	FreeDiskSpace = freeDiskSpace
+)
+
+// KernelModulesCheck checks whether the given kernel modules are loaded or built into the kernel. Missing modules are
+// reported as warnings since they might be loaded on demand.
+type KernelModulesCheck struct {
+	FS      afero.Afero
+	Modules []string
+}
+
+// Name implements Checker.
+func (c *KernelModulesCheck) Name() string { return "KernelModules" }
+
+// Check implements Checker.
+func (c *KernelModulesCheck) Check(_ context.Context) (warnings, errs []error) {
+	for _, module := range c.Modules {
+		exists, err := c.FS.DirExists(path.Join("/sys/module", module))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed checking kernel module %s: %w", module, err))
+			continue
+		}
+		if !exists {
+			warnings = append(warnings, fmt.Errorf("kernel module %s is not loaded", module))
+		}
+	}
+	return
+}
+
+// SysctlsCheck checks whether the kernel supports the given sysctls. Their values are configured by
+// gardener-node-agent.
+type SysctlsCheck struct {
+	FS      afero.Afero
+	Sysctls []string
+}
+
+// Name implements Checker.
+func (c *SysctlsCheck) Name() string { return "Sysctls" }
+
+// Check implements Checker.
+func (c *SysctlsCheck) Check(_ context.Context) (warnings, errs []error) {
+	for _, sysctl := range c.Sysctls {
+		exists, err := c.FS.Exists(path.Join("/proc/sys", strings.ReplaceAll(sysctl, ".", "/")))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed checking sysctl %s: %w", sysctl, err))
+			continue
+		}
+		if !exists {
+			errs = append(errs, fmt.Errorf("sysctl %s is not supported by the kernel", sysctl))
+		}
+	}
+	return
+}
+
+// PortsCheck checks whether the given TCP ports are free.
+type PortsCheck struct {
+	// Ports maps the ports to the names of the components using them.
+	Ports map[int32]string
+}
+
+// Name implements Checker.
+func (c *PortsCheck) Name() string { return "Ports" }
+
+// Check implements Checker.
+func (c *PortsCheck) Check(_ context.Context) (warnings, errs []error) {
+	for _, port := range slices.Sorted(maps.Keys(c.Ports)) {
+		listener, err := Listen("tcp", ":"+strconv.Itoa(int(port)))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("port %d required by %s is in use", port, c.Ports[port]))
+			continue
+		}
+		_ = listener.Close()
+	}
+	return
+}
+
+// ContainerRuntimeCheck checks whether containerd is reachable.
+type ContainerRuntimeCheck struct{}
+
+// Name implements Checker.
+func (c *ContainerRuntimeCheck) Name() string { return "ContainerRuntime" }
+
+// Check implements Checker.
+func (c *ContainerRuntimeCheck) Check(ctx context.Context) (warnings, errs []error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	if _, err := ContainerdVersion(timeoutCtx); err != nil {
+		errs = append(errs, fmt.Errorf("containerd is not reachable: %w", err))
+	}
+	return
+}
+
+// DiskSpaceCheck checks whether enough disk space is available on the file system containing the given path.
+type DiskSpaceCheck struct {
+	Path string
+	// Minimum is the number of bytes below which an error is reported.
+	Minimum uint64
+	// Recommended is the number of bytes below which a warning is reported.
+	Recommended uint64
+}
+
+// Name implements Checker.
+func (c *DiskSpaceCheck) Name() string { return "DiskSpace" }
+
+// Check implements Checker.
+func (c *DiskSpaceCheck) Check(_ context.Context) (warnings, errs []error) {
+	free, err := FreeDiskSpace(c.Path)
+	if err != nil {
+		warnings = append(warnings, fmt.Errorf("cannot determine free disk space of %s: %w", c.Path, err))
+		return
+	}
+
+	switch {
+	case free < c.Minimum:
+		errs = append(errs, fmt.Errorf("only %s are available on the file system of %s, at least %s are required", formatBytes(free), c.Path, formatBytes(c.Minimum)))
+	case free < c.Recommended:
+		warnings = append(warnings, fmt.Errorf("only %s are available on the file system of %s, at least %s are recommended", formatBytes(free), c.Path, formatBytes(c.Recommended)))
+	}
+	return
+}
+
+// HostnameCheck checks whether the hostname of the machine is a valid node name.
+type HostnameCheck struct{}
+
+// Name implements Checker.
+func (c *HostnameCheck) Name() string { return "Hostname" }
+
+// Check implements Checker.
+func (c *HostnameCheck) Check(_ context.Context) (warnings, errs []error) {
+	hostName, err := nodeagent.Hostname()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed fetching hostname: %w", err))
+		return
+	}
+
+	nodeName, err := nodeagent.GetHostName()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed fetching hostname: %w", err))
+		return
+	}
+
+	for _, msg := range validation.IsDNS1123Subdomain(nodeName) {
+		errs = append(errs, fmt.Errorf("hostname %q is not a valid node name: %s", nodeName, msg))
+	}
+	if hostName != nodeName {
+		warnings = append(warnings, fmt.Errorf("hostname %q contains uppercase characters, the node will be registered as %q", hostName, nodeName))
+	}
+	return
+}
+
+// ExistingStateCheck checks whether the machine was already set up, i.e., whether any of the given paths exist.
+type ExistingStateCheck struct {
+	FS    afero.Afero
+	Paths []string
+	// Resumable indicates that existing state is expected when a previous run is resumed. In this case, existing state
+	// is only reported as a warning.
+	Resumable bool
+}
+
+// Name implements Checker.
+func (c *ExistingStateCheck) Name() string { return "ExistingState" }
+
+// Check implements Checker.
+func (c *ExistingStateCheck) Check(_ context.Context) (warnings, errs []error) {
+	for _, p := range c.Paths {
+		exists, err := c.FS.Exists(p)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed checking whether %s exists: %w", p, err))
+			continue
+		}
+		if !exists {
+			continue
+		}
+
+		if c.Resumable {
+			warnings = append(warnings, fmt.Errorf("%s exists, resuming previous run", p))
+		} else {
+			errs = append(errs, fmt.Errorf("%s exists, the machine was already set up (run 'gardenadm reset' first)", p))
+		}
+	}
+	return
+}
+
+func formatBytes(bytes uint64) string {
+	return fmt.Sprintf("%.1fGiB", float64(bytes)/(1<<30))
+}
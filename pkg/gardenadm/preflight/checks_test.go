@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/preflight"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Checks", func() {
+	var (
+		ctx = context.Background()
+		fs  afero.Afero
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+	})
+
+	Describe("KernelModulesCheck", func() {
+		It("should warn about missing kernel modules", func() {
+			Expect(fs.MkdirAll("/sys/module/overlay", 0755)).To(Succeed())
+
+			warnings, errs := (&KernelModulesCheck{FS: fs, Modules: []string{"overlay", "br_netfilter"}}).Check(ctx)
+			Expect(warnings).To(ConsistOf(MatchError("kernel module br_netfilter is not loaded")))
+			Expect(errs).To(BeEmpty())
+		})
+	})
+
+	Describe("SysctlsCheck", func() {
+		It("should report sysctls not supported by the kernel", func() {
+			Expect(fs.WriteFile("/proc/sys/net/ipv4/conf/all/forwarding", []byte("1"), 0644)).To(Succeed())
+
+			warnings, errs := (&SysctlsCheck{FS: fs, Sysctls: []string{"net.ipv4.conf.all.forwarding", "fs.inotify.max_user_watches"}}).Check(ctx)
+			Expect(warnings).To(BeEmpty())
+			Expect(errs).To(ConsistOf(MatchError("sysctl fs.inotify.max_user_watches is not supported by the kernel")))
+		})
+	})
+
+	Describe("PortsCheck", func() {
+		It("should report ports in use", func() {
+			DeferCleanup(test.WithVar(&Listen, func(_, address string) (net.Listener, error) {
+				if address == ":443" {
+					return nil, fmt.Errorf("address already in use")
+				}
+				return &fakeListener{}, nil
+			}))
+
+			warnings, errs := (&PortsCheck{Ports: map[int32]string{443: "kube-apiserver", 10250: "kubelet"}}).Check(ctx)
+			Expect(warnings).To(BeEmpty())
+			Expect(errs).To(ConsistOf(MatchError("port 443 required by kube-apiserver is in use")))
+		})
+	})
+
+	Describe("ContainerRuntimeCheck", func() {
+		It("should succeed if containerd is reachable", func() {
+			DeferCleanup(test.WithVar(&ContainerdVersion, func(context.Context) (string, error) { return "v2.0.0", nil }))
+
+			warnings, errs := (&ContainerRuntimeCheck{}).Check(ctx)
+			Expect(warnings).To(BeEmpty())
+			Expect(errs).To(BeEmpty())
+		})
+
+		It("should report if containerd is not reachable", func() {
+			DeferCleanup(test.WithVar(&ContainerdVersion, func(context.Context) (string, error) { return "", fmt.Errorf("connection refused") }))
+
+			_, errs := (&ContainerRuntimeCheck{}).Check(ctx)
+			Expect(errs).To(ConsistOf(MatchError("containerd is not reachable: connection refused")))
+		})
+	})
+
+	Describe("DiskSpaceCheck", func() {
+		var (
+			free  uint64
+			check *DiskSpaceCheck
+		)
+
+		BeforeEach(func() {
+			DeferCleanup(test.WithVar(&FreeDiskSpace, func(path string) (uint64, error) {
+				Expect(path).To(Equal("/var/lib"))
+				return free, nil
+			}))
+			check = &DiskSpaceCheck{Path: "/var/lib", Minimum: 2 << 30, Recommended: 10 << 30}
+		})
+
+		It("should pass if enough disk space is available", func() {
+			free = 20 << 30
+
+			warnings, errs := check.Check(ctx)
+			Expect(warnings).To(BeEmpty())
+			Expect(errs).To(BeEmpty())
+		})
+
+		It("should warn if less than the recommended disk space is available", func() {
+			free = 5 << 30
+
+			warnings, errs := check.Check(ctx)
+			Expect(warnings).To(ConsistOf(MatchError("only 5.0GiB are available on the file system of /var/lib, at least 10.0GiB are recommended")))
+			Expect(errs).To(BeEmpty())
+		})
+
+		It("should fail if less than the minimum disk space is available", func() {
+			free = 1 << 30
+
+			warnings, errs := check.Check(ctx)
+			Expect(warnings).To(BeEmpty())
+			Expect(errs).To(ConsistOf(MatchError("only 1.0GiB are available on the file system of /var/lib, at least 2.0GiB are required")))
+		})
+	})
+
+	Describe("HostnameCheck", func() {
+		It("should pass for a valid hostname", func() {
+			DeferCleanup(test.WithVar(&nodeagent.Hostname, func() (string, error) { return "machine-0", nil }))
+
+			warnings, errs := (&HostnameCheck{}).Check(ctx)
+			Expect(warnings).To(BeEmpty())
+			Expect(errs).To(BeEmpty())
+		})
+
+		It("should warn if the hostname contains uppercase characters", func() {
+			DeferCleanup(test.WithVar(&nodeagent.Hostname, func() (string, error) { return "Machine-0", nil }))
+
+			warnings, errs := (&HostnameCheck{}).Check(ctx)
+			Expect(warnings).To(ConsistOf(MatchError(`hostname "Machine-0" contains uppercase characters, the node will be registered as "machine-0"`)))
+			Expect(errs).To(BeEmpty())
+		})
+
+		It("should fail if the hostname is not a valid node name", func() {
+			DeferCleanup(test.WithVar(&nodeagent.Hostname, func() (string, error) { return "machine_0", nil }))
+
+			_, errs := (&HostnameCheck{}).Check(ctx)
+			Expect(errs).To(ConsistOf(MatchError(ContainSubstring(`hostname "machine_0" is not a valid node name`))))
+		})
+	})
+
+	Describe("ExistingStateCheck", func() {
+		BeforeEach(func() {
+			Expect(fs.WriteFile("/etc/kubernetes/admin.conf", nil, 0600)).To(Succeed())
+		})
+
+		It("should fail if the machine was already set up", func() {
+			warnings, errs := (&ExistingStateCheck{FS: fs, Paths: []string{"/etc/kubernetes/admin.conf", "/var/lib/kubelet/kubeconfig-real"}}).Check(ctx)
+			Expect(warnings).To(BeEmpty())
+			Expect(errs).To(ConsistOf(MatchError(ContainSubstring("/etc/kubernetes/admin.conf exists, the machine was already set up"))))
+		})
+
+		It("should only warn if the previous run is resumed", func() {
+			warnings, errs := (&ExistingStateCheck{FS: fs, Paths: []string{"/etc/kubernetes/admin.conf"}, Resumable: true}).Check(ctx)
+			Expect(warnings).To(ConsistOf(MatchError("/etc/kubernetes/admin.conf exists, resuming previous run")))
+			Expect(errs).To(BeEmpty())
+		})
+	})
+
+	Describe("#InitChecks", func() {
+		It("should check the control plane ports on a fresh machine", func() {
+			checks := InitChecks(fs, false)
+			Expect(checks).To(ContainElement(&PortsCheck{Ports: map[int32]string{443: "kube-apiserver", 2379: "etcd-main", 2380: "etcd-main", 2382: "etcd-events", 2383: "etcd-events", 10250: "kubelet"}}))
+			Expect(checks).To(ContainElement(HaveField("Resumable", false)))
+		})
+
+		It("should not check the ports when resuming a previous run", func() {
+			checks := InitChecks(fs, true)
+			Expect(checks).NotTo(ContainElement(BeAssignableToTypeOf(&PortsCheck{})))
+			Expect(checks).To(ContainElement(&ExistingStateCheck{FS: fs, Paths: []string{"/etc/kubernetes/admin.conf"}, Resumable: true}))
+		})
+	})
+
+	Describe("#JoinChecks", func() {
+		It("should only check the kubelet port for worker nodes", func() {
+			Expect(JoinChecks(fs, false, false)).To(ContainElement(&PortsCheck{Ports: map[int32]string{10250: "kubelet"}}))
+		})
+
+		It("should check the control plane ports for control plane nodes", func() {
+			Expect(JoinChecks(fs, true, false)).To(ContainElement(HaveField("Ports", HaveKey(int32(443)))))
+		})
+
+		It("should not check the ports when the node has already joined", func() {
+			checks := JoinChecks(fs, true, true)
+			Expect(checks).NotTo(ContainElement(BeAssignableToTypeOf(&PortsCheck{})))
+			Expect(checks).To(ContainElement(HaveField("Resumable", true)))
+		})
+	})
+})
+
+type fakeListener struct{ net.Listener }
+
+func (f *fakeListener) Close() error { return nil }
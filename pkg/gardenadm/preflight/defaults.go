@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"github.com/spf13/afero"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	kubeletcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+)
+
+const kubeletPort int32 = 10250
+
+var (
+	// requiredKernelModules are the kernel modules needed by containerd and the pod network.
+	requiredKernelModules = []string{"overlay", "br_netfilter"}
+	// requiredSysctls are sysctls configured by gardener-node-agent which must be supported by the kernel.
+	requiredSysctls = []string{"net.ipv4.conf.all.forwarding", "fs.inotify.max_user_watches"}
+
+	controlPlanePorts = map[int32]string{
+		kubeapiserverconstants.Port:                 "kube-apiserver",
+		etcdconstants.PortEtcdClient:                "etcd-main",
+		etcdconstants.PortEtcdPeer:                  "etcd-main",
+		etcdconstants.StaticPodPortEtcdEventsClient: "etcd-events",
+		etcdconstants.StaticPodPortEtcdEventsPeer:   "etcd-events",
+		kubeletPort: "kubelet",
+	}
+	workerPorts = map[int32]string{
+		kubeletPort: "kubelet",
+	}
+
+	// nodeStatePaths are files which exist once a machine was set up with 'gardenadm init' or 'gardenadm join'.
+	nodeStatePaths = []string{
+		kubeletcomponent.PathKubeconfigReal,
+		nodeagentconfigv1alpha1.KubeconfigFilePath,
+		nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath,
+	}
+)
+
+const (
+	diskSpacePath        = "/var/lib"
+	diskSpaceMinimum     = 2 << 30
+	diskSpaceRecommended = 10 << 30
+)
+
+// InitChecks returns the preflight checks for 'gardenadm init'. If a previous run is resumed (i.e., the admin
+// kubeconfig exists), the existing state is expected, and the ports are not checked since they are already used by the
+// control plane components.
+func InitChecks(fs afero.Afero, resume bool) []Checker {
+	checks := commonChecks(fs)
+
+	if resume {
+		return append(checks, &ExistingStateCheck{FS: fs, Paths: []string{botanist.PathKubeconfig}, Resumable: true})
+	}
+
+	return append(checks,
+		&PortsCheck{Ports: controlPlanePorts},
+		&ExistingStateCheck{FS: fs, Paths: nodeStatePaths},
+	)
+}
+
+// JoinChecks returns the preflight checks for 'gardenadm join'. If the node has already joined the cluster in a
+// previous run, the existing state is expected, and the ports are not checked since they are already used by the node
+// components.
+func JoinChecks(fs afero.Afero, controlPlane, resume bool) []Checker {
+	checks := commonChecks(fs)
+
+	if resume {
+		return append(checks, &ExistingStateCheck{FS: fs, Paths: nodeStatePaths, Resumable: true})
+	}
+
+	ports := workerPorts
+	if controlPlane {
+		ports = controlPlanePorts
+	}
+
+	return append(checks,
+		&PortsCheck{Ports: ports},
+		&ExistingStateCheck{FS: fs, Paths: append([]string{botanist.PathKubeconfig}, nodeStatePaths...)},
+	)
+}
+
+func commonChecks(fs afero.Afero) []Checker {
+	return []Checker{
+		&HostnameCheck{},
+		&KernelModulesCheck{FS: fs, Modules: requiredKernelModules},
+		&SysctlsCheck{FS: fs, Sysctls: requiredSysctls},
+		&ContainerRuntimeCheck{},
+		&DiskSpaceCheck{Path: diskSpacePath, Minimum: diskSpaceMinimum, Recommended: diskSpaceRecommended},
+	}
+}
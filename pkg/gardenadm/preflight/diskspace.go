@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux && !darwin
+
+package preflight
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// freeDiskSpace is a fallback for operating systems which are not supported for running self-hosted shoot nodes.
+func freeDiskSpace(_ string) (uint64, error) {
+	return 0, fmt.Errorf("determining free disk space is not supported on %s", runtime.GOOS)
+}
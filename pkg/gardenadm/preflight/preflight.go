@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+// Checker is a preflight check which is run before `gardenadm init` or `gardenadm join` mutate the machine.
+type Checker interface {
+	// Name returns the name of the check. It is used for ignoring the errors of the check.
+	Name() string
+	// Check runs the check. Warnings are reported but don't fail the preflight checks, errors fail them unless they are
+	// ignored.
+	Check(ctx context.Context) (warnings, errs []error)
+}
+
+// Status is the status of a preflight check.
+type Status string
+
+const (
+	// StatusPassed indicates that the check neither reported warnings nor errors.
+	StatusPassed Status = "Passed"
+	// StatusWarning indicates that the check reported warnings but no errors.
+	StatusWarning Status = "Warning"
+	// StatusFailed indicates that the check reported errors.
+	StatusFailed Status = "Failed"
+	// StatusIgnored indicates that the check reported errors which are ignored.
+	StatusIgnored Status = "Ignored"
+)
+
+// IgnoreAll can be passed as ignored preflight error to ignore the errors of all checks.
+const IgnoreAll = "all"
+
+// Result is the result of a preflight check.
+type Result struct {
+	// Name is the name of the check.
+	Name string `json:"name"`
+	// Status is the status of the check.
+	Status Status `json:"status"`
+	// Warnings are the warnings reported by the check.
+	Warnings []string `json:"warnings,omitempty"`
+	// Errors are the errors reported by the check.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Results are the results of the preflight checks.
+type Results []Result
+
+// Run runs the given checks. The errors of the checks whose (case-insensitive) names are contained in ignoredErrors are
+// ignored. If ignoredErrors contains IgnoreAll, the errors of all checks are ignored.
+func Run(ctx context.Context, checks []Checker, ignoredErrors sets.Set[string]) Results {
+	results := make(Results, 0, len(checks))
+
+	for _, check := range checks {
+		warnings, errs := check.Check(ctx)
+
+		result := Result{Name: check.Name(), Status: StatusPassed}
+		for _, warning := range warnings {
+			result.Warnings = append(result.Warnings, warning.Error())
+		}
+		for _, err := range errs {
+			result.Errors = append(result.Errors, err.Error())
+		}
+
+		switch {
+		case len(errs) > 0 && (ignoredErrors.Has(IgnoreAll) || ignoredErrors.Has(strings.ToLower(check.Name()))):
+			result.Status = StatusIgnored
+		case len(errs) > 0:
+			result.Status = StatusFailed
+		case len(warnings) > 0:
+			result.Status = StatusWarning
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// Err returns an error if any of the checks failed.
+func (r Results) Err() error {
+	var failed []string
+	for _, result := range r {
+		if result.Status == StatusFailed {
+			failed = append(failed, result.Name)
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("preflight checks failed: %s (use --ignore-preflight-errors=%s to ignore them)", strings.Join(failed, ", "), strings.Join(failed, ","))
+}
+
+const (
+	// OutputFormatTable prints the results as a table.
+	OutputFormatTable = "table"
+	// OutputFormatJSON prints the results as JSON.
+	OutputFormatJSON = "json"
+)
+
+// Print prints the results to the given writer in the given output format.
+func (r Results) Print(w io.Writer, format string) error {
+	switch format {
+	case OutputFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(struct {
+			Checks Results `json:"checks"`
+		}{r})
+
+	case OutputFormatTable:
+		table := &metav1.Table{
+			ColumnDefinitions: []metav1.TableColumnDefinition{
+				{Name: "CHECK", Type: "string", Description: "Name of the preflight check"},
+				{Name: "STATUS", Type: "string", Description: "Status of the preflight check"},
+				{Name: "MESSAGE", Type: "string", Description: "Warnings and errors reported by the preflight check"},
+			},
+			Rows: make([]metav1.TableRow, 0, len(r)),
+		}
+
+		for _, result := range r {
+			messages := append(append([]string{}, result.Errors...), result.Warnings...)
+			if len(messages) == 0 {
+				messages = []string{""}
+			}
+			for _, message := range messages {
+				table.Rows = append(table.Rows, metav1.TableRow{Cells: []any{result.Name, string(result.Status), message}})
+			}
+		}
+
+		return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, w)
+
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/preflight"
+)
+
+type fakeCheck struct {
+	name     string
+	warnings []error
+	errs     []error
+}
+
+func (f *fakeCheck) Name() string { return f.name }
+
+func (f *fakeCheck) Check(_ context.Context) ([]error, []error) { return f.warnings, f.errs }
+
+var _ = Describe("Preflight", func() {
+	var (
+		ctx    = context.Background()
+		checks []Checker
+	)
+
+	BeforeEach(func() {
+		checks = []Checker{
+			&fakeCheck{name: "Passing"},
+			&fakeCheck{name: "Warning", warnings: []error{fmt.Errorf("warning")}},
+			&fakeCheck{name: "Failing", warnings: []error{fmt.Errorf("warning")}, errs: []error{fmt.Errorf("error 1"), fmt.Errorf("error 2")}},
+		}
+	})
+
+	Describe("#Run", func() {
+		It("should compute the status of the checks", func() {
+			Expect(Run(ctx, checks, nil)).To(Equal(Results{
+				{Name: "Passing", Status: StatusPassed},
+				{Name: "Warning", Status: StatusWarning, Warnings: []string{"warning"}},
+				{Name: "Failing", Status: StatusFailed, Warnings: []string{"warning"}, Errors: []string{"error 1", "error 2"}},
+			}))
+		})
+
+		It("should ignore the errors of the given checks case-insensitively", func() {
+			Expect(Run(ctx, checks, sets.New("failing"))).To(ContainElement(
+				Result{Name: "Failing", Status: StatusIgnored, Warnings: []string{"warning"}, Errors: []string{"error 1", "error 2"}},
+			))
+		})
+
+		It("should ignore the errors of all checks", func() {
+			Expect(Run(ctx, checks, sets.New(IgnoreAll))).To(ContainElement(HaveField("Status", StatusIgnored)))
+		})
+	})
+
+	Describe("#Err", func() {
+		It("should return nil if no check failed", func() {
+			Expect(Run(ctx, checks[:2], nil).Err()).To(Succeed())
+			Expect(Run(ctx, checks, sets.New(IgnoreAll)).Err()).To(Succeed())
+		})
+
+		It("should return an error listing the failed checks", func() {
+			checks = append(checks, &fakeCheck{name: "Other", errs: []error{fmt.Errorf("error")}})
+
+			Expect(Run(ctx, checks, nil).Err()).To(MatchError("preflight checks failed: Failing, Other (use --ignore-preflight-errors=Failing,Other to ignore them)"))
+		})
+	})
+
+	Describe("#Print", func() {
+		var buffer *gbytes.Buffer
+
+		BeforeEach(func() {
+			buffer = gbytes.NewBuffer()
+		})
+
+		It("should print the results as table", func() {
+			Expect(Run(ctx, checks, nil).Print(buffer, OutputFormatTable)).To(Succeed())
+
+			Expect(buffer).To(gbytes.Say(`CHECK\s+STATUS\s+MESSAGE\n`))
+			Expect(buffer).To(gbytes.Say(`Passing\s+Passed\s*\n`))
+			Expect(buffer).To(gbytes.Say(`Warning\s+Warning\s+warning\n`))
+			Expect(buffer).To(gbytes.Say(`Failing\s+Failed\s+error 1\n`))
+			Expect(buffer).To(gbytes.Say(`Failing\s+Failed\s+error 2\n`))
+			Expect(buffer).To(gbytes.Say(`Failing\s+Failed\s+warning\n`))
+		})
+
+		It("should print the results as JSON", func() {
+			Expect(Run(ctx, checks[:2], nil).Print(buffer, OutputFormatJSON)).To(Succeed())
+
+			Expect(string(buffer.Contents())).To(MatchJSON(`{"checks": [
+  {"name": "Passing", "status": "Passed"},
+  {"name": "Warning", "status": "Warning", "warnings": ["warning"]}
+]}`))
+		})
+
+		It("should fail for unsupported output formats", func() {
+			Expect(Run(ctx, checks, nil).Print(buffer, "yaml")).To(MatchError(`unsupported output format "yaml"`))
+		})
+	})
+})
@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/bastion"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/gardenlet"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/managedresourcerollout"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/managedseed"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/networkpolicy"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/seed"
@@ -36,6 +37,8 @@ import (
 	"github.com/gardener/gardener/pkg/healthz"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	managedresourcesbuilder "github.com/gardener/gardener/pkg/utils/managedresources/builder"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // AddToManager adds all gardenlet controllers to the given manager.
@@ -51,6 +54,7 @@ func AddToManager(
 	cfg *gardenletconfigv1alpha1.GardenletConfiguration,
 	healthManager healthz.Manager,
 	selfHostedShoot *gardencorev1beta1.Shoot,
+	helmChartCache oci.Cache,
 ) error {
 	identity, err := gardenerutils.DetermineIdentity()
 	if err != nil {
@@ -93,12 +97,13 @@ func AddToManager(
 		return fmt.Errorf("failed adding Bastion controller: %w", err)
 	}
 
-	if err := controllerinstallation.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, gardenClusterIdentity, seedIsSelfHostedShoot, selfHostedShoot, seedName(cfg), gardenNamespace()); err != nil {
+	if err := controllerinstallation.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, gardenClusterIdentity, seedIsSelfHostedShoot, selfHostedShoot, seedName(cfg), gardenNamespace(), helmChartCache); err != nil {
 		return fmt.Errorf("failed adding ControllerInstallation controller: %w", err)
 	}
 
 	if err := (&gardenlet.Reconciler{
-		Config: *cfg,
+		Config:       *cfg,
+		HelmRegistry: oci.NewHelmRegistry(gardenCluster.GetClient(), helmChartCache),
 	}).AddToManager(mgr, gardenCluster, seedClientSet); err != nil {
 		return fmt.Errorf("failed adding Gardenlet controller: %w", err)
 	}
@@ -111,6 +116,18 @@ func AddToManager(
 		return fmt.Errorf("failed adding ManagedSeed controller: %w", err)
 	}
 
+	if !gardenletutils.IsResponsibleForSelfHostedShoot() {
+		// The ManagedResources whose changes shall be rolled out progressively are labeled when they are deployed, so that
+		// their changes are held back from the beginning.
+		managedresourcesbuilder.RolloutGroups.Insert(cfg.Controllers.ManagedResourceRollout.ManagedResourceNames...)
+
+		if err := (&managedresourcerollout.Reconciler{
+			Config: *cfg.Controllers.ManagedResourceRollout,
+		}).AddToManager(mgr, seedCluster); err != nil {
+			return fmt.Errorf("failed adding ManagedResourceRollout controller: %w", err)
+		}
+	}
+
 	if err := networkpolicy.AddToManager(ctx, mgr, gardenletCancel, seedCluster, *cfg.Controllers.NetworkPolicy, networkConfigForNetworkPolicyController(cfg, selfHostedShoot), nil); err != nil {
 		return fmt.Errorf("failed adding NetworkPolicy controller: %w", err)
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/controllerinstallation"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/required"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // AddToManager adds all ControllerInstallation controllers to the given manager.
@@ -35,6 +36,7 @@ func AddToManager(
 	selfHostedShoot *gardencorev1beta1.Shoot,
 	seedName string,
 	gardenNamespace string,
+	helmChartCache oci.Cache,
 ) error {
 	if gardenletutils.IsResponsibleForSelfHostedShoot() || !seedIsSelfHostedShoot {
 		if err := (&care.Reconciler{
@@ -56,6 +58,7 @@ func AddToManager(
 			GardenClusterIdentity: gardenClusterIdentity,
 			GardenNamespace:       gardenNamespace,
 			SelfHostedShootMeta:   selfHostedShootMeta,
+			HelmRegistry:          oci.NewHelmRegistry(gardenCluster.GetClient(), helmChartCache),
 		}).AddToManager(ctx, mgr, gardenCluster); err != nil {
 			return fmt.Errorf("failed adding main reconciler: %w", err)
 		}
@@ -23,6 +23,7 @@ import (
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/utils/oci"
 )
@@ -42,11 +43,14 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, gard
 		r.Clock = clock.RealClock{}
 	}
 	if r.HelmRegistry == nil {
-		r.HelmRegistry = oci.NewHelmRegistry(r.GardenClient)
+		r.HelmRegistry = oci.NewHelmRegistry(r.GardenClient, nil)
 	}
 	if r.GardenNamespace == "" {
 		r.GardenNamespace = v1beta1constants.GardenNamespace
 	}
+	if r.ChartRenderer == nil {
+		r.ChartRenderer = chartrenderer.NewWithRenderCache(r.SeedClientSet.ChartRenderer(), chartrenderer.DefaultRenderCacheSize)
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
@@ -98,7 +102,7 @@ func (r *Reconciler) ControllerInstallationPredicate() predicate.Predicate {
 }
 
 // HelmTypePredicate is a predicate which checks whether the ControllerDeployment referenced in the
-// ControllerInstallation has .type=helm.
+// ControllerInstallation has .type=helm or .type=manifests.
 func (r *Reconciler) HelmTypePredicate(ctx context.Context, reader client.Reader) predicate.Predicate {
 	return &helmTypePredicate{
 		ctx:    ctx,
@@ -127,7 +131,7 @@ func (p *helmTypePredicate) isResponsible(obj client.Object) bool {
 		if err := p.reader.Get(p.ctx, client.ObjectKey{Name: deploymentName.Name}, controllerDeployment); err != nil {
 			return false
 		}
-		return controllerDeployment.Helm != nil
+		return controllerDeployment.Helm != nil || controllerDeployment.Manifests != nil
 	}
 
 	return false
@@ -164,7 +164,7 @@ var _ = Describe("Add", func() {
 				Expect(f(controllerInstallation)).To(BeFalse())
 			})
 
-			It("should return false if the deployment ref is not of type helm", func() {
+			It("should return false if the deployment ref is neither of type helm nor manifests", func() {
 				controllerDeployment.Helm = nil
 				Expect(fakeClient.Create(ctx, controllerDeployment)).To(Succeed())
 
@@ -176,6 +176,14 @@ var _ = Describe("Add", func() {
 
 				Expect(f(controllerInstallation)).To(BeTrue())
 			})
+
+			It("should return true if the deployment ref is of type manifests", func() {
+				controllerDeployment.Helm = nil
+				controllerDeployment.Manifests = &gardencorev1.ManifestsControllerDeployment{}
+				Expect(fakeClient.Create(ctx, controllerDeployment)).To(Succeed())
+
+				Expect(f(controllerInstallation)).To(BeTrue())
+			})
 		}
 
 		Describe("#Create", func() {
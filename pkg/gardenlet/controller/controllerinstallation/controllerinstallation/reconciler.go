@@ -7,8 +7,10 @@ package controllerinstallation
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -18,6 +20,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,12 +39,14 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	v1helper "github.com/gardener/gardener/pkg/api/core/v1/helper"
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/features"
@@ -84,6 +89,16 @@ type Reconciler struct {
 	// SelfHostedShootMeta holds the namespace and name of the self-hosted shoot. When set, this reconciler runs in the
 	// context of a self-hosted shoot (e.g., in gardenadm or in the shoot gardenlet).
 	SelfHostedShootMeta *types.NamespacedName
+
+	// ChartRenderer renders the charts and manifests of the ControllerDeployments. It caches the rendered charts so that
+	// unchanged charts are not rendered again in every reconciliation.
+	ChartRenderer chartrenderer.Interface
+
+	// appliedObjectChecksums contains the checksums of the objects of the last release applied per
+	// ControllerInstallation name (see chartrenderer.ObjectChecksums). They are used to log which objects changed when
+	// a new release is applied.
+	appliedObjectChecksums   map[string]map[string]string
+	appliedObjectChecksumsMu sync.Mutex
 }
 
 // Reconcile reconciles ControllerInstallations and deploys them into the seed cluster or the self-hosted shoot cluster.
@@ -186,14 +201,22 @@ func (r *Reconciler) reconcile(
 		if err := r.GardenClient.Get(gardenCtx, client.ObjectKey{Name: deploymentRef.Name}, controllerDeployment); err != nil {
 			return reconcile.Result{}, err
 		}
-		if controllerDeployment.Helm == nil {
+		if controllerDeployment.Helm == nil && controllerDeployment.Manifests == nil {
 			return reconcile.Result{}, nil
 		}
 	}
 
-	var helmValues map[string]any
-	if controllerDeployment.Helm != nil && controllerDeployment.Helm.Values != nil {
-		if err := json.Unmarshal(controllerDeployment.Helm.Values.Raw, &helmValues); err != nil {
+	var (
+		rawValues  *apiextensionsv1.JSON
+		helmValues map[string]any
+	)
+	if controllerDeployment.Helm != nil {
+		rawValues = controllerDeployment.Helm.Values
+	} else if controllerDeployment.Manifests != nil {
+		rawValues = controllerDeployment.Manifests.Values
+	}
+	if rawValues != nil {
+		if err := json.Unmarshal(rawValues.Raw, &helmValues); err != nil {
 			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartInformationInvalid", fmt.Sprintf("chart values cannot be unmarshalled: %+v", err))
 			return reconcile.Result{}, err
 		}
@@ -361,17 +384,28 @@ func (r *Reconciler) reconcile(
 		gardenerValues["usablePorts"] = ports
 	}
 
-	archive := controllerDeployment.Helm.RawChart
+	var archive []byte
+	if controllerDeployment.Helm != nil {
+		archive = controllerDeployment.Helm.RawChart
+	}
 	if len(archive) == 0 {
 		var err error
-		archive, err = r.HelmRegistry.Pull(seedCtx, controllerDeployment.Helm.OCIRepository)
+		archive, err = r.HelmRegistry.Pull(seedCtx, v1helper.GetOCIRepositoryForControllerDeployment(controllerDeployment))
 		if err != nil {
 			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "OCIChartCannotBePulled", fmt.Sprintf("chart pulling process failed: %+v", err))
+			if errors.Is(err, oci.ErrVerificationFailed) {
+				conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionFalse, "ChartVerificationFailed", fmt.Sprintf("Signature of the OCI chart could not be verified: %+v", err))
+			}
 			return reconcile.Result{}, err
 		}
 	}
 
-	release, err := r.SeedClientSet.ChartRenderer().RenderArchive(archive, controllerRegistration.Name, namespace.Name, utils.MergeMaps(helmValues, gardenerValues))
+	var release *chartrenderer.RenderedChart
+	if controllerDeployment.Manifests != nil {
+		release, err = r.ChartRenderer.RenderManifestsArchive(archive, ptr.Deref(controllerDeployment.Manifests.Path, ""), controllerRegistration.Name, namespace.Name, utils.MergeMaps(helmValues, gardenerValues))
+	} else {
+		release, err = r.ChartRenderer.RenderArchive(archive, controllerRegistration.Name, namespace.Name, utils.MergeMaps(helmValues, gardenerValues))
+	}
 	if err != nil {
 		conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "ChartCannotBeRendered", fmt.Sprintf("chart rendering process failed: %+v", err))
 		return reconcile.Result{}, err
@@ -451,6 +485,15 @@ func (r *Reconciler) reconcile(
 	}
 
 	managedResourceName := gardenerutils.ManagedResourceNameForControllerInstallation(controllerInstallation)
+	objectChecksums := chartrenderer.ObjectChecksums(release)
+
+	// The ManagedResource and its secret are always updated so that drift (e.g., manual changes or deletion) is
+	// corrected. The changes are only logged if the release changed since it was last applied.
+	if lastObjectChecksums, found := r.lastAppliedObjectChecksums(controllerInstallation.Name); found {
+		if changes := chartrenderer.DiffObjectChecksums(lastObjectChecksums, objectChecksums); len(changes) > 0 {
+			log.Info("Release changed, updating ManagedResource", "managedResourceName", managedResourceName, "changes", changes)
+		}
+	}
 
 	if err := managedresources.Create(
 		seedCtx,
@@ -472,6 +515,8 @@ func (r *Reconciler) reconcile(
 		return reconcile.Result{}, err
 	}
 
+	r.rememberAppliedObjectChecksums(controllerInstallation.Name, objectChecksums)
+
 	if conditionInstalled.Status == gardencorev1beta1.ConditionUnknown {
 		// initially set condition to Pending
 		// care controller will update condition based on 'ResourcesApplied' condition of ManagedResource
@@ -524,6 +569,8 @@ func (r *Reconciler) delete(
 		},
 	}
 
+	r.forgetAppliedObjectChecksums(controllerInstallation.Name)
+
 	if err := client.IgnoreNotFound(managedresources.Delete(seedCtx, r.SeedClientSet.Client(), mr.Namespace, mr.Name, false)); err != nil {
 		log.Info("Deletion of ManagedResource and its secrets failed", "managedResource", client.ObjectKeyFromObject(mr))
 		conditionInstalled = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionInstalled, gardencorev1beta1.ConditionFalse, "DeletionFailed", fmt.Sprintf("Deletion of ManagedResource %q and its secrets failed: %+v", managedResourceName, err))
@@ -581,6 +628,31 @@ func (r *Reconciler) delete(
 	return reconcile.Result{}, nil
 }
 
+func (r *Reconciler) lastAppliedObjectChecksums(controllerInstallationName string) (map[string]string, bool) {
+	r.appliedObjectChecksumsMu.Lock()
+	defer r.appliedObjectChecksumsMu.Unlock()
+
+	objectChecksums, found := r.appliedObjectChecksums[controllerInstallationName]
+	return objectChecksums, found
+}
+
+func (r *Reconciler) rememberAppliedObjectChecksums(controllerInstallationName string, objectChecksums map[string]string) {
+	r.appliedObjectChecksumsMu.Lock()
+	defer r.appliedObjectChecksumsMu.Unlock()
+
+	if r.appliedObjectChecksums == nil {
+		r.appliedObjectChecksums = make(map[string]map[string]string)
+	}
+	r.appliedObjectChecksums[controllerInstallationName] = objectChecksums
+}
+
+func (r *Reconciler) forgetAppliedObjectChecksums(controllerInstallationName string) {
+	r.appliedObjectChecksumsMu.Lock()
+	defer r.appliedObjectChecksumsMu.Unlock()
+
+	delete(r.appliedObjectChecksums, controllerInstallationName)
+}
+
 func patchConditions(ctx context.Context, c client.StatusClient, controllerInstallation *gardencorev1beta1.ControllerInstallation, conditions ...gardencorev1beta1.Condition) error {
 	patch := client.StrategicMergeFrom(controllerInstallation.DeepCopy())
 	controllerInstallation.Status.Conditions = v1beta1helper.MergeConditions(controllerInstallation.Status.Conditions, conditions...)
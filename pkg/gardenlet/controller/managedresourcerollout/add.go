@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresourcerollout
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "managedresource-rollout"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, seedCluster cluster.Cluster) error {
+	if r.SeedClient == nil {
+		r.SeedClient = seedCluster.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.Recorder == nil {
+		r.Recorder = seedCluster.GetEventRecorder(ControllerName + "-controller")
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: *r.Config.ConcurrentSyncs,
+			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
+		}).
+		WatchesRawSource(
+			source.Kind[client.Object](seedCluster.GetCache(),
+				&resourcesv1alpha1.ManagedResource{},
+				handler.EnqueueRequestsFromMapFunc(r.MapManagedResourceToRolloutGroup),
+			),
+		).
+		Complete(r)
+}
+
+// MapManagedResourceToRolloutGroup maps a ManagedResource to a request for its rollout group. The request only contains
+// the name of the rollout group.
+func (r *Reconciler) MapManagedResourceToRolloutGroup(_ context.Context, obj client.Object) []reconcile.Request {
+	if group, ok := obj.GetLabels()[resourcesv1alpha1.RolloutGroup]; ok {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: group}}}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresourcerollout_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/managedresourcerollout"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		ctx        = context.Background()
+		reconciler *Reconciler
+		mr         *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		mr = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "shoot--foo--bar"}}
+	})
+
+	Describe("#MapManagedResourceToRolloutGroup", func() {
+		It("should map to the rollout group from the label", func() {
+			mr.Labels = map[string]string{"resources.gardener.cloud/rollout-group": "group"}
+
+			Expect(reconciler.MapManagedResourceToRolloutGroup(ctx, mr)).To(ConsistOf(reconcile.Request{NamespacedName: types.NamespacedName{Name: "group"}}))
+		})
+
+		It("should not map ManagedResources without rollout group", func() {
+			Expect(reconciler.MapManagedResourceToRolloutGroup(ctx, mr)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresourcerollout
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// EventReasonRolloutHalted is the reason of the event recorded for ManagedResources which halt the rollout of their
+// rollout group because they are degraded.
+const EventReasonRolloutHalted = "RolloutHalted"
+
+// Reconciler rolls out changes of ManagedResources of the same rollout group in waves.
+type Reconciler struct {
+	SeedClient client.Client
+	Config     gardenletconfigv1alpha1.ManagedResourceRolloutControllerConfiguration
+	Clock      clock.Clock
+	Recorder   events.EventRecorder
+}
+
+// Reconcile approves the pending changes of the ManagedResources of the requested rollout group wave by wave. The
+// request only contains the name of the rollout group.
+// The participants of a rollout are all ManagedResources with pending changes or with an approval annotation. The next
+// wave is only started after all approved ManagedResources applied their changes and were healthy for the configured
+// soak duration. If any of them is degraded, no further changes are approved until it is healthy again or until the
+// rollout is resumed via the rollout operation annotation. The rollout can also be aborted via this annotation. When all
+// participants are done, the approval and operation annotations are removed again.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+	group := request.Name
+
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := r.SeedClient.List(ctx, managedResourceList, client.MatchingLabels{resourcesv1alpha1.RolloutGroup: group}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing ManagedResources of rollout group %q: %w", group, err)
+	}
+
+	var (
+		now = r.Clock.Now()
+		s   = &rolloutState{}
+	)
+
+	for _, mr := range managedResourceList.Items {
+		s.add(mr.DeepCopy(), now, r.Config.SoakDuration.Duration)
+	}
+
+	if len(s.pending) == 0 && len(s.inFlight) == 0 && len(s.degraded) == 0 && len(s.aborted) == 0 {
+		if len(s.done) > 0 {
+			log.Info("Rollout completed, removing approvals", "managedResources", len(s.done))
+		}
+		return reconcile.Result{}, r.removeApprovals(ctx, s.done)
+	}
+
+	if len(s.aborted) > 0 {
+		log.Info("Rollout aborted", "aborted", objectKeys(s.aborted), "pending", len(s.pending))
+		return reconcile.Result{}, nil
+	}
+
+	if len(s.degraded) > 0 {
+		for _, mr := range s.degraded {
+			r.Recorder.Eventf(mr, nil, corev1.EventTypeWarning, EventReasonRolloutHalted, gardencorev1beta1.EventActionReconcile,
+				"Rollout of rollout group %q is halted because the ManagedResource is degraded after applying the approved changes, annotate it with %s=%s to resume or with %s=%s to abort the rollout",
+				group, resourcesv1alpha1.RolloutOperation, resourcesv1alpha1.RolloutOperationResume, resourcesv1alpha1.RolloutOperation, resourcesv1alpha1.RolloutOperationAbort)
+		}
+		log.Info("Rollout halted because ManagedResources are degraded", "degraded", objectKeys(s.degraded), "pending", len(s.pending))
+		return reconcile.Result{RequeueAfter: r.Config.SoakDuration.Duration}, nil
+	}
+
+	var (
+		participants = len(s.pending) + len(s.inFlight) + len(s.done)
+		target       = participants
+	)
+
+	for _, wave := range r.Config.Waves {
+		if waveTarget := int(math.Ceil(float64(wave) * float64(participants) / 100)); len(s.done) < waveTarget {
+			target = waveTarget
+			break
+		}
+	}
+
+	slices.SortFunc(s.pending, func(a, b *resourcesv1alpha1.ManagedResource) int {
+		return strings.Compare(client.ObjectKeyFromObject(a).String(), client.ObjectKeyFromObject(b).String())
+	})
+
+	for _, mr := range s.pending[:min(max(target-len(s.done)-len(s.inFlight), 0), len(s.pending))] {
+		log.Info("Approving rollout of changes", "managedResource", client.ObjectKeyFromObject(mr), "secretsDataChecksum", *mr.Status.PendingSecretsDataChecksum)
+
+		patch := client.MergeFrom(mr.DeepCopy())
+		metav1.SetMetaDataAnnotation(&mr.ObjectMeta, resourcesv1alpha1.RolloutApprovedChecksum, *mr.Status.PendingSecretsDataChecksum)
+		// An operation annotation only refers to the degradation caused by the previously approved changes.
+		delete(mr.Annotations, resourcesv1alpha1.RolloutOperation)
+		if err := r.SeedClient.Patch(ctx, mr, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed approving rollout of ManagedResource %s: %w", client.ObjectKeyFromObject(mr), err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: s.requeueAfter(r.Config.SoakDuration.Duration)}, nil
+}
+
+func (r *Reconciler) removeApprovals(ctx context.Context, managedResources []*resourcesv1alpha1.ManagedResource) error {
+	for _, mr := range managedResources {
+		patch := client.MergeFrom(mr.DeepCopy())
+		delete(mr.Annotations, resourcesv1alpha1.RolloutApprovedChecksum)
+		delete(mr.Annotations, resourcesv1alpha1.RolloutOperation)
+		if err := r.SeedClient.Patch(ctx, mr, patch); err != nil {
+			return fmt.Errorf("failed removing rollout approval from ManagedResource %s: %w", client.ObjectKeyFromObject(mr), err)
+		}
+	}
+
+	return nil
+}
+
+// rolloutState groups the participants of a rollout by their progress.
+type rolloutState struct {
+	// pending are ManagedResources whose changes are not approved yet.
+	pending []*resourcesv1alpha1.ManagedResource
+	// inFlight are ManagedResources whose approved changes are not yet applied or which were not yet healthy for the
+	// soak duration.
+	inFlight []*resourcesv1alpha1.ManagedResource
+	// degraded are ManagedResources which are unhealthy after applying the approved changes.
+	degraded []*resourcesv1alpha1.ManagedResource
+	// aborted are ManagedResources for which the rollout of the approved changes was aborted.
+	aborted []*resourcesv1alpha1.ManagedResource
+	// done are ManagedResources which were healthy for the soak duration after applying the approved changes.
+	done []*resourcesv1alpha1.ManagedResource
+	// soakedAt is the earliest point in time at which an in-flight ManagedResource completes its soak duration.
+	soakedAt *time.Time
+	now      time.Time
+}
+
+func (s *rolloutState) add(mr *resourcesv1alpha1.ManagedResource, now time.Time, soakDuration time.Duration) {
+	s.now = now
+
+	var (
+		approved, isApproved = mr.Annotations[resourcesv1alpha1.RolloutApprovedChecksum]
+		pendingChecksum      = mr.Status.PendingSecretsDataChecksum
+	)
+
+	switch {
+	case pendingChecksum != nil && *pendingChecksum != approved:
+		s.pending = append(s.pending, mr)
+
+	case !isApproved:
+		// The ManagedResource does not participate in the current rollout.
+
+	case pendingChecksum != nil || mr.Status.ObservedGeneration != mr.Generation:
+		// The approval was not yet observed.
+		s.inFlight = append(s.inFlight, mr)
+
+	case ptr.Deref(mr.Status.SecretsDataChecksum, "") != approved:
+		// The resources changed in the meantime, hence the approval is outdated.
+		s.done = append(s.done, mr)
+
+	case mr.Annotations[resourcesv1alpha1.RolloutOperation] == resourcesv1alpha1.RolloutOperationAbort:
+		s.aborted = append(s.aborted, mr)
+
+	default:
+		conditionApplied := v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+		conditionHealthy := v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesHealthy)
+		conditionProgressing := v1beta1helper.GetCondition(mr.Status.Conditions, resourcesv1alpha1.ResourcesProgressing)
+
+		if conditionStatus(conditionApplied) == gardencorev1beta1.ConditionFalse || conditionStatus(conditionHealthy) == gardencorev1beta1.ConditionFalse {
+			if mr.Annotations[resourcesv1alpha1.RolloutOperation] == resourcesv1alpha1.RolloutOperationResume {
+				s.done = append(s.done, mr)
+				return
+			}
+			s.degraded = append(s.degraded, mr)
+			return
+		}
+
+		if conditionStatus(conditionApplied) != gardencorev1beta1.ConditionTrue ||
+			conditionStatus(conditionHealthy) != gardencorev1beta1.ConditionTrue ||
+			conditionStatus(conditionProgressing) == gardencorev1beta1.ConditionTrue ||
+			conditionStatus(conditionProgressing) == gardencorev1beta1.ConditionUnknown {
+			s.inFlight = append(s.inFlight, mr)
+			return
+		}
+
+		if soakedAt := conditionHealthy.LastTransitionTime.Add(soakDuration); soakedAt.After(now) {
+			s.inFlight = append(s.inFlight, mr)
+			if s.soakedAt == nil || soakedAt.Before(*s.soakedAt) {
+				s.soakedAt = &soakedAt
+			}
+			return
+		}
+
+		s.done = append(s.done, mr)
+	}
+}
+
+// requeueAfter returns the duration after which the rollout must be checked again. Changes of the ManagedResources
+// trigger reconciliations anyway, but the end of the soak duration must be awaited.
+func (s *rolloutState) requeueAfter(soakDuration time.Duration) time.Duration {
+	if s.soakedAt != nil {
+		return s.soakedAt.Sub(s.now) + time.Second
+	}
+	return soakDuration
+}
+
+func conditionStatus(condition *gardencorev1beta1.Condition) gardencorev1beta1.ConditionStatus {
+	if condition == nil {
+		return ""
+	}
+	return condition.Status
+}
+
+func objectKeys(managedResources []*resourcesv1alpha1.ManagedResource) []string {
+	var keys []string
+	for _, mr := range managedResources {
+		keys = append(keys, client.ObjectKeyFromObject(mr).String())
+	}
+	return keys
+}
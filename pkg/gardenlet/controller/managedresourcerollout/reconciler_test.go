@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresourcerollout_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/managedresourcerollout"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          = logf.IntoContext(context.Background(), logf.Log)
+		fakeClient   client.Client
+		fakeClock    *testclock.FakeClock
+		fakeRecorder *events.FakeRecorder
+		reconciler   *Reconciler
+		request      = reconcile.Request{NamespacedName: types.NamespacedName{Name: "group"}}
+
+		soakDuration = 5 * time.Minute
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		fakeRecorder = events.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			SeedClient: fakeClient,
+			Config: gardenletconfigv1alpha1.ManagedResourceRolloutControllerConfiguration{
+				Waves:        []int32{10, 50, 100},
+				SoakDuration: &metav1.Duration{Duration: soakDuration},
+			},
+			Clock:    fakeClock,
+			Recorder: fakeRecorder,
+		}
+	})
+
+	key := func(i int) client.ObjectKey {
+		return client.ObjectKey{Name: "group", Namespace: fmt.Sprintf("shoot--foo--bar%d", i)}
+	}
+
+	createManagedResources := func(count int) {
+		GinkgoHelper()
+
+		for i := range count {
+			mr := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{
+				Name:      key(i).Name,
+				Namespace: key(i).Namespace,
+				Labels:    map[string]string{"resources.gardener.cloud/rollout-group": "group"},
+			}}
+			Expect(fakeClient.Create(ctx, mr)).To(Succeed())
+
+			mr.Status.SecretsDataChecksum = ptr.To("old")
+			mr.Status.PendingSecretsDataChecksum = ptr.To("new")
+			Expect(fakeClient.Status().Update(ctx, mr)).To(Succeed())
+		}
+	}
+
+	// apply simulates gardener-resource-manager applying the approved changes.
+	apply := func(i int, healthy gardencorev1beta1.ConditionStatus) {
+		GinkgoHelper()
+
+		mr := &resourcesv1alpha1.ManagedResource{}
+		Expect(fakeClient.Get(ctx, key(i), mr)).To(Succeed())
+		Expect(mr.Annotations).To(HaveKeyWithValue("resources.gardener.cloud/rollout-approved-checksum", "new"))
+
+		mr.Status.SecretsDataChecksum = ptr.To("new")
+		mr.Status.PendingSecretsDataChecksum = nil
+		mr.Status.Conditions = []gardencorev1beta1.Condition{
+			{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+			{Type: resourcesv1alpha1.ResourcesHealthy, Status: healthy, LastTransitionTime: metav1.NewTime(fakeClock.Now())},
+			{Type: resourcesv1alpha1.ResourcesProgressing, Status: gardencorev1beta1.ConditionFalse},
+		}
+		Expect(fakeClient.Status().Update(ctx, mr)).To(Succeed())
+	}
+
+	approved := func(count int) []int {
+		GinkgoHelper()
+
+		var result []int
+		for i := range count {
+			mr := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, key(i), mr)).To(Succeed())
+			if _, ok := mr.Annotations["resources.gardener.cloud/rollout-approved-checksum"]; ok {
+				result = append(result, i)
+			}
+		}
+		return result
+	}
+
+	It("should roll out the changes in waves", func() {
+		createManagedResources(10)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: soakDuration}))
+		Expect(approved(10)).To(Equal([]int{0}))
+
+		By("Wait for the first wave to soak")
+		apply(0, gardencorev1beta1.ConditionTrue)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: soakDuration + time.Second}))
+		Expect(approved(10)).To(Equal([]int{0}))
+
+		By("Start the second wave")
+		fakeClock.Step(soakDuration)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: soakDuration}))
+		Expect(approved(10)).To(Equal([]int{0, 1, 2, 3, 4}))
+
+		By("Start the last wave")
+		for i := 1; i <= 4; i++ {
+			apply(i, gardencorev1beta1.ConditionTrue)
+		}
+		fakeClock.Step(soakDuration)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: soakDuration}))
+		Expect(approved(10)).To(HaveLen(10))
+
+		By("Complete the rollout")
+		for i := 5; i < 10; i++ {
+			apply(i, gardencorev1beta1.ConditionTrue)
+		}
+		fakeClock.Step(soakDuration)
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(approved(10)).To(BeEmpty())
+	})
+
+	It("should halt the rollout if a ManagedResource is degraded", func() {
+		createManagedResources(10)
+
+		Expect(reconciler.Reconcile(ctx, request)).Error().NotTo(HaveOccurred())
+		apply(0, gardencorev1beta1.ConditionFalse)
+		fakeClock.Step(soakDuration)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: soakDuration}))
+		Expect(approved(10)).To(Equal([]int{0}))
+		Eventually(fakeRecorder.Events).Should(Receive(ContainSubstring("RolloutHalted")))
+	})
+
+	Context("rollout operation annotation", func() {
+		annotate := func(i int, operation string) {
+			GinkgoHelper()
+
+			mr := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, key(i), mr)).To(Succeed())
+			metav1.SetMetaDataAnnotation(&mr.ObjectMeta, "resources.gardener.cloud/rollout-operation", operation)
+			Expect(fakeClient.Update(ctx, mr)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			createManagedResources(10)
+
+			Expect(reconciler.Reconcile(ctx, request)).Error().NotTo(HaveOccurred())
+			apply(0, gardencorev1beta1.ConditionFalse)
+			fakeClock.Step(soakDuration)
+		})
+
+		It("should resume the rollout although the ManagedResource is degraded", func() {
+			annotate(0, "resume")
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: soakDuration}))
+			Expect(approved(10)).To(Equal([]int{0, 1, 2, 3, 4}))
+			Expect(fakeRecorder.Events).To(BeEmpty())
+
+			By("Complete the rollout")
+			for i := 1; i <= 4; i++ {
+				apply(i, gardencorev1beta1.ConditionTrue)
+			}
+			fakeClock.Step(soakDuration)
+			Expect(reconciler.Reconcile(ctx, request)).Error().NotTo(HaveOccurred())
+			Expect(approved(10)).To(HaveLen(10))
+			for i := 5; i < 10; i++ {
+				apply(i, gardencorev1beta1.ConditionTrue)
+			}
+			fakeClock.Step(soakDuration)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(approved(10)).To(BeEmpty())
+
+			mr := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, key(0), mr)).To(Succeed())
+			Expect(mr.Annotations).NotTo(HaveKey("resources.gardener.cloud/rollout-operation"))
+		})
+
+		It("should abort the rollout even if the ManagedResource becomes healthy again", func() {
+			annotate(0, "abort")
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(approved(10)).To(Equal([]int{0}))
+			Expect(fakeRecorder.Events).To(BeEmpty())
+
+			By("Recover the degraded ManagedResource")
+			apply(0, gardencorev1beta1.ConditionTrue)
+			fakeClock.Step(soakDuration)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(approved(10)).To(Equal([]int{0}))
+		})
+
+		It("should remove the annotation when new changes are approved", func() {
+			annotate(0, "abort")
+
+			mr := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, key(0), mr)).To(Succeed())
+			mr.Status.PendingSecretsDataChecksum = ptr.To("fixed")
+			Expect(fakeClient.Status().Update(ctx, mr)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).Error().NotTo(HaveOccurred())
+
+			Expect(fakeClient.Get(ctx, key(0), mr)).To(Succeed())
+			Expect(mr.Annotations).To(HaveKeyWithValue("resources.gardener.cloud/rollout-approved-checksum", "fixed"))
+			Expect(mr.Annotations).NotTo(HaveKey("resources.gardener.cloud/rollout-operation"))
+		})
+	})
+})
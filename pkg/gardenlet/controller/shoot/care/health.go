@@ -7,6 +7,8 @@ package care
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -836,7 +838,24 @@ func (h *Health) CheckClusterNodes(
 		}
 	}
 
-	return preservedNodeFailure, nil
+	if preservedNodeFailure != nil {
+		return preservedNodeFailure, nil
+	}
+
+	if nodesWithHeldChanges := botanist.NodesWithHeldOperatingSystemConfigChanges(h.shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes, workerPoolToCloudConfigSecretMeta); len(nodesWithHeldChanges) > 0 {
+		return new(v1beta1helper.UpdatedConditionWithClock(h.clock, everyNodeReadyCondition, gardencorev1beta1.ConditionTrue, "OperatingSystemConfigChangesHeld", heldOperatingSystemConfigChangesMessage(nodesWithHeldChanges))), nil
+	}
+
+	return nil, nil
+}
+
+func heldOperatingSystemConfigChangesMessage(workerPoolToNodeNames map[string][]string) string {
+	var pools []string
+	for _, pool := range slices.Sorted(maps.Keys(workerPoolToNodeNames)) {
+		pools = append(pools, fmt.Sprintf("%s (%s)", pool, strings.Join(slices.Sorted(slices.Values(workerPoolToNodeNames[pool])), ", ")))
+	}
+
+	return fmt.Sprintf("All nodes are ready, but the nodes of the following worker pools hold back operating system config changes until the next maintenance time window: %s.", strings.Join(pools, "; "))
 }
 
 // CheckNodeAgentLeases checks if all nodes in the shoot cluster have a corresponding Lease object maintained by gardener-node-agent
@@ -479,6 +479,41 @@ var _ = Describe("health check", func() {
 					Spec:       coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: time.Now()}, LeaseDurationSeconds: new(int32(40))},
 				}},
 				BeNil()),
+			Entry("should report nodes holding back operating system config changes until the maintenance time window",
+				kubernetesVersion,
+				[]corev1.Node{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        nodeName,
+							Labels:      labels.Set{"worker.gardener.cloud/pool": workerPoolName1, "worker.gardener.cloud/kubernetes-version": kubernetesVersion.Original()},
+							Annotations: map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig: "outdated"},
+						},
+						Status: corev1.NodeStatus{
+							NodeInfo: corev1.NodeSystemInfo{KubeletVersion: kubernetesVersion.Original()},
+							Conditions: []corev1.NodeCondition{
+								{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+								{Type: nodeagentconfigv1alpha1.ConditionTypeOperatingSystemConfigApplied, Status: corev1.ConditionFalse, Reason: nodeagentconfigv1alpha1.ConditionReasonOperatingSystemConfigChangesHeld},
+							},
+						},
+					},
+				},
+				[]gardencorev1beta1.Worker{{Name: workerPoolName1, Maximum: 10, Minimum: 1}},
+				map[string]metav1.ObjectMeta{
+					workerPoolName1: {
+						Name: operatingsystemconfig.Key(kubernetesVersion, nil, &gardencorev1beta1.Worker{Name: workerPoolName1}, false, nil, nil),
+						Annotations: map[string]string{
+							"checksum/data-script": cloudConfigSecretChecksum1,
+							"reconciliation.osc.node-agent.gardener.cloud/hold-until-maintenance-window": "true",
+						},
+						Labels: map[string]string{"worker.gardener.cloud/pool": workerPoolName1},
+					},
+				},
+				int32(0),
+				[]coordinationv1.Lease{{
+					ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-agent-" + nodeName},
+					Spec:       coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: time.Now()}, LeaseDurationSeconds: new(int32(40))},
+				}},
+				PointTo(beConditionWithStatusAndMsg(gardencorev1beta1.ConditionTrue, "OperatingSystemConfigChangesHeld", fmt.Sprintf("hold back operating system config changes until the next maintenance time window: %s (%s).", workerPoolName1, nodeName)))),
 			Entry("should report NodeAgentUnhealthy for managed node without lease",
 				kubernetesVersion,
 				[]corev1.Node{
@@ -6,6 +6,7 @@ package shoot
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/types"
@@ -25,6 +26,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
+	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // ControllerName is the name of this controller.
@@ -41,6 +43,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.FlowExecutions == nil && r.Config.Debugging != nil && ptr.Deref(r.Config.Debugging.EnableProfiling, false) {
+		r.FlowExecutions = flow.NewExecutionStore()
+		if err := mgr.AddMetricsServerExtraHandler(flow.DebugHandlerPath, flow.NewDebugHandler(r.FlowExecutions)); err != nil {
+			return fmt.Errorf("failed adding flow debug handler: %w", err)
+		}
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
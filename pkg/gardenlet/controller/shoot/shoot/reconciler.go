@@ -69,6 +69,7 @@ type Reconciler struct {
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
 	ShootStateControllerEnabled bool
+	FlowExecutions              *flow.ExecutionStore
 }
 
 // Reconcile implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
@@ -593,6 +594,10 @@ func (r *Reconciler) removeFinalizerFromShoot(ctx context.Context, log logr.Logg
 
 	reportMetrics(shoot, operationType, r.Clock.Now().UTC().Sub(shoot.DeletionTimestamp.Time))
 
+	if r.FlowExecutions != nil {
+		r.FlowExecutions.Delete(client.ObjectKeyFromObject(shoot).String())
+	}
+
 	// Wait until the above modifications are reflected in the cache to prevent unwanted reconcile
 	// operations (sometimes the cache is not synced fast enough).
 	return retryutils.UntilTimeout(ctx, time.Second, 30*time.Second, func(context.Context) (bool, error) {
@@ -611,6 +616,52 @@ func (r *Reconciler) removeFinalizerFromShoot(ctx context.Context, log logr.Logg
 	})
 }
 
+// flowExecutionRecorder returns a function which records the executed flow of the given shoot for the debug handler. It
+// returns nil if flow executions are not recorded.
+func (r *Reconciler) flowExecutionRecorder(shoot *gardencorev1beta1.Shoot) func(*flow.Execution) {
+	if r.FlowExecutions == nil {
+		return nil
+	}
+	return r.FlowExecutions.Recorder(client.ObjectKeyFromObject(shoot).String())
+}
+
+// flowCheckpointConfigMapName is the name of the ConfigMap in the control plane namespace of a shoot which contains the
+// checkpoints of its flows.
+const flowCheckpointConfigMapName = "shoot-flow-checkpoints"
+
+// flowCheckpointStore returns the store for the checkpoints of the flows of the given operation. They are persisted in
+// the control plane namespace of the shoot, so that a failed flow can resume from the tasks which did not succeed yet.
+func flowCheckpointStore(o *operation.Operation) flow.CheckpointStore {
+	return &flow.ConfigMapCheckpointStore{
+		Client:    o.SeedClientSet.Client(),
+		Namespace: o.Shoot.ControlPlaneNamespace,
+		Name:      flowCheckpointConfigMapName,
+	}
+}
+
+// flowCheckpointInputHash returns the hash of the inputs of the flows of the given shoot. Checkpoints are discarded if
+// the shoot's specification (including operation annotations, which increase the generation), the specification of its
+// seed or cloud profile, its credentials, or gardenlet's version changed since they were recorded.
+func flowCheckpointInputHash(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed, cloudProfile *gardencorev1beta1.CloudProfile, credentials client.Object) string {
+	inputs := []string{
+		fmt.Sprintf("%s/%d", shoot.UID, shoot.Generation),
+		version.Get().GitVersion,
+	}
+
+	if seed != nil {
+		inputs = append(inputs, fmt.Sprintf("seed:%s/%d", seed.UID, seed.Generation))
+	}
+	if cloudProfile != nil {
+		inputs = append(inputs, fmt.Sprintf("cloudprofile:%s/%d", cloudProfile.UID, cloudProfile.Generation))
+	}
+	if credentials != nil {
+		// Secrets do not have a generation, hence, the resource version is used to detect changes of the credentials.
+		inputs = append(inputs, fmt.Sprintf("credentials:%s/%s", credentials.GetUID(), credentials.GetResourceVersion()))
+	}
+
+	return utils.ComputeSHA256Hex([]byte(strings.Join(inputs, "/")))
+}
+
 func (r *Reconciler) newProgressReporter(reporterFn flow.ProgressReporterFn) flow.ProgressReporter {
 	if r.Config.Controllers.Shoot != nil && r.Config.Controllers.Shoot.ProgressReportPeriod != nil {
 		return flow.NewDelayingProgressReporter(clock.RealClock{}, reporterFn, r.Config.Controllers.Shoot.ProgressReportPeriod.Duration)
@@ -158,9 +158,12 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		g = flow.NewGraph("Shoot cluster deletion")
 
 		deployNamespace = g.Add(flow.Task{
-			Name:   "Deploying Shoot namespace in Seed",
-			Fn:     flow.TaskFn(botanist.DeployControlPlaneNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			SkipIf: !nonTerminatingNamespace,
+			Name: "Deploying Shoot namespace in Seed",
+			// The namespace object is kept in memory and used by many subsequent tasks, hence, this task must also run
+			// when resuming from a checkpoint.
+			AlwaysRun: true,
+			Fn:        flow.TaskFn(botanist.DeployControlPlaneNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:    !nonTerminatingNamespace,
 		})
 		ensureShootClusterIdentity = g.Add(flow.Task{
 			Name:         "Ensuring Shoot cluster identity",
@@ -179,13 +182,17 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Dependencies: flow.NewTaskIDs(deployNamespace),
 		})
 		deployKubeAPIServerService = g.Add(flow.Task{
-			Name:         "Deploying Kubernetes API server service in the Seed cluster",
+			Name: "Deploying Kubernetes API server service in the Seed cluster",
+			// The cluster IP of the service is kept in memory and used by subsequent tasks, hence, this task must also run
+			// when resuming from a checkpoint.
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.Shoot.Components.ControlPlane.KubeAPIServerService.Deploy).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       !cleanupShootResources,
 			Dependencies: flow.NewTaskIDs(deployNamespace, ensureShootClusterIdentity),
 		})
 		waitUntilKubeAPIServerServiceIsReady = g.Add(flow.Task{
 			Name:         "Waiting until Kubernetes API LoadBalancer in the Seed cluster has reported readiness",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.ControlPlane.KubeAPIServerService.Wait,
 			SkipIf:       !cleanupShootResources,
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServerService),
@@ -198,12 +205,14 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		initializeSecretsManagement = g.Add(flow.Task{
 			Name:         "Initializing secrets management",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.InitializeSecretsManagement).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       !nonTerminatingNamespace,
 			Dependencies: flow.NewTaskIDs(deployNamespace, reconcileIstioInternalLoadbalancingConfigMap),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Ensuring advertised addresses for the Shoot",
+			AlwaysRun:    true,
 			Fn:           botanist.UpdateAdvertisedAddresses,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, waitUntilKubeAPIServerServiceIsReady),
 		})
@@ -221,6 +230,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		deployETCD = g.Add(flow.Task{
 			Name:         "Deploying main and events etcd",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.DeployEtcd).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       !cleanupShootResources,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret),
@@ -233,12 +243,14 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		waitUntilEtcdReady = g.Add(flow.Task{
 			Name:         "Waiting until main and event etcd report readiness",
+			AlwaysRun:    true,
 			Fn:           botanist.WaitUntilEtcdsReady,
 			SkipIf:       !cleanupShootResources,
 			Dependencies: flow.NewTaskIDs(scaleETCD),
 		})
 		deployKubeAPIServer = g.Add(flow.Task{
-			Name: "Deploying Kubernetes API server",
+			Name:      "Deploying Kubernetes API server",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.DeployKubeAPIServer(ctx)
 			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -258,6 +270,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		waitUntilKubeAPIServerIsReady = g.Add(flow.Task{
 			Name:         "Waiting until Kubernetes API server reports readiness",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.ControlPlane.KubeAPIServer.Wait,
 			SkipIf:       !cleanupShootResources,
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServer, scaleUpKubeAPIServer),
@@ -285,6 +298,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		waitUntilGardenerResourceManagerReady = g.Add(flow.Task{
 			Name:         "Waiting until gardener-resource-manager reports readiness",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.ControlPlane.ResourceManager.Wait,
 			SkipIf:       !cleanupShootResources,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
@@ -298,7 +312,8 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
 		})
 		waitUntilControlPlaneReady = g.Add(flow.Task{
-			Name: "Waiting until Shoot control plane has been reconciled",
+			Name:      "Waiting until Shoot control plane has been reconciled",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.ControlPlane.Wait(ctx)
 			}),
@@ -313,6 +328,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		initializeShootClients = g.Add(flow.Task{
 			Name:         "Initializing connection to Shoot",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.InitializeDesiredShootClients).RetryUntilTimeout(defaultInterval, 2*time.Minute),
 			SkipIf:       !cleanupShootResources,
 			Dependencies: flow.NewTaskIDs(deployCloudProviderSecret, waitUntilKubeAPIServerIsReady, deployInternalDomainDNSRecord, deployGardenerAccess),
@@ -349,6 +365,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		waitForControllersToBeActive = g.Add(flow.Task{
 			Name:         "Waiting until kube-controller-manager is active",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.WaitForKubeControllerManagerToBeActive).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       !cleanupShootResources || !kubeControllerManagerDeploymentFound,
 			Dependencies: flow.NewTaskIDs(initializeShootClients, cleanupWebhooks, deployKubeControllerManager),
@@ -398,7 +415,8 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Dependencies: flow.NewTaskIDs(syncPointCleanedKubernetesResources),
 		})
 		waitUntilNetworkIsDestroyed = g.Add(flow.Task{
-			Name: "Waiting until shoot network plugin has been destroyed",
+			Name:      "Waiting until shoot network plugin has been destroyed",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.Network.WaitCleanup(ctx)
 			}),
@@ -420,7 +438,8 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Dependencies: flow.NewTaskIDs(deployMachineControllerManager),
 		})
 		waitUntilWorkerDeleted = g.Add(flow.Task{
-			Name: "Waiting until shoot worker nodes have been terminated",
+			Name:      "Waiting until shoot worker nodes have been terminated",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.Worker.WaitCleanup(ctx)
 			}),
@@ -444,7 +463,8 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Dependencies: flow.NewTaskIDs(waitUntilWorkerDeleted),
 		})
 		waitUntilOperatingSystemConfigsAreDeleted = g.Add(flow.Task{
-			Name: "Waiting until all operating system config resources are deleted",
+			Name:      "Waiting until all operating system config resources are deleted",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.OperatingSystemConfig.WaitCleanup(ctx)
 			}),
@@ -466,6 +486,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		waitUntilManagedResourcesDeleted = g.Add(flow.Task{
 			Name:         "Waiting until managed resources have been deleted",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.WaitUntilManagedResourcesDeleted).Timeout(10 * time.Minute),
 			Dependencies: flow.NewTaskIDs(deleteDWDResources),
 		})
@@ -476,6 +497,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		waitUntilExtensionResourcesBeforeKubeAPIServerDeleted = g.Add(flow.Task{
 			Name:         "Waiting until extension resources that should be handled before kube-apiserver have been deleted",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitCleanupBeforeKubeAPIServer,
 			Dependencies: flow.NewTaskIDs(deleteExtensionResourcesBeforeKubeAPIServer),
 		})
@@ -486,6 +508,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		waitUntilStaleExtensionResourcesDeleted = g.Add(flow.Task{
 			Name:         "Waiting until all stale extension resources have been deleted",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitCleanupStaleResources,
 			Dependencies: flow.NewTaskIDs(deleteStaleExtensionResources),
 		})
@@ -498,7 +521,8 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Dependencies: flow.NewTaskIDs(initializeShootClients, syncPointCleanedKubernetesResources),
 		})
 		waitUntilContainerRuntimeResourcesDeleted = g.Add(flow.Task{
-			Name: "Waiting until stale container runtime resources are deleted",
+			Name:      "Waiting until stale container runtime resources are deleted",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.ContainerRuntime.WaitCleanup(ctx)
 			}),
@@ -526,7 +550,8 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Dependencies: flow.NewTaskIDs(syncPointCleaned),
 		})
 		waitUntilControlPlaneDeleted = g.Add(flow.Task{
-			Name: "Waiting until shoot control plane has been destroyed",
+			Name:      "Waiting until shoot control plane has been destroyed",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.ControlPlane.WaitCleanup(ctx)
 			}),
@@ -536,6 +561,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 
 		waitUntilShootManagedResourcesDeleted = g.Add(flow.Task{
 			Name:         "Waiting until shoot managed resources have been deleted",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.WaitUntilShootManagedResourcesDeleted).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneDeleted),
 		})
@@ -546,6 +572,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		waitUntilKubeAPIServerDeleted = g.Add(flow.Task{
 			Name:         "Waiting until Kubernetes API server has been deleted",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.ControlPlane.KubeAPIServer.WaitCleanup,
 			Dependencies: flow.NewTaskIDs(deleteKubeAPIServer),
 		})
@@ -556,12 +583,14 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		waitUntilExtensionResourcesAfterKubeAPIServerDeleted = g.Add(flow.Task{
 			Name:         "Waiting until extension resources that should be handled after kube-apiserver have been deleted",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitCleanupAfterKubeAPIServer,
 			Dependencies: flow.NewTaskIDs(deleteExtensionResourcesAfterKubeAPIServer),
 		})
 		// Add this step in interest of completeness. All extension deletions should have already been triggered by previous steps.
 		waitUntilExtensionResourcesDeleted = g.Add(flow.Task{
 			Name:         "Waiting until all extension resources have been deleted",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitCleanup,
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerDeleted),
 		})
@@ -601,7 +630,8 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Dependencies: flow.NewTaskIDs(syncPointCleaned, waitUntilControlPlaneDeleted),
 		})
 		waitUntilInfrastructureDeleted = g.Add(flow.Task{
-			Name: "Waiting until shoot infrastructure has been deleted",
+			Name:      "Waiting until shoot infrastructure has been deleted",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.Infrastructure.WaitCleanup(ctx)
 			}),
@@ -683,16 +713,24 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		})
 		waitUntilEtcdDeleted = g.Add(flow.Task{
 			Name:         "Waiting until main and event etcd have been destroyed",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.WaitUntilEtcdsDeleted).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(syncPointObservabilityDown, destroyEtcd),
 		})
+		deleteCAKeysFromKeyBackend = g.Add(flow.Task{
+			Name:         "Deleting CA private keys from key backend",
+			Fn:           flow.TaskFn(botanist.DeleteCAKeysFromKeyBackend).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       botanist.KeyBackend == nil,
+			Dependencies: flow.NewTaskIDs(syncPointObservabilityDown, destroyInternalDomainDNSRecord, destroyReferencedResources, waitUntilEtcdDeleted),
+		})
 		deleteNamespace = g.Add(flow.Task{
 			Name:         "Deleting shoot namespace in Seed",
 			Fn:           flow.TaskFn(botanist.DeleteSeedNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(syncPointObservabilityDown, destroyInternalDomainDNSRecord, destroyReferencedResources, waitUntilEtcdDeleted),
+			Dependencies: flow.NewTaskIDs(syncPointObservabilityDown, destroyInternalDomainDNSRecord, destroyReferencedResources, waitUntilEtcdDeleted, deleteCAKeysFromKeyBackend),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until shoot namespace in Seed has been deleted",
+			AlwaysRun:    true,
 			Fn:           botanist.WaitUntilSeedNamespaceDeleted,
 			Dependencies: flow.NewTaskIDs(deleteNamespace),
 		})
@@ -708,10 +746,13 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 	)
 
 	if err := f.Run(ctx, flow.Opts{
-		Log:              o.Logger,
-		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
-		ErrorCleaner:     o.CleanShootTaskError,
-		ErrorContext:     errorContext,
+		Log:                 o.Logger,
+		ProgressReporter:    r.newProgressReporter(o.ReportShootProgress),
+		ErrorCleaner:        o.CleanShootTaskError,
+		ErrorContext:        errorContext,
+		ExecutionRecorder:   r.flowExecutionRecorder(o.Shoot.GetInfo()),
+		CheckpointStore:     flowCheckpointStore(o),
+		CheckpointInputHash: flowCheckpointInputHash(o.Shoot.GetInfo(), o.Seed.GetInfo(), o.Shoot.CloudProfile, o.Shoot.Credentials),
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -202,10 +202,11 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 	)
 
 	if err := f.Run(ctx, flow.Opts{
-		Log:              o.Logger,
-		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
-		ErrorCleaner:     o.CleanShootTaskError,
-		ErrorContext:     errorContext,
+		Log:               o.Logger,
+		ProgressReporter:  r.newProgressReporter(o.ReportShootProgress),
+		ErrorCleaner:      o.CleanShootTaskError,
+		ErrorContext:      errorContext,
+		ExecutionRecorder: r.flowExecutionRecorder(o.Shoot.GetInfo()),
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -436,10 +436,11 @@ func (r *Reconciler) runMigrateShootFlow(ctx context.Context, o *operation.Opera
 	)
 
 	if err := f.Run(ctx, flow.Opts{
-		Log:              o.Logger,
-		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
-		ErrorContext:     errorContext,
-		ErrorCleaner:     o.CleanShootTaskError,
+		Log:               o.Logger,
+		ProgressReporter:  r.newProgressReporter(o.ReportShootProgress),
+		ErrorContext:      errorContext,
+		ErrorCleaner:      o.CleanShootTaskError,
+		ExecutionRecorder: r.flowExecutionRecorder(o.Shoot.GetInfo()),
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
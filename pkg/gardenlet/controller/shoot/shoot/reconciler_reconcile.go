@@ -160,7 +160,10 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 
 		deployNamespace = g.Add(flow.Task{
 			Name: "Deploying Shoot namespace in Seed",
-			Fn:   flow.TaskFn(botanist.DeployControlPlaneNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			// The namespace object is kept in memory and used by many subsequent tasks, hence, this task must also run
+			// when resuming from a checkpoint.
+			AlwaysRun: true,
+			Fn:        flow.TaskFn(botanist.DeployControlPlaneNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
 		})
 		ensureShootClusterIdentity = g.Add(flow.Task{
 			Name:         "Ensuring Shoot cluster identity",
@@ -180,6 +183,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		initializeSecretsManagement = g.Add(flow.Task{
 			Name:         "Initializing secrets management",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.InitializeSecretsManagement).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(deployNamespace, reconcileIstioInternalLoadbalancingConfigMap),
 		})
@@ -201,7 +205,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, deployReferencedResources),
 		})
 		waitUntilInfrastructureReady = g.Add(flow.Task{
-			Name: "Waiting until shoot infrastructure has been reconciled",
+			Name:      "Waiting until shoot infrastructure has been reconciled",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				if !skipReadiness {
 					if err := botanist.WaitForInfrastructure(ctx); err != nil {
@@ -214,18 +219,23 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(deployInfrastructure),
 		})
 		deployKubeAPIServerService = g.Add(flow.Task{
-			Name:         "Deploying Kubernetes API server service in the Seed cluster",
+			Name: "Deploying Kubernetes API server service in the Seed cluster",
+			// The cluster IP of the service is kept in memory and used by subsequent tasks, hence, this task must also run
+			// when resuming from a checkpoint.
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.Shoot.Components.ControlPlane.KubeAPIServerService.Deploy).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(deployNamespace, ensureShootClusterIdentity, initializeSecretsManagement).InsertIf(!hasNodesCIDR, waitUntilInfrastructureReady),
 		})
 		waitUntilKubeAPIServerServiceIsReady = g.Add(flow.Task{
 			Name:         "Waiting until Kubernetes API server service in the Seed cluster has reported readiness",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.ControlPlane.KubeAPIServerService.Wait,
 			SkipIf:       o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServerService),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Ensuring advertised addresses for the Shoot",
+			AlwaysRun:    true,
 			Fn:           botanist.UpdateAdvertisedAddresses,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, waitUntilKubeAPIServerServiceIsReady),
 		})
@@ -259,6 +269,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilSourceBackupEntryInGardenReconciled = g.Add(flow.Task{
 			Name:         "Waiting until the source backup entry has been reconciled",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.SourceBackupEntry.Wait,
 			SkipIf:       skipReadiness || !isCopyOfBackupsRequired,
 			Dependencies: flow.NewTaskIDs(deploySourceBackupEntry),
@@ -271,6 +282,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilBackupEntryInGardenReconciled = g.Add(flow.Task{
 			Name:         "Waiting until the backup entry has been reconciled",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.BackupEntry.Wait,
 			SkipIf:       skipReadiness || !allowBackup,
 			Dependencies: flow.NewTaskIDs(deployBackupEntryInGarden),
@@ -283,6 +295,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilEtcdBackupsCopied = g.Add(flow.Task{
 			Name:         "Waiting until etcd backups are copied",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.Wait,
 			SkipIf:       skipReadiness || !isCopyOfBackupsRequired,
 			Dependencies: flow.NewTaskIDs(copyEtcdBackups),
@@ -295,6 +308,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployETCD = g.Add(flow.Task{
 			Name:         "Deploying main and events etcd",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.DeployEtcd).RetryUntilTimeout(defaultInterval, helper.GetEtcdDeployTimeout(o.Shoot, defaultTimeout)),
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilBackupEntryInGardenReconciled, waitUntilEtcdBackupsCopied),
 		})
@@ -306,12 +320,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until source backup entry has been deleted",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.SourceBackupEntry.WaitCleanup,
 			SkipIf:       !allowBackup || skipReadiness || !botanist.IsRestorePhase(),
 			Dependencies: flow.NewTaskIDs(destroySourceBackupEntry),
 		})
 		waitUntilEtcdReady = g.Add(flow.Task{
 			Name:         "Waiting until main and event etcd report readiness",
+			AlwaysRun:    true,
 			Fn:           botanist.WaitUntilEtcdsReady,
 			SkipIf:       (!isRestoringHAControlPlane && o.Shoot.HibernationEnabled) || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployETCD),
@@ -324,12 +340,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilExtensionResourcesBeforeKAPIReady = g.Add(flow.Task{
 			Name:         "Waiting until extension resources handled before kube-apiserver are ready",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitBeforeKubeAPIServer,
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployExtensionResourcesBeforeKAPI),
 		})
 		deployKubeAPIServer = g.Add(flow.Task{
-			Name: "Deploying Kubernetes API server",
+			Name:      "Deploying Kubernetes API server",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.DeployKubeAPIServer(ctx)
 			}).RetryUntilTimeout(defaultInterval, deployKubeAPIServerTaskTimeout),
@@ -343,6 +361,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilKubeAPIServerIsReady = g.Add(flow.Task{
 			Name:         "Waiting until Kubernetes API server rolled out",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.ControlPlane.KubeAPIServer.Wait,
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServer),
@@ -360,6 +379,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilEtcdScaledAfterRestore = g.Add(flow.Task{
 			Name:         "Waiting until main and events etcd scaled up after kube-apiserver is ready",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.WaitUntilEtcdsReady),
 			SkipIf:       !isRestoringHAControlPlane || skipReadiness,
 			Dependencies: flow.NewTaskIDs(scaleEtcdAfterRestore),
@@ -371,6 +391,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilGardenerResourceManagerReady = g.Add(flow.Task{
 			Name:         "Waiting until gardener-resource-manager reports readiness",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.ControlPlane.ResourceManager.Wait,
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
@@ -396,7 +417,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
 		})
 		waitUntilControlPlaneReady = g.Add(flow.Task{
-			Name: "Waiting until shoot control plane has been reconciled",
+			Name:      "Waiting until shoot control plane has been reconciled",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.ControlPlane.Wait(ctx)
 			}),
@@ -410,12 +432,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilShootNamespacesReady = g.Add(flow.Task{
 			Name:         "Waiting until shoot namespaces have been reconciled",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.SystemComponents.Namespaces.Wait,
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, deployShootNamespaces),
 		})
 		deployVPNSeedServer = g.Add(flow.Task{
 			Name:         "Deploying vpn-seed-server",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.DeployVPNServer).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployNamespace, waitUntilGardenerResourceManagerReady),
@@ -427,6 +451,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		initializeShootClients = g.Add(flow.Task{
 			Name:         "Initializing connection to Shoot",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.InitializeDesiredShootClients).RetryUntilTimeout(defaultInterval, 2*time.Minute),
 			Dependencies: flow.NewTaskIDs(deployInternalDomainDNSRecord, deployGardenerAccess),
 		})
@@ -534,8 +559,9 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilGardenerResourceManagerReady),
 		})
 		waitUntilKubeControllerManagerReady = g.Add(flow.Task{
-			Name: "Waiting until kube-controller-manager reports readiness",
-			Fn:   botanist.Shoot.Components.ControlPlane.KubeControllerManager.Wait,
+			Name:      "Waiting until kube-controller-manager reports readiness",
+			AlwaysRun: true,
+			Fn:        botanist.Shoot.Components.ControlPlane.KubeControllerManager.Wait,
 			SkipIf: skipReadiness || !sets.New(
 				gardencorev1beta1.RotationPreparing,
 				gardencorev1beta1.RotationPreparingWithoutWorkersRollout,
@@ -580,12 +606,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		deployOperatingSystemConfig = g.Add(flow.Task{
 			Name:         "Deploying operating system specific configuration for shoot workers",
+			AlwaysRun:    true,
 			Fn:           flow.TaskFn(botanist.DeployOperatingSystemConfig).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			SkipIf:       o.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilInfrastructureReady, waitUntilControlPlaneReady, deleteBastions, waitUntilExtensionResourcesAfterKAPIReady),
 		})
 		waitUntilOperatingSystemConfigReady = g.Add(flow.Task{
-			Name: "Waiting until operating system configurations for worker nodes have been reconciled",
+			Name:      "Waiting until operating system configurations for worker nodes have been reconciled",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.OperatingSystemConfig.Wait(ctx)
 			}),
@@ -601,7 +629,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(deployOperatingSystemConfig),
 		})
 		_ = g.Add(flow.Task{
-			Name: "Waiting until stale operating system config resources are deleted",
+			Name:      "Waiting until stale operating system config resources are deleted",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.OperatingSystemConfig.WaitCleanupStaleResources(ctx)
 			}),
@@ -615,7 +644,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, waitUntilGardenerResourceManagerReady, waitUntilOperatingSystemConfigReady, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
 		waitUntilNetworkIsReady = g.Add(flow.Task{
-			Name: "Waiting until shoot network plugin has been reconciled",
+			Name:      "Waiting until shoot network plugin has been reconciled",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.Network.Wait(ctx)
 			}),
@@ -623,7 +653,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(deployNetwork),
 		})
 		_ = g.Add(flow.Task{
-			Name: "Check coreDNS migration",
+			Name:      "Check coreDNS migration",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.CheckDNSServiceMigration(ctx)
 			}),
@@ -799,7 +830,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(deployMachineControllerManager),
 		})
 		waitUntilWorkerStatusUpdate = g.Add(flow.Task{
-			Name: "Waiting until worker resource status is updated with latest machine deployments",
+			Name:      "Waiting until worker resource status is updated with latest machine deployments",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.Worker.WaitUntilWorkerStatusMachineDeploymentsUpdated(ctx)
 			}),
@@ -819,7 +851,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(waitUntilWorkerStatusUpdate, deployManagedResourceForGardenerNodeAgent),
 		})
 		waitUntilWorkerReady = g.Add(flow.Task{
-			Name: "Waiting until shoot worker nodes have been reconciled",
+			Name:      "Waiting until shoot worker nodes have been reconciled",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				if err := botanist.Shoot.Components.Extensions.Worker.Wait(ctx); err != nil {
 					return err
@@ -861,12 +894,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Checking if we have dual-stack pod CIDRs in nodes",
+			AlwaysRun:    true,
 			Fn:           botanist.CheckPodCIDRsInNodes,
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilWorkerReady),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until extension resources handled after workers are ready",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitAfterWorker,
 			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployExtensionResourcesAfterWorker),
@@ -890,6 +925,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		nginxLBReady = g.Add(flow.Task{
 			Name:         "Waiting until nginx ingress LoadBalancer is ready",
+			AlwaysRun:    true,
 			Fn:           botanist.WaitUntilNginxIngressServiceIsReady,
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || !v1beta1helper.NginxIngressEnabled(botanist.Shoot.GetInfo().Spec.Addons),
 			Dependencies: flow.NewTaskIDs(initializeShootClients, waitUntilWorkerReady, ensureShootClusterIdentity),
@@ -907,12 +943,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilTunnelConnectionExists = g.Add(flow.Task{
 			Name:         "Waiting until the Kubernetes API server can connect to the Shoot workers",
+			AlwaysRun:    true,
 			Fn:           botanist.WaitUntilTunnelConnectionExists,
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(syncPointAllSystemComponentsDeployed, waitUntilNetworkIsReady, waitUntilWorkerReady),
 		})
 		_ = g.Add(flow.Task{
-			Name: "Waiting until all shoot worker nodes have updated the operating system config",
+			Name:      "Waiting until all shoot worker nodes have updated the operating system config",
+			AlwaysRun: true,
 			Fn: func(ctx context.Context) error {
 				return botanist.WaitUntilOperatingSystemConfigUpdatedForAllWorkerPools(ctx, false)
 			},
@@ -926,6 +964,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilAlertmanagerReconciled = g.Add(flow.Task{
 			Name:         "Waiting until Shoot Alertmanager is reconciled",
+			AlwaysRun:    true,
 			Fn:           botanist.WaitForAlertManager,
 			Dependencies: flow.NewTaskIDs(deployAlertmanager),
 		})
@@ -936,6 +975,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilPrometheusReconciled = g.Add(flow.Task{
 			Name:         "Waiting until Shoot Prometheus is reconciled",
+			AlwaysRun:    true,
 			Fn:           botanist.WaitForPrometheus,
 			Dependencies: flow.NewTaskIDs(deployPrometheus),
 		})
@@ -957,6 +997,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		waitUntilPlutonoReconciled = g.Add(flow.Task{
 			Name:         "Waiting until Plutono for Shoot in Seed is reconciled",
+			AlwaysRun:    true,
 			Fn:           botanist.WaitForPlutono,
 			Dependencies: flow.NewTaskIDs(deployPlutonoForLogging, deployPlutonoForMonitoring),
 		})
@@ -983,6 +1024,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until extension resources hibernated after kube-apiserver hibernation are ready",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitBeforeKubeAPIServer,
 			SkipIf:       skipReadiness || !o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(hibernateExtensionResourcesAfterKAPIHibernation),
@@ -1012,6 +1054,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until stale extension resources are deleted",
+			AlwaysRun:    true,
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitCleanupStaleResources,
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deleteStaleExtensionResources),
@@ -1023,7 +1066,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(deployReferencedResources, initializeShootClients),
 		})
 		_ = g.Add(flow.Task{
-			Name: "Waiting until container runtime resources are ready",
+			Name:      "Waiting until container runtime resources are ready",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.ContainerRuntime.Wait(ctx)
 			}),
@@ -1039,7 +1083,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(initializeShootClients),
 		})
 		_ = g.Add(flow.Task{
-			Name: "Waiting until stale container runtime resources are deleted",
+			Name:      "Waiting until stale container runtime resources are deleted",
+			AlwaysRun: true,
 			Fn: flow.TaskFn(func(ctx context.Context) error {
 				return botanist.Shoot.Components.Extensions.ContainerRuntime.WaitCleanupStaleResources(ctx)
 			}),
@@ -1059,21 +1104,39 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		})
 	)
 
-	f := g.Compile()
+	var (
+		f        = g.Compile()
+		recorder = r.flowExecutionRecorder(o.Shoot.GetInfo())
+		resumed  bool
+	)
 
 	if err := f.Run(ctx, flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorContext:     errorContext,
 		ErrorCleaner:     o.CleanShootTaskError,
+		ExecutionRecorder: func(execution *flow.Execution) {
+			resumed = execution.Resumed()
+			if recorder != nil {
+				recorder(execution)
+			}
+		},
+		CheckpointStore:     flowCheckpointStore(o),
+		CheckpointInputHash: flowCheckpointInputHash(o.Shoot.GetInfo(), o.Seed.GetInfo(), o.Shoot.CloudProfile, o.Shoot.Credentials),
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
 
-	o.Logger.Info("Cleaning no longer required secrets")
-	if err := botanist.SecretsManager.Cleanup(ctx); err != nil {
-		err = fmt.Errorf("failed to clean no longer required secrets: %w", err)
-		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
+	// Tasks restored from a checkpoint did not generate their secrets in this execution, hence, the secrets manager
+	// would consider them as no longer required. They are cleaned up by the next execution which runs all tasks.
+	if resumed {
+		o.Logger.Info("Skipping cleanup of no longer required secrets since the flow resumed from a checkpoint")
+	} else {
+		o.Logger.Info("Cleaning no longer required secrets")
+		if err := botanist.SecretsManager.Cleanup(ctx); err != nil {
+			err = fmt.Errorf("failed to clean no longer required secrets: %w", err)
+			return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
+		}
 	}
 
 	if !r.ShootStateControllerEnabled && botanist.IsRestorePhase() {
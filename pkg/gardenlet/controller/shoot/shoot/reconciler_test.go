@@ -254,4 +254,64 @@ var _ = Describe("Reconciler", func() {
 			Expect(shoot.Status.Credentials.Rotation.ServiceAccountKey.LastInitiationFinishedTime.UTC()).To(Equal(fakeClock.Now()))
 		})
 	})
+
+	Describe("#flowCheckpointInputHash", func() {
+		var (
+			shoot        *gardencorev1beta1.Shoot
+			seed         *gardencorev1beta1.Seed
+			cloudProfile *gardencorev1beta1.CloudProfile
+			credentials  *corev1.Secret
+
+			hash func() string
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-bar", UID: "uid", Generation: 1}}
+			seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed", UID: "seed-uid", Generation: 1}}
+			cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile", UID: "profile-uid", Generation: 1}}
+			credentials = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "garden-bar", UID: "secret-uid", ResourceVersion: "1"}}
+
+			hash = func() string {
+				return flowCheckpointInputHash(shoot, seed, cloudProfile, credentials)
+			}
+		})
+
+		It("should be stable", func() {
+			Expect(hash()).To(Equal(flowCheckpointInputHash(shoot.DeepCopy(), seed.DeepCopy(), cloudProfile.DeepCopy(), credentials.DeepCopy())))
+		})
+
+		It("should change if the generation of the shoot changed", func() {
+			oldHash := hash()
+			shoot.Generation++
+			Expect(hash()).NotTo(Equal(oldHash))
+		})
+
+		It("should change if the shoot was recreated", func() {
+			oldHash := hash()
+			shoot.UID = "other-uid"
+			Expect(hash()).NotTo(Equal(oldHash))
+		})
+
+		It("should change if the generation of the seed changed", func() {
+			oldHash := hash()
+			seed.Generation++
+			Expect(hash()).NotTo(Equal(oldHash))
+		})
+
+		It("should change if the generation of the cloud profile changed", func() {
+			oldHash := hash()
+			cloudProfile.Generation++
+			Expect(hash()).NotTo(Equal(oldHash))
+		})
+
+		It("should change if the credentials changed", func() {
+			oldHash := hash()
+			credentials.ResourceVersion = "2"
+			Expect(hash()).NotTo(Equal(oldHash))
+		})
+
+		It("should tolerate missing credentials", func() {
+			Expect(flowCheckpointInputHash(shoot, seed, cloudProfile, nil)).NotTo(Equal(hash()))
+		})
+	})
 })
@@ -23,6 +23,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -49,14 +50,32 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		namespaces = append(namespaces, v1beta1constants.GardenNamespace)
 	}
 
+	secretsManagerOptions := []secretsmanager.NewOption{
+		secretsmanager.WithSecretNamesToTimes(b.lastSecretRotationStartTimes()),
+		secretsmanager.WithNamespaces(namespaces...),
+	}
+
+	if o.Config != nil && o.Config.SecretsManagement != nil {
+		if keyAlgorithm := o.Config.SecretsManagement.KeyAlgorithm; keyAlgorithm != nil {
+			secretsManagerOptions = append(secretsManagerOptions, secretsmanager.WithKeyAlgorithm(secretsutils.KeyAlgorithm(*keyAlgorithm)))
+		}
+
+		o.KeyBackend, err = newKeyBackend(o.Config.SecretsManagement.KeyBackend)
+		if err != nil {
+			return nil, fmt.Errorf("failed to instantiate key backend: %w", err)
+		}
+		if o.KeyBackend != nil {
+			secretsManagerOptions = append(secretsManagerOptions, KeyBackendOption(o.KeyBackend))
+		}
+	}
+
 	o.SecretsManager, err = secretsmanager.New(
 		ctx,
 		b.Logger.WithName("secretsmanager"),
 		clock.RealClock{},
 		b.SeedClientSet.Client(),
 		secretsManagerIdentity,
-		secretsmanager.WithSecretNamesToTimes(b.lastSecretRotationStartTimes()),
-		secretsmanager.WithNamespaces(namespaces...),
+		secretsManagerOptions...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to instantiate secrets manager: %w", err)
@@ -10,15 +10,19 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/component-base/version"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/imagevector"
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
@@ -88,6 +92,16 @@ func (b *Botanist) OperatingSystemConfigValues() (*operatingsystemconfig.Values,
 		region = new(b.Shoot.GetInfo().Spec.Region)
 	}
 
+	var imageVerification *extensionsv1alpha1.ImageVerification
+	if b.Config != nil && b.Config.NodeAgent != nil && b.Config.NodeAgent.ImageVerification != nil {
+		imageVerification = &extensionsv1alpha1.ImageVerification{PublicKeys: b.Config.NodeAgent.ImageVerification.PublicKeys}
+	}
+
+	var nodeAgentDriftDetectionMode *nodeagentconfigv1alpha1.DriftDetectionMode
+	if b.Config != nil && b.Config.NodeAgent != nil && b.Config.NodeAgent.DriftDetectionMode != nil {
+		nodeAgentDriftDetectionMode = new(nodeagentconfigv1alpha1.DriftDetectionMode(*b.Config.NodeAgent.DriftDetectionMode))
+	}
+
 	return &operatingsystemconfig.Values{
 		Namespace:         b.Shoot.ControlPlaneNamespace,
 		KubernetesVersion: b.Shoot.KubernetesVersion,
@@ -108,6 +122,8 @@ func (b *Botanist) OperatingSystemConfigValues() (*operatingsystemconfig.Values,
 			PrimaryIPFamily:                         b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
 			KubeProxyConfig:                         b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy,
 			Region:                                  region,
+			ImageVerification:                       imageVerification,
+			NodeAgentDriftDetectionMode:             nodeAgentDriftDetectionMode,
 		},
 	}, nil
 }
@@ -298,6 +314,12 @@ func (b *Botanist) generateOperatingSystemConfigSecretForWorker(
 		return nil, fmt.Errorf("failed computing the OperatingSystemConfig secret for gardener-node-agent for pool %q: %w", worker.Name, err)
 	}
 
+	if b.holdOperatingSystemConfigChanges(worker) {
+		maintenanceTimeWindow := b.Shoot.GetInfo().Spec.Maintenance.TimeWindow
+		metav1.SetMetaDataAnnotation(&oscSecret.ObjectMeta, v1beta1constants.AnnotationNodeAgentHoldOSCChanges, "true")
+		metav1.SetMetaDataAnnotation(&oscSecret.ObjectMeta, v1beta1constants.AnnotationShootMaintenanceWindow, maintenanceTimeWindow.Begin+","+maintenanceTimeWindow.End)
+	}
+
 	resources, err := managedresources.
 		NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer).
 		AddAllAndSerialize(oscSecret)
@@ -307,3 +329,36 @@ func (b *Botanist) generateOperatingSystemConfigSecretForWorker(
 
 	return resources, nil
 }
+
+// holdOperatingSystemConfigChanges returns whether gardener-node-agent shall hold back changes of the operating system
+// config of the given worker pool until the next maintenance time window. This is requested per worker pool via the
+// MaintenanceTimeWindow operating system config update policy. Changes are never held for worker pools with in-place
+// update strategy (which are orchestrated by machine-controller-manager) and for security-critical changes, i.e., while
+// the certificate authorities, the service account signing key, or the SSH keypair are rotated.
+func (b *Botanist) holdOperatingSystemConfigChanges(worker gardencorev1beta1.Worker) bool {
+	shoot := b.Shoot.GetInfo()
+
+	if shoot.Spec.Maintenance == nil || shoot.Spec.Maintenance.TimeWindow == nil {
+		return false
+	}
+
+	if ptr.Deref(worker.OperatingSystemConfigUpdatePolicy, "") != gardencorev1beta1.OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow {
+		return false
+	}
+
+	if v1beta1helper.IsUpdateStrategyInPlace(worker.UpdateStrategy) {
+		return false
+	}
+
+	return !operatingSystemConfigChangesCritical(shoot.Status.Credentials)
+}
+
+func operatingSystemConfigChangesCritical(credentials *gardencorev1beta1.ShootCredentials) bool {
+	rotationInProgress := func(phase gardencorev1beta1.CredentialsRotationPhase) bool {
+		return len(phase) > 0 && phase != gardencorev1beta1.RotationPrepared && phase != gardencorev1beta1.RotationCompleted
+	}
+
+	return rotationInProgress(v1beta1helper.GetShootCARotationPhase(credentials)) ||
+		rotationInProgress(v1beta1helper.GetShootServiceAccountKeyRotationPhase(credentials)) ||
+		v1beta1helper.IsShootSSHKeypairRotationInitiationTimeAfterLastCompletionTime(credentials)
+}
@@ -435,6 +435,74 @@ var _ = Describe("operatingsystemconfig", func() {
 					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(oldSecret1), oldSecret1)).To(BeNotFoundError())
 					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(oldSecret2), oldSecret2)).To(BeNotFoundError())
 				})
+
+				Context("holding operating system config changes until the maintenance time window", func() {
+					var (
+						versions = schema.GroupVersions([]schema.GroupVersion{corev1.SchemeGroupVersion})
+						codec    = kubernetes.ShootCodec.CodecForVersions(kubernetes.ShootSerializer, kubernetes.ShootSerializer, versions, versions)
+					)
+
+					expectOSCSecretForWorker := func(workerName, secretName string, annotations map[string]string) {
+						expectedOSCSecret, err := NodeAgentOSCSecretFn(ctx, fakeClient, workerNameToOperatingSystemConfigMaps[workerName].Original.Object, secretName, workerName, true)
+						Expect(err).NotTo(HaveOccurred())
+						for k, v := range annotations {
+							metav1.SetMetaDataAnnotation(&expectedOSCSecret.ObjectMeta, k, v)
+						}
+						expectedOSCSecretRaw, err := runtime.Encode(codec, expectedOSCSecret)
+						Expect(err).NotTo(HaveOccurred())
+						compressedOSCSecretRaw, err := test.BrotliCompression(expectedOSCSecretRaw)
+						Expect(err).NotTo(HaveOccurred())
+
+						expectedMRSecret := &corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{Name: "managedresource-shoot-gardener-node-agent-" + workerName, Namespace: namespace},
+							Data:       map[string][]byte{"data.yaml.br": compressedOSCSecretRaw},
+						}
+						utilruntime.Must(kubernetesutils.MakeUnique(expectedMRSecret))
+
+						mrSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: expectedMRSecret.Name, Namespace: expectedMRSecret.Namespace}}
+						Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(mrSecret), mrSecret)).To(Succeed())
+						Expect(mrSecret.Data).To(Equal(expectedMRSecret.Data))
+					}
+
+					JustBeforeEach(func() {
+						shoot := botanist.Shoot.GetInfo()
+						shoot.Spec.Provider.Workers[0].OperatingSystemConfigUpdatePolicy = new(gardencorev1beta1.OperatingSystemConfigUpdatePolicyMaintenanceTimeWindow)
+						shoot.Spec.Maintenance = &gardencorev1beta1.Maintenance{TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"}}
+						botanist.Shoot.SetInfo(shoot)
+					})
+
+					It("should annotate the secrets of the configured worker pools", func() {
+						Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(Succeed())
+
+						expectOSCSecretForWorker(worker1Name, worker1Key, map[string]string{
+							"reconciliation.osc.node-agent.gardener.cloud/hold-until-maintenance-window": "true",
+							"shoot.gardener.cloud/maintenance-window":                                    "220000+0100,230000+0100",
+						})
+						expectOSCSecretForWorker(worker2Name, worker2Key, nil)
+					})
+
+					It("should not annotate the secrets while the certificate authorities are rotated", func() {
+						shoot := botanist.Shoot.GetInfo()
+						shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+							CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPreparing},
+						}}
+						botanist.Shoot.SetInfo(shoot)
+
+						Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(Succeed())
+
+						expectOSCSecretForWorker(worker1Name, worker1Key, nil)
+					})
+
+					It("should not annotate the secrets of worker pools with in-place update strategy", func() {
+						shoot := botanist.Shoot.GetInfo()
+						shoot.Spec.Provider.Workers[0].UpdateStrategy = new(gardencorev1beta1.AutoInPlaceUpdate)
+						botanist.Shoot.SetInfo(shoot)
+
+						Expect(botanist.DeployManagedResourceForGardenerNodeAgent(ctx)).To(Succeed())
+
+						expectOSCSecretForWorker(worker1Name, worker1Key, nil)
+					})
+				})
 			})
 		})
 	})
@@ -6,8 +6,12 @@ package botanist
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"os"
 	"regexp"
 	"time"
 
@@ -17,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/gardenlet/v1alpha1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -30,6 +35,7 @@ import (
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/secrets/vault"
 	"github.com/gardener/gardener/pkg/utils/workloadidentity"
 )
 
@@ -566,3 +572,66 @@ func (b *Botanist) DeployCloudProviderSecret(ctx context.Context) error {
 	})
 	return err
 }
+
+// KeyBackendOption returns the option configuring the given key backend for the secrets manager of the shoot. The
+// private keys of the client and kubelet CAs are read from the secrets by kube-controller-manager for signing CSRs, and
+// the one of the client CA additionally by gardener-apiserver for issuing shoot kubeconfigs. Hence, they are not kept
+// in the key backend.
+func KeyBackendOption(keyBackend secretsutils.KeyBackend) secretsmanager.NewOption {
+	return secretsmanager.WithKeyBackend(keyBackend, v1beta1constants.SecretNameCAClient, v1beta1constants.SecretNameCAKubelet)
+}
+
+// DeleteCAKeysFromKeyBackend deletes the private keys of all CAs of the shoot from the key backend (if configured).
+// It must only be called when the shoot is deleted, since the keys are still needed after a control plane migration.
+func (b *Botanist) DeleteCAKeysFromKeyBackend(ctx context.Context) error {
+	if b.KeyBackend == nil {
+		return nil
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := b.SeedClientSet.Client().List(ctx, secretList, client.InNamespace(b.Shoot.ControlPlaneNamespace), client.MatchingLabels{
+		secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager,
+	}); err != nil {
+		return err
+	}
+
+	for _, secret := range secretList.Items {
+		if keyRef := secret.Data[secretsutils.DataKeyPrivateKeyReferenceCA]; len(keyRef) > 0 {
+			if err := b.KeyBackend.DeleteKey(ctx, string(keyRef)); err != nil {
+				return fmt.Errorf("failed deleting private key of secret %s from key backend: %w", client.ObjectKeyFromObject(&secret), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func newKeyBackend(config *gardenletconfigv1alpha1.KeyBackendConfiguration) (secretsutils.KeyBackend, error) {
+	if config == nil || config.VaultTransit == nil {
+		return nil, nil
+	}
+
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	if caFile := config.VaultTransit.CAFile; caFile != nil {
+		caBundle, err := os.ReadFile(*caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading CA file: %w", err)
+		}
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("no certificates found in CA file %s", *caFile)
+		}
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+		httpClient.Transport = transport
+	}
+
+	return vault.NewTransitKeyBackend(vault.TransitConfig{
+		Address:    config.VaultTransit.Address,
+		MountPath:  ptr.Deref(config.VaultTransit.MountPath, ""),
+		Namespace:  ptr.Deref(config.VaultTransit.Namespace, ""),
+		TokenFile:  config.VaultTransit.TokenFile,
+		HTTPClient: httpClient,
+	})
+}
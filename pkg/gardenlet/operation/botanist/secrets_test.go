@@ -6,6 +6,7 @@ package botanist_test
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
 
 	"github.com/go-logr/logr"
@@ -17,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -29,7 +31,10 @@ import (
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils"
 	shootstate "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	fakesecretsutils "github.com/gardener/gardener/pkg/utils/secrets/fake"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -302,6 +307,50 @@ var _ = Describe("Secrets", func() {
 				Expect(internalSecret.Data).To(And(HaveKey("ca.crt"), HaveKey("ca.key")))
 			})
 
+			It("should generate the CAs with the configured key algorithm", func() {
+				secretsManager, err := secretsmanager.New(ctx, logr.Discard(), clock.RealClock{}, seedClient, "test", secretsmanager.WithNamespaces(controlPlaneNamespace), secretsmanager.WithKeyAlgorithm(secretsutils.KeyAlgorithmECDSAP256))
+				Expect(err).NotTo(HaveOccurred())
+				botanist.SecretsManager = secretsManager
+
+				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+
+				for _, name := range caSecretNames {
+					secretList := &corev1.SecretList{}
+					Expect(seedClient.List(ctx, secretList, client.InNamespace(controlPlaneNamespace), client.MatchingLabels{"name": name})).To(Succeed())
+					Expect(secretList.Items).To(HaveLen(1), name)
+
+					certificate, err := utils.DecodeCertificate(secretList.Items[0].Data["ca.crt"])
+					Expect(err).NotTo(HaveOccurred())
+					Expect(certificate.PublicKeyAlgorithm).To(Equal(x509.ECDSA), name)
+				}
+			})
+
+			It("should only keep the private keys of CAs which are not read by other components in the key backend", func() {
+				keyBackend := fakesecretsutils.NewKeyBackend()
+				secretsManager, err := secretsmanager.New(ctx, logr.Discard(), clock.RealClock{}, seedClient, "test", secretsmanager.WithNamespaces(controlPlaneNamespace), KeyBackendOption(keyBackend))
+				Expect(err).NotTo(HaveOccurred())
+				botanist.SecretsManager, botanist.KeyBackend = secretsManager, keyBackend
+
+				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+
+				for _, name := range caSecretNames {
+					secretList := &corev1.SecretList{}
+					Expect(seedClient.List(ctx, secretList, client.InNamespace(controlPlaneNamespace), client.MatchingLabels{"name": name})).To(Succeed())
+					Expect(secretList.Items).To(HaveLen(1), name)
+
+					if name == "ca-client" || name == "ca-kubelet" {
+						Expect(secretList.Items[0].Data).To(And(HaveKey("ca.key"), Not(HaveKey("ca.key-ref"))), name)
+					} else {
+						Expect(secretList.Items[0].Data).To(And(HaveKey("ca.key-ref"), Not(HaveKey("ca.key"))), name)
+					}
+				}
+				Expect(keyBackend.KeyReferences()).NotTo(BeEmpty())
+
+				By("Delete private keys from key backend")
+				Expect(botanist.DeleteCAKeysFromKeyBackend(ctx)).To(Succeed())
+				Expect(keyBackend.KeyReferences()).To(BeEmpty())
+			})
+
 			It("should generate the generic token kubeconfig", func() {
 				Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
 
@@ -166,6 +166,11 @@ func OperatingSystemConfigUpdatedForAllWorkerPools(
 				continue
 			}
 
+			// Skip nodes which intentionally hold back the changes until the next maintenance time window.
+			if nodeHoldsOperatingSystemConfigChanges(node, secretMeta) {
+				continue
+			}
+
 			if nodeChecksum, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]; !ok {
 				result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q hasn't been reported yet", node.Name))
 			} else if nodeChecksum != secretChecksum {
@@ -177,6 +182,48 @@ func OperatingSystemConfigUpdatedForAllWorkerPools(
 	return result
 }
 
+// NodesWithHeldOperatingSystemConfigChanges returns the names of all nodes per worker pool which hold back changes of
+// their operating system config until the next maintenance time window.
+func NodesWithHeldOperatingSystemConfigChanges(
+	workers []gardencorev1beta1.Worker,
+	workerPoolToNodes map[string][]corev1.Node,
+	workerPoolToOperatingSystemConfigSecretMeta map[string]metav1.ObjectMeta,
+) map[string][]string {
+	result := make(map[string][]string)
+
+	for _, worker := range workers {
+		secretMeta, ok := workerPoolToOperatingSystemConfigSecretMeta[worker.Name]
+		if !ok {
+			continue
+		}
+
+		for _, node := range workerPoolToNodes[worker.Name] {
+			if nodeHoldsOperatingSystemConfigChanges(node, secretMeta) {
+				result[worker.Name] = append(result[worker.Name], node.Name)
+			}
+		}
+	}
+
+	return result
+}
+
+// nodeHoldsOperatingSystemConfigChanges returns true if the gardener-node-agent Secret requests to hold back changes
+// until the next maintenance time window and the node reports that it does so for the current operating system config.
+func nodeHoldsOperatingSystemConfigChanges(node corev1.Node, secretMeta metav1.ObjectMeta) bool {
+	if secretMeta.Annotations[v1beta1constants.AnnotationNodeAgentHoldOSCChanges] != "true" ||
+		node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == "" {
+		return false
+	}
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == nodeagentconfigv1alpha1.ConditionTypeOperatingSystemConfigApplied {
+			return condition.Status == corev1.ConditionFalse && condition.Reason == nodeagentconfigv1alpha1.ConditionReasonOperatingSystemConfigChangesHeld
+		}
+	}
+
+	return false
+}
+
 func nodeToBeDeleted(node corev1.Node, gardenerNodeAgentSecretName string) bool {
 	if nodeTaintedForNoSchedule(node) {
 		return true
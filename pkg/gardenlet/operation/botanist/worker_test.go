@@ -492,6 +492,45 @@ var _ = Describe("Worker", func() {
 			}},
 			MatchError(ContainSubstring("is outdated")),
 		),
+		Entry("skip node which holds the changes until the maintenance time window",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}},
+			map[string][]corev1.Node{"pool1": {{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"checksum/cloud-config-data": "outdated"},
+					Labels: map[string]string{
+						"worker.gardener.cloud/kubernetes-version":              "1.24.0",
+						"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0",
+					},
+				},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: "OperatingSystemConfigApplied", Status: corev1.ConditionFalse, Reason: "OSCChangesHeld"}}},
+			}}},
+			map[string]metav1.ObjectMeta{"pool1": {
+				Name: "gardener-node-agent--c63c0",
+				Annotations: map[string]string{
+					"checksum/data-script": "foo",
+					"reconciliation.osc.node-agent.gardener.cloud/hold-until-maintenance-window": "true",
+				},
+			}},
+			BeNil(),
+		),
+		Entry("do not skip node which holds the changes although the secret does not request it anymore",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}},
+			map[string][]corev1.Node{"pool1": {{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"checksum/cloud-config-data": "outdated"},
+					Labels: map[string]string{
+						"worker.gardener.cloud/kubernetes-version":              "1.24.0",
+						"worker.gardener.cloud/gardener-node-agent-secret-name": "gardener-node-agent--c63c0",
+					},
+				},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: "OperatingSystemConfigApplied", Status: corev1.ConditionFalse, Reason: "OSCChangesHeld"}}},
+			}}},
+			map[string]metav1.ObjectMeta{"pool1": {
+				Name:        "gardener-node-agent--c63c0",
+				Annotations: map[string]string{"checksum/data-script": "foo"},
+			}},
+			MatchError(ContainSubstring("is outdated")),
+		),
 
 		Entry("everything up-to-date",
 			[]gardencorev1beta1.Worker{{Name: "pool1"}, {Name: "pool2"}},
@@ -525,6 +564,33 @@ var _ = Describe("Worker", func() {
 		),
 	)
 
+	Describe("#NodesWithHeldOperatingSystemConfigChanges", func() {
+		It("should return the nodes holding back changes per worker pool", func() {
+			var (
+				heldCondition = corev1.NodeCondition{Type: "OperatingSystemConfigApplied", Status: corev1.ConditionFalse, Reason: "OSCChangesHeld"}
+				appliedNode   = func(name string, conditions ...corev1.NodeCondition) corev1.Node {
+					return corev1.Node{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: map[string]string{"checksum/cloud-config-data": "old"}},
+						Status:     corev1.NodeStatus{Conditions: conditions},
+					}
+				}
+			)
+
+			Expect(NodesWithHeldOperatingSystemConfigChanges(
+				[]gardencorev1beta1.Worker{{Name: "pool1"}, {Name: "pool2"}, {Name: "pool3"}},
+				map[string][]corev1.Node{
+					"pool1": {appliedNode("node1", heldCondition), appliedNode("node2")},
+					"pool2": {appliedNode("node3", heldCondition)},
+					"pool3": {appliedNode("node4", heldCondition)},
+				},
+				map[string]metav1.ObjectMeta{
+					"pool1": {Annotations: map[string]string{"reconciliation.osc.node-agent.gardener.cloud/hold-until-maintenance-window": "true"}},
+					"pool2": {},
+				},
+			)).To(Equal(map[string][]string{"pool1": {"node1"}}))
+		})
+	})
+
 	Describe("#WaitUntilOperatingSystemConfigUpdatedForAllWorkerPools", func() {
 		var (
 			seedFakeClient  client.Client
@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -45,6 +46,8 @@ type Operation struct {
 	secrets        map[string]*corev1.Secret
 	secretsMutex   sync.RWMutex
 	SecretsManager secretsmanager.Interface
+	// KeyBackend is the external backend managing the private keys of CAs. It is nil if no backend is configured.
+	KeyBackend secretsutils.KeyBackend
 
 	Clock                 clock.Clock
 	Config                *gardenletconfigv1alpha1.GardenletConfiguration
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeagent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UpdateNodeCondition sets the given condition on the given Node and patches its status. The heartbeat time is set to
+// the current time, the transition time is only updated if the status of the condition changes.
+func UpdateNodeCondition(ctx context.Context, c client.Client, clock clock.Clock, node *corev1.Node, newCondition corev1.NodeCondition) error {
+	var (
+		patch = client.MergeFrom(node.DeepCopy())
+		now   = metav1.NewTime(clock.Now())
+	)
+
+	existingIdx := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool {
+		return c.Type == newCondition.Type
+	})
+
+	if existingIdx >= 0 && node.Status.Conditions[existingIdx].Status == newCondition.Status {
+		newCondition.LastTransitionTime = node.Status.Conditions[existingIdx].LastTransitionTime
+	} else {
+		newCondition.LastTransitionTime = now
+	}
+	newCondition.LastHeartbeatTime = now
+
+	if existingIdx >= 0 {
+		node.Status.Conditions[existingIdx] = newCondition
+	} else {
+		node.Status.Conditions = append(node.Status.Conditions, newCondition)
+	}
+
+	if err := c.Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching node status with %s condition: %w", newCondition.Type, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodeagent_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/pkg/nodeagent"
+)
+
+var _ = Describe("Condition", func() {
+	Describe("#UpdateNodeCondition", func() {
+		var (
+			ctx        = context.Background()
+			fakeClient client.Client
+			fakeClock  *testclock.FakeClock
+
+			node *corev1.Node
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithStatusSubresource(&corev1.Node{}).Build()
+			fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		})
+
+		It("should add the condition", func() {
+			Expect(UpdateNodeCondition(ctx, fakeClient, fakeClock, node, corev1.NodeCondition{Type: "Foo", Status: corev1.ConditionTrue, Reason: "Bar", Message: "baz"})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(HaveLen(1))
+			Expect(node.Status.Conditions[0].Type).To(Equal(corev1.NodeConditionType("Foo")))
+			Expect(node.Status.Conditions[0].Status).To(Equal(corev1.ConditionTrue))
+			Expect(node.Status.Conditions[0].Reason).To(Equal("Bar"))
+			Expect(node.Status.Conditions[0].Message).To(Equal("baz"))
+			Expect(node.Status.Conditions[0].LastHeartbeatTime.Time).To(BeTemporally("==", fakeClock.Now()))
+			Expect(node.Status.Conditions[0].LastTransitionTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		})
+
+		It("should only update the transition time if the status changes", func() {
+			transitionTime := metav1.NewTime(fakeClock.Now())
+			Expect(UpdateNodeCondition(ctx, fakeClient, fakeClock, node, corev1.NodeCondition{Type: "Foo", Status: corev1.ConditionTrue})).To(Succeed())
+
+			fakeClock.Step(time.Minute)
+			Expect(UpdateNodeCondition(ctx, fakeClient, fakeClock, node, corev1.NodeCondition{Type: "Foo", Status: corev1.ConditionTrue, Reason: "Other"})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(HaveLen(1))
+			Expect(node.Status.Conditions[0].Reason).To(Equal("Other"))
+			Expect(node.Status.Conditions[0].LastHeartbeatTime.Time).To(BeTemporally("==", fakeClock.Now()))
+			Expect(node.Status.Conditions[0].LastTransitionTime.Time).To(BeTemporally("==", transitionTime.Time))
+
+			fakeClock.Step(time.Minute)
+			Expect(UpdateNodeCondition(ctx, fakeClient, fakeClock, node, corev1.NodeCondition{Type: "Foo", Status: corev1.ConditionFalse})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(HaveLen(1))
+			Expect(node.Status.Conditions[0].LastTransitionTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		})
+	})
+})
@@ -7,9 +7,11 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -17,6 +19,7 @@ import (
 	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/containerd"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/certificate"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/driftdetection"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/hostnamecheck"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/lease"
@@ -50,7 +53,11 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		return fmt.Errorf("failed obtaining containerd client: %w", err)
 	}
 
-	var channel = make(chan event.TypedGenericEvent[*corev1.Secret])
+	var (
+		channel            = make(chan event.TypedGenericEvent[*corev1.Secret])
+		applyLock          = &sync.Mutex{}
+		healthCheckResults = healthcheck.NewResults(clock.RealClock{})
+	)
 
 	if err := (&operatingsystemconfig.Reconciler{
 		Config:                 cfg.Controllers.OperatingSystemConfig,
@@ -62,6 +69,8 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		MachineName:            machineName,
 		CancelContext:          cancel,
 		ContainerdClient:       containerdClient,
+		ApplyLock:              applyLock,
+		HealthCheckResults:     healthCheckResults,
 	}).AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed adding operating system config controller: %w", err)
 	}
@@ -80,7 +89,9 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		}
 	}
 
-	if err := (&healthcheck.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+	if err := (&healthcheck.Reconciler{
+		Results: healthCheckResults,
+	}).AddToManager(mgr, nodePredicate); err != nil {
 		return fmt.Errorf("failed adding health-check controller: %w", err)
 	}
 
@@ -90,6 +101,15 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		return fmt.Errorf("failed adding systemd-unit-check controller: %w", err)
 	}
 
+	if err := (&driftdetection.Reconciler{
+		Config:        cfg.Controllers.DriftDetection,
+		HostName:      hostName,
+		ApplyLock:     applyLock,
+		CancelContext: cancel,
+	}).AddToManager(mgr, nodePredicate); err != nil {
+		return fmt.Errorf("failed adding drift-detection controller: %w", err)
+	}
+
 	if err := (&hostnamecheck.Reconciler{
 		HostName:      hostName,
 		CancelContext: cancel,
@@ -0,0 +1,409 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driftdetection
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	filespkg "github.com/gardener/gardener/pkg/nodeagent/files"
+	nodeagentmetrics "github.com/gardener/gardener/pkg/nodeagent/metrics"
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	reasonNoDrift       = "NoDrift"
+	reasonDriftDetected = "DriftDetected"
+	reasonDriftRestored = "DriftRestored"
+
+	kindFile = "file"
+	kindUnit = "unit"
+)
+
+// Reconciler periodically verifies the checksums of all files and units which have been written for the last applied
+// OperatingSystemConfig and reports drift via a condition on the Node. Depending on the configured mode, drifted files
+// and units are restored to the desired state.
+type Reconciler struct {
+	Client    client.Client
+	APIReader client.Reader
+	DBus      dbus.DBus
+	Clock     clock.Clock
+	FS        afero.Afero
+	Extractor registry.Extractor
+	Verifier  registry.Verifier
+	Recorder  events.EventRecorder
+	Config    nodeagentconfigv1alpha1.DriftDetectionControllerConfig
+	HostName  string
+	// ApplyLock is shared with the operating system config controller. Drift is not checked while an
+	// OperatingSystemConfig is being applied.
+	ApplyLock     *sync.Mutex
+	CancelContext context.CancelFunc
+}
+
+// Reconcile checks the files and units managed by gardener-node-agent for drift and updates the Node condition.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, request.NamespacedName, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if r.ApplyLock != nil {
+		if !r.ApplyLock.TryLock() {
+			log.V(1).Info("Operating system config is currently being applied, skipping drift detection")
+			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+		}
+		defer r.ApplyLock.Unlock()
+	}
+
+	appliedFiles, err := operatingsystemconfig.ReadAppliedFiles(r.FS)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading applied files: %w", err)
+	}
+	if appliedFiles == nil {
+		log.V(1).Info("No applied files found, skipping drift detection")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	drifted, err := r.detectDrift(appliedFiles)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed detecting drift: %w", err)
+	}
+
+	if len(drifted) > 0 && ptr.Deref(r.Config.Mode, nodeagentconfigv1alpha1.DriftDetectionModeReport) == nodeagentconfigv1alpha1.DriftDetectionModeRestore {
+		log.Info("Detected drift, restoring desired state", "drift", driftMessages(drifted))
+		if err := r.restore(ctx, log, node, drifted); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed restoring drifted files: %w", err)
+		}
+
+		restored := drifted
+		if drifted, err = r.detectDrift(appliedFiles); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed detecting drift after restoration: %w", err)
+		}
+
+		if len(drifted) == 0 {
+			setDriftedFilesMetric(nil)
+			if err := r.updateNodeCondition(ctx, node, corev1.ConditionTrue, reasonDriftRestored, "Restored drifted files: "+strings.Join(driftMessages(restored), "; ")); err != nil {
+				return reconcile.Result{}, err
+			}
+			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+		}
+	}
+
+	setDriftedFilesMetric(drifted)
+
+	if len(drifted) == 0 {
+		if err := r.updateNodeCondition(ctx, node, corev1.ConditionTrue, reasonNoDrift, "All files and units managed by gardener-node-agent match the applied operating system config."); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	message := "Drifted files: " + strings.Join(driftMessages(drifted), "; ")
+	log.Info("Detected drift of files managed by gardener-node-agent", "drift", driftMessages(drifted))
+
+	// Only emit an event when the drift is detected for the first time to not spam events with every sync.
+	if condition := nodeCondition(node); condition == nil || condition.Status != corev1.ConditionFalse {
+		r.Recorder.Eventf(node, nil, corev1.EventTypeWarning, reasonDriftDetected, gardencorev1beta1.EventActionReconcile, "%s", message)
+	}
+
+	if err := r.updateNodeCondition(ctx, node, corev1.ConditionFalse, reasonDriftDetected, message); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+type driftedFile struct {
+	operatingsystemconfig.AppliedFile
+	reason string
+}
+
+func (f driftedFile) kind() string {
+	if f.UnitName != "" {
+		return kindUnit
+	}
+	return kindFile
+}
+
+// detectDrift compares the content and the permissions of all applied files with the persisted checksums.
+func (r *Reconciler) detectDrift(appliedFiles *operatingsystemconfig.AppliedFiles) ([]driftedFile, error) {
+	var drifted []driftedFile
+
+	for _, file := range appliedFiles.Files {
+		info, err := r.FS.Stat(file.Path)
+		if err != nil {
+			if errors.Is(err, afero.ErrFileNotFound) {
+				drifted = append(drifted, driftedFile{AppliedFile: file, reason: "missing"})
+				continue
+			}
+			return nil, fmt.Errorf("unable to stat file %q: %w", file.Path, err)
+		}
+
+		data, err := r.FS.ReadFile(file.Path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file %q: %w", file.Path, err)
+		}
+
+		switch {
+		case utils.ComputeSHA256Hex(data) != file.SHA256:
+			drifted = append(drifted, driftedFile{AppliedFile: file, reason: "content changed"})
+		case info.Mode().Perm() != file.Permissions.Perm():
+			drifted = append(drifted, driftedFile{AppliedFile: file, reason: fmt.Sprintf("permissions changed from %04o to %04o", file.Permissions.Perm(), info.Mode().Perm())})
+		}
+	}
+
+	return drifted, nil
+}
+
+// restore writes the desired content of all drifted files, reloads the systemd daemon if unit files or drop-ins were
+// restored, and restarts all units which are affected by the restored files.
+func (r *Reconciler) restore(ctx context.Context, log logr.Logger, node *corev1.Node, drifted []driftedFile) error {
+	osc, err := r.readLastAppliedOperatingSystemConfig()
+	if err != nil {
+		return err
+	}
+
+	var (
+		files = operatingsystemconfig.CollectAllFiles(osc, r.HostName)
+		units = operatingsystemconfig.MergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits)
+
+		unitNamesToRestart = sets.New[string]()
+		mustReloadDaemon   bool
+	)
+
+	publicKeys, err := operatingsystemconfig.TrustedPublicKeys(osc)
+	if err != nil {
+		return err
+	}
+
+	tmpDir, err := r.FS.TempDir(nodeagentconfigv1alpha1.TempDir, "drift-restoration-")
+	if err != nil {
+		return fmt.Errorf("unable to create temporary directory: %w", err)
+	}
+
+	defer func() { utilruntime.HandleError(r.FS.RemoveAll(tmpDir)) }()
+
+	for _, file := range drifted {
+		if err := r.restoreFile(ctx, tmpDir, files, units, publicKeys, file); err != nil {
+			return fmt.Errorf("unable to restore file %q: %w", file.Path, err)
+		}
+		log.Info("Restored drifted file", "path", file.Path, "reason", file.reason)
+		nodeagentmetrics.DriftRestorationsTotal.WithLabelValues(file.kind()).Inc()
+
+		if file.UnitName != "" {
+			mustReloadDaemon = true
+			unitNamesToRestart.Insert(file.UnitName)
+			continue
+		}
+
+		for _, unit := range units {
+			if slices.Contains(unit.FilePaths, file.Path) {
+				unitNamesToRestart.Insert(unit.Name)
+			}
+		}
+	}
+
+	if mustReloadDaemon {
+		if err := r.DBus.DaemonReload(ctx); err != nil {
+			return fmt.Errorf("unable to reload systemd daemon: %w", err)
+		}
+	}
+
+	var mustRestartNodeAgent bool
+	for _, unitName := range sets.List(unitNamesToRestart) {
+		idx := slices.IndexFunc(units, func(unit extensionsv1alpha1.Unit) bool { return unit.Name == unitName })
+		if idx < 0 || !ptr.Deref(units[idx].Enable, true) || ptr.Deref(units[idx].Command, "") == extensionsv1alpha1.CommandStop {
+			continue
+		}
+
+		if unitName == nodeagentconfigv1alpha1.UnitName {
+			mustRestartNodeAgent = true
+			continue
+		}
+
+		if err := r.DBus.Restart(ctx, r.Recorder, node, unitName); err != nil {
+			return fmt.Errorf("unable to restart unit %q: %w", unitName, err)
+		}
+		log.Info("Successfully restarted unit", "unitName", unitName)
+	}
+
+	r.Recorder.Eventf(node, nil, corev1.EventTypeNormal, reasonDriftRestored, gardencorev1beta1.EventActionReconcile, "Restored drifted files: %s", strings.Join(driftMessages(drifted), "; "))
+
+	if mustRestartNodeAgent {
+		log.Info("Must restart myself (gardener-node-agent unit), canceling the context to initiate graceful shutdown")
+		r.CancelContext()
+	}
+
+	return nil
+}
+
+// restoreFile writes the desired content of the given drifted file to a temporary file first. It is only moved to its
+// final destination if its checksum matches the applied checksum, i.e., restoring never results in content which has
+// not been applied by the operating system config controller before. Images are verified in the same way as by the
+// operating system config controller before files are extracted from them.
+func (r *Reconciler) restoreFile(ctx context.Context, tmpDir string, files []extensionsv1alpha1.File, units []extensionsv1alpha1.Unit, publicKeys []crypto.PublicKey, file driftedFile) error {
+	tmpFilePath := filepath.Join(tmpDir, filepath.Base(file.Path))
+
+	if file.UnitName != "" {
+		data, err := desiredUnitFileContent(units, file)
+		if err != nil {
+			return err
+		}
+		if err := r.FS.WriteFile(tmpFilePath, data, file.Permissions); err != nil {
+			return fmt.Errorf("unable to create temporary file %q: %w", tmpFilePath, err)
+		}
+	} else {
+		idx := slices.IndexFunc(files, func(f extensionsv1alpha1.File) bool { return f.Path == file.Path })
+		if idx < 0 {
+			return fmt.Errorf("file is not part of the last applied operating system config")
+		}
+
+		data, ok, err := operatingsystemconfig.GetFileContentData(ctx, r.APIReader, files[idx])
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case ok:
+			if err := r.FS.WriteFile(tmpFilePath, data, file.Permissions); err != nil {
+				return fmt.Errorf("unable to create temporary file %q: %w", tmpFilePath, err)
+			}
+		case files[idx].Content.ImageRef != nil:
+			imageRef := files[idx].Content.ImageRef
+			verifiedImageRef, _, err := operatingsystemconfig.VerifyImage(ctx, r.Verifier, imageRef, publicKeys)
+			if err != nil {
+				return fmt.Errorf("failed verifying image %q: %w", imageRef.Image, err)
+			}
+			if err := r.Extractor.CopyFromImage(ctx, verifiedImageRef, imageRef.FilePathInImage, tmpFilePath, file.Permissions); err != nil {
+				return fmt.Errorf("unable to copy file %q from image %q: %w", imageRef.FilePathInImage, verifiedImageRef, err)
+			}
+		default:
+			return fmt.Errorf("file has no content")
+		}
+	}
+
+	data, err := r.FS.ReadFile(tmpFilePath)
+	if err != nil {
+		return fmt.Errorf("unable to read temporary file %q: %w", tmpFilePath, err)
+	}
+	if utils.ComputeSHA256Hex(data) != file.SHA256 {
+		return fmt.Errorf("checksum of desired content does not match the applied checksum")
+	}
+
+	if err := r.FS.Chmod(tmpFilePath, file.Permissions); err != nil {
+		return fmt.Errorf("unable to set permissions of temporary file %q: %w", tmpFilePath, err)
+	}
+	if err := r.FS.MkdirAll(filepath.Dir(file.Path), 0755); err != nil {
+		return fmt.Errorf("unable to create directory %q: %w", filepath.Dir(file.Path), err)
+	}
+	if err := filespkg.Move(r.FS, tmpFilePath, file.Path); err != nil {
+		return fmt.Errorf("unable to rename temporary file %q to %q: %w", tmpFilePath, file.Path, err)
+	}
+
+	return nil
+}
+
+func desiredUnitFileContent(units []extensionsv1alpha1.Unit, file driftedFile) ([]byte, error) {
+	idx := slices.IndexFunc(units, func(unit extensionsv1alpha1.Unit) bool { return unit.Name == file.UnitName })
+	if idx < 0 {
+		return nil, fmt.Errorf("unit %q is not part of the last applied operating system config", file.UnitName)
+	}
+	unit := units[idx]
+
+	if path.Base(file.Path) == unit.Name {
+		if unit.Content == nil {
+			return nil, fmt.Errorf("unit %q has no content", unit.Name)
+		}
+		return []byte(*unit.Content), nil
+	}
+
+	dropInIdx := slices.IndexFunc(unit.DropIns, func(dropIn extensionsv1alpha1.DropIn) bool { return dropIn.Name == path.Base(file.Path) })
+	if dropInIdx < 0 {
+		return nil, fmt.Errorf("drop-in %q of unit %q is not part of the last applied operating system config", path.Base(file.Path), unit.Name)
+	}
+	return []byte(unit.DropIns[dropInIdx].Content), nil
+}
+
+func (r *Reconciler) readLastAppliedOperatingSystemConfig() (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	data, err := r.FS.ReadFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read last-applied OSC: %w", err)
+	}
+
+	obj, _, err := nodeagent.OSCDecoder.Decode(data, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode last-applied OSC: %w", err)
+	}
+
+	osc, ok := obj.(*extensionsv1alpha1.OperatingSystemConfig)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type: %T", obj)
+	}
+	return osc, nil
+}
+
+func driftMessages(drifted []driftedFile) []string {
+	messages := make([]string, 0, len(drifted))
+	for _, file := range drifted {
+		messages = append(messages, fmt.Sprintf("%s: %s", file.Path, file.reason))
+	}
+	return messages
+}
+
+func setDriftedFilesMetric(drifted []driftedFile) {
+	counts := map[string]float64{kindFile: 0, kindUnit: 0}
+	for _, file := range drifted {
+		counts[file.kind()]++
+	}
+	for kind, count := range counts {
+		nodeagentmetrics.DriftedFiles.WithLabelValues(kind).Set(count)
+	}
+}
+
+func nodeCondition(node *corev1.Node) *corev1.NodeCondition {
+	for i, condition := range node.Status.Conditions {
+		if condition.Type == nodeagentconfigv1alpha1.ConditionTypeConfigurationDriftFree {
+			return &node.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) updateNodeCondition(ctx context.Context, node *corev1.Node, status corev1.ConditionStatus, reason, message string) error {
+	return nodeagent.UpdateNodeCondition(ctx, r.Client, r.Clock, node, corev1.NodeCondition{Type: nodeagentconfigv1alpha1.ConditionTypeConfigurationDriftFree, Status: status, Reason: reason, Message: message})
+}
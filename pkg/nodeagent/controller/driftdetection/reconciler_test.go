@@ -0,0 +1,332 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driftdetection_test
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/driftdetection"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	nodeagentmetrics "github.com/gardener/gardener/pkg/nodeagent/metrics"
+	fakeregistry "github.com/gardener/gardener/pkg/nodeagent/registry/fake"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          context.Context
+		c            client.Client
+		fs           afero.Afero
+		fakeDBus     *fakedbus.DBus
+		fakeClock    *testclock.FakeClock
+		fakeRecorder *events.FakeRecorder
+		fakeVerifier *fakeregistry.Verifier
+		applyLock    *sync.Mutex
+
+		contextCanceled bool
+		reconciler      *Reconciler
+		node            *corev1.Node
+		request         reconcile.Request
+		syncPeriod      = 5 * time.Minute
+
+		osc *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&corev1.Node{}).Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		fakeRecorder = events.NewFakeRecorder(2)
+		fakeVerifier = fakeregistry.NewVerifier()
+		applyLock = &sync.Mutex{}
+		contextCanceled = false
+
+		reconciler = &Reconciler{
+			Client:    c,
+			APIReader: c,
+			DBus:      fakeDBus,
+			Clock:     fakeClock,
+			FS:        fs,
+			Extractor: fakeregistry.NewExtractor(fs, "/images"),
+			Verifier:  fakeVerifier,
+			Recorder:  fakeRecorder,
+			Config: nodeagentconfigv1alpha1.DriftDetectionControllerConfig{
+				SyncPeriod: &metav1.Duration{Duration: syncPeriod},
+				Mode:       new(nodeagentconfigv1alpha1.DriftDetectionModeReport),
+			},
+			ApplyLock:     applyLock,
+			CancelContext: func() { contextCanceled = true },
+		}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node"}}
+		Expect(c.Create(ctx, node)).To(Succeed())
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)}
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: extensionsv1alpha1.SchemeGroupVersion.String(), Kind: "OperatingSystemConfig"},
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Units: []extensionsv1alpha1.Unit{
+					{Name: "foo.service", Content: new("foo unit"), DropIns: []extensionsv1alpha1.DropIn{{Name: "10-foo.conf", Content: "foo drop-in"}}},
+					{Name: "bar.service", Enable: new(false), Content: new("bar unit"), FilePaths: []string{"/etc/bar"}},
+					{Name: "baz.service", FilePaths: []string{"/etc/baz"}},
+					{Name: nodeagentconfigv1alpha1.UnitName, Content: new("node-agent unit")},
+				},
+				Files: []extensionsv1alpha1.File{
+					{Path: "/etc/bar", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "bar"}}},
+					{Path: "/etc/baz", Permissions: new(uint32(0644)), Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "YmF6", Encoding: "b64"}}},
+					{Path: "/opt/bin/kubelet", Permissions: new(uint32(0755)), Content: extensionsv1alpha1.FileContent{ImageRef: &extensionsv1alpha1.FileContentImageRef{Image: "hyperkube:v1.33.0", FilePathInImage: "/kubelet"}}},
+				},
+			},
+		}
+		oscRaw, err := yaml.Marshal(osc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fs.WriteFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, oscRaw, 0600)).To(Succeed())
+
+		Expect(fs.WriteFile("/etc/bar", []byte("bar"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/baz", []byte("baz"), 0644)).To(Succeed())
+		Expect(fs.WriteFile("/opt/bin/kubelet", []byte("kubelet"), 0755)).To(Succeed())
+		Expect(fs.WriteFile("/images/kubelet", []byte("kubelet"), 0755)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/foo.service", []byte("foo unit"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/foo.service.d/10-foo.conf", []byte("foo drop-in"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/bar.service", []byte("bar unit"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/"+nodeagentconfigv1alpha1.UnitName, []byte("node-agent unit"), 0600)).To(Succeed())
+
+		writeAppliedFiles(fs, map[string]appliedFile{
+			"/etc/bar":                        {"bar", 0600, ""},
+			"/etc/baz":                        {"baz", 0644, ""},
+			"/opt/bin/kubelet":                {"kubelet", 0755, ""},
+			"/etc/systemd/system/foo.service": {"foo unit", 0600, "foo.service"},
+			"/etc/systemd/system/foo.service.d/10-foo.conf":           {"foo drop-in", 0600, "foo.service"},
+			"/etc/systemd/system/bar.service":                         {"bar unit", 0600, "bar.service"},
+			"/etc/systemd/system/" + nodeagentconfigv1alpha1.UnitName: {"node-agent unit", 0600, nodeagentconfigv1alpha1.UnitName},
+		})
+	})
+
+	getCondition := func() *corev1.NodeCondition {
+		ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == nodeagentconfigv1alpha1.ConditionTypeConfigurationDriftFree {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	Describe("#Reconcile", func() {
+		It("should do nothing if there are no applied files", func() {
+			Expect(fs.Remove(nodeagentconfigv1alpha1.AppliedFileChecksumsFilePath)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(getCondition()).To(BeNil())
+		})
+
+		It("should do nothing while an operating system config is being applied", func() {
+			Expect(fs.WriteFile("/etc/bar", []byte("changed"), 0600)).To(Succeed())
+
+			applyLock.Lock()
+			defer applyLock.Unlock()
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			Expect(getCondition()).To(BeNil())
+		})
+
+		It("should report that there is no drift", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(corev1.ConditionTrue),
+				"Reason": Equal("NoDrift"),
+			})))
+			Expect(testutil.ToFloat64(nodeagentmetrics.DriftedFiles.WithLabelValues("file"))).To(BeZero())
+			Expect(testutil.ToFloat64(nodeagentmetrics.DriftedFiles.WithLabelValues("unit"))).To(BeZero())
+			Expect(fakeRecorder.Events).NotTo(Receive())
+		})
+
+		Context("Report mode", func() {
+			BeforeEach(func() {
+				Expect(fs.WriteFile("/etc/bar", []byte("changed"), 0600)).To(Succeed())
+				Expect(fs.Chmod("/etc/baz", 0666)).To(Succeed())
+				Expect(fs.Remove("/etc/systemd/system/foo.service.d/10-foo.conf")).To(Succeed())
+			})
+
+			It("should report the drift without touching the files", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+				Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Status": Equal(corev1.ConditionFalse),
+					"Reason": Equal("DriftDetected"),
+					"Message": And(
+						ContainSubstring("/etc/bar: content changed"),
+						ContainSubstring("/etc/baz: permissions changed from 0644 to 0666"),
+						ContainSubstring("/etc/systemd/system/foo.service.d/10-foo.conf: missing"),
+					),
+				})))
+				Expect(testutil.ToFloat64(nodeagentmetrics.DriftedFiles.WithLabelValues("file"))).To(Equal(float64(2)))
+				Expect(testutil.ToFloat64(nodeagentmetrics.DriftedFiles.WithLabelValues("unit"))).To(Equal(float64(1)))
+				Expect(fakeRecorder.Events).To(Receive(ContainSubstring("Warning DriftDetected")))
+
+				test.AssertFileOnDisk(fs, "/etc/bar", "changed", 0600)
+				test.AssertNoFileOnDisk(fs, "/etc/systemd/system/foo.service.d/10-foo.conf")
+				Expect(fakeDBus.Actions).To(BeEmpty())
+			})
+
+			It("should not emit another event if the drift has already been reported", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+				Expect(fakeRecorder.Events).To(Receive())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+				Expect(fakeRecorder.Events).NotTo(Receive())
+			})
+		})
+
+		Context("Restore mode", func() {
+			BeforeEach(func() {
+				reconciler.Config.Mode = new(nodeagentconfigv1alpha1.DriftDetectionModeRestore)
+			})
+
+			It("should restore drifted files and units and restart the affected units", func() {
+				Expect(fs.WriteFile("/etc/baz", []byte("changed"), 0600)).To(Succeed())
+				Expect(fs.Remove("/etc/systemd/system/foo.service.d/10-foo.conf")).To(Succeed())
+				Expect(fs.WriteFile("/etc/systemd/system/foo.service", []byte("changed"), 0600)).To(Succeed())
+				restoredBefore := testutil.ToFloat64(nodeagentmetrics.DriftRestorationsTotal.WithLabelValues("unit"))
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+				test.AssertFileOnDisk(fs, "/etc/baz", "baz", 0644)
+				test.AssertFileOnDisk(fs, "/etc/systemd/system/foo.service", "foo unit", 0600)
+				test.AssertFileOnDisk(fs, "/etc/systemd/system/foo.service.d/10-foo.conf", "foo drop-in", 0600)
+
+				Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+					{Action: fakedbus.ActionDaemonReload},
+					{Action: fakedbus.ActionRestart, UnitNames: []string{"baz.service"}},
+					{Action: fakedbus.ActionRestart, UnitNames: []string{"foo.service"}},
+				}))
+				Expect(contextCanceled).To(BeFalse())
+
+				Expect(getCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Status":  Equal(corev1.ConditionTrue),
+					"Reason":  Equal("DriftRestored"),
+					"Message": ContainSubstring("/etc/baz: content changed"),
+				})))
+				Expect(testutil.ToFloat64(nodeagentmetrics.DriftedFiles.WithLabelValues("unit"))).To(BeZero())
+				Expect(testutil.ToFloat64(nodeagentmetrics.DriftRestorationsTotal.WithLabelValues("unit"))).To(Equal(restoredBefore + 2))
+				Expect(fakeRecorder.Events).To(Receive(ContainSubstring("Normal DriftRestored")))
+			})
+
+			It("should restore permissions and not restart disabled units", func() {
+				Expect(fs.Chmod("/etc/bar", 0644)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+				test.AssertFileOnDisk(fs, "/etc/bar", "bar", 0600)
+				Expect(fakeDBus.Actions).To(BeEmpty())
+			})
+
+			It("should restore files from images", func() {
+				Expect(fs.Remove("/opt/bin/kubelet")).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+				test.AssertFileOnDisk(fs, "/opt/bin/kubelet", "kubelet", 0755)
+				Expect(fakeVerifier.Verified).To(BeEmpty())
+			})
+
+			Context("image verification", func() {
+				BeforeEach(func() {
+					osc.Spec.Files[2].Content.ImageRef.Digest = new("sha256:kubelet")
+					oscRaw, err := yaml.Marshal(osc)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fs.WriteFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, oscRaw, 0600)).To(Succeed())
+
+					Expect(fs.Remove("/opt/bin/kubelet")).To(Succeed())
+				})
+
+				It("should verify images before restoring files from them", func() {
+					fakeVerifier.Digests["hyperkube:v1.33.0"] = "sha256:kubelet"
+
+					Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+					test.AssertFileOnDisk(fs, "/opt/bin/kubelet", "kubelet", 0755)
+					Expect(fakeVerifier.Verified).To(ConsistOf("hyperkube:v1.33.0"))
+				})
+
+				It("should not restore files from images which fail the verification", func() {
+					fakeVerifier.Digests["hyperkube:v1.33.0"] = "sha256:other"
+
+					_, err := reconciler.Reconcile(ctx, request)
+					Expect(err).To(MatchError(ContainSubstring(`failed verifying image "hyperkube:v1.33.0"`)))
+
+					test.AssertNoFileOnDisk(fs, "/opt/bin/kubelet")
+				})
+			})
+
+			It("should cancel the context instead of restarting gardener-node-agent", func() {
+				Expect(fs.WriteFile("/etc/systemd/system/"+nodeagentconfigv1alpha1.UnitName, []byte("changed"), 0600)).To(Succeed())
+
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+				test.AssertFileOnDisk(fs, "/etc/systemd/system/"+nodeagentconfigv1alpha1.UnitName, "node-agent unit", 0600)
+				Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{{Action: fakedbus.ActionDaemonReload}}))
+				Expect(contextCanceled).To(BeTrue())
+			})
+
+			It("should refuse restoring content which does not match the applied checksum", func() {
+				Expect(fs.WriteFile("/images/kubelet", []byte("other kubelet"), 0755)).To(Succeed())
+				Expect(fs.WriteFile("/opt/bin/kubelet", []byte("changed"), 0755)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).To(MatchError(ContainSubstring("checksum of desired content does not match the applied checksum")))
+
+				test.AssertFileOnDisk(fs, "/opt/bin/kubelet", "changed", 0755)
+			})
+		})
+	})
+})
+
+type appliedFile struct {
+	content     string
+	permissions uint32
+	unitName    string
+}
+
+func writeAppliedFiles(fs afero.Afero, files map[string]appliedFile) {
+	appliedFiles := &operatingsystemconfig.AppliedFiles{OperatingSystemConfigChecksum: "checksum"}
+	for path, file := range files {
+		appliedFiles.Files = append(appliedFiles.Files, operatingsystemconfig.AppliedFile{
+			Path:        path,
+			SHA256:      utils.ComputeSHA256Hex([]byte(file.content)),
+			Permissions: os.FileMode(file.permissions),
+			UnitName:    file.unitName,
+		})
+	}
+
+	out, err := yaml.Marshal(appliedFiles)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	ExpectWithOffset(1, fs.WriteFile(nodeagentconfigv1alpha1.AppliedFileChecksumsFilePath, out, 0600)).To(Succeed())
+}
@@ -26,6 +26,7 @@ type containerdHealthChecker struct {
 
 	containerdClient containerd.Client
 	firstFailure     *time.Time
+	healthy          bool
 	clock            clock.Clock
 	dbus             dbus.DBus
 	recorder         events.EventRecorder
@@ -44,7 +45,12 @@ func NewContainerdHealthChecker(client client.Client, containerdClient container
 
 // Name returns the name of this health check.
 func (*containerdHealthChecker) Name() string {
-	return "containerd"
+	return NameContainerd
+}
+
+// Healthy returns whether the last check found containerd healthy.
+func (c *containerdHealthChecker) Healthy() bool {
+	return c.healthy
 }
 
 // Check performs the actual health check for containerd.
@@ -52,6 +58,7 @@ func (c *containerdHealthChecker) Check(ctx context.Context, node *corev1.Node)
 	log := logf.FromContext(ctx).WithName(c.Name())
 
 	_, err := c.containerdClient.Version(ctx)
+	c.healthy = err == nil
 	if err != nil {
 		if c.firstFailure == nil {
 			now := c.clock.Now()
@@ -11,7 +11,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
-const maxFailureDuration = time.Minute
+const (
+	// NameKubelet is the name of the kubelet health check.
+	NameKubelet = "kubelet"
+	// NameContainerd is the name of the containerd health check.
+	NameContainerd = "containerd"
+
+	maxFailureDuration = time.Minute
+)
 
 // HealthChecker can be implemented to run a health check against a node component
 // and fix it if possible.
@@ -47,6 +47,7 @@ type KubeletHealthChecker struct {
 	client                client.Client
 	httpClient            *http.Client
 	firstFailure          *time.Time
+	healthy               bool
 	dbus                  dbus.DBus
 	recorder              events.EventRecorder
 	lastInternalIP        netip.Addr
@@ -70,7 +71,12 @@ func NewKubeletHealthChecker(client client.Client, clock clock.Clock, dbus dbus.
 
 // Name returns the name of this health check.
 func (*KubeletHealthChecker) Name() string {
-	return "kubelet"
+	return NameKubelet
+}
+
+// Healthy returns whether the last check found the kubelet healthy.
+func (k *KubeletHealthChecker) Healthy() bool {
+	return k.healthy
 }
 
 // HasLastInternalIP returns true if the node.InternalIP was stored.
@@ -106,7 +112,8 @@ func (k *KubeletHealthChecker) Check(ctx context.Context, node *corev1.Node) err
 	if err != nil {
 		log.Error(err, "HTTP request to kubelet health endpoint failed")
 	}
-	if err == nil && response.StatusCode == http.StatusOK {
+	k.healthy = err == nil && response.StatusCode == http.StatusOK
+	if k.healthy {
 		if k.firstFailure != nil {
 			log.Info("Kubelet is healthy again", "statusCode", response.StatusCode)
 			k.recorder.Eventf(node, nil, corev1.EventTypeNormal, "kubelet", gardencorev1beta1.EventActionHealthCheck, "Kubelet is healthy")
@@ -25,6 +25,8 @@ type Reconciler struct {
 	DBus                       dbus.DBus
 	HealthCheckers             []HealthChecker
 	HealthCheckIntervalSeconds int32
+	// Results is optional. If set, the results of all health checkers implementing HealthReporter are recorded in it.
+	Results *Results
 }
 
 // Reconcile executes all defined health checks.
@@ -44,7 +46,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		taskFns = append(taskFns, func(ctx context.Context) error { return f.Check(ctx, node.DeepCopy()) })
 	}
 
-	if err := flow.Parallel(taskFns...)(ctx); err != nil {
+	err := flow.Parallel(taskFns...)(ctx)
+
+	if r.Results != nil {
+		for _, healthChecker := range r.HealthCheckers {
+			if reporter, ok := healthChecker.(HealthReporter); ok {
+				r.Results.Report(healthChecker.Name(), reporter.Healthy())
+			}
+		}
+	}
+
+	if err != nil {
 		return reconcile.Result{}, err
 	}
 
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// HealthReporter can be implemented by a HealthChecker to report whether the last check found the component healthy.
+type HealthReporter interface {
+	// Healthy returns whether the last check found the component healthy.
+	Healthy() bool
+}
+
+// Result is the result of the last health check of a component.
+type Result struct {
+	// Healthy states whether the component was found healthy.
+	Healthy bool
+	// Time is the time of the check.
+	Time time.Time
+}
+
+// Results keeps track of the results of the health checks so that other controllers can act on them, e.g., the
+// operating-system-config controller decides based on them whether restarted units must be rolled back.
+type Results struct {
+	clock clock.Clock
+
+	lock    sync.RWMutex
+	results map[string]Result
+}
+
+// NewResults returns a new Results object.
+func NewResults(clock clock.Clock) *Results {
+	return &Results{
+		clock:   clock,
+		results: map[string]Result{},
+	}
+}
+
+// Report records the result of a health check of the component with the given name.
+func (r *Results) Report(name string, healthy bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.results[name] = Result{Healthy: healthy, Time: r.clock.Now()}
+}
+
+// Get returns the result of the last health check of the component with the given name. The second return value is
+// false if no result has been reported yet.
+func (r *Results) Get(name string) (Result, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	result, ok := r.results[name]
+	return result, ok
+}
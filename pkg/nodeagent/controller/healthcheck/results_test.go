@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock/testing"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+)
+
+var _ = Describe("Results", func() {
+	var (
+		ctx     = context.Background()
+		clock   *testing.FakeClock
+		results *Results
+	)
+
+	BeforeEach(func() {
+		clock = testing.NewFakeClock(time.Now())
+		results = NewResults(clock)
+	})
+
+	Describe("#Report", func() {
+		It("should return nothing if no result was reported", func() {
+			_, ok := results.Get("foo")
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should return the last reported result", func() {
+			results.Report("foo", false)
+			clock.Step(time.Minute)
+			results.Report("foo", true)
+
+			result, ok := results.Get("foo")
+			Expect(ok).To(BeTrue())
+			Expect(result).To(Equal(Result{Healthy: true, Time: clock.Now()}))
+		})
+	})
+
+	Describe("Reconciler", func() {
+		It("should record the results of the health checkers reporting their health", func() {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+			c := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(node).Build()
+
+			reconciler := &Reconciler{
+				Client: c,
+				HealthCheckers: []HealthChecker{
+					&fakeHealthChecker{name: "healthy", healthy: true},
+					&fakeHealthChecker{name: "unhealthy", err: errors.New("fake")},
+				},
+				Results: results,
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: node.Name}})
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+
+			result, ok := results.Get("healthy")
+			Expect(ok).To(BeTrue())
+			Expect(result).To(Equal(Result{Healthy: true, Time: clock.Now()}))
+
+			result, ok = results.Get("unhealthy")
+			Expect(ok).To(BeTrue())
+			Expect(result).To(Equal(Result{Healthy: false, Time: clock.Now()}))
+		})
+	})
+})
+
+type fakeHealthChecker struct {
+	name    string
+	healthy bool
+	err     error
+}
+
+func (f *fakeHealthChecker) Name() string                                  { return f.name }
+func (f *fakeHealthChecker) Check(_ context.Context, _ *corev1.Node) error { return f.err }
+func (f *fakeHealthChecker) Healthy() bool                                 { return f.healthy }
@@ -68,6 +68,9 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 	if r.Extractor == nil {
 		r.Extractor = registry.NewExtractor()
 	}
+	if r.Verifier == nil {
+		r.Verifier = registry.NewVerifier()
+	}
 
 	log := mgr.GetLogger().WithValues("controller", ControllerName)
 	controller := builder.
@@ -81,7 +84,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 		Watches(
 			&corev1.Node{},
 			handler.EnqueueRequestsFromMapFunc(r.NodeToSecretMapper()),
-			builder.WithPredicates(r.NodeReadyForInPlaceUpdate()),
+			builder.WithPredicates(predicate.Or(r.NodeReadyForInPlaceUpdate(), r.NodeRequestsApplyingHeldChanges())),
 		).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 1,
@@ -140,13 +143,19 @@ func (r *Reconciler) SecretPredicate() predicate.Predicate {
 				return false
 			}
 
-			return !bytes.Equal(oldSecret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig], newSecret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig])
+			return !bytes.Equal(oldSecret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig], newSecret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig]) ||
+				holdAnnotationsChanged(oldSecret, newSecret)
 		},
 		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
 		GenericFunc: func(_ event.GenericEvent) bool { return false },
 	}
 }
 
+func holdAnnotationsChanged(oldSecret, newSecret *corev1.Secret) bool {
+	return oldSecret.Annotations[v1beta1constants.AnnotationNodeAgentHoldOSCChanges] != newSecret.Annotations[v1beta1constants.AnnotationNodeAgentHoldOSCChanges] ||
+		oldSecret.Annotations[v1beta1constants.AnnotationShootMaintenanceWindow] != newSecret.Annotations[v1beta1constants.AnnotationShootMaintenanceWindow]
+}
+
 // LeasePredicate returns the predicate for Lease events. It only reacts on 'Update' events and returns true when both
 // of the following conditions are met:
 // - Lease was just released by another instance (i.e., it is free to be claimed)
@@ -235,7 +244,8 @@ func (r *Reconciler) EnqueueWithJitterDelay(ctx context.Context, log logr.Logger
 				return
 			}
 
-			if !bytes.Equal(oldSecret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig], newSecret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig]) {
+			if !bytes.Equal(oldSecret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig], newSecret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig]) ||
+				holdAnnotationsChanged(oldSecret, newSecret) {
 				duration := delay.fetch(ctx, r.NodeName)
 				log.Info("Enqueued secret with operating system config with a jitter period", "duration", duration)
 				q.AddAfter(reconcileRequest(evt.ObjectNew), duration)
@@ -303,6 +313,32 @@ func (r *Reconciler) NodeReadyForInPlaceUpdate() predicate.Predicate {
 	}
 }
 
+// NodeRequestsApplyingHeldChanges returns a predicate that returns
+// - true for Create event if the new node has the annotation for applying held operating system config changes.
+// - true for Update event if the new node has the annotation for applying held operating system config changes and
+// the old node doesn't.
+// - false for Delete and Generic events.
+func (r *Reconciler) NodeRequestsApplyingHeldChanges() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return requestsApplyingHeldChanges(e.Object)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !requestsApplyingHeldChanges(e.ObjectOld) && requestsApplyingHeldChanges(e.ObjectNew)
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+func requestsApplyingHeldChanges(obj client.Object) bool {
+	return obj != nil && obj.GetAnnotations()[v1beta1constants.AnnotationNodeAgentApplyHeldOSCChanges] == "true"
+}
+
 func nodeHasInPlaceUpdateConditionWithReasonReadyForUpdate(conditions []corev1.NodeCondition) bool {
 	return slices.ContainsFunc(conditions, func(condition corev1.NodeCondition) bool {
 		return condition.Type == machinev1alpha1.NodeInPlaceUpdate && condition.Reason == machinev1alpha1.ReadyForUpdate
@@ -62,6 +62,18 @@ var _ = Describe("Add", func() {
 				secret.Data = map[string][]byte{"osc.yaml": []byte("foo")}
 				Expect(p.Update(event.UpdateEvent{ObjectOld: oldSecret, ObjectNew: secret})).To(BeTrue())
 			})
+
+			It("should return true because the hold annotation changes", func() {
+				oldSecret := secret.DeepCopy()
+				metav1.SetMetaDataAnnotation(&secret.ObjectMeta, "reconciliation.osc.node-agent.gardener.cloud/hold-until-maintenance-window", "true")
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldSecret, ObjectNew: secret})).To(BeTrue())
+			})
+
+			It("should return true because the maintenance time window annotation changes", func() {
+				oldSecret := secret.DeepCopy()
+				metav1.SetMetaDataAnnotation(&secret.ObjectMeta, "shoot.gardener.cloud/maintenance-window", "220000+0000,230000+0000")
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldSecret, ObjectNew: secret})).To(BeTrue())
+			})
 		})
 
 		Describe("#Delete", func() {
@@ -263,6 +275,15 @@ var _ = Describe("Add", func() {
 				Expect(queue.AddedAfter).To(BeEmpty())
 			})
 
+			It("should enqueue the object when the OSC did not change but the hold annotation was removed", func() {
+				oldObj := obj.DeepCopy()
+				metav1.SetMetaDataAnnotation(&oldObj.ObjectMeta, "reconciliation.osc.node-agent.gardener.cloud/hold-until-maintenance-window", "true")
+
+				hdlr.Update(ctx, event.UpdateEvent{ObjectNew: obj, ObjectOld: oldObj}, queue)
+
+				Expect(queue.AddedAfter).To(ConsistOf(test.AddAfterArgs[reconcile.Request]{Item: req, Duration: time.Duration(0)}))
+			})
+
 			It("should enqueue the object when the OSC did not change if reconciliation is serial", func() {
 				metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "reconciliation.osc.node-agent.gardener.cloud/serial", "true")
 
@@ -559,4 +580,53 @@ var _ = Describe("Add", func() {
 			})
 		})
 	})
+
+	Describe("#NodeRequestsApplyingHeldChanges", func() {
+		var (
+			p    predicate.Predicate
+			node *corev1.Node
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).NodeRequestsApplyingHeldChanges()
+
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"node-agent.gardener.cloud/apply-held-osc-changes": "true"}}}
+		})
+
+		Describe("#Create", func() {
+			It("should return false when node does not have the annotation", func() {
+				Expect(p.Create(event.CreateEvent{Object: &corev1.Node{}})).To(BeFalse())
+			})
+
+			It("should return true when node has the annotation", func() {
+				Expect(p.Create(event.CreateEvent{Object: node})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because both new and old object have the annotation", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+			})
+
+			It("should return false because the annotation was removed", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: &corev1.Node{}})).To(BeFalse())
+			})
+
+			It("should return true because the annotation was added", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: &corev1.Node{}, ObjectNew: node})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+			})
+		})
+	})
 })
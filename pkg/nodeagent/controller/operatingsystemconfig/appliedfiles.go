@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// AppliedFiles contains the checksums of all files and units which have been written when applying an
+// OperatingSystemConfig. It is used to detect drift of the node from the applied state.
+type AppliedFiles struct {
+	// OperatingSystemConfigChecksum is the checksum of the applied OperatingSystemConfig.
+	OperatingSystemConfigChecksum string `json:"operatingSystemConfigChecksum"`
+	// Files are the applied files, unit files, and drop-in files.
+	Files []AppliedFile `json:"files,omitempty"`
+}
+
+// AppliedFile contains the checksum and the permissions of an applied file.
+type AppliedFile struct {
+	// Path is the path of the file on the node.
+	Path string `json:"path"`
+	// SHA256 is the SHA-256 checksum of the file content.
+	SHA256 string `json:"sha256"`
+	// Permissions are the permissions of the file.
+	Permissions os.FileMode `json:"permissions"`
+	// UnitName is the name of the unit in case the file is a unit file or a drop-in file.
+	// +optional
+	UnitName string `json:"unitName,omitempty"`
+}
+
+// ReadAppliedFiles reads the checksums of the files which have been written for the last applied
+// OperatingSystemConfig. It returns nil if there are none, e.g., because an OperatingSystemConfig is currently being
+// applied.
+func ReadAppliedFiles(fs afero.Afero) (*AppliedFiles, error) {
+	out, err := fs.ReadFile(nodeagentconfigv1alpha1.AppliedFileChecksumsFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read file %q: %w", nodeagentconfigv1alpha1.AppliedFileChecksumsFilePath, err)
+	}
+
+	appliedFiles := &AppliedFiles{}
+	if err := yaml.Unmarshal(out, appliedFiles); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal file %q: %w", nodeagentconfigv1alpha1.AppliedFileChecksumsFilePath, err)
+	}
+	return appliedFiles, nil
+}
+
+// persistAppliedFiles computes and persists the checksums of all files and units of the given OperatingSystemConfig.
+// The checksums of inline files and units are computed from the desired content so that manual changes which happened
+// before are not accepted. For all other files, the content on the disk is used.
+func (r *Reconciler) persistAppliedFiles(osc *extensionsv1alpha1.OperatingSystemConfig, oscChecksum string) error {
+	appliedFiles := &AppliedFiles{OperatingSystemConfigChecksum: oscChecksum}
+
+	for _, file := range CollectAllFiles(osc, r.HostName) {
+		var data []byte
+		if file.Content.Inline != nil {
+			var err error
+			if data, err = extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data)); err != nil {
+				return fmt.Errorf("unable to decode inline data of file %q: %w", file.Path, err)
+			}
+		} else {
+			var err error
+			if data, err = r.FS.ReadFile(file.Path); err != nil {
+				if errors.Is(err, afero.ErrFileNotFound) {
+					continue
+				}
+				return fmt.Errorf("unable to read file %q: %w", file.Path, err)
+			}
+		}
+
+		appliedFiles.Files = append(appliedFiles.Files, AppliedFile{Path: file.Path, SHA256: utils.ComputeSHA256Hex(data), Permissions: getFilePermissions(file)})
+	}
+
+	for _, unit := range MergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits) {
+		unitFilePath := path.Join(etcSystemdSystem, unit.Name)
+
+		if unit.Content != nil {
+			appliedFiles.Files = append(appliedFiles.Files, AppliedFile{Path: unitFilePath, SHA256: utils.ComputeSHA256Hex([]byte(*unit.Content)), Permissions: defaultFilePermissions, UnitName: unit.Name})
+		}
+
+		for _, dropIn := range unit.DropIns {
+			appliedFiles.Files = append(appliedFiles.Files, AppliedFile{Path: path.Join(unitFilePath+".d", dropIn.Name), SHA256: utils.ComputeSHA256Hex([]byte(dropIn.Content)), Permissions: defaultFilePermissions, UnitName: unit.Name})
+		}
+	}
+
+	out, err := yaml.Marshal(appliedFiles)
+	if err != nil {
+		return fmt.Errorf("failed marshalling the applied files into YAML: %w", err)
+	}
+	if err := r.FS.WriteFile(nodeagentconfigv1alpha1.AppliedFileChecksumsFilePath, out, 0600); err != nil {
+		return fmt.Errorf("unable to write file %q: %w", nodeagentconfigv1alpha1.AppliedFileChecksumsFilePath, err)
+	}
+	return nil
+}
+
+// removeAppliedFiles removes the checksums of the previously applied files before new changes are applied, so that
+// drift is not reported (or even restored) for files which are about to change.
+func (r *Reconciler) removeAppliedFiles() error {
+	if err := r.FS.Remove(nodeagentconfigv1alpha1.AppliedFileChecksumsFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed removing file %q: %w", nodeagentconfigv1alpha1.AppliedFileChecksumsFilePath, err)
+	}
+	return nil
+}
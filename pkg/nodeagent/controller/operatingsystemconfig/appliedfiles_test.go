@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("AppliedFiles", func() {
+	var (
+		fs         afero.Afero
+		reconciler *Reconciler
+		osc        *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		reconciler = &Reconciler{FS: fs, HostName: "host"}
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Units: []extensionsv1alpha1.Unit{
+					{Name: "foo.service", Content: new("foo unit"), DropIns: []extensionsv1alpha1.DropIn{{Name: "10-foo.conf", Content: "foo drop-in"}}},
+					{Name: "bar.service", DropIns: []extensionsv1alpha1.DropIn{{Name: "10-bar.conf", Content: "bar drop-in"}}},
+				},
+				Files: []extensionsv1alpha1.File{
+					{Path: "/etc/foo", Permissions: new(uint32(0644)), Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "Zm9v", Encoding: "b64"}}},
+					{Path: "/etc/other-host", HostName: new("other"), Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "other"}}},
+					{Path: "/opt/bin/kubelet", Permissions: new(uint32(0755)), Content: extensionsv1alpha1.FileContent{ImageRef: &extensionsv1alpha1.FileContentImageRef{Image: "hyperkube", FilePathInImage: "/kubelet"}}},
+					{Path: "/opt/bin/kubectl", Content: extensionsv1alpha1.FileContent{ImageRef: &extensionsv1alpha1.FileContentImageRef{Image: "hyperkube", FilePathInImage: "/kubectl"}}},
+				},
+			},
+			Status: extensionsv1alpha1.OperatingSystemConfigStatus{
+				ExtensionUnits: []extensionsv1alpha1.Unit{
+					{Name: "foo.service", DropIns: []extensionsv1alpha1.DropIn{{Name: "20-extension.conf", Content: "extension drop-in"}}},
+				},
+			},
+		}
+	})
+
+	Describe("#persistAppliedFiles", func() {
+		It("should persist the checksums of all files and units", func() {
+			Expect(fs.WriteFile("/etc/foo", []byte("manually changed"), 0644)).To(Succeed())
+			Expect(fs.WriteFile("/opt/bin/kubelet", []byte("kubelet"), 0755)).To(Succeed())
+
+			Expect(reconciler.persistAppliedFiles(osc, "checksum")).To(Succeed())
+
+			appliedFiles, err := ReadAppliedFiles(fs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(appliedFiles).To(Equal(&AppliedFiles{
+				OperatingSystemConfigChecksum: "checksum",
+				Files: []AppliedFile{
+					{Path: "/etc/foo", SHA256: utils.ComputeSHA256Hex([]byte("foo")), Permissions: 0644},
+					{Path: "/opt/bin/kubelet", SHA256: utils.ComputeSHA256Hex([]byte("kubelet")), Permissions: 0755},
+					{Path: "/etc/systemd/system/foo.service", SHA256: utils.ComputeSHA256Hex([]byte("foo unit")), Permissions: 0600, UnitName: "foo.service"},
+					{Path: "/etc/systemd/system/foo.service.d/10-foo.conf", SHA256: utils.ComputeSHA256Hex([]byte("foo drop-in")), Permissions: 0600, UnitName: "foo.service"},
+					{Path: "/etc/systemd/system/foo.service.d/20-extension.conf", SHA256: utils.ComputeSHA256Hex([]byte("extension drop-in")), Permissions: 0600, UnitName: "foo.service"},
+					{Path: "/etc/systemd/system/bar.service.d/10-bar.conf", SHA256: utils.ComputeSHA256Hex([]byte("bar drop-in")), Permissions: 0600, UnitName: "bar.service"},
+				},
+			}))
+		})
+	})
+
+	Describe("#ReadAppliedFiles", func() {
+		It("should return nil if no files have been applied", func() {
+			Expect(ReadAppliedFiles(fs)).To(BeNil())
+		})
+	})
+
+	Describe("#removeAppliedFiles", func() {
+		It("should remove the applied files", func() {
+			Expect(reconciler.persistAppliedFiles(osc, "checksum")).To(Succeed())
+
+			Expect(reconciler.removeAppliedFiles()).To(Succeed())
+			test.AssertNoFileOnDisk(fs, nodeagentconfigv1alpha1.AppliedFileChecksumsFilePath)
+		})
+
+		It("should succeed if there are no applied files", func() {
+			Expect(reconciler.removeAppliedFiles()).To(Succeed())
+		})
+	})
+})
@@ -103,7 +103,7 @@ func computeOperatingSystemConfigChanges(
 			unitCommands []unitCommand
 		)
 
-		for _, unit := range mergeUnits(newOSC.Spec.Units, newOSC.Status.ExtensionUnits) {
+		for _, unit := range MergeUnits(newOSC.Spec.Units, newOSC.Status.ExtensionUnits) {
 			unitCommands = append(unitCommands, unitCommand{
 				Name:    unit.Name,
 				Command: getCommandToExecute(unit),
@@ -149,11 +149,18 @@ func computeOperatingSystemConfigChanges(
 	changes.Files = computeFileDiffs(oldOSCFiles, newOSCFiles)
 
 	changes.Units = computeUnitDiffs(
-		mergeUnits(oldOSC.Spec.Units, oldOSC.Status.ExtensionUnits),
-		mergeUnits(newOSC.Spec.Units, newOSC.Status.ExtensionUnits),
+		MergeUnits(oldOSC.Spec.Units, oldOSC.Status.ExtensionUnits),
+		MergeUnits(newOSC.Spec.Units, newOSC.Status.ExtensionUnits),
 		changes.Files,
 	)
 
+	// When the trusted keys change, the images of all files with imageRef content have to be verified again, even if
+	// the files themselves did not change. This is done after computing the unit diffs since the files are only extracted
+	// again and the units using them do not need to be restarted.
+	if !apiequality.Semantic.DeepEqual(oldOSC.Spec.ImageVerification, newOSC.Spec.ImageVerification) {
+		changes.Files.Changed = appendUnchangedImageRefFiles(changes.Files.Changed, newOSCFiles)
+	}
+
 	if oldOSC.Spec.InPlaceUpdates != nil && newOSC.Spec.InPlaceUpdates != nil {
 		isOsVersionUpToDate, err := IsOsVersionUpToDate(currentOSVersion, newOSC)
 		if err != nil {
@@ -427,7 +434,21 @@ func computeFileDiffs(oldFiles, newFiles []extensionsv1alpha1.File) files {
 	return f
 }
 
-func mergeUnits(specUnits, statusUnits []extensionsv1alpha1.Unit) []extensionsv1alpha1.Unit {
+func appendUnchangedImageRefFiles(changedFiles, newFiles []extensionsv1alpha1.File) []extensionsv1alpha1.File {
+	for _, newFile := range newFiles {
+		if newFile.Content.ImageRef == nil || slices.ContainsFunc(changedFiles, func(changedFile extensionsv1alpha1.File) bool {
+			return changedFile.Path == newFile.Path
+		}) {
+			continue
+		}
+		changedFiles = append(changedFiles, newFile)
+	}
+
+	return changedFiles
+}
+
+// MergeUnits merges the units from the spec and the status (extension units) of an OSC by their names.
+func MergeUnits(specUnits, statusUnits []extensionsv1alpha1.Unit) []extensionsv1alpha1.Unit {
 	var out []extensionsv1alpha1.Unit
 
 	for _, unit := range append(specUnits, statusUnits...) {
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/utils/timewindow"
+)
+
+// holdChangesUntilMaintenanceWindow checks whether the changes of the operating system config in the given secret must
+// be held back until the next maintenance time window. This is only the case if the secret is annotated accordingly,
+// the node already applied an operating system config before (new nodes must always apply the current configuration),
+// no reconciliation for this operating system config is already in progress, and the user did not request to apply
+// the held changes via the node annotation. If the changes are held, the returned duration is the time until a random
+// point in the next maintenance time window.
+func (r *Reconciler) holdChangesUntilMaintenanceWindow(node *corev1.Node, secret *corev1.Secret, oscChecksum string) (*timewindow.MaintenanceTimeWindow, time.Duration, error) {
+	if secret.Annotations[v1beta1constants.AnnotationNodeAgentHoldOSCChanges] != "true" {
+		return nil, 0, nil
+	}
+
+	if node == nil || node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == "" {
+		return nil, 0, nil
+	}
+
+	if node.Annotations[v1beta1constants.AnnotationNodeAgentApplyHeldOSCChanges] == "true" {
+		return nil, 0, nil
+	}
+
+	if changes, err := loadOSCChanges(r.FS); err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return nil, 0, fmt.Errorf("failed loading the last computed operating system config changes: %w", err)
+		}
+	} else if changes.OperatingSystemConfigChecksum == oscChecksum {
+		// The reconciliation of this operating system config was already started (e.g., before gardener-node-agent
+		// restarted itself), hence it must be completed.
+		return nil, 0, nil
+	}
+
+	begin, end, found := strings.Cut(secret.Annotations[v1beta1constants.AnnotationShootMaintenanceWindow], ",")
+	if !found {
+		return nil, 0, fmt.Errorf("maintenance time window annotation %q is not in format '<begin>,<end>'", v1beta1constants.AnnotationShootMaintenanceWindow)
+	}
+
+	maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(begin, end)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed parsing maintenance time window: %w", err)
+	}
+
+	now := r.Clock.Now()
+	if maintenanceTimeWindow.Contains(now) {
+		return nil, 0, nil
+	}
+
+	return maintenanceTimeWindow, maintenanceTimeWindow.RandomDurationUntilNext(now, false), nil
+}
+
+func (r *Reconciler) reportHeldChanges(ctx context.Context, node *corev1.Node, oscChecksum string, maintenanceTimeWindow *timewindow.MaintenanceTimeWindow) error {
+	message := fmt.Sprintf("Changes of operating system config with checksum %s are held until the next maintenance time window (%s).", oscChecksum, maintenanceTimeWindow)
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == nodeagentconfigv1alpha1.ConditionTypeOperatingSystemConfigApplied &&
+			condition.Reason == nodeagentconfigv1alpha1.ConditionReasonOperatingSystemConfigChangesHeld &&
+			condition.Message == message {
+			return nil
+		}
+	}
+
+	r.Recorder.Eventf(node, nil, corev1.EventTypeNormal, nodeagentconfigv1alpha1.ConditionReasonOperatingSystemConfigChangesHeld, gardencorev1beta1.EventActionReconcile, "%s", message)
+	return r.updateNodeCondition(ctx, node, nodeagentconfigv1alpha1.ConditionTypeOperatingSystemConfigApplied, corev1.ConditionFalse, nodeagentconfigv1alpha1.ConditionReasonOperatingSystemConfigChangesHeld, message)
+}
+
+func (r *Reconciler) removeApplyHeldChangesAnnotation(ctx context.Context, node *corev1.Node) error {
+	if _, ok := node.Annotations[v1beta1constants.AnnotationNodeAgentApplyHeldOSCChanges]; !ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	delete(node.Annotations, v1beta1constants.AnnotationNodeAgentApplyHeldOSCChanges)
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed removing annotation %q from node: %w", v1beta1constants.AnnotationNodeAgentApplyHeldOSCChanges, err)
+	}
+
+	return nil
+}
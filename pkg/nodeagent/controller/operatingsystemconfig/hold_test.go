@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Hold", func() {
+	var (
+		ctx          context.Context
+		fs           afero.Afero
+		c            client.Client
+		fakeClock    *testclock.FakeClock
+		fakeRecorder *events.FakeRecorder
+		reconciler   *Reconciler
+		node         *corev1.Node
+		secret       *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&corev1.Node{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		fakeRecorder = events.NewFakeRecorder(2)
+
+		reconciler = &Reconciler{
+			Client:   c,
+			Clock:    fakeClock,
+			Recorder: fakeRecorder,
+			FS:       fs,
+		}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-node",
+			Annotations: map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig: "old-checksum"},
+		}}
+		Expect(c.Create(ctx, node)).To(Succeed())
+
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			"reconciliation.osc.node-agent.gardener.cloud/hold-until-maintenance-window": "true",
+			"shoot.gardener.cloud/maintenance-window":                                    "220000+0000,230000+0000",
+		}}}
+	})
+
+	Describe("#holdChangesUntilMaintenanceWindow", func() {
+		It("should hold the changes until the next maintenance time window", func() {
+			maintenanceTimeWindow, requeueAfter, err := reconciler.holdChangesUntilMaintenanceWindow(node, secret, "new-checksum")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(maintenanceTimeWindow).NotTo(BeNil())
+			Expect(requeueAfter).To(BeNumerically(">=", 10*time.Hour))
+			Expect(requeueAfter).To(BeNumerically("<=", 11*time.Hour))
+		})
+
+		It("should not hold the changes if the secret is not annotated", func() {
+			delete(secret.Annotations, "reconciliation.osc.node-agent.gardener.cloud/hold-until-maintenance-window")
+
+			Expect(reconciler.holdChangesUntilMaintenanceWindow(node, secret, "new-checksum")).To(BeNil())
+		})
+
+		It("should not hold the changes if the node did not apply an operating system config yet", func() {
+			delete(node.Annotations, nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig)
+
+			Expect(reconciler.holdChangesUntilMaintenanceWindow(node, secret, "new-checksum")).To(BeNil())
+			Expect(reconciler.holdChangesUntilMaintenanceWindow(nil, secret, "new-checksum")).To(BeNil())
+		})
+
+		It("should not hold the changes if applying them was requested via the node annotation", func() {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "node-agent.gardener.cloud/apply-held-osc-changes", "true")
+
+			Expect(reconciler.holdChangesUntilMaintenanceWindow(node, secret, "new-checksum")).To(BeNil())
+		})
+
+		It("should not hold the changes if their reconciliation was already started", func() {
+			Expect(fs.WriteFile(lastComputedOperatingSystemConfigChangesFilePath, []byte("operatingSystemConfigChecksum: new-checksum"), 0600)).To(Succeed())
+
+			Expect(reconciler.holdChangesUntilMaintenanceWindow(node, secret, "new-checksum")).To(BeNil())
+		})
+
+		It("should hold the changes if the reconciliation of a previous operating system config was started", func() {
+			Expect(fs.WriteFile(lastComputedOperatingSystemConfigChangesFilePath, []byte("operatingSystemConfigChecksum: old-checksum"), 0600)).To(Succeed())
+
+			maintenanceTimeWindow, _, err := reconciler.holdChangesUntilMaintenanceWindow(node, secret, "new-checksum")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(maintenanceTimeWindow).NotTo(BeNil())
+		})
+
+		It("should not hold the changes if the maintenance time window has begun", func() {
+			fakeClock.SetTime(time.Date(2026, 1, 1, 22, 30, 0, 0, time.UTC))
+
+			Expect(reconciler.holdChangesUntilMaintenanceWindow(node, secret, "new-checksum")).To(BeNil())
+		})
+
+		It("should return an error if the maintenance time window annotation is malformed", func() {
+			secret.Annotations["shoot.gardener.cloud/maintenance-window"] = "220000+0000"
+
+			_, _, err := reconciler.holdChangesUntilMaintenanceWindow(node, secret, "new-checksum")
+			Expect(err).To(MatchError(ContainSubstring("is not in format")))
+		})
+	})
+
+	Describe("#reportHeldChanges", func() {
+		It("should set the node condition and record an event only once", func() {
+			maintenanceTimeWindow, _, err := reconciler.holdChangesUntilMaintenanceWindow(node, secret, "new-checksum")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.reportHeldChanges(ctx, node, "new-checksum", maintenanceTimeWindow)).To(Succeed())
+			Expect(reconciler.reportHeldChanges(ctx, node, "new-checksum", maintenanceTimeWindow)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(nodeagentconfigv1alpha1.ConditionTypeOperatingSystemConfigApplied),
+				"Status":  Equal(corev1.ConditionFalse),
+				"Reason":  Equal("OSCChangesHeld"),
+				"Message": ContainSubstring("new-checksum are held until the next maintenance time window"),
+			})))
+			Expect(fakeRecorder.Events).To(HaveLen(1))
+		})
+	})
+
+	Describe("#removeApplyHeldChangesAnnotation", func() {
+		It("should remove the annotation from the node", func() {
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "node-agent.gardener.cloud/apply-held-osc-changes", "true")
+			Expect(c.Update(ctx, node)).To(Succeed())
+
+			Expect(reconciler.removeApplyHeldChangesAnnotation(ctx, node)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).NotTo(HaveKey("node-agent.gardener.cloud/apply-held-osc-changes"))
+		})
+	})
+})
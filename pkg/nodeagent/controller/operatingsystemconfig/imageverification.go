@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+	"github.com/gardener/gardener/pkg/utils/cosign"
+)
+
+const (
+	reasonImagesVerified          = "ImagesVerified"
+	reasonImageVerificationFailed = "ImageVerificationFailed"
+)
+
+// verifyChangedImageRefFiles verifies the images of all new or changed files with imageRef content which specify a
+// digest, or all of them if image verification is configured in the OperatingSystemConfig. It returns the verified
+// image references pinned to their digests, keyed by file path. The images must be pulled by these references to
+// prevent that a tag is moved after the verification. A failed verification is reported via the ImagesVerified node
+// condition and an event.
+func (r *Reconciler) verifyChangedImageRefFiles(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig, node *corev1.Node, changes *operatingSystemConfigChanges) (map[string]string, error) {
+	publicKeys, err := TrustedPublicKeys(osc)
+	if err != nil {
+		return nil, r.reportImageVerificationFailure(ctx, node, err)
+	}
+
+	verifiedImageRefs := make(map[string]string)
+	for _, file := range changes.Files.Changed {
+		if file.Content.ImageRef == nil {
+			continue
+		}
+
+		imageRef, verified, err := VerifyImage(ctx, r.Verifier, file.Content.ImageRef, publicKeys)
+		if err != nil {
+			err = fmt.Errorf("failed verifying image %q of file %q: %w", file.Content.ImageRef.Image, file.Path, err)
+			if errors.Is(err, registry.ErrVerificationFailed) {
+				return nil, r.reportImageVerificationFailure(ctx, node, err)
+			}
+			return nil, err
+		}
+		if !verified {
+			continue
+		}
+
+		log.Info("Successfully verified image", "path", file.Path, "image", file.Content.ImageRef.Image, "verifiedImage", imageRef)
+		verifiedImageRefs[file.Path] = imageRef
+	}
+
+	if len(verifiedImageRefs) > 0 && node != nil {
+		if err := r.updateNodeCondition(ctx, node, nodeagentconfigv1alpha1.ConditionTypeImagesVerified, corev1.ConditionTrue, reasonImagesVerified, "All images from which files are extracted have been verified successfully."); err != nil {
+			return nil, err
+		}
+	}
+
+	return verifiedImageRefs, nil
+}
+
+// TrustedPublicKeys returns the public keys which are trusted for verifying the images of files with imageRef content
+// according to the given OperatingSystemConfig.
+func TrustedPublicKeys(osc *extensionsv1alpha1.OperatingSystemConfig) ([]crypto.PublicKey, error) {
+	if osc.Spec.ImageVerification == nil {
+		return nil, nil
+	}
+
+	publicKeys, err := cosign.ParsePublicKeys(osc.Spec.ImageVerification.PublicKeys)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed parsing trusted public keys: %w", registry.ErrVerificationFailed, err)
+	}
+	return publicKeys, nil
+}
+
+// VerifyImage verifies the image of the given imageRef content if it specifies a digest or if trusted public keys are
+// given. It returns the image reference pinned to the verified digest which must be used for extracting the file. If
+// the image does not need to be verified, the image reference is returned as is and the second return value is false.
+func VerifyImage(ctx context.Context, verifier registry.Verifier, imageRef *extensionsv1alpha1.FileContentImageRef, publicKeys []crypto.PublicKey) (string, bool, error) {
+	if imageRef.Digest == nil && len(publicKeys) == 0 {
+		return imageRef.Image, false, nil
+	}
+
+	verifiedImageRef, err := verifier.Verify(ctx, imageRef.Image, registry.VerifyOptions{
+		Digest:     ptr.Deref(imageRef.Digest, ""),
+		PublicKeys: publicKeys,
+	})
+	if err != nil {
+		return "", false, err
+	}
+	return verifiedImageRef, true, nil
+}
+
+func (r *Reconciler) reportImageVerificationFailure(ctx context.Context, node *corev1.Node, verificationErr error) error {
+	if node == nil {
+		return verificationErr
+	}
+
+	r.Recorder.Eventf(node, nil, corev1.EventTypeWarning, reasonImageVerificationFailed, gardencorev1beta1.EventActionReconcile, "Operating system config is not applied: %s", verificationErr.Error())
+
+	return errors.Join(verificationErr, r.updateNodeCondition(ctx, node, nodeagentconfigv1alpha1.ConditionTypeImagesVerified, corev1.ConditionFalse, reasonImageVerificationFailed, verificationErr.Error()))
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+	fakeregistry "github.com/gardener/gardener/pkg/nodeagent/registry/fake"
+)
+
+var _ = Describe("ImageVerification", func() {
+	var (
+		ctx          context.Context
+		c            client.Client
+		fakeClock    *testclock.FakeClock
+		fakeRecorder *events.FakeRecorder
+		fakeVerifier *fakeregistry.Verifier
+		reconciler   *Reconciler
+		node         *corev1.Node
+
+		osc     *extensionsv1alpha1.OperatingSystemConfig
+		changes *operatingSystemConfigChanges
+
+		publicKeyPEM string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&corev1.Node{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		fakeRecorder = events.NewFakeRecorder(1)
+		fakeVerifier = fakeregistry.NewVerifier()
+
+		reconciler = &Reconciler{
+			Client:   c,
+			Clock:    fakeClock,
+			Recorder: fakeRecorder,
+			Verifier: fakeVerifier,
+		}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node"}}
+		Expect(c.Create(ctx, node)).To(Succeed())
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{}
+		changes = &operatingSystemConfigChanges{}
+		changes.Files.Changed = []extensionsv1alpha1.File{
+			{
+				Path:    "/opt/bin/kubelet",
+				Content: extensionsv1alpha1.FileContent{ImageRef: &extensionsv1alpha1.FileContentImageRef{Image: "registry.example.com/hyperkube:v1.33.0", FilePathInImage: "/kubelet", Digest: new("sha256:kubelet")}},
+			},
+			{
+				Path:    "/opt/bin/kubectl",
+				Content: extensionsv1alpha1.FileContent{ImageRef: &extensionsv1alpha1.FileContentImageRef{Image: "registry.example.com/hyperkube:v1.33.0", FilePathInImage: "/kubectl"}},
+			},
+			{
+				Path:    "/etc/foo",
+				Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "foo"}},
+			},
+		}
+
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		der, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+		Expect(err).NotTo(HaveOccurred())
+		publicKeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	})
+
+	Describe("#verifyChangedImageRefFiles", func() {
+		It("should only verify images of files with a digest if image verification is not configured", func() {
+			Expect(reconciler.verifyChangedImageRefFiles(ctx, logr.Discard(), osc, node, changes)).To(Equal(map[string]string{
+				"/opt/bin/kubelet": "registry.example.com/hyperkube:v1.33.0",
+			}))
+			Expect(fakeVerifier.Verified).To(ConsistOf("registry.example.com/hyperkube:v1.33.0"))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(nodeagentconfigv1alpha1.ConditionTypeImagesVerified),
+				"Status": Equal(corev1.ConditionTrue),
+				"Reason": Equal("ImagesVerified"),
+			})))
+		})
+
+		It("should verify images of all imageRef files if image verification is configured", func() {
+			osc.Spec.ImageVerification = &extensionsv1alpha1.ImageVerification{PublicKeys: []string{publicKeyPEM}}
+
+			Expect(reconciler.verifyChangedImageRefFiles(ctx, logr.Discard(), osc, node, changes)).To(Equal(map[string]string{
+				"/opt/bin/kubelet": "registry.example.com/hyperkube:v1.33.0",
+				"/opt/bin/kubectl": "registry.example.com/hyperkube:v1.33.0",
+			}))
+			Expect(fakeVerifier.Verified).To(HaveLen(2))
+		})
+
+		It("should not verify anything and not set the condition if no file requires verification", func() {
+			changes.Files.Changed[0].Content.ImageRef.Digest = nil
+
+			Expect(reconciler.verifyChangedImageRefFiles(ctx, logr.Discard(), osc, node, changes)).To(BeEmpty())
+			Expect(fakeVerifier.Verified).To(BeEmpty())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(BeEmpty())
+		})
+
+		It("should report a failed verification via the node condition and an event", func() {
+			fakeVerifier.Digests["registry.example.com/hyperkube:v1.33.0"] = "sha256:other"
+
+			_, err := reconciler.verifyChangedImageRefFiles(ctx, logr.Discard(), osc, node, changes)
+			Expect(err).To(MatchError(registry.ErrVerificationFailed))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(nodeagentconfigv1alpha1.ConditionTypeImagesVerified),
+				"Status":  Equal(corev1.ConditionFalse),
+				"Reason":  Equal("ImageVerificationFailed"),
+				"Message": ContainSubstring(`failed verifying image "registry.example.com/hyperkube:v1.33.0" of file "/opt/bin/kubelet"`),
+			})))
+			Expect(fakeRecorder.Events).To(Receive(ContainSubstring("Warning ImageVerificationFailed")))
+		})
+
+		It("should report invalid trusted public keys as failed verification", func() {
+			osc.Spec.ImageVerification = &extensionsv1alpha1.ImageVerification{PublicKeys: []string{"foo"}}
+
+			_, err := reconciler.verifyChangedImageRefFiles(ctx, logr.Discard(), osc, node, changes)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing trusted public keys")))
+			Expect(fakeVerifier.Verified).To(BeEmpty())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(corev1.ConditionFalse),
+			})))
+		})
+
+		It("should not touch the node condition for errors unrelated to the verification", func() {
+			fakeVerifier.Err = errors.New("network problems")
+
+			_, err := reconciler.verifyChangedImageRefFiles(ctx, logr.Discard(), osc, node, changes)
+			Expect(err).To(MatchError(ContainSubstring("network problems")))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(BeEmpty())
+			Expect(fakeRecorder.Events).NotTo(Receive())
+		})
+
+		It("should keep the last transition time if the condition status does not change", func() {
+			transitionTime := metav1.NewTime(fakeClock.Now().Add(-time.Hour))
+			node.Status.Conditions = []corev1.NodeCondition{{
+				Type:               nodeagentconfigv1alpha1.ConditionTypeImagesVerified,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: transitionTime,
+			}}
+			Expect(c.Status().Update(ctx, node)).To(Succeed())
+
+			Expect(reconciler.verifyChangedImageRefFiles(ctx, logr.Discard(), osc, node, changes)).To(HaveLen(1))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"LastTransitionTime": Equal(transitionTime),
+				"LastHeartbeatTime":  Equal(metav1.NewTime(fakeClock.Now())),
+			})))
+		})
+	})
+
+	Describe("#computeOperatingSystemConfigChanges", func() {
+		var (
+			fs     afero.Afero
+			oldOSC *extensionsv1alpha1.OperatingSystemConfig
+			newOSC *extensionsv1alpha1.OperatingSystemConfig
+		)
+
+		BeforeEach(func() {
+			fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+			oldOSC = &extensionsv1alpha1.OperatingSystemConfig{
+				Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+					Units: []extensionsv1alpha1.Unit{{Name: "kubelet.service", Content: new("kubelet unit"), FilePaths: []string{"/opt/bin/kubelet"}}},
+					Files: changes.Files.Changed,
+				},
+			}
+			oldOSCRaw, err := runtime.Encode(codec, oldOSC)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fs.WriteFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, oldOSCRaw, 0600)).To(Succeed())
+
+			newOSC = oldOSC.DeepCopy()
+		})
+
+		It("should not consider unchanged files if the image verification did not change", func() {
+			changes, err := computeOperatingSystemConfigChanges(logr.Discard(), fs, newOSC, "new-checksum", nil, true, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes.Files.Changed).To(BeEmpty())
+		})
+
+		It("should consider all files with imageRef content as changed if the trusted keys changed", func() {
+			newOSC.Spec.ImageVerification = &extensionsv1alpha1.ImageVerification{PublicKeys: []string{publicKeyPEM}}
+
+			changes, err := computeOperatingSystemConfigChanges(logr.Discard(), fs, newOSC, "new-checksum", nil, true, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes.Files.Changed).To(ConsistOf(
+				HaveField("Path", "/opt/bin/kubelet"),
+				HaveField("Path", "/opt/bin/kubectl"),
+			))
+			Expect(changes.Units.Changed).To(BeEmpty())
+			Expect(changes.Units.Commands).To(BeEmpty())
+		})
+	})
+})
@@ -5,6 +5,7 @@
 package operatingsystemconfig
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 	"sync"
@@ -304,3 +305,80 @@ func loadOSCChanges(fs afero.Afero) (*operatingSystemConfigChanges, error) {
 	changes.fs = fs
 	return &changes, nil
 }
+
+// PendingChanges summarizes the changes of an OperatingSystemConfig which have been computed but not yet completely
+// applied.
+type PendingChanges struct {
+	// OperatingSystemConfigChecksum is the checksum of the OperatingSystemConfig the changes have been computed for.
+	OperatingSystemConfigChecksum string `json:"operatingSystemConfigChecksum"`
+	// ChangedUnits are the names of units which are changed.
+	ChangedUnits []string `json:"changedUnits,omitempty"`
+	// DeletedUnits are the names of units which are deleted.
+	DeletedUnits []string `json:"deletedUnits,omitempty"`
+	// UnitCommands maps the names of units to the commands which are executed for them.
+	UnitCommands map[string]extensionsv1alpha1.UnitCommand `json:"unitCommands,omitempty"`
+	// ChangedFiles are the paths of files which are changed.
+	ChangedFiles []string `json:"changedFiles,omitempty"`
+	// DeletedFiles are the paths of files which are deleted.
+	DeletedFiles []string `json:"deletedFiles,omitempty"`
+	// ContainerdConfigFileChanged is true if the containerd config file is changed.
+	ContainerdConfigFileChanged bool `json:"containerdConfigFileChanged,omitempty"`
+	// MustRestartNodeAgent is true if gardener-node-agent must restart itself.
+	MustRestartNodeAgent bool `json:"mustRestartNodeAgent,omitempty"`
+	// InPlaceUpdates are the names of the in-place updates which are performed.
+	InPlaceUpdates []string `json:"inPlaceUpdates,omitempty"`
+}
+
+// ReadPendingChanges reads the last computed changes of an OperatingSystemConfig and returns those which have not been
+// applied yet. It returns nil if no changes were computed so far.
+func ReadPendingChanges(fs afero.Afero) (*PendingChanges, error) {
+	changes, err := loadOSCChanges(fs)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	pending := &PendingChanges{
+		OperatingSystemConfigChecksum: changes.OperatingSystemConfigChecksum,
+		ContainerdConfigFileChanged:   changes.Containerd.ConfigFileChanged,
+		MustRestartNodeAgent:          changes.MustRestartNodeAgent,
+	}
+
+	for _, unit := range changes.Units.Changed {
+		pending.ChangedUnits = append(pending.ChangedUnits, unit.Name)
+	}
+	for _, unit := range changes.Units.Deleted {
+		pending.DeletedUnits = append(pending.DeletedUnits, unit.Name)
+	}
+	for _, command := range changes.Units.Commands {
+		if pending.UnitCommands == nil {
+			pending.UnitCommands = make(map[string]extensionsv1alpha1.UnitCommand, len(changes.Units.Commands))
+		}
+		pending.UnitCommands[command.Name] = command.Command
+	}
+	for _, file := range changes.Files.Changed {
+		pending.ChangedFiles = append(pending.ChangedFiles, file.Path)
+	}
+	for _, file := range changes.Files.Deleted {
+		pending.DeletedFiles = append(pending.DeletedFiles, file.Path)
+	}
+
+	for name, pendingUpdate := range map[string]bool{
+		"operatingSystem":                         changes.InPlaceUpdates.OperatingSystem,
+		"kubeletMinorVersion":                     changes.InPlaceUpdates.Kubelet.MinorVersion,
+		"kubeletConfig":                           changes.InPlaceUpdates.Kubelet.Config,
+		"kubeletCPUManagerPolicy":                 changes.InPlaceUpdates.Kubelet.CPUManagerPolicy,
+		"certificateAuthoritiesRotationKubelet":   changes.InPlaceUpdates.CertificateAuthoritiesRotation.Kubelet,
+		"certificateAuthoritiesRotationNodeAgent": changes.InPlaceUpdates.CertificateAuthoritiesRotation.NodeAgent,
+		"serviceAccountKeyRotation":               changes.InPlaceUpdates.ServiceAccountKeyRotation,
+	} {
+		if pendingUpdate {
+			pending.InPlaceUpdates = append(pending.InPlaceUpdates, name)
+		}
+	}
+	slices.Sort(pending.InPlaceUpdates)
+
+	return pending, nil
+}
@@ -19,6 +19,7 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
@@ -95,6 +96,14 @@ var (
 	// ready.
 	// Exposed for testing.
 	RequeueAfterWaitForStaticPods = 5 * time.Second
+	// RequeueAfterWaitForRestartedUnits defines when to requeue in case the units restarted while applying the changes
+	// have not yet been reported healthy.
+	// Exposed for testing.
+	RequeueAfterWaitForRestartedUnits = 10 * time.Second
+	// RestartedUnitsHealthCheckTimeout is the timeout after which units restarted while applying the changes are
+	// considered unhealthy if the health checks did not report them healthy. In this case, the changes are rolled back.
+	// Exposed for testing.
+	RestartedUnitsHealthCheckTimeout = 5 * time.Minute
 )
 
 func init() {
@@ -121,6 +130,7 @@ type Reconciler struct {
 	DBus             dbus.DBus
 	FS               afero.Afero
 	Extractor        registry.Extractor
+	Verifier         registry.Verifier
 	CancelContext    context.CancelFunc
 	HostName         string
 	NodeName         string
@@ -130,6 +140,12 @@ type Reconciler struct {
 	// gardener-node-agent might delete files which exist in the provision OSC only after it comes up and reconciles the
 	// actual OSC, or not reconcile them at all.
 	SkipWritingStateFiles bool
+	// HealthCheckResults are the results of the health-check controller. If set, the changes are rolled back in case it
+	// does not report a restarted kubelet or containerd healthy in time.
+	HealthCheckResults *healthcheckcontroller.Results
+	// ApplyLock is held while the OperatingSystemConfig is reconciled. It is shared with the drift detection controller
+	// to prevent that it interferes with files and units which are about to change.
+	ApplyLock *sync.Mutex
 
 	// Channel and TokenSecretSyncConfigs are used by the reconciler to trigger events for the token reconciler during
 	// an in-place service-account-key rotation.
@@ -142,6 +158,11 @@ type Reconciler struct {
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
 
+	if r.ApplyLock != nil {
+		r.ApplyLock.Lock()
+		defer r.ApplyLock.Unlock()
+	}
+
 	secret := &corev1.Secret{}
 	if err := r.Client.Get(ctx, request.NamespacedName, secret); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -197,9 +218,29 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if node != nil && node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum {
 		log.Info("Configuration on this node is up to date, nothing to be done")
+		if err := r.removeApplyHeldChangesAnnotation(ctx, node); err != nil {
+			return reconcile.Result{}, err
+		}
 		return reconcile.Result{}, serialReconciliationLease.release(ctx)
 	}
 
+	if rolledBack, err := r.operatingSystemConfigRolledBack(oscChecksum); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking whether operating system config was rolled back: %w", err)
+	} else if rolledBack {
+		log.Info("Configuration was rolled back after applying it failed, waiting for a new configuration", "path", rolledBackOperatingSystemConfigChecksumFilePath)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, serialReconciliationLease.release(ctx)
+	}
+
+	if maintenanceTimeWindow, requeueAfter, err := r.holdChangesUntilMaintenanceWindow(node, secret, oscChecksum); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking whether operating system config changes must be held: %w", err)
+	} else if maintenanceTimeWindow != nil {
+		log.Info("Changes of operating system config are held until the next maintenance time window", "maintenanceTimeWindow", maintenanceTimeWindow, "requeueAfter", requeueAfter)
+		if err := r.reportHeldChanges(ctx, node, oscChecksum, maintenanceTimeWindow); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: requeueAfter}, serialReconciliationLease.release(ctx)
+	}
+
 	if serialReconciliation(secret) {
 		log.Info("OperatingSystemConfig reconciliation is serial")
 
@@ -287,6 +328,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		)
 	}
 
+	log.Info("Verifying images of new or changed imageRef files")
+	verifiedImageRefs, err := r.verifyChangedImageRefFiles(ctx, log, osc, node, oscChanges)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed verifying images of changed imageRef files: %w", err)
+	}
+
+	if !r.SkipWritingStateFiles {
+		if err := r.removeAppliedFiles(); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	log.Info("Taking snapshot of files and units which are going to be changed")
+	snapshot, err := r.takeSnapshot(log, oscChanges)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed taking snapshot of the previous state: %w", err)
+	}
+
 	log.Info("Applying new or changed inline and secretRef files")
 	if err := r.applyChangedInlineFiles(ctx, log, oscChanges); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed applying changed inline files: %w", err)
@@ -299,7 +358,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	log.Info("Applying new or changed imageRef files")
-	if err := r.applyChangedImageRefFiles(ctx, log, oscChanges); err != nil {
+	if err := r.applyChangedImageRefFiles(ctx, log, oscChanges, verifiedImageRefs); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed applying changed imageRef files: %w", err)
 	}
 
@@ -326,14 +385,42 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return r.restartNodeAgent(oscChanges, log)
 	}
 
+	// Without a snapshot, there is no known-good state to roll back to, hence the health of restarted units is not
+	// checked. The kubelet's health is already checked when completing its in-place update.
+	restartedUnits := unitNamesToRestart(oscChanges)
+	if isInPlaceKubeletUpdate(oscChanges) {
+		restartedUnits = slices.DeleteFunc(restartedUnits, func(unitName string) bool {
+			return unitName == v1beta1constants.OperatingSystemConfigUnitNameKubeletService
+		})
+	}
+
+	if snapshot != nil && len(restartedUnits) > 0 {
+		if err := r.recordRestartedUnits(snapshot, restartedUnits); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	log.Info("Executing unit commands (start/stop)", "unitCommands", len(oscChanges.Units.Commands))
 	if err := r.executeUnitCommands(ctx, log, node, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
+		return reconcile.Result{}, r.rollback(ctx, log, node, snapshot, fmt.Errorf("failed executing unit commands: %w", err))
 	}
 
 	if isInPlaceKubeletUpdate(oscChanges) {
 		if err := r.completeKubeletInPlaceUpdate(ctx, log, oscChanges, node); err != nil {
-			return reconcile.Result{}, fmt.Errorf("failed completing kubelet in-place update: %w", err)
+			return reconcile.Result{}, r.rollback(ctx, log, node, snapshot, fmt.Errorf("failed completing kubelet in-place update: %w", err))
+		}
+	}
+
+	// The health of the restarted units is not awaited here. Instead, the reconciliation is requeued until the
+	// health-check controller reports them healthy. The remaining steps resume from the persisted
+	// [operatingSystemConfigChanges] file, and the snapshot is kept until then.
+	if snapshot != nil {
+		log.Info("Checking health of restarted units", "restartedUnits", len(snapshot.RestartedUnits))
+		if healthy, err := r.checkRestartedUnitsHealthy(ctx, log, node, snapshot); err != nil {
+			return reconcile.Result{}, r.rollback(ctx, log, node, snapshot, fmt.Errorf("failed checking health of restarted units: %w", err))
+		} else if !healthy {
+			log.Info("Waiting for restarted units to be reported healthy, requeuing", "requeueAfter", RequeueAfterWaitForRestartedUnits)
+			return reconcile.Result{RequeueAfter: RequeueAfterWaitForRestartedUnits}, nil
 		}
 	}
 
@@ -371,6 +458,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		if err := r.FS.WriteFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, oscRaw, 0600); err != nil {
 			return reconcile.Result{}, fmt.Errorf("unable to write current OSC to file path %q: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
 		}
+
+		if err := r.removeSnapshot(); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		log.Info("Persisting checksums of applied files and units to the disk", "path", nodeagentconfigv1alpha1.AppliedFileChecksumsFilePath)
+		if err := r.persistAppliedFiles(osc, oscChecksum); err != nil {
+			return reconcile.Result{}, err
+		}
 	}
 
 	// Second restart site: catches MustRestartNodeAgent set by the CA-rotation path inside
@@ -398,10 +494,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{RequeueAfter: RequeueAfterWaitForStaticPods}, nil
 	}
 
-	r.Recorder.Eventf(node, nil, corev1.EventTypeNormal, "OSCApplied", gardencorev1beta1.EventActionReconcile, "Operating system config has been applied successfully")
+	r.Recorder.Eventf(node, nil, corev1.EventTypeNormal, reasonOSCApplied, gardencorev1beta1.EventActionReconcile, "Operating system config has been applied successfully")
+	if err := r.updateNodeCondition(ctx, node, nodeagentconfigv1alpha1.ConditionTypeOperatingSystemConfigApplied, corev1.ConditionTrue, reasonOSCApplied, "Operating system config has been applied successfully."); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	patch := client.MergeFrom(node.DeepCopy())
 	metav1.SetMetaDataLabel(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, oscChecksum)
+	delete(node.Annotations, v1beta1constants.AnnotationNodeAgentApplyHeldOSCChanges)
 	if err := r.Client.Patch(ctx, node, patch); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed patching Node annotations after OSC was applied: %w", err)
 	}
@@ -446,7 +547,7 @@ func getFilePermissions(file extensionsv1alpha1.File) os.FileMode {
 	return permissions
 }
 
-func (r *Reconciler) applyChangedImageRefFiles(ctx context.Context, log logr.Logger, changes *operatingSystemConfigChanges) error {
+func (r *Reconciler) applyChangedImageRefFiles(ctx context.Context, log logr.Logger, changes *operatingSystemConfigChanges, verifiedImageRefs map[string]string) error {
 	for _, file := range slices.Clone(changes.Files.Changed) {
 		if file.Content.ImageRef == nil {
 			continue
@@ -456,9 +557,15 @@ func (r *Reconciler) applyChangedImageRefFiles(ctx context.Context, log logr.Log
 			permissions     = getFilePermissions(file)
 			filePathInImage = file.Content.ImageRef.FilePathInImage
 			fileLog         = log.WithValues("path", file.Path, "image", file.Content.ImageRef.Image)
+			imageRef        = file.Content.ImageRef.Image
 		)
 
-		err := r.Extractor.CopyFromImage(ctx, file.Content.ImageRef.Image, filePathInImage, file.Path, permissions)
+		// Pull verified images by their digest so that the extracted file is guaranteed to stem from the verified image.
+		if verifiedImageRef, ok := verifiedImageRefs[file.Path]; ok {
+			imageRef = verifiedImageRef
+		}
+
+		err := r.Extractor.CopyFromImage(ctx, imageRef, filePathInImage, file.Path, permissions)
 
 		// Fall back to /ko-app/gardener-node-agent if /gardener-node-agent doesn't exist in image to support images built
 		// with ko.
@@ -467,7 +574,7 @@ func (r *Reconciler) applyChangedImageRefFiles(ctx context.Context, log logr.Log
 		if errors.Is(err, fs.ErrNotExist) && filePathInImage == "/gardener-node-agent" {
 			filePathInImage = "/ko-app/gardener-node-agent"
 			fileLog.Info("Could not find gardener-node-agent in root directory of image, falling back to ko binary path")
-			err = r.Extractor.CopyFromImage(ctx, file.Content.ImageRef.Image, filePathInImage, file.Path, permissions)
+			err = r.Extractor.CopyFromImage(ctx, imageRef, filePathInImage, file.Path, permissions)
 		}
 
 		if err != nil {
@@ -486,6 +593,12 @@ func (r *Reconciler) applyChangedImageRefFiles(ctx context.Context, log logr.Log
 // getFileContentData resolves the data for a file from its inline content or secretRef.
 // It returns ok=false if the file has neither (e.g., imageRef files handled separately).
 func (r *Reconciler) getFileContentData(ctx context.Context, file extensionsv1alpha1.File) ([]byte, bool, error) {
+	return GetFileContentData(ctx, r.APIReader, file)
+}
+
+// GetFileContentData resolves the data for a file from its inline content or secretRef. Secrets are read with the
+// given reader from the kube-system namespace. It returns ok=false if the file has neither (e.g., imageRef files).
+func GetFileContentData(ctx context.Context, apiReader client.Reader, file extensionsv1alpha1.File) ([]byte, bool, error) {
 	switch {
 	case file.Content.Inline != nil:
 		data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
@@ -502,7 +615,7 @@ func (r *Reconciler) getFileContentData(ctx context.Context, file extensionsv1al
 		// Since we plan to use files with secretRef only for the control plane worker pool of self-hosted shoots, the
 		// network I/O impact should be negligible.
 		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: file.Content.SecretRef.Name, Namespace: metav1.NamespaceSystem}}
-		if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		if err := apiReader.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
 			return nil, false, fmt.Errorf("unable to read referenced secret %q: %w", file.Content.SecretRef.Name, err)
 		}
 
@@ -881,6 +994,18 @@ func isInPlaceKubeletUpdate(changes *operatingSystemConfigChanges) bool {
 }
 
 func (r *Reconciler) checkKubeletHealth(ctx context.Context, log logr.Logger, node *corev1.Node) error {
+	if err := r.waitForKubeletHealthy(ctx, log); err != nil {
+		if patchErr := r.patchNodeUpdateFailed(ctx, log, node, fmt.Sprintf("kubelet is not healthy after in-place update: %s", err.Error())); patchErr != nil {
+			return patchErr
+		}
+
+		return fmt.Errorf("kubelet is not healthy after in-place update: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) waitForKubeletHealthy(ctx context.Context, log logr.Logger) error {
 	httpClient := &http.Client{Timeout: 10 * time.Second}
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, healthcheckcontroller.DefaultKubeletHealthEndpoint, nil)
 	if err != nil {
@@ -888,24 +1013,16 @@ func (r *Reconciler) checkKubeletHealth(ctx context.Context, log logr.Logger, no
 		return err
 	}
 
-	if err := retryutils.UntilTimeout(ctx, KubeletHealthCheckRetryInterval, KubeletHealthCheckRetryTimeout, func(_ context.Context) (bool, error) {
+	return retryutils.UntilTimeout(ctx, KubeletHealthCheckRetryInterval, KubeletHealthCheckRetryTimeout, func(_ context.Context) (bool, error) {
 		if response, err2 := httpClient.Do(request); err2 != nil { // #nosec: G704 -- URL is kubelet health endpoint, not user input.
 			return retryutils.MinorError(fmt.Errorf("HTTP request to kubelet health endpoint failed: %w", err2))
 		} else if response.StatusCode == http.StatusOK {
-			log.Info("Kubelet is healthy after in-place update")
+			log.Info("Kubelet is healthy")
 			return retryutils.Ok()
 		}
 
 		return retryutils.NotOk()
-	}); err != nil {
-		if patchErr := r.patchNodeUpdateFailed(ctx, log, node, fmt.Sprintf("kubelet is not healthy after in-place update: %s", err.Error())); patchErr != nil {
-			return patchErr
-		}
-
-		return fmt.Errorf("kubelet is not healthy after in-place update: %w", err)
-	}
-
-	return nil
+	})
 }
 
 func (r *Reconciler) completeKubeletInPlaceUpdate(ctx context.Context, log logr.Logger, changes *operatingSystemConfigChanges, node *corev1.Node) error {
@@ -1196,6 +1313,30 @@ func (r *Reconciler) patchNodeUpdateFailed(ctx context.Context, log logr.Logger,
 	return nil
 }
 
+// removeNodeUpdateFailed removes the in-place update failed label and reason which were added by patchNodeUpdateFailed,
+// e.g., because the kubelet was not healthy after applying changes which have been rolled back.
+func (r *Reconciler) removeNodeUpdateFailed(ctx context.Context, log logr.Logger, node *corev1.Node) error {
+	if !kubernetesutils.HasMetaDataLabel(node, machinev1alpha1.LabelKeyNodeUpdateResult, machinev1alpha1.LabelValueNodeUpdateFailed) {
+		return nil
+	}
+
+	log.Info("Removing the in-place update failed label from the node", "node", node.Name)
+
+	patch := client.MergeFrom(node.DeepCopy())
+	delete(node.Labels, machinev1alpha1.LabelKeyNodeUpdateResult)
+	delete(node.Annotations, machinev1alpha1.AnnotationKeyMachineUpdateFailedReason)
+
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed removing update-failed label from node: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) updateNodeCondition(ctx context.Context, node *corev1.Node, conditionType corev1.NodeConditionType, status corev1.ConditionStatus, reason, message string) error {
+	return nodeagent.UpdateNodeCondition(ctx, r.Client, r.Clock, node, corev1.NodeCondition{Type: conditionType, Status: status, Reason: reason, Message: message})
+}
+
 func (r *Reconciler) restartNodeAgent(oscChanges *operatingSystemConfigChanges, log logr.Logger) (reconcile.Result, error) {
 	log.Info("Must restart myself (gardener-node-agent unit), canceling the context to initiate graceful shutdown")
 	if err := oscChanges.setMustRestartNodeAgent(false); err != nil {
@@ -0,0 +1,492 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	healthcheckcontroller "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	filespkg "github.com/gardener/gardener/pkg/nodeagent/files"
+)
+
+const (
+	snapshotDirectory                               = nodeagentconfigv1alpha1.BaseDir + "/osc-snapshot"
+	snapshotManifestFilePath                        = snapshotDirectory + "/manifest.yaml"
+	snapshotFilesDirectory                          = snapshotDirectory + "/files"
+	rolledBackOperatingSystemConfigChecksumFilePath = nodeagentconfigv1alpha1.BaseDir + "/rolled-back-osc-checksum"
+
+	reasonOSCApplied    = "OSCApplied"
+	reasonOSCRolledBack = "OSCRolledBack"
+)
+
+// unitNameToHealthCheckName maps the names of the units whose health is checked by the health-check controller to the
+// names of the respective health checks.
+var unitNameToHealthCheckName = map[string]string{
+	v1beta1constants.OperatingSystemConfigUnitNameKubeletService:    healthcheckcontroller.NameKubelet,
+	v1beta1constants.OperatingSystemConfigUnitNameContainerDService: healthcheckcontroller.NameContainerd,
+}
+
+// snapshot is the state of all files and units affected by an OperatingSystemConfig change before the change is
+// applied. It is persisted to the disk so that it survives restarts of gardener-node-agent during the reconciliation.
+type snapshot struct {
+	// OperatingSystemConfigChecksum is the checksum of the OperatingSystemConfig whose changes are applied.
+	OperatingSystemConfigChecksum string `json:"operatingSystemConfigChecksum"`
+	// Files are the files which are touched when applying the changes.
+	Files []snapshotFile `json:"files,omitempty"`
+	// Units are the units which are touched when applying the changes.
+	Units []snapshotUnit `json:"units,omitempty"`
+	// RestartedUnits are the units which are (re)started when applying the changes. The changes are only considered
+	// successful once these units are healthy.
+	RestartedUnits []string `json:"restartedUnits,omitempty"`
+	// RestartedAt is the time when the commands for the restarted units have been executed.
+	RestartedAt *metav1.Time `json:"restartedAt,omitempty"`
+}
+
+type snapshotFile struct {
+	// Path is the path of the file on the node.
+	Path string `json:"path"`
+	// Existed states whether the file existed before the changes were applied.
+	Existed bool `json:"existed"`
+	// Permissions are the permissions of the file before the changes were applied.
+	Permissions os.FileMode `json:"permissions,omitempty"`
+	// Backup is the name of the copy of the file in the snapshot directory.
+	Backup string `json:"backup,omitempty"`
+}
+
+type snapshotUnit struct {
+	// Name is the name of the unit.
+	Name string `json:"name"`
+	// Previous is the definition of the unit in the last applied OperatingSystemConfig. It is nil if the unit is new.
+	Previous *extensionsv1alpha1.Unit `json:"previous,omitempty"`
+}
+
+// takeSnapshot persists the current content of all files and unit files which are going to be changed or deleted
+// together with the unit definitions of the last applied OperatingSystemConfig. If there is no last applied
+// OperatingSystemConfig, there is no known-good state to roll back to, hence no snapshot is taken. An existing snapshot
+// for the same OperatingSystemConfig is reused since the changes might already have been applied partially.
+func (r *Reconciler) takeSnapshot(log logr.Logger, changes *operatingSystemConfigChanges) (*snapshot, error) {
+	if r.SkipWritingStateFiles {
+		return nil, nil
+	}
+
+	existingSnapshot, err := r.loadSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	if existingSnapshot != nil && existingSnapshot.OperatingSystemConfigChecksum == changes.OperatingSystemConfigChecksum {
+		log.Info("Found existing snapshot of the previous state on disk", "path", snapshotDirectory)
+		return existingSnapshot, nil
+	}
+
+	if err := r.FS.RemoveAll(snapshotDirectory); err != nil {
+		return nil, fmt.Errorf("failed removing outdated snapshot directory %q: %w", snapshotDirectory, err)
+	}
+
+	oldOSCRaw, err := r.FS.ReadFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			log.Info("No operating system config was applied before, skipping snapshot of the previous state")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading last applied OSC from file path %s: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	oldOSC := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(nodeagent.OSCDecoder, oldOSCRaw, oldOSC); err != nil {
+		return nil, fmt.Errorf("unable to decode the old OSC read from file path %s: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+	oldUnits := MergeUnits(oldOSC.Spec.Units, oldOSC.Status.ExtensionUnits)
+
+	if err := r.FS.MkdirAll(snapshotFilesDirectory, 0700); err != nil {
+		return nil, fmt.Errorf("unable to create snapshot directory %q: %w", snapshotFilesDirectory, err)
+	}
+
+	s := &snapshot{OperatingSystemConfigChecksum: changes.OperatingSystemConfigChecksum}
+
+	paths, err := r.affectedPaths(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, filePath := range paths {
+		info, err := r.FS.Stat(filePath)
+		if err != nil {
+			if errors.Is(err, afero.ErrFileNotFound) {
+				s.Files = append(s.Files, snapshotFile{Path: filePath})
+				continue
+			}
+			return nil, fmt.Errorf("unable to stat file %q: %w", filePath, err)
+		}
+
+		if !info.Mode().IsRegular() {
+			continue
+		}
+
+		backup := strconv.Itoa(i)
+		if err := filespkg.Copy(r.FS, filePath, filepath.Join(snapshotFilesDirectory, backup), 0600); err != nil {
+			return nil, fmt.Errorf("unable to copy file %q to snapshot directory: %w", filePath, err)
+		}
+		s.Files = append(s.Files, snapshotFile{Path: filePath, Existed: true, Permissions: info.Mode().Perm(), Backup: backup})
+	}
+
+	for _, unitName := range affectedUnitNames(changes) {
+		unit := snapshotUnit{Name: unitName}
+		if idx := slices.IndexFunc(oldUnits, func(u extensionsv1alpha1.Unit) bool { return u.Name == unitName }); idx >= 0 {
+			unit.Previous = &oldUnits[idx]
+		}
+		s.Units = append(s.Units, unit)
+	}
+
+	// The manifest is written last so that only complete snapshots are found on disk.
+	if err := r.writeSnapshot(s); err != nil {
+		return nil, err
+	}
+
+	log.Info("Took snapshot of the previous state", "path", snapshotDirectory, "files", len(s.Files), "units", len(s.Units))
+	return s, nil
+}
+
+func (r *Reconciler) writeSnapshot(s *snapshot) error {
+	out, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed marshalling the snapshot into YAML: %w", err)
+	}
+	if err := r.FS.WriteFile(snapshotManifestFilePath, out, 0600); err != nil {
+		return fmt.Errorf("unable to write snapshot manifest %q: %w", snapshotManifestFilePath, err)
+	}
+	return nil
+}
+
+func (r *Reconciler) loadSnapshot() (*snapshot, error) {
+	out, err := r.FS.ReadFile(snapshotManifestFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read snapshot manifest %q: %w", snapshotManifestFilePath, err)
+	}
+
+	s := &snapshot{}
+	if err := yaml.Unmarshal(out, s); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal snapshot manifest %q: %w", snapshotManifestFilePath, err)
+	}
+	return s, nil
+}
+
+// removeSnapshot removes the snapshot after the changes have been applied successfully and clears the marker of a
+// previously rolled back OperatingSystemConfig.
+func (r *Reconciler) removeSnapshot() error {
+	if err := r.FS.RemoveAll(snapshotDirectory); err != nil {
+		return fmt.Errorf("failed removing snapshot directory %q: %w", snapshotDirectory, err)
+	}
+	if err := r.FS.Remove(rolledBackOperatingSystemConfigChecksumFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed removing file %q: %w", rolledBackOperatingSystemConfigChecksumFilePath, err)
+	}
+	return nil
+}
+
+// affectedPaths returns the paths of all files, unit files and drop-in files which are written or removed when the
+// given changes are applied.
+func (r *Reconciler) affectedPaths(changes *operatingSystemConfigChanges) ([]string, error) {
+	paths := sets.New[string]()
+
+	for _, file := range slices.Concat(changes.Files.Changed, changes.Files.Deleted) {
+		paths.Insert(file.Path)
+	}
+
+	for _, unit := range changes.Units.Changed {
+		unitFilePath := path.Join(etcSystemdSystem, unit.Name)
+		dropInDirectory := unitFilePath + ".d"
+		paths.Insert(unitFilePath)
+
+		for _, dropIn := range slices.Concat(unit.DropInsChanges.Changed, unit.DropInsChanges.Deleted) {
+			paths.Insert(path.Join(dropInDirectory, dropIn.Name))
+		}
+
+		// The complete drop-in directory is removed if the unit does not have drop-ins anymore.
+		if len(unit.DropIns) == 0 {
+			dropInFiles, err := r.FS.ReadDir(dropInDirectory)
+			if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+				return nil, fmt.Errorf("unable to read drop-in directory %q: %w", dropInDirectory, err)
+			}
+			for _, dropInFile := range dropInFiles {
+				paths.Insert(path.Join(dropInDirectory, dropInFile.Name()))
+			}
+		}
+	}
+
+	for _, unit := range changes.Units.Deleted {
+		unitFilePath := path.Join(etcSystemdSystem, unit.Name)
+		paths.Insert(unitFilePath)
+
+		for _, dropIn := range unit.DropIns {
+			paths.Insert(path.Join(unitFilePath+".d", dropIn.Name))
+		}
+	}
+
+	return sets.List(paths), nil
+}
+
+func affectedUnitNames(changes *operatingSystemConfigChanges) []string {
+	names := sets.New[string]()
+	for _, unit := range changes.Units.Changed {
+		names.Insert(unit.Name)
+	}
+	for _, unit := range changes.Units.Deleted {
+		names.Insert(unit.Name)
+	}
+	for _, command := range changes.Units.Commands {
+		names.Insert(command.Name)
+	}
+	return sets.List(names)
+}
+
+func (s *snapshot) fileExisted(filePath string) bool {
+	return slices.ContainsFunc(s.Files, func(f snapshotFile) bool { return f.Path == filePath && f.Existed })
+}
+
+// recordRestartedUnits persists the names of the units which are going to be (re)started in the snapshot so that
+// their health is still checked in case gardener-node-agent is restarted before they became healthy.
+func (r *Reconciler) recordRestartedUnits(s *snapshot, unitNames []string) error {
+	s.RestartedUnits = sets.List(sets.New(s.RestartedUnits...).Insert(unitNames...))
+	s.RestartedAt = nil
+	return r.writeSnapshot(s)
+}
+
+// checkRestartedUnitsHealthy checks whether the units which have been (re)started while applying the changes are
+// healthy. It does not block: Units in failed state are reported immediately. The health of the kubelet and containerd
+// is taken from the results of the health-check controller. As long as it has not reported them healthy after they
+// have been restarted, false is returned so that the caller can requeue. If they are not reported healthy within
+// RestartedUnitsHealthCheckTimeout, an error is returned.
+func (r *Reconciler) checkRestartedUnitsHealthy(ctx context.Context, log logr.Logger, node *corev1.Node, s *snapshot) (bool, error) {
+	if len(s.RestartedUnits) == 0 {
+		return true, nil
+	}
+
+	if s.RestartedAt == nil {
+		s.RestartedAt = &metav1.Time{Time: r.Clock.Now()}
+		if err := r.writeSnapshot(s); err != nil {
+			return false, err
+		}
+	}
+
+	unitStatuses, err := r.DBus.ListByNames(ctx, s.RestartedUnits)
+	if err != nil {
+		return false, fmt.Errorf("unable to list units: %w", err)
+	}
+
+	var failedUnits []string
+	for _, status := range unitStatuses {
+		if status.ActiveState == "failed" {
+			failedUnits = append(failedUnits, status.Name)
+		}
+	}
+	if len(failedUnits) > 0 {
+		return false, fmt.Errorf("units are in failed state after they have been restarted: %s", strings.Join(failedUnits, ", "))
+	}
+
+	// The health-check controller only runs once the node is registered.
+	if node == nil || r.HealthCheckResults == nil {
+		return true, nil
+	}
+
+	var pendingUnits []string
+	for _, unitName := range s.RestartedUnits {
+		healthCheckName, ok := unitNameToHealthCheckName[unitName]
+		if !ok {
+			continue
+		}
+
+		if result, ok := r.HealthCheckResults.Get(healthCheckName); ok && result.Healthy && result.Time.After(s.RestartedAt.Time) {
+			continue
+		}
+		pendingUnits = append(pendingUnits, unitName)
+	}
+
+	if len(pendingUnits) == 0 {
+		return true, nil
+	}
+
+	if r.Clock.Since(s.RestartedAt.Time) >= RestartedUnitsHealthCheckTimeout {
+		return false, fmt.Errorf("units have not been reported healthy within %s after they have been restarted: %s", RestartedUnitsHealthCheckTimeout, strings.Join(pendingUnits, ", "))
+	}
+
+	log.Info("Restarted units have not yet been reported healthy by the health checks", "units", pendingUnits, "restartedAt", s.RestartedAt.Time)
+	return false, nil
+}
+
+// rollback restores the state captured in the given snapshot after applying the changes failed with the given error.
+// The OperatingSystemConfig is marked as rolled back so that it is not applied again until a new OperatingSystemConfig
+// is provided. The rollback is reported via the OperatingSystemConfigApplied node condition and an event. If there is
+// no snapshot, the given error is returned as is.
+func (r *Reconciler) rollback(ctx context.Context, log logr.Logger, node *corev1.Node, s *snapshot, applyErr error) error {
+	if s == nil {
+		return applyErr
+	}
+
+	log.Error(applyErr, "Applying operating system config failed, rolling back to the last applied operating system config")
+
+	if err := r.restoreSnapshot(ctx, log, node, s); err != nil {
+		return errors.Join(applyErr, fmt.Errorf("failed rolling back to the last applied operating system config: %w", err))
+	}
+
+	// The changes are computed from scratch in case the rolled back OperatingSystemConfig is retried.
+	if err := r.FS.Remove(lastComputedOperatingSystemConfigChangesFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return errors.Join(applyErr, fmt.Errorf("failed removing file %q: %w", lastComputedOperatingSystemConfigChangesFilePath, err))
+	}
+
+	if err := r.FS.WriteFile(rolledBackOperatingSystemConfigChecksumFilePath, []byte(s.OperatingSystemConfigChecksum), 0600); err != nil {
+		return errors.Join(applyErr, fmt.Errorf("unable to write file %q: %w", rolledBackOperatingSystemConfigChecksumFilePath, err))
+	}
+
+	if err := r.FS.RemoveAll(snapshotDirectory); err != nil {
+		return errors.Join(applyErr, fmt.Errorf("failed removing snapshot directory %q: %w", snapshotDirectory, err))
+	}
+
+	log.Info("Successfully rolled back to the last applied operating system config")
+
+	if node != nil {
+		// The update-failed label is added if the kubelet is not healthy after applying in-place updates. As the changes
+		// have been rolled back, the node is not considered failed anymore.
+		if err := r.removeNodeUpdateFailed(ctx, log, node); err != nil {
+			return errors.Join(applyErr, err)
+		}
+
+		r.Recorder.Eventf(node, nil, corev1.EventTypeWarning, reasonOSCRolledBack, gardencorev1beta1.EventActionReconcile, "Operating system config has been rolled back: %s", applyErr.Error())
+		if err := r.updateNodeCondition(ctx, node, nodeagentconfigv1alpha1.ConditionTypeOperatingSystemConfigApplied, corev1.ConditionFalse, reasonOSCRolledBack, "Operating system config has been rolled back: "+applyErr.Error()); err != nil {
+			return errors.Join(applyErr, err)
+		}
+	}
+
+	if slices.ContainsFunc(s.Units, func(u snapshotUnit) bool { return u.Name == nodeagentconfigv1alpha1.UnitName }) {
+		log.Info("Must restart myself (gardener-node-agent unit) after rollback, canceling the context to initiate graceful shutdown")
+		r.CancelContext()
+	}
+
+	return fmt.Errorf("rolled back to the last applied operating system config: %w", applyErr)
+}
+
+func (r *Reconciler) restoreSnapshot(ctx context.Context, log logr.Logger, node *corev1.Node, s *snapshot) error {
+	// New units created by gardener-node-agent are stopped before their unit files are removed.
+	for _, unit := range s.Units {
+		if unit.Previous != nil || s.fileExisted(path.Join(etcSystemdSystem, unit.Name)) {
+			continue
+		}
+
+		if err := r.DBus.Disable(ctx, unit.Name); err != nil {
+			return fmt.Errorf("unable to disable unit %q: %w", unit.Name, err)
+		}
+		if err := r.DBus.Stop(ctx, r.Recorder, node, unit.Name); err != nil {
+			return fmt.Errorf("unable to stop unit %q: %w", unit.Name, err)
+		}
+		log.Info("Stopped and disabled new unit", "unitName", unit.Name)
+	}
+
+	for _, file := range s.Files {
+		if !file.Existed {
+			if err := r.FS.Remove(file.Path); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+				return fmt.Errorf("unable to remove file %q: %w", file.Path, err)
+			}
+			continue
+		}
+
+		if err := r.FS.MkdirAll(filepath.Dir(file.Path), defaultDirPermissions); err != nil {
+			return fmt.Errorf("unable to create directory %q: %w", filepath.Dir(file.Path), err)
+		}
+		if err := filespkg.Copy(r.FS, filepath.Join(snapshotFilesDirectory, file.Backup), file.Path, file.Permissions); err != nil {
+			return fmt.Errorf("unable to restore file %q: %w", file.Path, err)
+		}
+	}
+	log.Info("Restored files", "files", len(s.Files))
+
+	if err := r.DBus.DaemonReload(ctx); err != nil {
+		return fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	for _, unit := range s.Units {
+		switch {
+		case unit.Name == nodeagentconfigv1alpha1.UnitName:
+			// gardener-node-agent is restarted by canceling its context after the rollback is complete.
+			continue
+
+		case unit.Previous != nil:
+			if ptr.Deref(unit.Previous.Enable, true) {
+				if err := r.DBus.Enable(ctx, unit.Name); err != nil {
+					return fmt.Errorf("unable to enable unit %q: %w", unit.Name, err)
+				}
+			} else {
+				if err := r.DBus.Disable(ctx, unit.Name); err != nil {
+					return fmt.Errorf("unable to disable unit %q: %w", unit.Name, err)
+				}
+			}
+
+			if getCommandToExecute(*unit.Previous) == extensionsv1alpha1.CommandStop {
+				if err := r.DBus.Stop(ctx, r.Recorder, node, unit.Name); err != nil {
+					return fmt.Errorf("unable to stop unit %q: %w", unit.Name, err)
+				}
+				continue
+			}
+
+		case !s.fileExisted(path.Join(etcSystemdSystem, unit.Name)):
+			// New units created by gardener-node-agent have already been stopped.
+			continue
+		}
+
+		if err := r.DBus.Restart(ctx, r.Recorder, node, unit.Name); err != nil {
+			return fmt.Errorf("unable to restart unit %q: %w", unit.Name, err)
+		}
+		log.Info("Restarted unit with previous configuration", "unitName", unit.Name)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) operatingSystemConfigRolledBack(oscChecksum string) (bool, error) {
+	out, err := r.FS.ReadFile(rolledBackOperatingSystemConfigChecksumFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to read file %q: %w", rolledBackOperatingSystemConfigChecksumFilePath, err)
+	}
+	return strings.TrimSpace(string(out)) == oscChecksum, nil
+}
+
+func unitNamesToRestart(changes *operatingSystemConfigChanges) []string {
+	var unitNames []string
+	for _, command := range changes.Units.Commands {
+		if command.Command == extensionsv1alpha1.CommandRestart {
+			unitNames = append(unitNames, command.Name)
+		}
+	}
+
+	if changes.Containerd.ConfigFileChanged && !slices.Contains(unitNames, v1beta1constants.OperatingSystemConfigUnitNameContainerDService) {
+		unitNames = append(unitNames, v1beta1constants.OperatingSystemConfigUnitNameContainerDService)
+	}
+
+	return unitNames
+}
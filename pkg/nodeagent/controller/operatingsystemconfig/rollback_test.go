@@ -0,0 +1,404 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	healthcheckcontroller "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Rollback", func() {
+	var (
+		ctx          context.Context
+		fs           afero.Afero
+		fakeDBus     *fakedbus.DBus
+		c            client.Client
+		fakeClock    *testclock.FakeClock
+		fakeRecorder *events.FakeRecorder
+		reconciler   *Reconciler
+		node         *corev1.Node
+
+		contextCanceled bool
+
+		oldOSC  *extensionsv1alpha1.OperatingSystemConfig
+		changes *operatingSystemConfigChanges
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&corev1.Node{}).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+		fakeRecorder = events.NewFakeRecorder(1)
+		contextCanceled = false
+
+		reconciler = &Reconciler{
+			Client:        c,
+			Clock:         fakeClock,
+			Recorder:      fakeRecorder,
+			DBus:          fakeDBus,
+			FS:            fs,
+			CancelContext: func() { contextCanceled = true },
+		}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node"}}
+		Expect(c.Create(ctx, node)).To(Succeed())
+
+		oldOSC = &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Units: []extensionsv1alpha1.Unit{
+					{Name: "foo.service", Content: new("old foo unit")},
+					{Name: "bar.service", Enable: new(false), Content: new("old bar unit")},
+				},
+				Files: []extensionsv1alpha1.File{
+					{Path: "/etc/foo", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "old foo"}}},
+				},
+			},
+		}
+		oldOSCRaw, err := runtime.Encode(codec, oldOSC)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fs.WriteFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, oldOSCRaw, 0600)).To(Succeed())
+
+		Expect(fs.WriteFile("/etc/foo", []byte("old foo"), 0640)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/foo.service", []byte("old foo unit"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/bar.service", []byte("old bar unit"), 0600)).To(Succeed())
+
+		changes = &operatingSystemConfigChanges{fs: fs, OperatingSystemConfigChecksum: "new-checksum"}
+		changes.Files.Changed = []extensionsv1alpha1.File{
+			{Path: "/etc/foo", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "new foo"}}},
+			{Path: "/etc/baz", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "new baz"}}},
+		}
+		changes.Units.Changed = []changedUnit{
+			{Unit: extensionsv1alpha1.Unit{Name: "foo.service", Content: new("new foo unit"), DropIns: []extensionsv1alpha1.DropIn{{Name: "10-foo.conf", Content: "new drop-in"}}}, DropInsChanges: dropIns{Changed: []extensionsv1alpha1.DropIn{{Name: "10-foo.conf", Content: "new drop-in"}}}},
+			{Unit: extensionsv1alpha1.Unit{Name: "baz.service", Content: new("new baz unit")}},
+		}
+		changes.Units.Deleted = []extensionsv1alpha1.Unit{oldOSC.Spec.Units[1]}
+		changes.Units.Commands = []unitCommand{
+			{Name: "foo.service", Command: extensionsv1alpha1.CommandRestart},
+			{Name: "baz.service", Command: extensionsv1alpha1.CommandRestart},
+		}
+	})
+
+	applyChanges := func() {
+		Expect(fs.WriteFile("/etc/foo", []byte("new foo"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/baz", []byte("new baz"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/foo.service", []byte("new foo unit"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/foo.service.d/10-foo.conf", []byte("new drop-in"), 0600)).To(Succeed())
+		Expect(fs.WriteFile("/etc/systemd/system/baz.service", []byte("new baz unit"), 0600)).To(Succeed())
+		Expect(fs.Remove("/etc/systemd/system/bar.service")).To(Succeed())
+		Expect(fs.WriteFile(lastComputedOperatingSystemConfigChangesFilePath, []byte("changes"), 0600)).To(Succeed())
+	}
+
+	Describe("#takeSnapshot", func() {
+		It("should not take a snapshot if no operating system config was applied before", func() {
+			Expect(fs.Remove(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)).To(Succeed())
+
+			Expect(reconciler.takeSnapshot(logr.Discard(), changes)).To(BeNil())
+			test.AssertNoDirectoryOnDisk(fs, snapshotDirectory)
+		})
+
+		It("should not take a snapshot if state files must not be written", func() {
+			reconciler.SkipWritingStateFiles = true
+
+			Expect(reconciler.takeSnapshot(logr.Discard(), changes)).To(BeNil())
+			test.AssertNoDirectoryOnDisk(fs, snapshotDirectory)
+		})
+
+		It("should take a snapshot of all affected files and units", func() {
+			s, err := reconciler.takeSnapshot(logr.Discard(), changes)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(s.OperatingSystemConfigChecksum).To(Equal("new-checksum"))
+			Expect(s.Files).To(ConsistOf(
+				snapshotFile{Path: "/etc/baz"},
+				MatchFields(IgnoreExtras, Fields{"Path": Equal("/etc/foo"), "Existed": BeTrue(), "Permissions": BeEquivalentTo(0640)}),
+				MatchFields(IgnoreExtras, Fields{"Path": Equal("/etc/systemd/system/bar.service"), "Existed": BeTrue()}),
+				snapshotFile{Path: "/etc/systemd/system/baz.service"},
+				MatchFields(IgnoreExtras, Fields{"Path": Equal("/etc/systemd/system/foo.service"), "Existed": BeTrue()}),
+				snapshotFile{Path: "/etc/systemd/system/foo.service.d/10-foo.conf"},
+			))
+			Expect(s.Units).To(ConsistOf(
+				snapshotUnit{Name: "bar.service", Previous: &oldOSC.Spec.Units[1]},
+				snapshotUnit{Name: "baz.service"},
+				snapshotUnit{Name: "foo.service", Previous: &oldOSC.Spec.Units[0]},
+			))
+
+			Expect(reconciler.loadSnapshot()).To(Equal(s))
+		})
+
+		It("should reuse an existing snapshot for the same operating system config", func() {
+			s, err := reconciler.takeSnapshot(logr.Discard(), changes)
+			Expect(err).NotTo(HaveOccurred())
+
+			applyChanges()
+
+			Expect(reconciler.takeSnapshot(logr.Discard(), changes)).To(Equal(s))
+			test.AssertFileOnDisk(fs, snapshotFilesDirectory+"/"+s.Files[1].Backup, "old foo", 0600)
+		})
+
+		It("should replace an existing snapshot for another operating system config", func() {
+			_, err := reconciler.takeSnapshot(logr.Discard(), changes)
+			Expect(err).NotTo(HaveOccurred())
+
+			changes.OperatingSystemConfigChecksum = "newer-checksum"
+			changes.Units = units{}
+			changes.Files.Changed = changes.Files.Changed[1:]
+
+			s, err := reconciler.takeSnapshot(logr.Discard(), changes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.OperatingSystemConfigChecksum).To(Equal("newer-checksum"))
+			Expect(s.Files).To(ConsistOf(snapshotFile{Path: "/etc/baz"}))
+			Expect(s.Units).To(BeEmpty())
+		})
+	})
+
+	Describe("#rollback", func() {
+		var applyErr error
+
+		BeforeEach(func() {
+			applyErr = errors.New("unit failed")
+		})
+
+		It("should return the error as is if there is no snapshot", func() {
+			Expect(reconciler.rollback(ctx, logr.Discard(), node, nil, applyErr)).To(Equal(applyErr))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should restore the previous state", func() {
+			s, err := reconciler.takeSnapshot(logr.Discard(), changes)
+			Expect(err).NotTo(HaveOccurred())
+
+			applyChanges()
+
+			err = reconciler.rollback(ctx, logr.Discard(), node, s, applyErr)
+			Expect(err).To(MatchError(applyErr))
+			Expect(err).To(MatchError(ContainSubstring("rolled back to the last applied operating system config")))
+
+			test.AssertFileOnDisk(fs, "/etc/foo", "old foo", 0640)
+			test.AssertNoFileOnDisk(fs, "/etc/baz")
+			test.AssertFileOnDisk(fs, "/etc/systemd/system/foo.service", "old foo unit", 0600)
+			test.AssertNoFileOnDisk(fs, "/etc/systemd/system/foo.service.d/10-foo.conf")
+			test.AssertFileOnDisk(fs, "/etc/systemd/system/bar.service", "old bar unit", 0600)
+			test.AssertNoFileOnDisk(fs, "/etc/systemd/system/baz.service")
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"baz.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"baz.service"}},
+				{Action: fakedbus.ActionDaemonReload},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"bar.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"bar.service"}},
+				{Action: fakedbus.ActionEnable, UnitNames: []string{"foo.service"}},
+				{Action: fakedbus.ActionRestart, UnitNames: []string{"foo.service"}},
+			}))
+
+			test.AssertFileOnDisk(fs, rolledBackOperatingSystemConfigChecksumFilePath, "new-checksum", 0600)
+			test.AssertNoFileOnDisk(fs, lastComputedOperatingSystemConfigChangesFilePath)
+			test.AssertNoDirectoryOnDisk(fs, snapshotDirectory)
+			Expect(reconciler.operatingSystemConfigRolledBack("new-checksum")).To(BeTrue())
+			Expect(reconciler.operatingSystemConfigRolledBack("other-checksum")).To(BeFalse())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(nodeagentconfigv1alpha1.ConditionTypeOperatingSystemConfigApplied),
+				"Status":  Equal(corev1.ConditionFalse),
+				"Reason":  Equal("OSCRolledBack"),
+				"Message": ContainSubstring("unit failed"),
+			})))
+			Expect(fakeRecorder.Events).To(Receive(ContainSubstring("Warning OSCRolledBack")))
+			Expect(contextCanceled).To(BeFalse())
+		})
+
+		It("should remove the update-failed label from the node", func() {
+			metav1.SetMetaDataLabel(&node.ObjectMeta, machinev1alpha1.LabelKeyNodeUpdateResult, machinev1alpha1.LabelValueNodeUpdateFailed)
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, machinev1alpha1.AnnotationKeyMachineUpdateFailedReason, "kubelet is not healthy after in-place update")
+			Expect(c.Update(ctx, node)).To(Succeed())
+
+			s, err := reconciler.takeSnapshot(logr.Discard(), changes)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.rollback(ctx, logr.Discard(), node, s, applyErr)).To(MatchError(applyErr))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Labels).NotTo(HaveKey(machinev1alpha1.LabelKeyNodeUpdateResult))
+			Expect(node.Annotations).NotTo(HaveKey(machinev1alpha1.AnnotationKeyMachineUpdateFailedReason))
+		})
+
+		It("should restart gardener-node-agent after the rollback if its unit was changed", func() {
+			changes.Units.Changed = append(changes.Units.Changed, changedUnit{Unit: extensionsv1alpha1.Unit{Name: nodeagentconfigv1alpha1.UnitName}})
+
+			s, err := reconciler.takeSnapshot(logr.Discard(), changes)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconciler.rollback(ctx, logr.Discard(), node, s, applyErr)).To(MatchError(applyErr))
+			Expect(fakeDBus.Actions).NotTo(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{nodeagentconfigv1alpha1.UnitName}}))
+			Expect(contextCanceled).To(BeTrue())
+		})
+
+		It("should keep the marker if restoring the previous state fails", func() {
+			s, err := reconciler.takeSnapshot(logr.Discard(), changes)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeDBus.InjectRestartFailure(errors.New("restart failed"), "foo.service")
+
+			err = reconciler.rollback(ctx, logr.Discard(), node, s, applyErr)
+			Expect(err).To(MatchError(applyErr))
+			Expect(err).To(MatchError(ContainSubstring("restart failed")))
+
+			test.AssertNoFileOnDisk(fs, rolledBackOperatingSystemConfigChecksumFilePath)
+			Expect(reconciler.loadSnapshot()).To(Equal(s))
+		})
+	})
+
+	Describe("#removeSnapshot", func() {
+		It("should remove the snapshot and the rollback marker", func() {
+			_, err := reconciler.takeSnapshot(logr.Discard(), changes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fs.WriteFile(rolledBackOperatingSystemConfigChecksumFilePath, []byte("old-checksum"), 0600)).To(Succeed())
+
+			Expect(reconciler.removeSnapshot()).To(Succeed())
+			test.AssertNoDirectoryOnDisk(fs, snapshotDirectory)
+			test.AssertNoFileOnDisk(fs, rolledBackOperatingSystemConfigChecksumFilePath)
+		})
+	})
+
+	Describe("#recordRestartedUnits", func() {
+		It("should persist the restarted units in the snapshot", func() {
+			s, err := reconciler.takeSnapshot(logr.Discard(), changes)
+			Expect(err).NotTo(HaveOccurred())
+			s.RestartedAt = &metav1.Time{Time: fakeClock.Now()}
+
+			Expect(reconciler.recordRestartedUnits(s, []string{"foo.service"})).To(Succeed())
+			Expect(reconciler.recordRestartedUnits(s, []string{"baz.service", "foo.service"})).To(Succeed())
+
+			Expect(s.RestartedUnits).To(Equal([]string{"baz.service", "foo.service"}))
+			Expect(s.RestartedAt).To(BeNil())
+			Expect(reconciler.loadSnapshot()).To(Equal(s))
+		})
+	})
+
+	Describe("#checkRestartedUnitsHealthy", func() {
+		var (
+			s       *snapshot
+			results *healthcheckcontroller.Results
+		)
+
+		BeforeEach(func() {
+			var err error
+			s, err = reconciler.takeSnapshot(logr.Discard(), changes)
+			Expect(err).NotTo(HaveOccurred())
+
+			results = healthcheckcontroller.NewResults(fakeClock)
+			reconciler.HealthCheckResults = results
+		})
+
+		It("should succeed if no units were restarted", func() {
+			Expect(reconciler.checkRestartedUnitsHealthy(ctx, logr.Discard(), node, s)).To(BeTrue())
+			Expect(s.RestartedAt).To(BeNil())
+		})
+
+		It("should succeed if the units are not failed and record the time of the restart", func() {
+			Expect(reconciler.recordRestartedUnits(s, []string{"foo.service", "baz.service"})).To(Succeed())
+			fakeDBus.SetUnits(systemddbus.UnitStatus{Name: "foo.service", ActiveState: "active"})
+
+			Expect(reconciler.checkRestartedUnitsHealthy(ctx, logr.Discard(), node, s)).To(BeTrue())
+			Expect(s.RestartedAt).To(PointTo(Equal(metav1.Time{Time: fakeClock.Now()})))
+			Expect(reconciler.loadSnapshot()).To(Equal(s))
+		})
+
+		It("should fail if a restarted unit is failed", func() {
+			Expect(reconciler.recordRestartedUnits(s, []string{"foo.service", "baz.service"})).To(Succeed())
+			fakeDBus.SetUnits(
+				systemddbus.UnitStatus{Name: "foo.service", ActiveState: "failed"},
+				systemddbus.UnitStatus{Name: "baz.service", ActiveState: "active"},
+			)
+
+			healthy, err := reconciler.checkRestartedUnitsHealthy(ctx, logr.Discard(), node, s)
+			Expect(err).To(MatchError("units are in failed state after they have been restarted: foo.service"))
+			Expect(healthy).To(BeFalse())
+		})
+
+		Context("kubelet and containerd", func() {
+			BeforeEach(func() {
+				Expect(reconciler.recordRestartedUnits(s, []string{"kubelet.service", "containerd.service"})).To(Succeed())
+			})
+
+			It("should not wait for the health checks if the node is not registered yet", func() {
+				Expect(reconciler.checkRestartedUnitsHealthy(ctx, logr.Discard(), nil, s)).To(BeTrue())
+			})
+
+			It("should not wait for the health checks if their results are not available", func() {
+				reconciler.HealthCheckResults = nil
+
+				Expect(reconciler.checkRestartedUnitsHealthy(ctx, logr.Discard(), node, s)).To(BeTrue())
+			})
+
+			It("should not be healthy as long as the health checks did not report them healthy after the restart", func() {
+				results.Report("kubelet", true)
+				results.Report("containerd", true)
+				Expect(reconciler.checkRestartedUnitsHealthy(ctx, logr.Discard(), node, s)).To(BeFalse())
+
+				fakeClock.Step(time.Second)
+				results.Report("kubelet", true)
+				results.Report("containerd", false)
+				Expect(reconciler.checkRestartedUnitsHealthy(ctx, logr.Discard(), node, s)).To(BeFalse())
+			})
+
+			It("should be healthy once the health checks reported them healthy after the restart", func() {
+				Expect(reconciler.checkRestartedUnitsHealthy(ctx, logr.Discard(), node, s)).To(BeFalse())
+
+				fakeClock.Step(time.Second)
+				results.Report("kubelet", true)
+				results.Report("containerd", true)
+				Expect(reconciler.checkRestartedUnitsHealthy(ctx, logr.Discard(), node, s)).To(BeTrue())
+			})
+
+			It("should fail if the health checks did not report them healthy in time", func() {
+				Expect(reconciler.checkRestartedUnitsHealthy(ctx, logr.Discard(), node, s)).To(BeFalse())
+
+				fakeClock.Step(time.Second)
+				results.Report("containerd", true)
+				fakeClock.Step(RestartedUnitsHealthCheckTimeout)
+				results.Report("kubelet", false)
+
+				healthy, err := reconciler.checkRestartedUnitsHealthy(ctx, logr.Discard(), node, s)
+				Expect(err).To(MatchError("units have not been reported healthy within 5m0s after they have been restarted: kubelet.service"))
+				Expect(healthy).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("#unitNamesToRestart", func() {
+		It("should return the names of all units to restart", func() {
+			changes.Units.Commands = append(changes.Units.Commands, unitCommand{Name: "bar.service", Command: extensionsv1alpha1.CommandStop})
+			changes.Containerd.ConfigFileChanged = true
+
+			Expect(unitNamesToRestart(changes)).To(ConsistOf("foo.service", "baz.service", "containerd.service"))
+		})
+	})
+})
@@ -16,7 +16,6 @@ import (
 	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -227,12 +226,7 @@ func (r *Reconciler) checkUnits(ctx context.Context, units []unitInfo) (unhealth
 
 // updateNodeCondition patches the Node's SystemdUnitsReady condition.
 func (r *Reconciler) updateNodeCondition(ctx context.Context, node *corev1.Node, unhealthyMessages, progressingMessages []string) error {
-	var (
-		patch = client.MergeFrom(node.DeepCopy())
-		now   = metav1.NewTime(r.Clock.Now())
-
-		newCondition corev1.NodeCondition
-	)
+	var newCondition corev1.NodeCondition
 	switch {
 	case len(unhealthyMessages) > 0:
 		newCondition = corev1.NodeCondition{
@@ -257,26 +251,5 @@ func (r *Reconciler) updateNodeCondition(ctx context.Context, node *corev1.Node,
 		}
 	}
 
-	existingIdx := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool {
-		return c.Type == nodeagentconfigv1alpha1.ConditionTypeSystemdUnitsReady
-	})
-
-	if existingIdx >= 0 && node.Status.Conditions[existingIdx].Status == newCondition.Status {
-		newCondition.LastTransitionTime = node.Status.Conditions[existingIdx].LastTransitionTime
-	} else {
-		newCondition.LastTransitionTime = now
-	}
-	newCondition.LastHeartbeatTime = now
-
-	if existingIdx >= 0 {
-		node.Status.Conditions[existingIdx] = newCondition
-	} else {
-		node.Status.Conditions = append(node.Status.Conditions, newCondition)
-	}
-
-	if err := r.Client.Status().Patch(ctx, node, patch); err != nil {
-		return fmt.Errorf("failed patching node status with systemd unit check condition: %w", err)
-	}
-
-	return nil
+	return nodeagent.UpdateNodeCondition(ctx, r.Client, r.Clock, node, newCondition)
 }
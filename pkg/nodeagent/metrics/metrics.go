@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for gardener-node-agent.
+const Namespace = "gardener_node_agent"
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	factory = promauto.With(runtimemetrics.Registry)
+	// DriftedFiles defines the gauge gardener_node_agent_drifted_files.
+	DriftedFiles = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "drifted_files",
+			Help:      "Number of files and units managed by gardener-node-agent whose content or permissions deviate from the applied OperatingSystemConfig.",
+		},
+		[]string{
+			"kind",
+		},
+	)
+	// DriftRestorationsTotal defines the counter gardener_node_agent_drift_restorations_total.
+	DriftRestorationsTotal = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "drift_restorations_total",
+			Help:      "Total number of files and units restored by gardener-node-agent after drift was detected.",
+		},
+		[]string{
+			"kind",
+		},
+	)
+)
@@ -12,6 +12,7 @@ import (
 
 	containerd "github.com/containerd/containerd/v2/client"
 	"github.com/containerd/containerd/v2/core/mount"
+	"github.com/containerd/containerd/v2/core/remotes"
 	"github.com/containerd/containerd/v2/core/remotes/docker"
 	"github.com/containerd/containerd/v2/core/remotes/docker/config"
 	"github.com/containerd/containerd/v2/core/snapshots"
@@ -57,11 +58,7 @@ func (e *containerdExtractor) CopyFromImage(ctx context.Context, imageRef string
 
 	defer func() { utilruntime.HandleError(done(ctx)) }()
 
-	resolver := docker.NewResolver(docker.ResolverOptions{
-		Hosts: config.ConfigureHosts(ctx, config.HostOptions{HostDir: config.HostDirFromRoot("/etc/containerd/certs.d")}),
-	})
-
-	image, err := client.Pull(ctx, imageRef, containerd.WithPullSnapshotter(defaults.DefaultSnapshotter), containerd.WithResolver(resolver), containerd.WithPullUnpack)
+	image, err := client.Pull(ctx, imageRef, containerd.WithPullSnapshotter(defaults.DefaultSnapshotter), containerd.WithResolver(newResolver(ctx)), containerd.WithPullUnpack)
 	if err != nil {
 		return fmt.Errorf("error pulling image: %w", err)
 	}
@@ -89,6 +86,13 @@ func (e *containerdExtractor) CopyFromImage(ctx context.Context, imageRef string
 	return nil
 }
 
+// newResolver returns a resolver for image references which respects the registry hosts configuration of containerd.
+func newResolver(ctx context.Context) remotes.Resolver {
+	return docker.NewResolver(docker.ResolverOptions{
+		Hosts: config.ConfigureHosts(ctx, config.HostOptions{HostDir: config.HostDirFromRoot("/etc/containerd/certs.d")}),
+	})
+}
+
 func mountImage(ctx context.Context, image containerd.Image, snapshotter snapshots.Snapshotter, directory string) error {
 	diffIDs, err := image.RootFS(ctx)
 	if err != nil {
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/containerd/containerd/v2/core/remotes"
+	"github.com/containerd/containerd/v2/pkg/reference"
+	"github.com/containerd/errdefs"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/gardener/gardener/pkg/utils/cosign"
+)
+
+// maxBlobSize is the maximum size of signature manifests and payloads which are fetched for verifying images.
+const maxBlobSize = 4 << 20
+
+type containerdVerifier struct {
+	newResolver func(ctx context.Context) remotes.Resolver
+}
+
+// NewVerifier creates a new instance of a verifier which resolves images with the registry hosts configuration of
+// containerd and verifies cosign signatures stored in the image repository.
+func NewVerifier() Verifier {
+	return &containerdVerifier{newResolver: newResolver}
+}
+
+// Verify resolves the given image reference and verifies it according to the given options.
+func (v *containerdVerifier) Verify(ctx context.Context, imageRef string, opts VerifyOptions) (string, error) {
+	spec, err := reference.Parse(imageRef)
+	if err != nil {
+		return "", fmt.Errorf("failed parsing image reference %q: %w", imageRef, err)
+	}
+
+	resolver := v.newResolver(ctx)
+
+	_, desc, err := resolver.Resolve(ctx, imageRef)
+	if err != nil {
+		return "", fmt.Errorf("failed resolving image %q: %w", imageRef, err)
+	}
+
+	if opts.Digest != "" && desc.Digest.String() != opts.Digest {
+		return "", fmt.Errorf("%w: image %q resolved to digest %q but %q is expected", ErrVerificationFailed, imageRef, desc.Digest, opts.Digest)
+	}
+
+	if len(opts.PublicKeys) > 0 {
+		if err := verifyCosignSignature(ctx, resolver, spec.Locator, desc.Digest, opts.PublicKeys); err != nil {
+			return "", fmt.Errorf("failed verifying signature of image %q: %w", imageRef, err)
+		}
+	}
+
+	return spec.Locator + "@" + desc.Digest.String(), nil
+}
+
+func verifyCosignSignature(ctx context.Context, resolver remotes.Resolver, locator string, manifestDigest digest.Digest, publicKeys []crypto.PublicKey) error {
+	signatureRef := locator + ":" + cosign.SignatureTag(manifestDigest)
+
+	_, signatureDesc, err := resolver.Resolve(ctx, signatureRef)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return fmt.Errorf("%w: no signature found (%s)", ErrVerificationFailed, signatureRef)
+		}
+		return fmt.Errorf("failed resolving signature %q: %w", signatureRef, err)
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, signatureRef)
+	if err != nil {
+		return fmt.Errorf("failed creating fetcher for signature %q: %w", signatureRef, err)
+	}
+
+	manifestBytes, err := fetchBlob(ctx, fetcher, signatureDesc)
+	if err != nil {
+		return fmt.Errorf("failed fetching signature manifest %q: %w", signatureRef, err)
+	}
+
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return fmt.Errorf("%w: failed decoding signature manifest %q: %w", ErrVerificationFailed, signatureRef, err)
+	}
+
+	var errs []error
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != cosign.MediaTypeSimpleSigning {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[cosign.AnnotationKeySignature])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed decoding signature of layer %s: %w", layer.Digest, err))
+			continue
+		}
+
+		payload, err := fetchBlob(ctx, fetcher, layer)
+		if err != nil {
+			return fmt.Errorf("failed fetching signed payload %s: %w", layer.Digest, err)
+		}
+
+		if err := cosign.VerifyPayload(payload, signature, manifestDigest, publicKeys); err != nil {
+			errs = append(errs, fmt.Errorf("layer %s: %w", layer.Digest, err))
+			continue
+		}
+
+		return nil
+	}
+
+	if len(errs) == 0 {
+		return fmt.Errorf("%w: signature manifest %q does not contain any signatures", ErrVerificationFailed, signatureRef)
+	}
+	return fmt.Errorf("%w: no valid signature found: %w", ErrVerificationFailed, errors.Join(errs...))
+}
+
+func fetchBlob(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor) ([]byte, error) {
+	if desc.Size > maxBlobSize {
+		return nil, fmt.Errorf("%w: blob %s exceeds maximum size of %d bytes", ErrVerificationFailed, desc.Digest, maxBlobSize)
+	}
+
+	reader, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(reader, maxBlobSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > maxBlobSize {
+		return nil, fmt.Errorf("%w: blob %s exceeds maximum size of %d bytes", ErrVerificationFailed, desc.Digest, maxBlobSize)
+	}
+	if desc.Digest.Validate() != nil || desc.Digest.Algorithm().FromBytes(data) != desc.Digest {
+		return nil, fmt.Errorf("%w: content of blob does not match digest %s", ErrVerificationFailed, desc.Digest)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/containerd/containerd/v2/core/remotes/docker"
+	"github.com/google/go-containerregistry/pkg/name"
+	containerregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/registry"
+	"github.com/gardener/gardener/pkg/utils/cosign"
+)
+
+var _ = Describe("ContainerdVerifier", func() {
+	var (
+		ctx = context.Background()
+
+		server     *httptest.Server
+		repository string
+		imageRef   string
+		imageHash  digest.Digest
+
+		signingKey *ecdsa.PrivateKey
+		verifier   Verifier
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(containerregistry.New(containerregistry.Logger(log.New(io.Discard, "", 0))))
+		DeferCleanup(server.Close)
+
+		repository = strings.TrimPrefix(server.URL, "http://") + "/gardener/hyperkube"
+		imageRef = repository + ":v1.33.0"
+
+		image, err := random.Image(1024, 1)
+		Expect(err).NotTo(HaveOccurred())
+		ref, err := name.ParseReference(imageRef, name.Insecure)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(remote.Write(ref, image)).To(Succeed())
+
+		hash, err := image.Digest()
+		Expect(err).NotTo(HaveOccurred())
+		imageHash = digest.Digest(hash.String())
+
+		signingKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		verifier = NewVerifierWithResolver(docker.NewResolver(docker.ResolverOptions{PlainHTTP: true}))
+	})
+
+	sign := func(signer crypto.Signer, manifestDigest digest.Digest) {
+		payload := fmt.Appendf(nil, `{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, repository, manifestDigest)
+
+		var (
+			signature []byte
+			err       error
+		)
+		if _, ok := signer.(ed25519.PrivateKey); ok {
+			signature, err = signer.Sign(rand.Reader, payload, crypto.Hash(0))
+		} else {
+			hash := sha256.Sum256(payload)
+			signature, err = signer.Sign(rand.Reader, hash[:], crypto.SHA256)
+		}
+		Expect(err).NotTo(HaveOccurred())
+
+		signatureImage, err := mutate.Append(empty.Image, mutate.Addendum{
+			Layer:       static.NewLayer(payload, cosign.MediaTypeSimpleSigning),
+			Annotations: map[string]string{cosign.AnnotationKeySignature: base64.StdEncoding.EncodeToString(signature)},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		signatureImage = mutate.MediaType(signatureImage, types.OCIManifestSchema1)
+
+		ref, err := name.ParseReference(repository+":"+cosign.SignatureTag(imageHash), name.Insecure)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(remote.Write(ref, signatureImage)).To(Succeed())
+	}
+
+	publicKeysOf := func(signers ...crypto.Signer) []crypto.PublicKey {
+		var publicKeysPEM []string
+		for _, signer := range signers {
+			der, err := x509.MarshalPKIXPublicKey(signer.Public())
+			Expect(err).NotTo(HaveOccurred())
+			publicKeysPEM = append(publicKeysPEM, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+		}
+
+		publicKeys, err := cosign.ParsePublicKeys(publicKeysPEM)
+		Expect(err).NotTo(HaveOccurred())
+		return publicKeys
+	}
+
+	Describe("#Verify", func() {
+		It("should return the reference pinned to the digest if nothing is to be verified", func() {
+			Expect(verifier.Verify(ctx, imageRef, VerifyOptions{})).To(Equal(repository + "@" + imageHash.String()))
+		})
+
+		It("should succeed if the digest matches", func() {
+			Expect(verifier.Verify(ctx, imageRef, VerifyOptions{Digest: imageHash.String()})).To(Equal(repository + "@" + imageHash.String()))
+		})
+
+		It("should fail if the digest does not match", func() {
+			_, err := verifier.Verify(ctx, imageRef, VerifyOptions{Digest: digest.FromString("foo").String()})
+			Expect(err).To(MatchError(ErrVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("but %q is expected", digest.FromString("foo"))))
+		})
+
+		It("should succeed if the image is signed by a trusted ECDSA key", func() {
+			sign(signingKey, imageHash)
+
+			Expect(verifier.Verify(ctx, imageRef, VerifyOptions{PublicKeys: publicKeysOf(signingKey)})).To(Equal(repository + "@" + imageHash.String()))
+		})
+
+		It("should succeed if the image is signed by any of the trusted keys", func() {
+			_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			sign(ed25519Key, imageHash)
+
+			Expect(verifier.Verify(ctx, imageRef, VerifyOptions{PublicKeys: publicKeysOf(signingKey, ed25519Key)})).To(Equal(repository + "@" + imageHash.String()))
+		})
+
+		It("should fail if the image is not signed", func() {
+			_, err := verifier.Verify(ctx, imageRef, VerifyOptions{PublicKeys: publicKeysOf(signingKey)})
+			Expect(err).To(MatchError(ErrVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("no signature found")))
+		})
+
+		It("should fail if the image is signed by an untrusted key", func() {
+			untrustedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			sign(untrustedKey, imageHash)
+
+			_, err = verifier.Verify(ctx, imageRef, VerifyOptions{PublicKeys: publicKeysOf(signingKey)})
+			Expect(err).To(MatchError(ErrVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("signature was not created by any of the trusted public keys")))
+		})
+
+		It("should fail if the signed payload refers to another image", func() {
+			sign(signingKey, digest.FromString("foo"))
+
+			_, err := verifier.Verify(ctx, imageRef, VerifyOptions{PublicKeys: publicKeysOf(signingKey)})
+			Expect(err).To(MatchError(ErrVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("signed payload refers to digest")))
+		})
+
+		It("should fail if the image does not exist", func() {
+			_, err := verifier.Verify(ctx, repository+":v1.34.0", VerifyOptions{})
+			Expect(err).To(MatchError(ContainSubstring("failed resolving image")))
+			Expect(err).NotTo(MatchError(ErrVerificationFailed))
+		})
+	})
+})
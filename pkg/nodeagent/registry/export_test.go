@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/v2/core/remotes"
+)
+
+// NewVerifierWithResolver creates a new verifier using the given resolver.
+func NewVerifierWithResolver(resolver remotes.Resolver) Verifier {
+	return &containerdVerifier{newResolver: func(context.Context) remotes.Resolver { return resolver }}
+}
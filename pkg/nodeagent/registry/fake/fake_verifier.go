@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+)
+
+// Verifier is a simple implementation of registry.Verifier which can be used to fake the registry verifier in unit
+// tests. It does not contact any registry and returns the given image references unchanged.
+type Verifier struct {
+	// Digests maps image references to the digests they resolve to. The digest is only checked for image references
+	// contained in this map.
+	Digests map[string]string
+	// Err is returned by Verify if set.
+	Err error
+	// Verified contains the image references which were verified.
+	Verified []string
+}
+
+var _ registry.Verifier = &Verifier{}
+
+// NewVerifier returns a new fake verifier.
+func NewVerifier() *Verifier {
+	return &Verifier{Digests: make(map[string]string)}
+}
+
+// Verify verifies the digest of the given image reference against the configured digests.
+func (v *Verifier) Verify(_ context.Context, imageRef string, opts registry.VerifyOptions) (string, error) {
+	if v.Err != nil {
+		return "", v.Err
+	}
+
+	if digest, ok := v.Digests[imageRef]; ok && opts.Digest != "" && opts.Digest != digest {
+		return "", fmt.Errorf("%w: image %q resolved to digest %q but %q is expected", registry.ErrVerificationFailed, imageRef, digest, opts.Digest)
+	}
+
+	v.Verified = append(v.Verified, imageRef)
+	return imageRef, nil
+}
@@ -6,11 +6,33 @@ package registry
 
 import (
 	"context"
+	"crypto"
+	"errors"
 	"os"
 )
 
+// ErrVerificationFailed is returned (wrapped) if the verification of an image failed.
+var ErrVerificationFailed = errors.New("image verification failed")
+
 // Extractor is an interface for extracting files from a container image.
 type Extractor interface {
 	// CopyFromImage copies a file from a given image reference to the destination file.
 	CopyFromImage(ctx context.Context, imageRef string, filePathInImage string, destination string, permissions os.FileMode) error
 }
+
+// Verifier is an interface for verifying container images before files are extracted from them.
+type Verifier interface {
+	// Verify resolves the given image reference and verifies it according to the given options. It returns the image
+	// reference pinned to the verified digest which must be used for extracting files from the image. Errors caused by
+	// a failed verification (in contrast to, e.g., network errors) wrap ErrVerificationFailed.
+	Verify(ctx context.Context, imageRef string, opts VerifyOptions) (string, error)
+}
+
+// VerifyOptions are options for verifying a container image.
+type VerifyOptions struct {
+	// Digest is the expected digest of the image manifest. If empty, the digest is not checked.
+	Digest string
+	// PublicKeys are the public keys trusted for signing images. If empty, the signature is not checked. Otherwise, the
+	// image must have a valid cosign signature created by any of these keys.
+	PublicKeys []crypto.PublicKey
+}
@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+const (
+	// PathStatus is the path of the endpoint serving the complete status.
+	PathStatus = "/status"
+	// PathOperatingSystemConfig is the path of the endpoint serving the status of the OperatingSystemConfig.
+	PathOperatingSystemConfig = PathStatus + "/operatingsystemconfig"
+	// PathUnits is the path of the endpoint serving the states of the units managed by gardener-node-agent.
+	PathUnits = PathStatus + "/units"
+	// PathControllers is the path of the endpoint serving the health of the controllers.
+	PathControllers = PathStatus + "/controllers"
+
+	requestTimeout = 10 * time.Second
+)
+
+// Handler serves a local read-only HTTP API exposing the status of gardener-node-agent. It is meant for debugging
+// nodes, e.g., via SSH or gardenadm, and must only be served on a loopback address.
+type Handler struct {
+	Log      logr.Logger
+	Client   client.Client
+	DBus     dbus.DBus
+	FS       afero.Afero
+	HostName string
+	NodeName string
+	// SecretName is the name of the secret containing the OperatingSystemConfig.
+	SecretName string
+	// HealthCheckers are the checkers whose results are reported as health of the controllers.
+	HealthCheckers map[string]healthz.Checker
+
+	once sync.Once
+	mux  *http.ServeMux
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.once.Do(func() {
+		h.mux = http.NewServeMux()
+		h.mux.HandleFunc("GET "+PathStatus, h.serve(func(ctx context.Context, r *http.Request) (any, error) { return h.Status(ctx, r) }))
+		h.mux.HandleFunc("GET "+PathOperatingSystemConfig, h.serve(func(ctx context.Context, _ *http.Request) (any, error) { return h.operatingSystemConfigStatus(ctx) }))
+		h.mux.HandleFunc("GET "+PathUnits, h.serve(func(ctx context.Context, _ *http.Request) (any, error) { return h.unitStatuses(ctx) }))
+		h.mux.HandleFunc("GET "+PathControllers, h.serve(func(_ context.Context, r *http.Request) (any, error) { return h.controllerStatuses(r), nil }))
+	})
+
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) serve(fn func(context.Context, *http.Request) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// The cache might not be synced if the API server is not reachable, hence, do not block requests forever.
+		ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+		defer cancel()
+
+		obj, err := fn(ctx, r)
+		if err != nil {
+			h.Log.Error(err, "Failed computing status", "path", r.URL.Path)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(obj); err != nil {
+			h.Log.Error(err, "Failed writing response", "path", r.URL.Path)
+		}
+	}
+}
+
+// Status computes the complete status of gardener-node-agent.
+func (h *Handler) Status(ctx context.Context, r *http.Request) (*Status, error) {
+	oscStatus, err := h.operatingSystemConfigStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := h.unitStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := h.getNode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{
+		HostName:              h.HostName,
+		OperatingSystemConfig: *oscStatus,
+		InPlaceUpdate:         inPlaceUpdateStatus(node),
+		Units:                 units,
+		Controllers:           h.controllerStatuses(r),
+	}
+	if node != nil {
+		status.NodeName = node.Name
+		status.NodeRegistered = true
+	}
+
+	return status, nil
+}
+
+func (h *Handler) operatingSystemConfigStatus(ctx context.Context) (*OperatingSystemConfigStatus, error) {
+	status := &OperatingSystemConfigStatus{}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: h.SecretName, Namespace: metav1.NamespaceSystem}}
+	if err := h.Client.Get(ctx, client.ObjectKeyFromObject(secret), secret); client.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf("failed reading secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+	status.CurrentChecksum = secret.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig]
+
+	node, err := h.getNode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if node != nil {
+		status.LastAppliedChecksum = node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]
+	}
+	status.UpToDate = status.CurrentChecksum != "" && status.CurrentChecksum == status.LastAppliedChecksum
+
+	pendingChanges, err := operatingsystemconfig.ReadPendingChanges(h.FS)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading pending changes: %w", err)
+	}
+	if pendingChanges != nil && pendingChanges.OperatingSystemConfigChecksum != status.LastAppliedChecksum {
+		status.PendingChanges = pendingChanges
+	}
+
+	return status, nil
+}
+
+func (h *Handler) unitStatuses(ctx context.Context) ([]UnitStatus, error) {
+	osc, err := h.readLastAppliedOperatingSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+	if osc == nil {
+		return nil, nil
+	}
+
+	var unitNames []string
+	for _, unit := range operatingsystemconfig.MergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits) {
+		unitNames = append(unitNames, unit.Name)
+	}
+	slices.Sort(unitNames)
+
+	systemdUnits, err := h.DBus.ListByNames(ctx, unitNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing systemd units: %w", err)
+	}
+
+	units := make([]UnitStatus, 0, len(unitNames))
+	for _, unitName := range unitNames {
+		unit := UnitStatus{Name: unitName, LoadState: "not-found"}
+		if idx := slices.IndexFunc(systemdUnits, func(u systemddbus.UnitStatus) bool { return u.Name == unitName }); idx >= 0 {
+			unit.LoadState = systemdUnits[idx].LoadState
+			unit.ActiveState = systemdUnits[idx].ActiveState
+			unit.SubState = systemdUnits[idx].SubState
+		}
+		units = append(units, unit)
+	}
+
+	return units, nil
+}
+
+func (h *Handler) controllerStatuses(r *http.Request) []ControllerStatus {
+	names := make([]string, 0, len(h.HealthCheckers))
+	for name := range h.HealthCheckers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	statuses := make([]ControllerStatus, 0, len(names))
+	for _, name := range names {
+		status := ControllerStatus{Name: name, Healthy: true}
+		if err := h.HealthCheckers[name](r); err != nil {
+			status.Healthy = false
+			status.Error = err.Error()
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// getNode returns the Node object of this machine, or nil if it is not registered (anymore).
+func (h *Handler) getNode(ctx context.Context) (*corev1.Node, error) {
+	if h.NodeName != "" {
+		node := &corev1.Node{}
+		if err := h.Client.Get(ctx, client.ObjectKey{Name: h.NodeName}, node); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return nil, fmt.Errorf("failed reading node %q: %w", h.NodeName, err)
+			}
+			return nil, nil
+		}
+		return node, nil
+	}
+
+	return nodeagent.FetchNodeByHostName(ctx, h.Client, h.HostName)
+}
+
+func (h *Handler) readLastAppliedOperatingSystemConfig() (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	data, err := h.FS.ReadFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read last-applied OSC: %w", err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if _, _, err := nodeagent.OSCDecoder.Decode(data, nil, osc); err != nil {
+		return nil, fmt.Errorf("unable to decode last-applied OSC: %w", err)
+	}
+	return osc, nil
+}
+
+func inPlaceUpdateStatus(node *corev1.Node) *InPlaceUpdateStatus {
+	if node == nil {
+		return nil
+	}
+
+	idx := slices.IndexFunc(node.Status.Conditions, func(condition corev1.NodeCondition) bool {
+		return condition.Type == machinev1alpha1.NodeInPlaceUpdate
+	})
+	if idx < 0 {
+		return nil
+	}
+
+	return &InPlaceUpdateStatus{
+		Phase:   node.Status.Conditions[idx].Reason,
+		Message: strings.TrimSpace(node.Status.Conditions[idx].Message),
+		Result:  node.Labels[machinev1alpha1.LabelKeyNodeUpdateResult],
+	}
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/nodeagent/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	. "github.com/gardener/gardener/pkg/nodeagent/status"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		ctx      context.Context
+		c        client.Client
+		fs       afero.Afero
+		fakeDBus *fakedbus.DBus
+		handler  *Handler
+		node     *corev1.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+
+		handler = &Handler{
+			Log:        logr.Discard(),
+			Client:     c,
+			DBus:       fakeDBus,
+			FS:         fs,
+			HostName:   "host",
+			NodeName:   "node",
+			SecretName: "osc-secret",
+			HealthCheckers: map[string]healthz.Checker{
+				"ping":          healthz.Ping,
+				"informer-sync": func(_ *http.Request) error { return errors.New("not synced") },
+			},
+		}
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:        "node",
+			Labels:      map[string]string{corev1.LabelHostname: "host", machinev1alpha1.LabelKeyNodeUpdateResult: "successful"},
+			Annotations: map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig: "old-checksum"},
+		}}
+		node.Status.Conditions = []corev1.NodeCondition{{Type: machinev1alpha1.NodeInPlaceUpdate, Reason: machinev1alpha1.ReadyForUpdate, Message: "ready"}}
+		Expect(c.Create(ctx, node)).To(Succeed())
+
+		Expect(c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:        "osc-secret",
+			Namespace:   metav1.NamespaceSystem,
+			Annotations: map[string]string{nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig: "new-checksum"},
+		}})).To(Succeed())
+
+		Expect(fs.WriteFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+spec:
+  units:
+  - name: foo.service
+  - name: bar.service
+status:
+  extensionUnits:
+  - name: baz.service
+`), 0600)).To(Succeed())
+
+		Expect(fs.WriteFile(nodeagentconfigv1alpha1.BaseDir+"/last-computed-osc-changes.yaml", []byte(`operatingSystemConfigChecksum: new-checksum
+units:
+  changed:
+  - name: foo.service
+  commands:
+  - name: foo.service
+    command: restart
+files:
+  changed:
+  - path: /etc/foo
+  deleted:
+  - path: /etc/bar
+mustRestartNodeAgent: true
+inPlaceUpdates:
+  operatingSystem: true
+  kubelet:
+    config: true
+`), 0600)).To(Succeed())
+
+		fakeDBus.SetUnits(
+			systemddbus.UnitStatus{Name: "foo.service", LoadState: "loaded", ActiveState: "active", SubState: "running"},
+			systemddbus.UnitStatus{Name: "baz.service", LoadState: "loaded", ActiveState: "failed", SubState: "failed"},
+		)
+	})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequestWithContext(ctx, http.MethodGet, path, nil))
+		return recorder
+	}
+
+	It("should serve the complete status", func() {
+		response := get(PathStatus)
+		Expect(response.Code).To(Equal(http.StatusOK))
+		Expect(response.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		status := &Status{}
+		Expect(json.Unmarshal(response.Body.Bytes(), status)).To(Succeed())
+		Expect(status).To(Equal(&Status{
+			HostName:       "host",
+			NodeName:       "node",
+			NodeRegistered: true,
+			OperatingSystemConfig: OperatingSystemConfigStatus{
+				CurrentChecksum:     "new-checksum",
+				LastAppliedChecksum: "old-checksum",
+				PendingChanges: &operatingsystemconfig.PendingChanges{
+					OperatingSystemConfigChecksum: "new-checksum",
+					ChangedUnits:                  []string{"foo.service"},
+					UnitCommands:                  map[string]extensionsv1alpha1.UnitCommand{"foo.service": extensionsv1alpha1.CommandRestart},
+					ChangedFiles:                  []string{"/etc/foo"},
+					DeletedFiles:                  []string{"/etc/bar"},
+					MustRestartNodeAgent:          true,
+					InPlaceUpdates:                []string{"kubeletConfig", "operatingSystem"},
+				},
+			},
+			InPlaceUpdate: &InPlaceUpdateStatus{Phase: machinev1alpha1.ReadyForUpdate, Message: "ready", Result: "successful"},
+			Units: []UnitStatus{
+				{Name: "bar.service", LoadState: "not-found"},
+				{Name: "baz.service", LoadState: "loaded", ActiveState: "failed", SubState: "failed"},
+				{Name: "foo.service", LoadState: "loaded", ActiveState: "active", SubState: "running"},
+			},
+			Controllers: []ControllerStatus{
+				{Name: "informer-sync", Healthy: false, Error: "not synced"},
+				{Name: "ping", Healthy: true},
+			},
+		}))
+	})
+
+	It("should not report pending changes if the operating system config is up to date", func() {
+		node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] = "new-checksum"
+		Expect(c.Update(ctx, node)).To(Succeed())
+
+		response := get(PathOperatingSystemConfig)
+		Expect(response.Code).To(Equal(http.StatusOK))
+
+		status := &OperatingSystemConfigStatus{}
+		Expect(json.Unmarshal(response.Body.Bytes(), status)).To(Succeed())
+		Expect(status).To(Equal(&OperatingSystemConfigStatus{CurrentChecksum: "new-checksum", LastAppliedChecksum: "new-checksum", UpToDate: true}))
+	})
+
+	It("should serve the unit states", func() {
+		response := get(PathUnits)
+		Expect(response.Code).To(Equal(http.StatusOK))
+
+		var units []UnitStatus
+		Expect(json.Unmarshal(response.Body.Bytes(), &units)).To(Succeed())
+		Expect(units).To(HaveLen(3))
+	})
+
+	It("should serve the controller health", func() {
+		response := get(PathControllers)
+		Expect(response.Code).To(Equal(http.StatusOK))
+
+		var controllers []ControllerStatus
+		Expect(json.Unmarshal(response.Body.Bytes(), &controllers)).To(Succeed())
+		Expect(controllers).To(ContainElement(ControllerStatus{Name: "ping", Healthy: true}))
+	})
+
+	It("should find the node by its hostname if the node name is not known", func() {
+		handler.NodeName = ""
+
+		status := &Status{}
+		Expect(json.Unmarshal(get(PathStatus).Body.Bytes(), status)).To(Succeed())
+		Expect(status.NodeName).To(Equal("node"))
+	})
+
+	It("should report the node as not registered if it does not exist", func() {
+		Expect(c.Delete(ctx, node)).To(Succeed())
+
+		response := get(PathStatus)
+		Expect(response.Code).To(Equal(http.StatusOK))
+
+		status := &Status{}
+		Expect(json.Unmarshal(response.Body.Bytes(), status)).To(Succeed())
+		Expect(status.NodeName).To(BeEmpty())
+		Expect(status.NodeRegistered).To(BeFalse())
+		Expect(status.OperatingSystemConfig.LastAppliedChecksum).To(BeEmpty())
+		Expect(status.InPlaceUpdate).To(BeNil())
+	})
+
+	It("should only allow reading the status", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequestWithContext(ctx, http.MethodPost, PathStatus, nil))
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("should return an error if the status cannot be computed", func() {
+		Expect(fs.WriteFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, []byte("{"), 0600)).To(Succeed())
+
+		Expect(get(PathUnits).Code).To(Equal(http.StatusInternalServerError))
+	})
+})
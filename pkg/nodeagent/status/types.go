@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+)
+
+// Status is the status of gardener-node-agent served by the status API.
+type Status struct {
+	// HostName is the hostname of the machine.
+	HostName string `json:"hostName"`
+	// NodeName is the name of the Node object, if it is registered already.
+	NodeName string `json:"nodeName,omitempty"`
+	// NodeRegistered is true if the Node object has been registered by the kubelet.
+	NodeRegistered bool `json:"nodeRegistered"`
+	// OperatingSystemConfig is the status of the OperatingSystemConfig.
+	OperatingSystemConfig OperatingSystemConfigStatus `json:"operatingSystemConfig"`
+	// InPlaceUpdate is the status of an in-place update, if there is one.
+	InPlaceUpdate *InPlaceUpdateStatus `json:"inPlaceUpdate,omitempty"`
+	// Units are the states of the units managed by gardener-node-agent.
+	Units []UnitStatus `json:"units,omitempty"`
+	// Controllers is the health of the controllers.
+	Controllers []ControllerStatus `json:"controllers,omitempty"`
+}
+
+// OperatingSystemConfigStatus is the status of the OperatingSystemConfig.
+type OperatingSystemConfigStatus struct {
+	// CurrentChecksum is the checksum of the OperatingSystemConfig which is currently provided for this node.
+	CurrentChecksum string `json:"currentChecksum,omitempty"`
+	// LastAppliedChecksum is the checksum of the OperatingSystemConfig which was applied last.
+	LastAppliedChecksum string `json:"lastAppliedChecksum,omitempty"`
+	// UpToDate is true if the current OperatingSystemConfig has been applied.
+	UpToDate bool `json:"upToDate"`
+	// PendingChanges are the changes which have been computed but not yet been applied completely.
+	PendingChanges *operatingsystemconfig.PendingChanges `json:"pendingChanges,omitempty"`
+}
+
+// InPlaceUpdateStatus is the status of an in-place update.
+type InPlaceUpdateStatus struct {
+	// Phase is the reason of the InPlaceUpdate condition of the Node, e.g., ReadyForUpdate.
+	Phase string `json:"phase"`
+	// Message is the message of the InPlaceUpdate condition of the Node.
+	Message string `json:"message,omitempty"`
+	// Result is the result of the update, if it is completed.
+	Result string `json:"result,omitempty"`
+}
+
+// UnitStatus is the state of a systemd unit.
+type UnitStatus struct {
+	// Name is the name of the unit.
+	Name string `json:"name"`
+	// LoadState is the load state of the unit, e.g., loaded or not-found.
+	LoadState string `json:"loadState"`
+	// ActiveState is the active state of the unit, e.g., active or failed.
+	ActiveState string `json:"activeState,omitempty"`
+	// SubState is the sub state of the unit, e.g., running or dead.
+	SubState string `json:"subState,omitempty"`
+}
+
+// ControllerStatus is the health of a controller.
+type ControllerStatus struct {
+	// Name is the name of the health check.
+	Name string `json:"name"`
+	// Healthy is true if the health check succeeded.
+	Healthy bool `json:"healthy"`
+	// Error is the error of the failed health check.
+	Error string `json:"error,omitempty"`
+}
@@ -29,10 +29,11 @@ import (
 	"github.com/gardener/gardener/pkg/operator/controller/gardenlet"
 	"github.com/gardener/gardener/pkg/operator/controller/virtual"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // AddToManager adds all controllers to the given manager.
-func AddToManager(operatorCancel context.CancelFunc, mgr manager.Manager, cfg *operatorconfigv1alpha1.OperatorConfiguration, gardenClientMap clientmap.ClientMap) error {
+func AddToManager(operatorCancel context.CancelFunc, mgr manager.Manager, cfg *operatorconfigv1alpha1.OperatorConfiguration, gardenClientMap clientmap.ClientMap, helmChartCache oci.Cache) error {
 	identity, err := gardenerutils.DetermineIdentity()
 	if err != nil {
 		return err
@@ -42,7 +43,7 @@ func AddToManager(operatorCancel context.CancelFunc, mgr manager.Manager, cfg *o
 		return err
 	}
 
-	if err := extension.AddToManager(mgr, cfg, gardenClientMap); err != nil {
+	if err := extension.AddToManager(mgr, cfg, gardenClientMap, helmChartCache); err != nil {
 		return err
 	}
 
@@ -101,7 +102,8 @@ func AddToManager(operatorCancel context.CancelFunc, mgr manager.Manager, cfg *o
 					}
 
 					return true, (&gardenlet.Reconciler{
-						Config: cfg.Controllers.GardenletDeployer,
+						Config:       cfg.Controllers.GardenletDeployer,
+						HelmRegistry: oci.NewHelmRegistry(virtualCluster.GetClient(), helmChartCache),
 						// garden.Spec.VirtualCluster.DNS.Domains[0].Name is immutable and always set.
 						DefaultGardenClusterAddress: fmt.Sprintf("https://%s", v1beta1helper.GetAPIServerDomain(garden.Spec.VirtualCluster.DNS.Domains[0].Name)),
 					}).AddToManager(ctx, mgr, virtualCluster)
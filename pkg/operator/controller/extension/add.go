@@ -12,13 +12,15 @@ import (
 	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/operator/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/operator/controller/extension/extension"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // AddToManager adds the extension controllers to the given manager.
-func AddToManager(mgr manager.Manager, cfg *operatorconfigv1alpha1.OperatorConfiguration, gardenClientMap clientmap.ClientMap) error {
+func AddToManager(mgr manager.Manager, cfg *operatorconfigv1alpha1.OperatorConfiguration, gardenClientMap clientmap.ClientMap, helmChartCache oci.Cache) error {
 	if err := (&extension.Reconciler{
 		Config:          *cfg,
 		GardenClientMap: gardenClientMap,
+		HelmRegistry:    oci.NewHelmRegistry(mgr.GetClient(), helmChartCache),
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
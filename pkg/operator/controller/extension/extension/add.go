@@ -67,7 +67,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	}
 
 	if r.HelmRegistry == nil {
-		r.HelmRegistry = oci.NewHelmRegistry(r.RuntimeClientSet.Client())
+		r.HelmRegistry = oci.NewHelmRegistry(r.RuntimeClientSet.Client(), nil)
 	}
 
 	if r.GardenNamespace == "" {
@@ -192,7 +192,7 @@ func (r *Reconciler) MapSecretToExtensions(log logr.Logger) handler.MapFunc {
 
 			if (pullSecretRef != nil && pullSecretRef.Name == secret.GetName()) ||
 				(caBundleSecretRef != nil && caBundleSecretRef.Name == secret.GetName()) {
-				// Helm pull secret and CA bundle secret of extension helm chart are considered,
+				// Pull secret and CA bundle secret of the extension Helm chart or manifests are considered,
 				// as they are used by gardenlets and need to be copied to the virtual garden.
 				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: ext.Name}})
 			}
@@ -18,6 +18,7 @@ import (
 	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	operatorv1alpha1helper "github.com/gardener/gardener/pkg/api/operator/v1alpha1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
@@ -90,9 +91,14 @@ func (d *deployment) Delete(ctx context.Context, log logr.Logger, extension *ope
 }
 
 func (d *deployment) createOrUpdateAdmissionRuntimeClusterResources(ctx context.Context, genericTokenKubeconfigSecretName string, extension *operatorv1alpha1.Extension) error {
-	archive, err := d.helmRegistry.Pull(ctx, extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster.Helm.OCIRepository)
+	var (
+		deploymentSpec = extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster
+		ociRepository  = operatorv1alpha1helper.GetOCIRepository(deploymentSpec)
+	)
+
+	archive, err := d.helmRegistry.Pull(ctx, ociRepository)
 	if err != nil {
-		return fmt.Errorf("failed pulling Helm chart from OCI repository %q: %w", extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster.Helm.OCIRepository.GetURL(), err)
+		return fmt.Errorf("failed pulling artifact from OCI repository %q: %w", ociRepository.GetURL(), err)
 	}
 
 	accessSecret := d.getVirtualClusterAccessSecret(resourceName(extension))
@@ -124,9 +130,9 @@ func (d *deployment) createOrUpdateAdmissionRuntimeClusterResources(ctx context.
 		return fmt.Errorf("failed rendering Helm values for admission deployment: %w", err)
 	}
 
-	renderedChart, err := d.runtimeClientSet.ChartRenderer().RenderArchive(archive, extension.Name, v1beta1constants.GardenNamespace, utils.MergeMaps(helmValues, gardenerValues))
+	renderedChart, err := operator.RenderExtensionDeployment(d.runtimeClientSet.ChartRenderer(), deploymentSpec, archive, extension.Name, v1beta1constants.GardenNamespace, utils.MergeMaps(helmValues, gardenerValues))
 	if err != nil {
-		return fmt.Errorf("failed rendering Helm chart %q: %w", extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster.Helm.OCIRepository.GetURL(), err)
+		return fmt.Errorf("failed rendering artifact %q: %w", ociRepository.GetURL(), err)
 	}
 
 	secretData := renderedChart.AsSecretData()
@@ -185,9 +191,14 @@ func (d *deployment) deleteAdmissionRuntimeClusterResources(ctx context.Context,
 }
 
 func (d *deployment) createOrUpdateAdmissionVirtualClusterResources(ctx context.Context, virtualClusterClientSet kubernetes.Interface, extension *operatorv1alpha1.Extension) error {
-	archive, err := d.helmRegistry.Pull(ctx, extension.Spec.Deployment.AdmissionDeployment.VirtualCluster.Helm.OCIRepository)
+	var (
+		deploymentSpec = extension.Spec.Deployment.AdmissionDeployment.VirtualCluster
+		ociRepository  = operatorv1alpha1helper.GetOCIRepository(deploymentSpec)
+	)
+
+	archive, err := d.helmRegistry.Pull(ctx, ociRepository)
 	if err != nil {
-		return fmt.Errorf("failed pulling Helm chart from OCI repository %q: %w", extension.Spec.Deployment.AdmissionDeployment.VirtualCluster.Helm.OCIRepository.GetURL(), err)
+		return fmt.Errorf("failed pulling artifact from OCI repository %q: %w", ociRepository.GetURL(), err)
 	}
 
 	accessSecret := d.getVirtualClusterAccessSecret(resourceName(extension))
@@ -222,9 +233,9 @@ func (d *deployment) createOrUpdateAdmissionVirtualClusterResources(ctx context.
 		return fmt.Errorf("failed adding namespace to registry: %w", err)
 	}
 
-	renderedChart, err := virtualClusterClientSet.ChartRenderer().RenderArchive(archive, extension.Name, namespace.Name, utils.MergeMaps(helmValues, gardenerValues))
+	renderedChart, err := operator.RenderExtensionDeployment(virtualClusterClientSet.ChartRenderer(), deploymentSpec, archive, extension.Name, namespace.Name, utils.MergeMaps(helmValues, gardenerValues))
 	if err != nil {
-		return fmt.Errorf("failed rendering Helm chart %q: %w", extension.Spec.Deployment.AdmissionDeployment.VirtualCluster.Helm.OCIRepository.GetURL(), err)
+		return fmt.Errorf("failed rendering artifact %q: %w", ociRepository.GetURL(), err)
 	}
 
 	serializedObjects, err := serializeRenderedChartAndRegistry(renderedChart, registry)
@@ -276,15 +287,13 @@ func serializeRenderedChartAndRegistry(chart *chartrenderer.RenderedChart, regis
 func runtimeDeploymentSpecified(extension *operatorv1alpha1.Extension) bool {
 	return extension.Spec.Deployment != nil &&
 		extension.Spec.Deployment.AdmissionDeployment != nil &&
-		extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster != nil &&
-		extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster.Helm != nil
+		operatorv1alpha1helper.IsDeploymentSpecified(extension.Spec.Deployment.AdmissionDeployment.RuntimeCluster)
 }
 
 func virtualDeploymentSpecified(extension *operatorv1alpha1.Extension) bool {
 	return extension.Spec.Deployment != nil &&
 		extension.Spec.Deployment.AdmissionDeployment != nil &&
-		extension.Spec.Deployment.AdmissionDeployment.VirtualCluster != nil &&
-		extension.Spec.Deployment.AdmissionDeployment.VirtualCluster.Helm != nil
+		operatorv1alpha1helper.IsDeploymentSpecified(extension.Spec.Deployment.AdmissionDeployment.VirtualCluster)
 }
 
 func virtualNamespace(extension *operatorv1alpha1.Extension) *corev1.Namespace {
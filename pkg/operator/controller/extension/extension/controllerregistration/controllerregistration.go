@@ -14,6 +14,8 @@ import (
 	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	v1helper "github.com/gardener/gardener/pkg/api/core/v1/helper"
+	operatorv1alpha1helper "github.com/gardener/gardener/pkg/api/operator/v1alpha1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
@@ -43,7 +45,7 @@ type registration struct {
 func (r *registration) Reconcile(ctx context.Context, log logr.Logger, extension *operatorv1alpha1.Extension) error {
 	if extension.Spec.Deployment == nil ||
 		extension.Spec.Deployment.ExtensionDeployment == nil ||
-		extension.Spec.Deployment.ExtensionDeployment.Helm == nil {
+		!operatorv1alpha1helper.IsDeploymentSpecified(&extension.Spec.Deployment.ExtensionDeployment.DeploymentSpec) {
 		if err := r.Delete(ctx, log, extension); err != nil {
 			return err
 		}
@@ -75,7 +77,7 @@ func (r *registration) createOrUpdateControllerRegistration(ctx context.Context,
 			return fmt.Errorf("failed to get pull secret: %w", err)
 		}
 		objs = append(objs, secret)
-		controllerDeployment.Helm.OCIRepository.PullSecretRef.Name = secret.Name
+		v1helper.GetOCIRepositoryForControllerDeployment(controllerDeployment).PullSecretRef.Name = secret.Name
 	}
 	if caBundleSecretRef := GetExtensionCABundleSecretRef(extension); caBundleSecretRef != nil {
 		secret, err := r.createSecretCopy(ctx, extension.Name, caBundleSecretRef)
@@ -83,7 +85,7 @@ func (r *registration) createOrUpdateControllerRegistration(ctx context.Context,
 			return fmt.Errorf("failed to get CA bundle secret: %w", err)
 		}
 		objs = append(objs, secret)
-		controllerDeployment.Helm.OCIRepository.CABundleSecretRef.Name = secret.Name
+		v1helper.GetOCIRepositoryForControllerDeployment(controllerDeployment).CABundleSecretRef.Name = secret.Name
 	}
 
 	referencedResources, err := chartutils.ResourceNamesFromValues(extension.Spec.Deployment.ExtensionDeployment.Values)
@@ -193,24 +195,26 @@ func New(runtimeClient client.Client, recorder events.EventRecorder, gardenNames
 	}
 }
 
-// GetExtensionPullSecretRef returns the pull secret reference for the extension's Helm chart.
+// GetExtensionPullSecretRef returns the pull secret reference for the extension's Helm chart or manifests.
 func GetExtensionPullSecretRef(extension *operatorv1alpha1.Extension) *corev1.LocalObjectReference {
 	if extension.Spec.Deployment == nil ||
-		extension.Spec.Deployment.ExtensionDeployment == nil ||
-		extension.Spec.Deployment.ExtensionDeployment.Helm == nil ||
-		extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository == nil {
+		extension.Spec.Deployment.ExtensionDeployment == nil {
 		return nil
 	}
-	return extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository.PullSecretRef
+	if ociRepository := operatorv1alpha1helper.GetOCIRepository(&extension.Spec.Deployment.ExtensionDeployment.DeploymentSpec); ociRepository != nil {
+		return ociRepository.PullSecretRef
+	}
+	return nil
 }
 
-// GetExtensionCABundleSecretRef returns the CA bundle secret reference for the extension's Helm chart.
+// GetExtensionCABundleSecretRef returns the CA bundle secret reference for the extension's Helm chart or manifests.
 func GetExtensionCABundleSecretRef(extension *operatorv1alpha1.Extension) *corev1.LocalObjectReference {
 	if extension.Spec.Deployment == nil ||
-		extension.Spec.Deployment.ExtensionDeployment == nil ||
-		extension.Spec.Deployment.ExtensionDeployment.Helm == nil ||
-		extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository == nil {
+		extension.Spec.Deployment.ExtensionDeployment == nil {
 		return nil
 	}
-	return extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository.CABundleSecretRef
+	if ociRepository := operatorv1alpha1helper.GetOCIRepository(&extension.Spec.Deployment.ExtensionDeployment.DeploymentSpec); ociRepository != nil {
+		return ociRepository.CABundleSecretRef
+	}
+	return nil
 }
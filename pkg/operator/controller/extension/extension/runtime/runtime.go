@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	operatorv1alpha1helper "github.com/gardener/gardener/pkg/api/operator/v1alpha1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
@@ -87,9 +88,14 @@ func (d *deployer) Delete(ctx context.Context, log logr.Logger, extension *opera
 }
 
 func (d *deployer) createOrUpdateResources(ctx context.Context, extension *operatorv1alpha1.Extension) error {
-	archive, err := d.helmRegistry.Pull(ctx, extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository)
+	var (
+		deploymentSpec = &extension.Spec.Deployment.ExtensionDeployment.DeploymentSpec
+		ociRepository  = operatorv1alpha1helper.GetOCIRepository(deploymentSpec)
+	)
+
+	archive, err := d.helmRegistry.Pull(ctx, ociRepository)
 	if err != nil {
-		return fmt.Errorf("failed pulling Helm chart from OCI repository %q: %w", extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository.GetURL(), err)
+		return fmt.Errorf("failed pulling artifact from OCI repository %q: %w", ociRepository.GetURL(), err)
 	}
 
 	gardenerValues := map[string]any{
@@ -118,9 +124,9 @@ func (d *deployer) createOrUpdateResources(ctx context.Context, extension *opera
 		return fmt.Errorf("failed ensuring namespace %q: %w", namespace, err)
 	}
 
-	renderedChart, err := d.runtimeClientSet.ChartRenderer().RenderArchive(archive, extension.Name, namespace, utils.MergeMaps(helmValues, gardenerValues))
+	renderedChart, err := operator.RenderExtensionDeployment(d.runtimeClientSet.ChartRenderer(), deploymentSpec, archive, extension.Name, namespace, utils.MergeMaps(helmValues, gardenerValues))
 	if err != nil {
-		return fmt.Errorf("failed rendering Helm chart %q: %w", extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository.GetURL(), err)
+		return fmt.Errorf("failed rendering artifact %q: %w", ociRepository.GetURL(), err)
 	}
 
 	mrName := operator.ExtensionRuntimeManagedResourceName(extension.Name)
@@ -203,7 +209,7 @@ func (d *deployer) deleteNamespace(ctx context.Context, name string) error {
 func extensionDeploymentSpecified(extension *operatorv1alpha1.Extension) bool {
 	return extension.Spec.Deployment != nil &&
 		extension.Spec.Deployment.ExtensionDeployment != nil &&
-		extension.Spec.Deployment.ExtensionDeployment.Helm != nil
+		operatorv1alpha1helper.IsDeploymentSpecified(&extension.Spec.Deployment.ExtensionDeployment.DeploymentSpec)
 }
 
 // New creates a new runtime deployer.
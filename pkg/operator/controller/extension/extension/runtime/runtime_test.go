@@ -115,7 +115,7 @@ var _ = Describe("Runtime", func() {
 	Describe("#Reconcile", func() {
 		It("should fail when OCI artifact is not found", func() {
 			_, err := runtime.Reconcile(ctx, log, extension)
-			Expect(err).To(MatchError(`failed pulling Helm chart from OCI repository "local-extension-runtime:v1.2.3": not found`))
+			Expect(err).To(MatchError(`failed pulling artifact from OCI repository "local-extension-runtime:v1.2.3": not found`))
 		})
 
 		It("should succeed reconciling the extension resources", func() {
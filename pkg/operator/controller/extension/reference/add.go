@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	v1helper "github.com/gardener/gardener/pkg/api/core/v1/helper"
+	operatorv1alpha1helper "github.com/gardener/gardener/pkg/api/operator/v1alpha1/helper"
 	operatorconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/operator/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
@@ -80,16 +81,12 @@ func namesForOCISecrets(extension *operatorv1alpha1.Extension) []string {
 	names := sets.New[string]()
 
 	if deployment := extension.Spec.Deployment; deployment != nil {
-		if deployment.ExtensionDeployment != nil && deployment.ExtensionDeployment.Helm != nil {
-			names.Insert(v1helper.GetSecretsForOCIRepository(deployment.ExtensionDeployment.Helm.OCIRepository)...)
+		if deployment.ExtensionDeployment != nil {
+			names.Insert(v1helper.GetSecretsForOCIRepository(operatorv1alpha1helper.GetOCIRepository(&deployment.ExtensionDeployment.DeploymentSpec))...)
 		}
 		if admissionDeployment := deployment.AdmissionDeployment; admissionDeployment != nil {
-			if admissionDeployment.RuntimeCluster != nil && admissionDeployment.RuntimeCluster.Helm != nil {
-				names.Insert(v1helper.GetSecretsForOCIRepository(admissionDeployment.RuntimeCluster.Helm.OCIRepository)...)
-			}
-			if admissionDeployment.VirtualCluster != nil && admissionDeployment.VirtualCluster.Helm != nil {
-				names.Insert(v1helper.GetSecretsForOCIRepository(admissionDeployment.VirtualCluster.Helm.OCIRepository)...)
-			}
+			names.Insert(v1helper.GetSecretsForOCIRepository(operatorv1alpha1helper.GetOCIRepository(admissionDeployment.RuntimeCluster))...)
+			names.Insert(v1helper.GetSecretsForOCIRepository(operatorv1alpha1helper.GetOCIRepository(admissionDeployment.VirtualCluster))...)
 		}
 	}
 
@@ -1294,6 +1294,7 @@ func (r *Reconciler) newGardenerScheduler(garden *operatorv1alpha1.Garden, secre
 		if config.Strategy != nil {
 			values.Strategy = schedulerconfigv1alpha1.CandidateDeterminationStrategy(*config.Strategy)
 		}
+		values.Scoring = operatorv1alpha1conversion.ConvertToSchedulerScoringConfiguration(config.Scoring)
 	}
 
 	return gardenerscheduler.New(r.RuntimeClientSet.Client(), r.GardenNamespace, secretsManager, values), nil
@@ -7,6 +7,7 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -18,11 +19,13 @@ import (
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener/extensions/pkg/infraflow"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/provider-local/cloud-provider/loadbalancer"
 	"github.com/gardener/gardener/pkg/provider-local/local"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
@@ -40,6 +43,16 @@ func NewActuator(mgr manager.Manager) infrastructure.Actuator {
 	}
 }
 
+const (
+	// keyNetworkPolicyAllowMachinePods is the key of the name of the NetworkPolicy allowing traffic of machine pods in the
+	// infrastructure state.
+	keyNetworkPolicyAllowMachinePods = "networkPolicyAllowMachinePods"
+	// keyServiceMachines is the key of the name of the machines Service in the infrastructure state.
+	keyServiceMachines = "serviceMachines"
+	// keyPrefixIPPool is the prefix of the keys of the names of the IPPools (per IP family) in the infrastructure state.
+	keyPrefixIPPool = "ipPool/"
+)
+
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, infrastructure *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
 	providerClient, err := local.GetProviderClient(ctx, log, a.runtimeClient, infrastructure.Spec.SecretRef)
 	if err != nil {
@@ -48,83 +61,80 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, infrastructur
 
 	// We don't need these resources in case we're operating in the kube-system namespace (only the case for self-hosted
 	// shoots) - we will never have machine pods there.
-	if infrastructure.Namespace != metav1.NamespaceSystem {
-		networkPolicyAllowMachinePods := emptyNetworkPolicy("allow-machine-pods", infrastructure.Namespace)
-		networkPolicyAllowMachinePods.Spec = networkingv1.NetworkPolicySpec{
-			Ingress: []networkingv1.NetworkPolicyIngressRule{{
-				From: []networkingv1.NetworkPolicyPeer{
-					{
-						PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "machine"}},
-					},
-					// Allow traffic from load balancer containers in the Docker kind network.
-					{
-						IPBlock: &networkingv1.IPBlock{CIDR: loadbalancer.InternalRangeV4},
-					},
-					{
-						IPBlock: &networkingv1.IPBlock{CIDR: loadbalancer.InternalRangeV6},
-					},
-				}},
-			},
-			Egress: []networkingv1.NetworkPolicyEgressRule{{
-				To: []networkingv1.NetworkPolicyPeer{
-					{
-						PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "machine"}},
-					},
-					{
-						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "registry"}},
-						PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "registry"}},
-					},
-				},
-			}},
-			PodSelector: metav1.LabelSelector{
-				MatchLabels: map[string]string{"app": "machine"},
-			},
-			PolicyTypes: []networkingv1.PolicyType{
-				networkingv1.PolicyTypeIngress,
-				networkingv1.PolicyTypeEgress,
-			},
-		}
-
-		// The machines service is used to add NetworkPolicies for accessing the machine ports,
-		// e.g., access from the Bastion pod to port 22
-		service := emptyService(infrastructure.Namespace)
-		service.Spec = corev1.ServiceSpec{
-			Type:     corev1.ServiceTypeClusterIP,
-			Selector: map[string]string{"app": "machine"},
-			Ports: []corev1.ServicePort{
-				{
-					Name:        "ssh",
-					Port:        22,
-					Protocol:    corev1.ProtocolTCP,
-					AppProtocol: new("ssh"),
-				},
-			},
-		}
+	skipMachineResources := infrastructure.Namespace == metav1.NamespaceSystem
+	if !skipMachineResources && (cluster.Shoot.Spec.Networking == nil || cluster.Shoot.Spec.Networking.Nodes == nil) {
+		return fmt.Errorf("shoot specification does not contain node network CIDR required for VPN tunnel")
+	}
 
-		if cluster.Shoot.Spec.Networking == nil || cluster.Shoot.Spec.Networking.Nodes == nil {
-			return fmt.Errorf("shoot specification does not contain node network CIDR required for VPN tunnel")
-		}
+	state, err := infraflow.LoadState(infrastructure, nil)
+	if err != nil {
+		return err
+	}
 
-		objects := []client.Object{
-			networkPolicyAllowMachinePods,
-			service,
-		}
+	var (
+		whiteboard = infraflow.NewWhiteboard(state)
+		g          = infraflow.NewGraph("Local infrastructure reconciliation", whiteboard, infraflow.InfrastructureStatePersister(a.runtimeClient, infrastructure))
+	)
 
-		for _, ipFamily := range cluster.Shoot.Spec.Networking.IPFamilies {
-			ipPoolObj, err := ipPool(cluster.ObjectMeta, string(ipFamily), *cluster.Shoot.Spec.Networking.Nodes)
-			if err != nil {
+	g.Add(infraflow.Step{
+		Name: "Ensuring NetworkPolicy allowing traffic of machine pods",
+		Fn: func(ctx context.Context, whiteboard *infraflow.Whiteboard) error {
+			networkPolicy := networkPolicyAllowMachinePods(infrastructure.Namespace)
+			if err := providerClient.Patch(ctx, networkPolicy, client.Apply, local.FieldOwner, client.ForceOwnership); err != nil {
 				return err
 			}
-			objects = append(objects, ipPoolObj)
-		}
+			whiteboard.Set(keyNetworkPolicyAllowMachinePods, networkPolicy.Name)
+			return nil
+		},
+		SkipIf: skipMachineResources,
+	})
 
-		for _, obj := range objects {
-			if err := providerClient.Patch(ctx, obj, client.Apply, local.FieldOwner, client.ForceOwnership); err != nil {
+	g.Add(infraflow.Step{
+		Name: "Ensuring machines Service",
+		Fn: func(ctx context.Context, whiteboard *infraflow.Whiteboard) error {
+			service := serviceMachines(infrastructure.Namespace)
+			if err := providerClient.Patch(ctx, service, client.Apply, local.FieldOwner, client.ForceOwnership); err != nil {
 				return err
 			}
+			whiteboard.Set(keyServiceMachines, service.Name)
+			return nil
+		},
+		SkipIf: skipMachineResources,
+	})
+
+	var ipFamilies []gardencorev1beta1.IPFamily
+	if cluster.Shoot.Spec.Networking != nil {
+		ipFamilies = cluster.Shoot.Spec.Networking.IPFamilies
+		for _, ipFamily := range ipFamilies {
+			g.Add(infraflow.Step{
+				Name: fmt.Sprintf("Ensuring IPPool for %s machine pods", ipFamily),
+				Fn: func(ctx context.Context, whiteboard *infraflow.Whiteboard) error {
+					ipPoolObj, err := ipPool(cluster.ObjectMeta, string(ipFamily), *cluster.Shoot.Spec.Networking.Nodes)
+					if err != nil {
+						return err
+					}
+					if err := providerClient.Patch(ctx, ipPoolObj, client.Apply, local.FieldOwner, client.ForceOwnership); err != nil {
+						return err
+					}
+					whiteboard.Set(ipPoolKey(ipFamily), ipPoolObj.GetName())
+					return nil
+				},
+				SkipIf: skipMachineResources,
+			})
+		}
+	}
+
+	// IPPools of IP families which were removed from the shoot are deleted with the names persisted in the state.
+	for _, ipFamily := range allIPFamilies {
+		if !slices.Contains(ipFamilies, ipFamily) && whiteboard.Has(ipPoolKey(ipFamily)) {
+			g.Add(deleteIPPoolStep(providerClient, infrastructure.Namespace, ipFamily))
 		}
 	}
 
+	if err := g.Run(ctx, flow.Opts{Log: log}); err != nil {
+		return flow.Errors(err)
+	}
+
 	patch := client.MergeFrom(infrastructure.DeepCopy())
 	infrastructure.Status.Networking = &extensionsv1alpha1.InfrastructureStatusNetworking{}
 	if nodes := cluster.Shoot.Spec.Networking.Nodes; nodes != nil {
@@ -151,12 +161,84 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, infrastructure *
 		return fmt.Errorf("could not create client for infrastructure resources: %w", err)
 	}
 
-	return kubernetesutils.DeleteObjects(ctx, providerClient,
-		emptyNetworkPolicy("allow-machine-pods", infrastructure.Namespace),
-		emptyService(infrastructure.Namespace),
-		&metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "crd.projectcalico.org/v1", Kind: "IPPool"}, ObjectMeta: metav1.ObjectMeta{Name: IPPoolName(infrastructure.Namespace, string(gardencorev1beta1.IPFamilyIPv4))}},
-		&metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{APIVersion: "crd.projectcalico.org/v1", Kind: "IPPool"}, ObjectMeta: metav1.ObjectMeta{Name: IPPoolName(infrastructure.Namespace, string(gardencorev1beta1.IPFamilyIPv6))}},
+	state, err := infraflow.LoadState(infrastructure, nil)
+	if err != nil {
+		return err
+	}
+
+	var (
+		whiteboard = infraflow.NewWhiteboard(state)
+		g          = infraflow.NewGraph("Local infrastructure deletion", whiteboard, infraflow.InfrastructureStatePersister(a.runtimeClient, infrastructure))
 	)
+
+	// The objects are deleted with the names persisted in the state. As they have deterministic names, they are also
+	// deleted if their names are not contained in the state, e.g., because they were created before the state was
+	// introduced.
+	g.Add(infraflow.Step{
+		Name: "Deleting NetworkPolicy allowing traffic of machine pods",
+		Fn: func(ctx context.Context, whiteboard *infraflow.Whiteboard) error {
+			name := nameFromWhiteboard(whiteboard, keyNetworkPolicyAllowMachinePods, networkPolicyNameAllowMachinePods)
+			if err := kubernetesutils.DeleteObject(ctx, providerClient, emptyNetworkPolicy(name, infrastructure.Namespace)); err != nil {
+				return err
+			}
+			whiteboard.Delete(keyNetworkPolicyAllowMachinePods)
+			return nil
+		},
+	})
+
+	g.Add(infraflow.Step{
+		Name: "Deleting machines Service",
+		Fn: func(ctx context.Context, whiteboard *infraflow.Whiteboard) error {
+			name := nameFromWhiteboard(whiteboard, keyServiceMachines, serviceNameMachines)
+			if err := kubernetesutils.DeleteObject(ctx, providerClient, emptyService(name, infrastructure.Namespace)); err != nil {
+				return err
+			}
+			whiteboard.Delete(keyServiceMachines)
+			return nil
+		},
+	})
+
+	for _, ipFamily := range allIPFamilies {
+		g.Add(deleteIPPoolStep(providerClient, infrastructure.Namespace, ipFamily))
+	}
+
+	if err := g.Run(ctx, flow.Opts{Log: log}); err != nil {
+		return flow.Errors(err)
+	}
+	return nil
+}
+
+// allIPFamilies are the IP families for which IPPools might have been created.
+var allIPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
+
+// deleteIPPoolStep returns a step which deletes the IPPool of the given IP family. The name persisted in the state is
+// used, falling back to the deterministic name if the state does not contain it.
+func deleteIPPoolStep(providerClient client.Client, namespace string, ipFamily gardencorev1beta1.IPFamily) infraflow.Step {
+	return infraflow.Step{
+		Name: fmt.Sprintf("Deleting IPPool for %s machine pods", ipFamily),
+		Fn: func(ctx context.Context, whiteboard *infraflow.Whiteboard) error {
+			name := nameFromWhiteboard(whiteboard, ipPoolKey(ipFamily), IPPoolName(namespace, string(ipFamily)))
+			if err := kubernetesutils.DeleteObject(ctx, providerClient, emptyIPPool(name)); err != nil {
+				return err
+			}
+			whiteboard.Delete(ipPoolKey(ipFamily))
+			return nil
+		},
+	}
+}
+
+// ipPoolKey returns the key of the name of the IPPool for the given IP family in the infrastructure state.
+func ipPoolKey(ipFamily gardencorev1beta1.IPFamily) string {
+	return keyPrefixIPPool + strings.ToLower(string(ipFamily))
+}
+
+// nameFromWhiteboard returns the name stored under the given key in the whiteboard, or the given default name if the
+// whiteboard does not contain the key.
+func nameFromWhiteboard(whiteboard *infraflow.Whiteboard, key, defaultName string) string {
+	if name := whiteboard.Get(key); name != "" {
+		return name
+	}
+	return defaultName
 }
 
 func (a *actuator) Migrate(context.Context, logr.Logger, *extensionsv1alpha1.Infrastructure, *extensionscontroller.Cluster) error {
@@ -177,6 +259,71 @@ func (a *actuator) Restore(ctx context.Context, log logr.Logger, infrastructure
 	return a.Reconcile(ctx, log, infrastructure, cluster)
 }
 
+const (
+	// networkPolicyNameAllowMachinePods is the name of the NetworkPolicy allowing traffic of machine pods.
+	networkPolicyNameAllowMachinePods = "allow-machine-pods"
+	// serviceNameMachines is the name of the machines Service.
+	serviceNameMachines = "machines"
+)
+
+func networkPolicyAllowMachinePods(namespace string) *networkingv1.NetworkPolicy {
+	networkPolicy := emptyNetworkPolicy(networkPolicyNameAllowMachinePods, namespace)
+	networkPolicy.Spec = networkingv1.NetworkPolicySpec{
+		Ingress: []networkingv1.NetworkPolicyIngressRule{{
+			From: []networkingv1.NetworkPolicyPeer{
+				{
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "machine"}},
+				},
+				// Allow traffic from load balancer containers in the Docker kind network.
+				{
+					IPBlock: &networkingv1.IPBlock{CIDR: loadbalancer.InternalRangeV4},
+				},
+				{
+					IPBlock: &networkingv1.IPBlock{CIDR: loadbalancer.InternalRangeV6},
+				},
+			}},
+		},
+		Egress: []networkingv1.NetworkPolicyEgressRule{{
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "machine"}},
+				},
+				{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "registry"}},
+					PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "registry"}},
+				},
+			},
+		}},
+		PodSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"app": "machine"},
+		},
+		PolicyTypes: []networkingv1.PolicyType{
+			networkingv1.PolicyTypeIngress,
+			networkingv1.PolicyTypeEgress,
+		},
+	}
+	return networkPolicy
+}
+
+// serviceMachines returns the machines Service which is used to add NetworkPolicies for accessing the machine ports,
+// e.g., access from the Bastion pod to port 22.
+func serviceMachines(namespace string) *corev1.Service {
+	service := emptyService(serviceNameMachines, namespace)
+	service.Spec = corev1.ServiceSpec{
+		Type:     corev1.ServiceTypeClusterIP,
+		Selector: map[string]string{"app": "machine"},
+		Ports: []corev1.ServicePort{
+			{
+				Name:        "ssh",
+				Port:        22,
+				Protocol:    corev1.ProtocolTCP,
+				AppProtocol: new("ssh"),
+			},
+		},
+	}
+	return service
+}
+
 func emptyNetworkPolicy(name, namespace string) *networkingv1.NetworkPolicy {
 	return &networkingv1.NetworkPolicy{
 		TypeMeta: metav1.TypeMeta{
@@ -190,14 +337,14 @@ func emptyNetworkPolicy(name, namespace string) *networkingv1.NetworkPolicy {
 	}
 }
 
-func emptyService(namespace string) *corev1.Service {
+func emptyService(name, namespace string) *corev1.Service {
 	return &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: corev1.SchemeGroupVersion.String(),
 			Kind:       "Service",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "machines",
+			Name:      name,
 			Namespace: namespace,
 			Labels: map[string]string{
 				"app": "machine",
@@ -206,6 +353,13 @@ func emptyService(namespace string) *corev1.Service {
 	}
 }
 
+func emptyIPPool(name string) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "crd.projectcalico.org/v1", Kind: "IPPool"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+}
+
 // IPPoolName returns the name of the crd.projectcalico.org/v1.IPPool resource for the given shoot namespace.
 func IPPoolName(shootNamespace, ipFamily string) string {
 	return "shoot-machine-pods-" + shootNamespace + "-" + strings.ToLower(ipFamily)
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsinfrastructurecontroller "github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	"github.com/gardener/gardener/extensions/pkg/infraflow"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/provider-local/controller/infrastructure"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Actuator", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		log        = logr.Discard()
+		fakeClient client.Client
+		actuator   extensionsinfrastructurecontroller.Actuator
+
+		cluster        *extensionscontroller.Cluster
+		infrastructure *extensionsv1alpha1.Infrastructure
+	)
+
+	newActuator := func(servicePatchErr error) {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace}}
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.SeedScheme).
+			WithObjects(secret, infrastructure).
+			WithStatusSubresource(&extensionsv1alpha1.Infrastructure{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					if _, ok := obj.(*corev1.Service); ok && servicePatchErr != nil {
+						return servicePatchErr
+					}
+					if patch.Type() != types.ApplyPatchType {
+						return c.Patch(ctx, obj, patch, opts...)
+					}
+
+					// The fake client cannot apply all used types, hence server-side apply is emulated by creating or
+					// updating the object.
+					if err := c.Create(ctx, obj); !apierrors.IsAlreadyExists(err) {
+						return err
+					}
+					return c.Update(ctx, obj)
+				},
+			}).
+			Build()
+		actuator = NewActuator(test.FakeManager{Client: fakeClient})
+	}
+
+	persistedState := func(ctx context.Context) map[string]string {
+		GinkgoHelper()
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(infrastructure), infrastructure)).To(Succeed())
+		state, err := infraflow.DecodeState(infrastructure.Status.State)
+		Expect(err).NotTo(HaveOccurred())
+		return state.Data
+	}
+
+	BeforeEach(func() {
+		cluster = &extensionscontroller.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Networking: &gardencorev1beta1.Networking{
+						Nodes: new("10.0.0.0/16"),
+					},
+				},
+			},
+		}
+
+		infrastructure = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infrastructure", Namespace: namespace},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				SecretRef: corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+			},
+		}
+	})
+
+	Describe("#Reconcile", func() {
+		It("should persist the names of the created objects in the state", func(ctx SpecContext) {
+			newActuator(nil)
+
+			Expect(actuator.Reconcile(ctx, log, infrastructure, cluster)).To(Succeed())
+
+			Expect(persistedState(ctx)).To(Equal(map[string]string{
+				"networkPolicyAllowMachinePods": "allow-machine-pods",
+				"serviceMachines":               "machines",
+			}))
+			Expect(infrastructure.Status.Networking).To(Equal(&extensionsv1alpha1.InfrastructureStatusNetworking{Nodes: []string{"10.0.0.0/16"}}))
+		})
+
+		It("should persist the state of the successful steps if a step fails", func(ctx SpecContext) {
+			newActuator(errors.New("fake"))
+
+			Expect(actuator.Reconcile(ctx, log, infrastructure, cluster)).To(MatchError(ContainSubstring("fake")))
+
+			Expect(persistedState(ctx)).To(Equal(map[string]string{
+				"networkPolicyAllowMachinePods": "allow-machine-pods",
+			}))
+		})
+
+		It("should delete the IPPools of removed IP families with the names persisted in the state", func(ctx SpecContext) {
+			var err error
+			infrastructure.Status.State, err = infraflow.NewState(map[string]string{
+				"ipPool/ipv6": "old-ipv6-pool",
+			}).Encode()
+			Expect(err).NotTo(HaveOccurred())
+
+			newActuator(nil)
+			ipPool := newIPPool("old-ipv6-pool")
+			Expect(fakeClient.Create(ctx, ipPool)).To(Succeed())
+
+			Expect(actuator.Reconcile(ctx, log, infrastructure, cluster)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(ipPool), ipPool)).To(BeNotFoundError())
+			Expect(persistedState(ctx)).To(Equal(map[string]string{
+				"networkPolicyAllowMachinePods": "allow-machine-pods",
+				"serviceMachines":               "machines",
+			}))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the objects and clear the state", func(ctx SpecContext) {
+			var err error
+			infrastructure.Status.State, err = infraflow.NewState(map[string]string{
+				"networkPolicyAllowMachinePods": "allow-machine-pods",
+				"serviceMachines":               "machines",
+			}).Encode()
+			Expect(err).NotTo(HaveOccurred())
+
+			newActuator(nil)
+			networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "allow-machine-pods", Namespace: namespace}}
+			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "machines", Namespace: namespace}}
+			Expect(fakeClient.Create(ctx, networkPolicy)).To(Succeed())
+			Expect(fakeClient.Create(ctx, service)).To(Succeed())
+
+			Expect(actuator.Delete(ctx, log, infrastructure, cluster)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(BeNotFoundError())
+			Expect(persistedState(ctx)).To(BeEmpty())
+		})
+
+		It("should delete the objects with the names persisted in the state", func(ctx SpecContext) {
+			var err error
+			infrastructure.Status.State, err = infraflow.NewState(map[string]string{
+				"networkPolicyAllowMachinePods": "other-policy",
+				"serviceMachines":               "other-service",
+				"ipPool/ipv4":                   "other-ipv4-pool",
+			}).Encode()
+			Expect(err).NotTo(HaveOccurred())
+
+			newActuator(nil)
+			networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "other-policy", Namespace: namespace}}
+			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "other-service", Namespace: namespace}}
+			ipPool := newIPPool("other-ipv4-pool")
+			Expect(fakeClient.Create(ctx, networkPolicy)).To(Succeed())
+			Expect(fakeClient.Create(ctx, service)).To(Succeed())
+			Expect(fakeClient.Create(ctx, ipPool)).To(Succeed())
+
+			Expect(actuator.Delete(ctx, log, infrastructure, cluster)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(networkPolicy), networkPolicy)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(service), service)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(ipPool), ipPool)).To(BeNotFoundError())
+			Expect(persistedState(ctx)).To(BeEmpty())
+		})
+	})
+})
+
+func newIPPool(name string) *unstructured.Unstructured {
+	ipPool := &unstructured.Unstructured{}
+	ipPool.SetAPIVersion("crd.projectcalico.org/v1")
+	ipPool.SetKind("IPPool")
+	ipPool.SetName(name)
+	return ipPool
+}
@@ -65,6 +65,8 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 				resourcemanagerpredicate.HasOperationAnnotation(),
 				resourcemanagerpredicate.ConditionStatusChanged(resourcesv1alpha1.ResourcesHealthy, resourcemanagerpredicate.ConditionChangedToUnhealthy),
 				resourcemanagerpredicate.NoLongerIgnored(),
+				resourcemanagerpredicate.PreviewChanged(),
+				resourcemanagerpredicate.RolloutChanged(),
 				// we need to reconcile once if the ManagedResource got marked as ignored in order to update the conditions
 				resourcemanagerpredicate.GotMarkedAsIgnored(),
 				r.ClassFilter.CleanupCompleted(),
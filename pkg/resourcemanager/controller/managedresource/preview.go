@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// ignoredFieldsForPreview are fields which are changed by the API server with every update or which are not managed by
+// the controller, hence they are not reported as changed fields in the preview.
+var ignoredFieldsForPreview = sets.New(
+	"metadata.generation",
+	"metadata.managedFields",
+	"metadata.resourceVersion",
+	"status",
+)
+
+// preview computes the changes which would be performed in the target cluster when applying the resources of the
+// ManagedResource and reports them in its status. The changes of the new resources are computed with a dry-run of the
+// same create or update requests which are sent when the resources are applied, the deletions based on the resources
+// recorded in the status. Neither the target cluster nor the status
+// of the applied resources are changed.
+func (r *Reconciler) preview(
+	ctx context.Context,
+	log logr.Logger,
+	mr *resourcesv1alpha1.ManagedResource,
+	origin string,
+	secretsDataChecksum string,
+	newResourcesObjects []object,
+	existingResourcesIndex *objectIndex,
+	equivalences Equivalences,
+	decodingErrors []*decodingError,
+) (reconcile.Result, error) {
+	log.Info("Computing preview of changes since ManagedResource is marked for preview")
+
+	changes, err := r.previewNewResources(ctx, origin, newResourcesObjects, mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue}), equivalences)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not compute preview of new resources: %w", err)
+	}
+
+	deletions, err := r.previewOldResources(ctx, existingResourcesIndex)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not compute preview of old resources: %w", err)
+	}
+	changes = append(changes, deletions...)
+
+	preview := &resourcesv1alpha1.ManagedResourcePreview{
+		SecretsDataChecksum: secretsDataChecksum,
+		LastUpdateTime:      metav1.NewTime(r.Clock.Now()),
+		Summary:             previewSummary(changes, decodingErrors),
+		Changes:             changes,
+	}
+
+	if !previewChanged(mr.Status.Preview, preview) {
+		log.V(1).Info("Preview of changes did not change")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	mr.Status.Preview = preview
+	if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+	}
+
+	log.Info("Finished computing preview of changes", "summary", preview.Summary)
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) previewNewResources(ctx context.Context, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences) ([]resourcesv1alpha1.ResourceChange, error) {
+	horizontallyScaledObjects, err := computeHorizontallyScaledObjectKeys(ctx, r.TargetClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
+	}
+
+	var (
+		changes []resourcesv1alpha1.ResourceChange
+		// The dry-run returns the objects as they would be persisted by the API server, i.e., including defaulting and
+		// mutating admission, without changing them in the target cluster.
+		dryRunClient = client.NewDryRunClient(r.TargetClient)
+	)
+
+	for _, obj := range sortByKind(newResourcesObjects) {
+		var (
+			current            = obj.obj.DeepCopy()
+			resource           = unstructuredToString(obj.obj)
+			scaledHorizontally = isScaled(obj.obj, horizontallyScaledObjects, equivalences)
+			change             = resourcesv1alpha1.ResourceChange{
+				ObjectReference: corev1.ObjectReference{
+					APIVersion: obj.obj.GetAPIVersion(),
+					Kind:       obj.obj.GetKind(),
+					Name:       obj.obj.GetName(),
+					Namespace:  obj.obj.GetNamespace(),
+				},
+				Operation: resourcesv1alpha1.ResourceChangeOperationUpdate,
+			}
+		)
+
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(obj.obj.GroupVersionKind())
+		if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(obj.obj), existing); err != nil {
+			if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				return nil, fmt.Errorf("error getting object %q: %w", resource, err)
+			}
+			change.Operation = resourcesv1alpha1.ResourceChangeOperationCreate
+		}
+
+		// Existing resources are not changed by the controller if they are marked to be ignored.
+		if change.Operation == resourcesv1alpha1.ResourceChangeOperationUpdate && ignore(obj.obj) {
+			continue
+		}
+
+		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, dryRunClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), mutateFunc(origin, obj, current, labelsToInject, scaledHorizontally))
+		if err != nil {
+			// The resource kind might not be known yet since its CustomResourceDefinition is only created as part of the
+			// same ManagedResource.
+			if !meta.IsNoMatchError(err) {
+				change.Error = ptr.To(err.Error())
+			}
+		}
+
+		if change.Operation == resourcesv1alpha1.ResourceChangeOperationUpdate && change.Error == nil {
+			if operationResult == controllerutil.OperationResultNone {
+				continue
+			}
+			if change.Fields = changedFields(existing.Object, current.Object); len(change.Fields) == 0 {
+				continue
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func (r *Reconciler) previewOldResources(ctx context.Context, index *objectIndex) ([]resourcesv1alpha1.ResourceChange, error) {
+	var changes []resourcesv1alpha1.ResourceChange
+
+	for _, oldResource := range index.Objects() {
+		if index.Found(oldResource) {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(oldResource.APIVersion)
+		obj.SetKind(oldResource.Kind)
+		obj.SetNamespace(oldResource.Namespace)
+		obj.SetName(oldResource.Name)
+
+		if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+				return nil, fmt.Errorf("error getting object %q: %w", unstructuredToString(obj), err)
+			}
+			continue
+		}
+
+		if keepObject(obj) || (r.GarbageCollectorActivated && isGarbageCollectableResource(obj)) {
+			continue
+		}
+
+		changes = append(changes, resourcesv1alpha1.ResourceChange{
+			ObjectReference: corev1.ObjectReference{
+				APIVersion: oldResource.APIVersion,
+				Kind:       oldResource.Kind,
+				Name:       oldResource.Name,
+				Namespace:  oldResource.Namespace,
+			},
+			Operation: resourcesv1alpha1.ResourceChangeOperationDelete,
+		})
+	}
+
+	return changes, nil
+}
+
+// changedFields returns the sorted paths of all fields which differ between the given objects. Changed lists are
+// reported as a whole. Values are never part of the result since the objects might contain confidential data.
+func changedFields(oldObj, newObj map[string]any) []string {
+	var fields []string
+	collectChangedFields(&fields, "", normalize(oldObj), normalize(newObj))
+	slices.Sort(fields)
+	return fields
+}
+
+func collectChangedFields(fields *[]string, path string, oldValue, newValue any) {
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+	if !oldIsMap || !newIsMap {
+		if !apiequality.Semantic.DeepEqual(oldValue, newValue) {
+			*fields = append(*fields, path)
+		}
+		return
+	}
+
+	for _, key := range sets.List(sets.KeySet(oldMap).Union(sets.KeySet(newMap))) {
+		fieldPath := key
+		switch {
+		case strings.ContainsAny(key, "./"):
+			fieldPath = fmt.Sprintf("%s[%s]", path, key)
+		case path != "":
+			fieldPath = path + "." + key
+		}
+
+		if ignoredFieldsForPreview.Has(fieldPath) {
+			continue
+		}
+
+		collectChangedFields(fields, fieldPath, oldMap[key], newMap[key])
+	}
+}
+
+// normalize converts the given object to its JSON representation and back to avoid false positives when comparing
+// values of different types, e.g. numbers decoded from YAML and JSON.
+func normalize(obj map[string]any) any {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return obj
+	}
+
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return obj
+	}
+	return out
+}
+
+func previewSummary(changes []resourcesv1alpha1.ResourceChange, decodingErrors []*decodingError) string {
+	var (
+		operations = map[resourcesv1alpha1.ResourceChangeOperation]int{}
+		failing    int
+	)
+
+	for _, change := range changes {
+		operations[change.Operation]++
+		if change.Error != nil {
+			failing++
+		}
+	}
+
+	summary := fmt.Sprintf("%d to create, %d to update, %d to delete",
+		operations[resourcesv1alpha1.ResourceChangeOperationCreate],
+		operations[resourcesv1alpha1.ResourceChangeOperationUpdate],
+		operations[resourcesv1alpha1.ResourceChangeOperationDelete],
+	)
+	if failing > 0 {
+		summary += fmt.Sprintf(", %d failing", failing)
+	}
+	if len(decodingErrors) > 0 {
+		summary += fmt.Sprintf(", could not decode all new resources: %v", decodingErrors)
+	}
+
+	return summary
+}
+
+func previewChanged(oldPreview, newPreview *resourcesv1alpha1.ManagedResourcePreview) bool {
+	if oldPreview == nil {
+		return true
+	}
+
+	oldPreview = oldPreview.DeepCopy()
+	oldPreview.LastUpdateTime = newPreview.LastUpdateTime
+	return !apiequality.Semantic.DeepEqual(oldPreview, newPreview)
+}
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/clock"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Preview", func() {
+	Describe("#Reconcile", func() {
+		var (
+			ctx          = logf.IntoContext(context.Background(), logf.Log)
+			sourceClient client.Client
+			targetClient client.Client
+			fakeClock    clock.Clock
+			reconciler   *Reconciler
+			mr           *resourcesv1alpha1.ManagedResource
+
+			configMapRef = func(name string) resourcesv1alpha1.ObjectReference {
+				return resourcesv1alpha1.ObjectReference{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: name, Namespace: "default"}}
+			}
+		)
+
+		BeforeEach(func() {
+			sourceClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).Build()
+			targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+			fakeClock = testclock.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+			restMapper := meta.NewDefaultRESTMapper(nil)
+			restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+
+			reconciler = &Reconciler{
+				SourceClient:     sourceClient,
+				TargetClient:     targetClient,
+				TargetScheme:     kubernetes.ShootScheme,
+				TargetRESTMapper: restMapper,
+				Config: resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig{
+					ManagedByLabelValue: ptr.To("gardener"),
+					SyncPeriod:          &metav1.Duration{Duration: time.Minute},
+				},
+				Clock:                         fakeClock,
+				ClassFilter:                   resourcemanagerpredicate.NewClassFilter(""),
+				RequeueAfterOnDeletionPending: ptr.To(time.Second),
+			}
+
+			Expect(sourceClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "resources", Namespace: "garden"},
+				Data: map[string][]byte{"data.yaml": []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: changed
+  namespace: default
+data:
+  foo: baz
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: new
+  namespace: default
+`)},
+			})).To(Succeed())
+
+			mr = &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Namespace:   "garden",
+					Annotations: map[string]string{"resources.gardener.cloud/preview": "true"},
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{SecretRefs: []corev1.LocalObjectReference{{Name: "resources"}}},
+			}
+			Expect(sourceClient.Create(ctx, mr)).To(Succeed())
+
+			mr.Status.Resources = []resourcesv1alpha1.ObjectReference{configMapRef("changed"), configMapRef("deleted"), configMapRef("gone"), configMapRef("kept")}
+			Expect(sourceClient.Status().Update(ctx, mr)).To(Succeed())
+
+			Expect(targetClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "changed", Namespace: "default", Labels: map[string]string{"resources.gardener.cloud/managed-by": "gardener"}, Annotations: map[string]string{"resources.gardener.cloud/origin": "garden/test", descriptionAnnotation: descriptionAnnotationText}},
+				Data:       map[string]string{"foo": "bar"},
+			})).To(Succeed())
+			Expect(targetClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "deleted", Namespace: "default"}})).To(Succeed())
+			Expect(targetClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kept", Namespace: "default", Annotations: map[string]string{"resources.gardener.cloud/keep-object": "true"}}})).To(Succeed())
+		})
+
+		It("should report the changes without applying them", func() {
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(mr)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Resources).To(HaveLen(4))
+			Expect(mr.Status.Conditions).To(BeEmpty())
+			Expect(mr.Status.Preview).NotTo(BeNil())
+			Expect(mr.Status.Preview.SecretsDataChecksum).NotTo(BeEmpty())
+			Expect(mr.Status.Preview.Summary).To(Equal("1 to create, 1 to update, 1 to delete"))
+			Expect(mr.Status.Preview.Changes).To(Equal([]resourcesv1alpha1.ResourceChange{
+				{ObjectReference: configMapRef("changed").ObjectReference, Operation: "Update", Fields: []string{"data.foo"}},
+				{ObjectReference: configMapRef("new").ObjectReference, Operation: "Create"},
+				{ObjectReference: configMapRef("deleted").ObjectReference, Operation: "Delete"},
+			}))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "changed", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "new", Namespace: "default"}, &corev1.ConfigMap{})).To(BeNotFoundError())
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "deleted", Namespace: "default"}, &corev1.ConfigMap{})).To(Succeed())
+		})
+
+		It("should not report fields of the existing resources which are not managed by the controller", func() {
+			configMap := &corev1.ConfigMap{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "changed", Namespace: "default"}, configMap)).To(Succeed())
+			metav1.SetMetaDataLabel(&configMap.ObjectMeta, "other", "label")
+			metav1.SetMetaDataAnnotation(&configMap.ObjectMeta, "other", "annotation")
+			Expect(targetClient.Update(ctx, configMap)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(mr)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Preview.Changes).To(ContainElement(resourcesv1alpha1.ResourceChange{ObjectReference: configMapRef("changed").ObjectReference, Operation: "Update", Fields: []string{"data.foo"}}))
+		})
+
+		It("should not report existing resources without changes", func() {
+			configMap := &corev1.ConfigMap{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "changed", Namespace: "default"}, configMap)).To(Succeed())
+			configMap.Data = map[string]string{"foo": "baz"}
+			Expect(targetClient.Update(ctx, configMap)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(mr)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Preview.Summary).To(Equal("1 to create, 0 to update, 1 to delete"))
+		})
+
+		It("should remove the preview when the resources are applied", func() {
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(mr)})).Error().NotTo(HaveOccurred())
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			delete(mr.Annotations, "resources.gardener.cloud/preview")
+			Expect(sourceClient.Update(ctx, mr)).To(Succeed())
+
+			// The first reconciliation only deletes the old resources.
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(mr)})).To(Equal(reconcile.Result{RequeueAfter: time.Second}))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Preview).To(BeNil())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(mr)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			configMap := &corev1.ConfigMap{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "changed", Namespace: "default"}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"foo": "baz"}))
+		})
+
+		It("should remove the preview when the rollout of the changes is held back", func() {
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(mr)})).Error().NotTo(HaveOccurred())
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Preview).NotTo(BeNil())
+			delete(mr.Annotations, "resources.gardener.cloud/preview")
+			mr.Labels = map[string]string{"resources.gardener.cloud/rollout-group": "test"}
+			Expect(sourceClient.Update(ctx, mr)).To(Succeed())
+			mr.Status.SecretsDataChecksum = ptr.To("old")
+			mr.Status.PendingSecretsDataChecksum = ptr.To(mr.Status.Preview.SecretsDataChecksum)
+			mr.Status.ObservedGeneration = mr.Generation
+			Expect(sourceClient.Status().Update(ctx, mr)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(mr)})).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Status.Preview).To(BeNil())
+		})
+	})
+
+	Describe("#changedFields", func() {
+		It("should return the paths of all changed fields", func() {
+			Expect(changedFields(
+				map[string]any{
+					"metadata": map[string]any{
+						"resourceVersion": "1",
+						"annotations":     map[string]any{"resources.gardener.cloud/origin": "foo", "bar": "baz"},
+					},
+					"spec":   map[string]any{"replicas": int64(1), "template": map[string]any{"containers": []any{"foo"}}},
+					"status": map[string]any{"replicas": int64(1)},
+				},
+				map[string]any{
+					"metadata": map[string]any{
+						"resourceVersion": "2",
+						"annotations":     map[string]any{"resources.gardener.cloud/origin": "bar", "bar": "baz"},
+						"labels":          map[string]any{"foo": "bar"},
+					},
+					"spec":   map[string]any{"replicas": float64(1), "template": map[string]any{"containers": []any{"bar"}}},
+					"status": map[string]any{"replicas": int64(2)},
+				},
+			)).To(Equal([]string{
+				"metadata.annotations[resources.gardener.cloud/origin]",
+				"metadata.labels",
+				"spec.template.containers",
+			}))
+		})
+	})
+})
@@ -284,6 +284,24 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	// (otherwise, the order will be different on each update)
 	sortObjectReferences(newResourcesObjectReferences)
 
+	if resourcemanagerpredicate.IsPreview(mr) {
+		return r.preview(ctx, log, mr, origin, secretsDataChecksum, newResourcesObjects, existingResourcesIndex, equivalences, decodingErrors)
+	}
+
+	// The preview is only valid as long as the ManagedResource is marked for preview.
+	if mr.Status.Preview != nil {
+		patch := client.MergeFrom(mr.DeepCopy())
+		mr.Status.Preview = nil
+		if err := r.SourceClient.Status().Patch(ctx, mr, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not remove the preview from the ManagedResource status: %w", err)
+		}
+	}
+
+	if rolloutPending(mr, secretsDataChecksum) {
+		return r.holdRollout(ctx, log, mr, secretsDataChecksum)
+	}
+	mr.Status.PendingSecretsDataChecksum = nil
+
 	// invalidate conditions, if resources have been added/removed from the managed resource
 	if !apiequality.Semantic.DeepEqual(mr.Status.Resources, newResourcesObjectReferences) || mr.Status.SecretsDataChecksum == nil || *mr.Status.SecretsDataChecksum != secretsDataChecksum {
 		conditionResourcesHealthy := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesHealthy)
@@ -482,26 +500,7 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 
 		resourceLogger.V(1).Info("Applying")
 
-		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
-			metadata, err := meta.Accessor(obj.obj)
-			if err != nil {
-				return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
-			}
-
-			// if the ignore annotation is set to false, do nothing (ignore the resource)
-			if ignore(metadata) {
-				annotations := current.GetAnnotations()
-				delete(annotations, descriptionAnnotation)
-				current.SetAnnotations(annotations)
-				return nil
-			}
-
-			if err := injectLabels(obj.obj, labelsToInject); err != nil {
-				return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
-			}
-
-			return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
-		})
+		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), mutateFunc(origin, obj, current, labelsToInject, scaledHorizontally))
 		if err != nil {
 			if apierrors.IsConflict(err) {
 				return err
@@ -539,6 +538,32 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 	return nil
 }
 
+// mutateFunc returns a function which merges the desired state of the given object into the current object.
+func mutateFunc(origin string, obj object, current *unstructured.Unstructured, labelsToInject map[string]string, scaledHorizontally bool) func() error {
+	return func() error {
+		resource := unstructuredToString(obj.obj)
+
+		metadata, err := meta.Accessor(obj.obj)
+		if err != nil {
+			return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
+		}
+
+		// if the ignore annotation is set to false, do nothing (ignore the resource)
+		if ignore(metadata) {
+			annotations := current.GetAnnotations()
+			delete(annotations, descriptionAnnotation)
+			current.SetAnnotations(annotations)
+			return nil
+		}
+
+		if err := injectLabels(obj.obj, labelsToInject); err != nil {
+			return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+		}
+
+		return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
+	}
+}
+
 // computeHorizontallyScaledObjectKeys returns a set of object keys (in the form `Group/Kind/Namespace/Name`)
 // to objects that are horizontally scaled by HPA.
 // VPAs are not checked, as they don't update the spec of Deployments/StatefulSets/... and only mutate resource
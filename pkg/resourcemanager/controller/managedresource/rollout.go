@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// rolloutPending checks whether the changes of the referenced secrets data must be held back because their rollout was
+// not approved yet. This is only the case if the ManagedResource belongs to a rollout group, its resources were already
+// applied before (new ManagedResources must always apply their resources), and the approved checksum does not match the
+// checksum of the referenced secrets data.
+func rolloutPending(mr *resourcesv1alpha1.ManagedResource, secretsDataChecksum string) bool {
+	if _, ok := mr.Labels[resourcesv1alpha1.RolloutGroup]; !ok {
+		return false
+	}
+
+	if mr.Status.SecretsDataChecksum == nil || *mr.Status.SecretsDataChecksum == secretsDataChecksum {
+		return false
+	}
+
+	return mr.Annotations[resourcesv1alpha1.RolloutApprovedChecksum] != secretsDataChecksum
+}
+
+// holdRollout reports the checksum of the held back secrets data in the status of the ManagedResource. The previously
+// applied resources are left untouched, hence the `ResourcesApplied` condition keeps its status. If it is `True`, its
+// reason indicates that a rollout is pending. The observed generation is not advanced since the current specification is
+// not applied yet, i.e., the ManagedResource is not considered applied by clients waiting for it.
+func (r *Reconciler) holdRollout(ctx context.Context, log logr.Logger, mr *resourcesv1alpha1.ManagedResource, secretsDataChecksum string) (reconcile.Result, error) {
+	log.Info("Holding back changes of resources until their rollout is approved", "secretsDataChecksum", secretsDataChecksum)
+
+	oldStatus := mr.Status.DeepCopy()
+	mr.Status.PendingSecretsDataChecksum = &secretsDataChecksum
+
+	conditionResourcesApplied := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+	message := fmt.Sprintf("All resources are applied, but changes with checksum %s are held back until their rollout is approved.", secretsDataChecksum)
+	if conditionResourcesApplied.Status == gardencorev1beta1.ConditionTrue && conditionResourcesApplied.Message != message {
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionRolloutPending, message)
+		mr.Status.Conditions = v1beta1helper.MergeConditions(mr.Status.Conditions, conditionResourcesApplied)
+	}
+
+	if !apiequality.Semantic.DeepEqual(oldStatus, &mr.Status) {
+		if err := r.SourceClient.Status().Update(ctx, mr); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
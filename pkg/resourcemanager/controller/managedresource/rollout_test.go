@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/resourcemanager/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("Rollout", func() {
+	var (
+		ctx          = logf.IntoContext(context.Background(), logf.Log)
+		sourceClient client.Client
+		targetClient client.Client
+		reconciler   *Reconciler
+		mr           *resourcesv1alpha1.ManagedResource
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		sourceClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&resourcesv1alpha1.ManagedResource{}).Build()
+		targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+		restMapper := meta.NewDefaultRESTMapper(nil)
+		restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+
+		reconciler = &Reconciler{
+			SourceClient:     sourceClient,
+			TargetClient:     targetClient,
+			TargetScheme:     kubernetes.ShootScheme,
+			TargetRESTMapper: restMapper,
+			Config: resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig{
+				ManagedByLabelValue: ptr.To("gardener"),
+				SyncPeriod:          &metav1.Duration{Duration: time.Minute},
+			},
+			Clock:                         testclock.NewFakeClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			ClassFilter:                   resourcemanagerpredicate.NewClassFilter(""),
+			RequeueAfterOnDeletionPending: ptr.To(time.Second),
+		}
+
+		Expect(sourceClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "resources", Namespace: "garden"},
+			Data: map[string][]byte{"data.yaml": []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+  namespace: default
+data:
+  foo: baz
+`)},
+		})).To(Succeed())
+
+		mr = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "garden",
+				Labels:    map[string]string{"resources.gardener.cloud/rollout-group": "test"},
+			},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{SecretRefs: []corev1.LocalObjectReference{{Name: "resources"}}},
+		}
+		Expect(sourceClient.Create(ctx, mr)).To(Succeed())
+
+		mr.Status.ObservedGeneration = mr.Generation
+		mr.Status.SecretsDataChecksum = ptr.To("old-checksum")
+		mr.Status.Resources = []resourcesv1alpha1.ObjectReference{{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "test", Namespace: "default"}}}
+		mr.Status.Conditions = []gardencorev1beta1.Condition{{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue, Reason: resourcesv1alpha1.ConditionApplySucceeded}}
+		Expect(sourceClient.Status().Update(ctx, mr)).To(Succeed())
+
+		Expect(targetClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Labels: map[string]string{"resources.gardener.cloud/managed-by": "gardener"}, Annotations: map[string]string{"resources.gardener.cloud/origin": "garden/test", descriptionAnnotation: descriptionAnnotationText}},
+			Data:       map[string]string{"foo": "bar"},
+		})).To(Succeed())
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(mr)}
+	})
+
+	expectConfigMapData := func(value string) {
+		GinkgoHelper()
+
+		configMap := &corev1.ConfigMap{}
+		Expect(targetClient.Get(ctx, client.ObjectKey{Name: "test", Namespace: "default"}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(Equal(map[string]string{"foo": value}))
+	}
+
+	It("should hold back the changes until their rollout is approved", func() {
+		// The fake client does not increase the generation when the specification changes.
+		observedGeneration := mr.Status.ObservedGeneration
+		mr.Generation++
+		Expect(sourceClient.Update(ctx, mr)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(sourceClient.Get(ctx, request.NamespacedName, mr)).To(Succeed())
+		Expect(mr.Status.SecretsDataChecksum).To(PointTo(Equal("old-checksum")))
+		Expect(mr.Status.PendingSecretsDataChecksum).NotTo(BeNil())
+		Expect(mr.Status.ObservedGeneration).To(Equal(observedGeneration))
+		Expect(mr.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(resourcesv1alpha1.ResourcesApplied),
+			"Status": Equal(gardencorev1beta1.ConditionTrue),
+			"Reason": Equal("RolloutPending"),
+		})))
+		expectConfigMapData("bar")
+
+		pendingChecksum := *mr.Status.PendingSecretsDataChecksum
+		metav1.SetMetaDataAnnotation(&mr.ObjectMeta, "resources.gardener.cloud/rollout-approved-checksum", pendingChecksum)
+		Expect(sourceClient.Update(ctx, mr)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(sourceClient.Get(ctx, request.NamespacedName, mr)).To(Succeed())
+		Expect(mr.Status.SecretsDataChecksum).To(PointTo(Equal(pendingChecksum)))
+		Expect(mr.Status.PendingSecretsDataChecksum).To(BeNil())
+		Expect(mr.Status.ObservedGeneration).To(Equal(mr.Generation))
+		expectConfigMapData("baz")
+	})
+
+	It("should apply the changes if the ManagedResource does not belong to a rollout group", func() {
+		mr.Labels = nil
+		Expect(sourceClient.Update(ctx, mr)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(sourceClient.Get(ctx, request.NamespacedName, mr)).To(Succeed())
+		Expect(mr.Status.PendingSecretsDataChecksum).To(BeNil())
+		expectConfigMapData("baz")
+	})
+
+	It("should apply the resources if they were never applied before", func() {
+		Expect(targetClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}})).To(Succeed())
+		mr.Status = resourcesv1alpha1.ManagedResourceStatus{}
+		Expect(sourceClient.Status().Update(ctx, mr)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(sourceClient.Get(ctx, request.NamespacedName, mr)).To(Succeed())
+		Expect(mr.Status.PendingSecretsDataChecksum).To(BeNil())
+		Expect(targetClient.Get(ctx, client.ObjectKey{Name: "test", Namespace: "default"}, &corev1.ConfigMap{})).To(Succeed())
+	})
+})
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package predicate
+
+import (
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// PreviewChanged returns a predicate that detects if the resources.gardener.cloud/preview=true annotation was added
+// or removed during an update.
+func PreviewChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return IsPreview(e.ObjectOld) != IsPreview(e.ObjectNew)
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return true
+		},
+	}
+}
+
+// IsPreview returns true if the object has the resources.gardener.cloud/preview=true annotation.
+func IsPreview(obj client.Object) bool {
+	value, ok := obj.GetAnnotations()[resourcesv1alpha1.Preview]
+	if !ok {
+		return false
+	}
+	truthy, _ := strconv.ParseBool(value)
+	return truthy
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package predicate_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("preview", func() {
+	var (
+		managedResource *resourcesv1alpha1.ManagedResource
+		predicate       predicate.Predicate
+	)
+
+	BeforeEach(func() {
+		managedResource = &resourcesv1alpha1.ManagedResource{}
+	})
+
+	Describe("#PreviewChanged", func() {
+		BeforeEach(func() {
+			predicate = PreviewChanged()
+		})
+
+		It("should match on create, delete and generic events", func() {
+			Expect(predicate.Create(event.CreateEvent{Object: managedResource})).To(BeTrue())
+			Expect(predicate.Delete(event.DeleteEvent{Object: managedResource})).To(BeTrue())
+			Expect(predicate.Generic(event.GenericEvent{Object: managedResource})).To(BeTrue())
+		})
+
+		It("should match because preview annotation was added", func() {
+			oldManagedResource := managedResource.DeepCopy()
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/preview", "true")
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: oldManagedResource, ObjectNew: managedResource})).To(BeTrue())
+		})
+
+		It("should match because preview annotation was removed", func() {
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/preview", "true")
+			oldManagedResource := managedResource.DeepCopy()
+			delete(managedResource.Annotations, "resources.gardener.cloud/preview")
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: oldManagedResource, ObjectNew: managedResource})).To(BeTrue())
+		})
+
+		It("should not match because preview annotation did not change", func() {
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/preview", "true")
+			oldManagedResource := managedResource.DeepCopy()
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: oldManagedResource, ObjectNew: managedResource})).To(BeFalse())
+		})
+	})
+
+	Describe("#IsPreview", func() {
+		It("should return false if the annotation is not present", func() {
+			Expect(IsPreview(managedResource)).To(BeFalse())
+		})
+
+		It("should return true if the annotation is set to true", func() {
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/preview", "True")
+
+			Expect(IsPreview(managedResource)).To(BeTrue())
+		})
+	})
+})
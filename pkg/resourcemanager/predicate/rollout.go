@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package predicate
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// RolloutChanged returns a predicate that detects if the resources.gardener.cloud/rollout-group label or the
+// resources.gardener.cloud/rollout-approved-checksum annotation was changed during an update.
+func RolloutChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.GetLabels()[resourcesv1alpha1.RolloutGroup] != e.ObjectNew.GetLabels()[resourcesv1alpha1.RolloutGroup] ||
+				e.ObjectOld.GetAnnotations()[resourcesv1alpha1.RolloutApprovedChecksum] != e.ObjectNew.GetAnnotations()[resourcesv1alpha1.RolloutApprovedChecksum]
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return true
+		},
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package predicate_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+)
+
+var _ = Describe("rollout", func() {
+	var (
+		managedResource *resourcesv1alpha1.ManagedResource
+		predicate       predicate.Predicate
+	)
+
+	BeforeEach(func() {
+		managedResource = &resourcesv1alpha1.ManagedResource{}
+	})
+
+	Describe("#RolloutChanged", func() {
+		BeforeEach(func() {
+			predicate = RolloutChanged()
+		})
+
+		It("should match on create, delete and generic events", func() {
+			Expect(predicate.Create(event.CreateEvent{Object: managedResource})).To(BeTrue())
+			Expect(predicate.Delete(event.DeleteEvent{Object: managedResource})).To(BeTrue())
+			Expect(predicate.Generic(event.GenericEvent{Object: managedResource})).To(BeTrue())
+		})
+
+		It("should match because rollout group label was removed", func() {
+			metav1.SetMetaDataLabel(&managedResource.ObjectMeta, "resources.gardener.cloud/rollout-group", "foo")
+			oldManagedResource := managedResource.DeepCopy()
+			delete(managedResource.Labels, "resources.gardener.cloud/rollout-group")
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: oldManagedResource, ObjectNew: managedResource})).To(BeTrue())
+		})
+
+		It("should match because approved checksum annotation was changed", func() {
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/rollout-approved-checksum", "old")
+			oldManagedResource := managedResource.DeepCopy()
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/rollout-approved-checksum", "new")
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: oldManagedResource, ObjectNew: managedResource})).To(BeTrue())
+		})
+
+		It("should not match because neither label nor annotation changed", func() {
+			metav1.SetMetaDataLabel(&managedResource.ObjectMeta, "resources.gardener.cloud/rollout-group", "foo")
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "resources.gardener.cloud/rollout-approved-checksum", "new")
+			oldManagedResource := managedResource.DeepCopy()
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "foo", "bar")
+
+			Expect(predicate.Update(event.UpdateEvent{ObjectOld: oldManagedResource, ObjectNew: managedResource})).To(BeFalse())
+		})
+	})
+})
@@ -5,6 +5,8 @@
 package shoot
 
 import (
+	"fmt"
+
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -36,6 +38,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.GardenNamespace = v1beta1constants.GardenNamespace
 	}
 
+	if r.Config.ExplainPlacement != nil && r.Config.ExplainPlacement.Enabled {
+		if err := mgr.AddMetricsServerExtraHandler(ExplainPlacementHandlerPath, NewExplainPlacementHandler(mgr.GetLogger().WithName("explain-placement"), r)); err != nil {
+			return fmt.Errorf("failed adding explain placement handler: %w", err)
+		}
+	}
+
 	return builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
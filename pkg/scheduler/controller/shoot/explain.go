@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+const (
+	// ExplainPlacementHandlerPath is the HTTP handler path for the explain placement debug handler.
+	ExplainPlacementHandlerPath = "/debug/explain-placement"
+	// maxExplainPlacementRequestBytes is the maximum size of a Shoot manifest accepted by the explain placement handler.
+	maxExplainPlacementRequestBytes = 1 << 20
+)
+
+// Explanation describes how the scheduler determines the seed for a shoot.
+type Explanation struct {
+	// Strategy is the candidate determination strategy used by the scheduler.
+	Strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy `json:"strategy"`
+	// Filters contains the result of every filter in the order they were run. The filter chain stops at the first
+	// filter which drops all remaining seeds.
+	Filters []FilterResult `json:"filters,omitempty"`
+	// Ranking contains the seed candidates which passed all filters, best candidate first.
+	Ranking []RankedSeed `json:"ranking,omitempty"`
+	// SeedName is the name of the seed the shoot would be scheduled to.
+	SeedName string `json:"seedName,omitempty"`
+	// Error is the reason why no seed could be determined.
+	Error string `json:"error,omitempty"`
+}
+
+// FilterResult is the result of a single filter of the seed determination.
+type FilterResult struct {
+	// Name is the name of the filter.
+	Name string `json:"name"`
+	// Remaining contains the names of the seeds which passed the filter.
+	Remaining []string `json:"remaining"`
+	// Dropped contains the names of the seeds which were dropped by the filter and the reason.
+	Dropped map[string]string `json:"dropped,omitempty"`
+	// Error is the error returned by the filter.
+	Error string `json:"error,omitempty"`
+}
+
+// RankedSeed is a seed candidate which passed all filters of the seed determination.
+type RankedSeed struct {
+	// SeedName is the name of the seed.
+	SeedName string `json:"seedName"`
+	// Shoots is the number of shoots currently scheduled to the seed.
+	Shoots int `json:"shoots"`
+	// Score is the weighted score of the seed. It is only set for the Scored strategy.
+	Score *int64 `json:"score,omitempty"`
+	// PluginScores contains the (unweighted) score of every score plugin. It is only set for the Scored strategy.
+	PluginScores map[schedulerconfigv1alpha1.ScorePluginName]int64 `json:"pluginScores,omitempty"`
+}
+
+func (e *Explanation) addFilterResult(filter seedFilter, input, remaining []gardencorev1beta1.Seed, err error) {
+	if e == nil {
+		return
+	}
+
+	result := FilterResult{
+		Name:      filter.name,
+		Remaining: make([]string, 0, len(remaining)),
+		Dropped:   make(map[string]string),
+	}
+
+	remainingNames := sets.New[string]()
+	for _, seed := range remaining {
+		result.Remaining = append(result.Remaining, seed.Name)
+		remainingNames.Insert(seed.Name)
+	}
+
+	for _, seed := range input {
+		if remainingNames.Has(seed.Name) {
+			continue
+		}
+
+		reason := filter.reason
+		if specificErr, ok := filter.reasons[seed.Name]; ok {
+			reason = specificErr.Error()
+		}
+		result.Dropped[seed.Name] = reason
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	e.Filters = append(e.Filters, result)
+}
+
+func (e *Explanation) setRanking(seeds []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot, scores []SeedScore) {
+	if e == nil {
+		return
+	}
+
+	seedUsage := v1beta1helper.CalculateSeedUsage(shootList)
+	e.Ranking = make([]RankedSeed, 0, len(seeds))
+
+	if scores != nil {
+		for _, score := range scores {
+			e.Ranking = append(e.Ranking, RankedSeed{
+				SeedName:     score.SeedName,
+				Shoots:       seedUsage[score.SeedName],
+				Score:        new(score.Score),
+				PluginScores: score.PluginScores,
+			})
+		}
+	} else {
+		for _, seed := range seeds {
+			e.Ranking = append(e.Ranking, RankedSeed{
+				SeedName: seed.Name,
+				Shoots:   seedUsage[seed.Name],
+			})
+		}
+	}
+
+	// Candidates with equal scores are ranked by the number of shoots deployed, see getSeedWithLeastShootsDeployed.
+	slices.SortStableFunc(e.Ranking, func(a, b RankedSeed) int {
+		if c := cmp.Compare(ptr.Deref(b.Score, 0), ptr.Deref(a.Score, 0)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Shoots, b.Shoots)
+	})
+}
+
+// ExplainPlacement determines the seed for the given shoot like the scheduler would do, but without binding the shoot
+// to the seed. The returned explanation contains the result of every filter and the final ranking of the candidates.
+func (r *Reconciler) ExplainPlacement(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) *Explanation {
+	explanation := &Explanation{Strategy: r.Config.Strategy}
+
+	seed, _, err := r.determineSeed(ctx, log, shoot, explanation)
+	if err != nil {
+		explanation.Error = err.Error()
+		return explanation
+	}
+
+	explanation.SeedName = seed.Name
+	return explanation
+}
+
+// NewExplainPlacementHandler returns an HTTP handler which takes a Shoot manifest (JSON or YAML) in the request body and
+// responds with the explanation of the seed determination for it. The shoot does not need to exist in the garden
+// cluster and is never scheduled.
+func NewExplainPlacementHandler(log logr.Logger, r *Reconciler) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "only POST requests containing a Shoot manifest are supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxExplainPlacementRequestBytes))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed reading request body: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		shoot := &gardencorev1beta1.Shoot{}
+		if err := runtime.DecodeInto(kubernetes.GardenCodec.UniversalDecoder(gardencorev1beta1.SchemeGroupVersion), body, shoot); err != nil {
+			http.Error(w, fmt.Sprintf("failed decoding Shoot manifest: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		if shoot.Namespace == "" {
+			http.Error(w, "Shoot manifest must specify a namespace", http.StatusBadRequest)
+			return
+		}
+
+		explanation := r.ExplainPlacement(req.Context(), log.WithValues("shoot", shoot.Namespace+"/"+shoot.Name), shoot)
+
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(explanation); err != nil {
+			log.Error(err, "Failed writing explain placement response")
+		}
+	}
+}
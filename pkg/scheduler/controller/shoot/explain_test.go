@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+)
+
+var _ = Describe("Explain", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		reconciler *Reconciler
+		handler    http.HandlerFunc
+
+		shootManifest = `apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: shoot
+  namespace: garden-dev
+spec:
+  cloudProfileName: cloudprofile
+  region: europe
+  provider:
+    type: foo
+    workers:
+    - name: worker
+  networking:
+    type: calico
+    nodes: 10.40.0.0/16
+    pods: 10.50.0.0/16
+    services: 10.60.0.0/16
+`
+	)
+
+	newSeed := func(name string, labels map[string]string, taints ...gardencorev1beta1.SeedTaint) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: "europe"},
+				Networks: gardencorev1beta1.SeedNetworks{
+					Nodes:    new("10.10.0.0/16"),
+					Pods:     "10.20.0.0/16",
+					Services: "10.30.0.0/16",
+				},
+				Settings: &gardencorev1beta1.SeedSettings{
+					Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+				},
+				Taints: taints,
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.GardenletReady, Status: gardencorev1beta1.ConditionTrue},
+				},
+				LastOperation: &gardencorev1beta1.LastOperation{},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.
+			NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, gardencore.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion},
+		}
+		handler = NewExplainPlacementHandler(logr.Discard(), reconciler)
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: new("garden-dev")},
+		})).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-1", nil))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-2", nil, gardencorev1beta1.SeedTaint{Key: "foo"}))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-3", map[string]string{"foo": "bar"}))).To(Succeed())
+	})
+
+	explain := func(body string) (*httptest.ResponseRecorder, *Explanation) {
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(http.MethodPost, ExplainPlacementHandlerPath, strings.NewReader(body)))
+
+		if recorder.Code != http.StatusOK {
+			return recorder, nil
+		}
+
+		explanation := &Explanation{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), explanation)).To(Succeed())
+		return recorder, explanation
+	}
+
+	It("should explain the filter chain and the ranking", func() {
+		recorder, explanation := explain(shootManifest)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		Expect(explanation.Strategy).To(Equal(schedulerconfigv1alpha1.SameRegion))
+		Expect(explanation.Error).To(BeEmpty())
+		Expect(explanation.SeedName).To(Equal("seed-1"))
+		Expect(explanation.Ranking).To(Equal([]RankedSeed{{SeedName: "seed-1"}, {SeedName: "seed-3"}}))
+
+		Expect(explanation.Filters).To(ContainElement(FilterResult{
+			Name:      "Candidates",
+			Remaining: []string{"seed-1", "seed-3"},
+			Dropped:   map[string]string{"seed-2": "shoot does not tolerate the seed's taints"},
+		}))
+	})
+
+	It("should explain which filter dropped all seeds", func() {
+		recorder, explanation := explain(strings.Replace(shootManifest, "  region: europe\n", "  region: europe\n  seedSelector:\n    matchLabels:\n      foo: baz\n", 1))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		Expect(explanation.SeedName).To(BeEmpty())
+		Expect(explanation.Error).To(ContainSubstring("none out of the 3 seeds has the matching labels required by seed selector of 'Shoot'"))
+		Expect(explanation.Ranking).To(BeEmpty())
+		Expect(explanation.Filters).To(HaveLen(3))
+		Expect(explanation.Filters[2]).To(Equal(FilterResult{
+			Name:      "ShootSeedSelector",
+			Remaining: []string{},
+			Dropped: map[string]string{
+				"seed-1": "seed does not match the seed selector of the Shoot",
+				"seed-2": "seed does not match the seed selector of the Shoot",
+				"seed-3": "seed does not match the seed selector of the Shoot",
+			},
+			Error: explanation.Error,
+		}))
+	})
+
+	It("should reject requests with other methods than POST", func() {
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(http.MethodGet, ExplainPlacementHandlerPath, nil))
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("should reject invalid manifests", func() {
+		recorder, _ := explain("foo")
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should reject manifests without namespace", func() {
+		recorder, _ := explain(strings.Replace(shootManifest, "  namespace: garden-dev\n", "", 1))
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		Expect(recorder.Body.String()).To(ContainSubstring("must specify a namespace"))
+	})
+})
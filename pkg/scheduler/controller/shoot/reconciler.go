@@ -65,7 +65,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	// If no Seed is referenced, we try to determine an adequate one.
-	seed, err := r.DetermineSeed(ctx, log, shoot)
+	seed, scores, err := r.determineSeed(ctx, log, shoot, nil)
 	if err != nil {
 		r.reportFailedScheduling(ctx, log, shoot, err)
 		return reconcile.Result{}, fmt.Errorf("failed to determine seed for shoot: %w", err)
@@ -85,6 +85,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		"strategy", r.Config.Strategy,
 	)
 
+	if len(scores) > 0 {
+		log.V(1).Info("Seed candidates were scored", "scores", scores)
+		r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, gardencorev1beta1.EventActionReconcile, "Scheduled to seed %q (scores: %s)", seed.Name, seedScoresToString(scores))
+		return reconcile.Result{}, nil
+	}
+
 	r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, gardencorev1beta1.EventActionReconcile, "Scheduled to seed %q", seed.Name)
 	return reconcile.Result{}, nil
 }
@@ -124,25 +130,42 @@ func (r *Reconciler) DetermineSeed(
 ) (
 	*gardencorev1beta1.Seed,
 	error,
+) {
+	seed, _, err := r.determineSeed(ctx, log, shoot, nil)
+	return seed, err
+}
+
+// determineSeed returns an appropriate Seed cluster (or nil). If the Scored strategy is configured, it additionally
+// returns the scores of all seed candidates. If an explanation is given, the result of every filter and the final
+// ranking of the candidates are recorded in it.
+func (r *Reconciler) determineSeed(
+	ctx context.Context,
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	explanation *Explanation,
+) (
+	*gardencorev1beta1.Seed,
+	[]SeedScore,
+	error,
 ) {
 	seedList := &gardencorev1beta1.SeedList{}
 	if err := r.Client.List(ctx, seedList); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	sl := &gardencorev1beta1.ShootList{}
 	if err := r.Client.List(ctx, sl); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	shootList := v1beta1helper.ConvertShootList(sl.Items)
 
 	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	regionConfigMaps, err := gardenerutils.GetRegionConfigMaps(ctx, r.Client, r.GardenNamespace, cloudProfile.Name)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	var regionConfig *corev1.ConfigMap
 	switch len(regionConfigMaps) {
@@ -156,54 +179,136 @@ func (r *Reconciler) DetermineSeed(
 	}
 	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsForZoneSelection(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsForAccessRestrictions(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingDomain(filteredSeeds, shoot, project.Name)
+	var (
+		candidateErrs = make(map[string]error)
+		filters       = []seedFilter{
+			{
+				name:   "UsableSeeds",
+				reason: "seed is deleting, not visible or not ready",
+				fn:     filterUsableSeeds,
+			},
+			{
+				name:   "CloudProfileSeedSelector",
+				reason: "seed does not match the seed selector of the CloudProfile",
+				fn: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsMatchingLabelSelector(seeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
+				},
+			},
+			{
+				name:   "ShootSeedSelector",
+				reason: "seed does not match the seed selector of the Shoot",
+				fn: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsMatchingLabelSelector(seeds, shoot.Spec.SeedSelector, "Shoot")
+				},
+			},
+			{
+				name:   "Providers",
+				reason: "seed provider type does not match the shoot provider type",
+				fn: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsMatchingProviders(cloudProfile, shoot, seeds)
+				},
+			},
+			{
+				name:   "ZonalShootControlPlanes",
+				reason: "seed has less than 3 zones for hosting a shoot control plane with failure tolerance type 'zone'",
+				fn: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsForZonalShootControlPlanes(seeds, shoot)
+				},
+			},
+			{
+				name:   "ZoneSelection",
+				reason: "seed has no zone overlap with the shoot's worker pool zones",
+				fn: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsForZoneSelection(seeds, shoot)
+				},
+			},
+			{
+				name:   "AccessRestrictions",
+				reason: "seed does not support the access restrictions configured in the shoot specification",
+				fn: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsForAccessRestrictions(seeds, shoot)
+				},
+			},
+			{
+				name:   "Domain",
+				reason: "seed does not support the domain configured in the shoot specification",
+				fn: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterSeedsMatchingDomain(seeds, shoot, project.Name)
+				},
+			},
+			{
+				name:   "ShootReconciliationsEnabled",
+				reason: "seed has shoot reconciliations disabled",
+				fn:     filterSeedsWithDisabledShootReconciliations,
+			},
+			{
+				name:    "Candidates",
+				reasons: candidateErrs,
+				fn: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return filterCandidates(shoot, shootList, seeds, candidateErrs)
+				},
+			},
+			{
+				name:   "Strategy",
+				reason: fmt.Sprintf("seed is no candidate for strategy %q", r.Config.Strategy),
+				fn: func(seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+					return applyStrategy(log, shoot, seeds, r.Config.Strategy, regionConfig)
+				},
+			},
+		}
+	)
+
+	filteredSeeds, err := runFilters(filters, seedList.Items, explanation)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	filteredSeeds, err = filterSeedsWithDisabledShootReconciliations(filteredSeeds)
-	if err != nil {
-		return nil, err
+
+	if r.Config.Strategy != schedulerconfigv1alpha1.Scored {
+		explanation.setRanking(filteredSeeds, shootList, nil)
+		seed, err := getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+		return seed, nil, err
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
+
+	scores, err := scoreSeeds(&scoringContext{
+		log:          log,
+		shoot:        shoot,
+		regionConfig: regionConfig,
+		seedUsage:    v1beta1helper.CalculateSeedUsage(shootList),
+	}, filteredSeeds, r.Config.Scoring)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
-	if err != nil {
-		return nil, err
+
+	explanation.setRanking(filteredSeeds, shootList, scores)
+
+	// If multiple seeds have the same score, fall back to the one with the least shoots deployed.
+	seed, err := getSeedWithLeastShootsDeployed(highestScoredSeeds(filteredSeeds, scores), shootList)
+	return seed, scores, err
+}
+
+// seedFilter is a named step of the filter chain which reduces the list of seed candidates for a shoot.
+type seedFilter struct {
+	name string
+	// reason is the reason reported for all seeds dropped by this filter if no specific reason is known.
+	reason string
+	// reasons contains specific reasons for dropped seeds, keyed by seed name. It is filled by the filter function.
+	reasons map[string]error
+	fn      func([]gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+}
+
+func runFilters(filters []seedFilter, seeds []gardencorev1beta1.Seed, explanation *Explanation) ([]gardencorev1beta1.Seed, error) {
+	for _, filter := range filters {
+		remaining, err := filter.fn(seeds)
+		explanation.addFilterResult(filter, seeds, remaining, err)
+		if err != nil {
+			return nil, err
+		}
+		seeds = remaining
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+	return seeds, nil
 }
 
 func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
@@ -428,6 +533,9 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 		if err != nil {
 			return nil, err
 		}
+	case strategy == schedulerconfigv1alpha1.Scored:
+		// All remaining seeds are candidates, they are ranked by the score plugins afterwards.
+		candidates = seedList
 	default:
 		return nil, fmt.Errorf("failed to determine seed candidates. shoot purpose: '%s', strategy: '%s', valid strategies are: %v", *shoot.Spec.Purpose, strategy, schedulerconfigv1alpha1.Strategies)
 	}
@@ -444,11 +552,12 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 	return candidates, nil
 }
 
-func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+// filterCandidates filters seeds whose networks are not disjoint with the shoot networks, whose taints are not tolerated
+// or which do not have capacity for further shoots. The reason for dropping a seed is stored in the given map.
+func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, seedNameToErr map[string]error) ([]gardencorev1beta1.Seed, error) {
 	var (
-		candidates    []gardencorev1beta1.Seed
-		seedNameToErr = make(map[string]error)
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
+		candidates []gardencorev1beta1.Seed
+		seedUsage  = v1beta1helper.CalculateSeedUsage(shootList)
 	)
 
 	for _, seed := range seedList {
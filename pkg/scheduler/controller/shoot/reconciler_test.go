@@ -633,6 +633,83 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using 'Scored' seed determination strategy", func() {
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			project = projectBase.DeepCopy()
+			seed = seedBase.DeepCopy()
+			shoot = shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration.Schedulers.Shoot.Strategy = schedulerconfigv1alpha1.Scored
+			schedulerConfiguration.Schedulers.Shoot.Scoring = &schedulerconfigv1alpha1.ScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginCapacityHeadroom, Weight: 1},
+					{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: 2},
+				},
+			}
+		})
+
+		It("should find the seed cluster with the highest score", func() {
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+			secondSeed.Spec.Provider.Region = "asia"
+
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &seed.Name
+
+			// seed-1 has less capacity headroom but is in the same region as the shoot, region distance has the higher weight
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
+
+			bestSeed, scores, err := reconciler.determineSeed(ctx, log, shoot, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+			Expect(seedScoresToString(scores)).To(Equal("seed-1=200, seed-2=100"))
+		})
+
+		It("should pick the candidate with least shoots deployed if scores are equal", func() {
+			schedulerConfiguration.Schedulers.Shoot.Scoring.Plugins = []schedulerconfigv1alpha1.ScorePlugin{
+				{Name: schedulerconfigv1alpha1.ScorePluginRegionDistance, Weight: 1},
+			}
+
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &seed.Name
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
+
+			bestSeed, scores, err := reconciler.determineSeed(ctx, log, shoot, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+			Expect(seedScoresToString(scores)).To(Equal("seed-1=100, seed-2=100"))
+		})
+
+		It("should fail if the scoring configuration is missing", func() {
+			schedulerConfiguration.Schedulers.Shoot.Scoring = nil
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("scoring configuration is required")))
+			Expect(bestSeed).To(BeNil())
+		})
+	})
+
 	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using default seed determination strategy", func() {
 		BeforeEach(func() {
 			cloudProfile = cloudProfileBase.DeepCopy()
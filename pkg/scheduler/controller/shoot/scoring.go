@@ -0,0 +1,354 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// minPluginScore is the lowest score a score plugin can give to a seed.
+	minPluginScore int64 = 0
+	// maxPluginScore is the highest score a score plugin can give to a seed.
+	maxPluginScore int64 = 100
+)
+
+// SeedScore contains the result of scoring a seed candidate with the Scored strategy.
+type SeedScore struct {
+	// SeedName is the name of the scored seed.
+	SeedName string
+	// Score is the weighted sum of all plugin scores.
+	Score int64
+	// PluginScores contains the (unweighted) score of every score plugin.
+	PluginScores map[schedulerconfigv1alpha1.ScorePluginName]int64
+}
+
+// scoringContext contains the information required by score plugins to score seed candidates.
+type scoringContext struct {
+	log          logr.Logger
+	shoot        *gardencorev1beta1.Shoot
+	regionConfig *corev1.ConfigMap
+	seedUsage    map[string]int
+}
+
+// scorePlugin scores seed candidates for a shoot. The returned scores must be between minPluginScore and
+// maxPluginScore, a higher score means a better fit.
+type scorePlugin interface {
+	// Name returns the name of the score plugin.
+	Name() schedulerconfigv1alpha1.ScorePluginName
+	// Score computes the score of every given seed. The result is keyed by seed name.
+	Score(sctx *scoringContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error)
+}
+
+// newScorePlugin returns the score plugin with the given name.
+func newScorePlugin(name schedulerconfigv1alpha1.ScorePluginName, config *schedulerconfigv1alpha1.ScoringConfiguration) (scorePlugin, error) {
+	switch name {
+	case schedulerconfigv1alpha1.ScorePluginCapacityHeadroom:
+		return &capacityHeadroom{}, nil
+	case schedulerconfigv1alpha1.ScorePluginRegionDistance:
+		return &regionDistance{}, nil
+	case schedulerconfigv1alpha1.ScorePluginZoneMatch:
+		return &zoneMatch{}, nil
+	case schedulerconfigv1alpha1.ScorePluginLabelAffinity:
+		var selectors []labels.Selector
+		if config.LabelAffinity != nil {
+			for _, s := range config.LabelAffinity.PreferredSeedSelectors {
+				selector, err := metav1.LabelSelectorAsSelector(&s)
+				if err != nil {
+					return nil, fmt.Errorf("label selector conversion failed: %v for preferred seed selector: %w", s, err)
+				}
+				selectors = append(selectors, selector)
+			}
+		}
+		return &labelAffinity{selectors: selectors}, nil
+	case schedulerconfigv1alpha1.ScorePluginSeedAge:
+		return &seedAge{}, nil
+	default:
+		return nil, fmt.Errorf("unknown score plugin %q, valid score plugins are: %v", name, schedulerconfigv1alpha1.ScorePlugins)
+	}
+}
+
+// scoreSeeds scores all given seeds with the configured score plugins and returns the scores sorted in descending
+// order. Seeds with equal scores are sorted by name.
+func scoreSeeds(sctx *scoringContext, seeds []gardencorev1beta1.Seed, config *schedulerconfigv1alpha1.ScoringConfiguration) ([]SeedScore, error) {
+	if config == nil {
+		return nil, fmt.Errorf("scoring configuration is required for strategy %q", schedulerconfigv1alpha1.Scored)
+	}
+
+	scores := make([]SeedScore, 0, len(seeds))
+	for _, seed := range seeds {
+		scores = append(scores, SeedScore{
+			SeedName:     seed.Name,
+			PluginScores: make(map[schedulerconfigv1alpha1.ScorePluginName]int64, len(config.Plugins)),
+		})
+	}
+
+	for _, p := range config.Plugins {
+		if p.Weight == 0 {
+			continue
+		}
+
+		plugin, err := newScorePlugin(p.Name, config)
+		if err != nil {
+			return nil, err
+		}
+
+		pluginScores, err := plugin.Score(sctx, seeds)
+		if err != nil {
+			return nil, fmt.Errorf("failed running score plugin %q: %w", plugin.Name(), err)
+		}
+
+		for i := range scores {
+			score := min(max(pluginScores[scores[i].SeedName], minPluginScore), maxPluginScore)
+			scores[i].PluginScores[plugin.Name()] = score
+			scores[i].Score += int64(p.Weight) * score
+		}
+	}
+
+	slices.SortStableFunc(scores, func(a, b SeedScore) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return strings.Compare(a.SeedName, b.SeedName)
+	})
+
+	return scores, nil
+}
+
+// highestScoredSeeds returns the seeds which have the highest score.
+func highestScoredSeeds(seeds []gardencorev1beta1.Seed, scores []SeedScore) []gardencorev1beta1.Seed {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	names := sets.New[string]()
+	for _, score := range scores {
+		if score.Score != scores[0].Score {
+			break
+		}
+		names.Insert(score.SeedName)
+	}
+
+	var candidates []gardencorev1beta1.Seed
+	for _, seed := range seeds {
+		if names.Has(seed.Name) {
+			candidates = append(candidates, seed)
+		}
+	}
+	return candidates
+}
+
+// seedScoresToString returns a human-readable representation of the given seed scores.
+func seedScoresToString(scores []SeedScore) string {
+	var out []string
+	for _, score := range scores {
+		out = append(out, fmt.Sprintf("%s=%d", score.SeedName, score.Score))
+	}
+	return strings.Join(out, ", ")
+}
+
+// normalizeInverse maps the given values to scores, where the lowest value gets maxPluginScore and the highest value
+// gets minPluginScore. If all values are equal, every key gets maxPluginScore.
+func normalizeInverse(values map[string]int64) map[string]int64 {
+	if len(values) == 0 {
+		return nil
+	}
+
+	var (
+		lowest  = slices.Min(slices.Collect(maps.Values(values)))
+		highest = slices.Max(slices.Collect(maps.Values(values)))
+		scores  = make(map[string]int64, len(values))
+	)
+
+	for key, value := range values {
+		if highest == lowest {
+			scores[key] = maxPluginScore
+			continue
+		}
+		scores[key] = maxPluginScore * (highest - value) / (highest - lowest)
+	}
+	return scores
+}
+
+// capacityHeadroom prefers seeds with more free capacity for shoots. If a seed reports an allocatable number of shoots,
+// the score is the percentage of free capacity. Otherwise, the seed is scored by its number of shoots relative to the
+// other candidates.
+type capacityHeadroom struct{}
+
+func (c *capacityHeadroom) Name() schedulerconfigv1alpha1.ScorePluginName {
+	return schedulerconfigv1alpha1.ScorePluginCapacityHeadroom
+}
+
+func (c *capacityHeadroom) Score(sctx *scoringContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var (
+		scores = make(map[string]int64, len(seeds))
+		usage  = make(map[string]int64)
+	)
+
+	for _, seed := range seeds {
+		used := int64(sctx.seedUsage[seed.Name])
+
+		if allocatable, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && allocatable.Value() > 0 {
+			scores[seed.Name] = maxPluginScore * (allocatable.Value() - used) / allocatable.Value()
+			continue
+		}
+
+		usage[seed.Name] = used
+	}
+
+	if len(usage) > 0 {
+		// Seeds without known capacity are compared against zero usage, i.e., an empty seed always gets the highest score.
+		usage[""] = 0
+		for seedName, score := range normalizeInverse(usage) {
+			if seedName != "" {
+				scores[seedName] = score
+			}
+		}
+	}
+
+	return scores, nil
+}
+
+// regionDistance prefers seeds with a smaller distance to the shoot's region. The distances are read from the region
+// config (see the MinimalDistance strategy) if it contains the shoot's region, otherwise the Levenshtein distance of the
+// region names is used.
+type regionDistance struct{}
+
+func (r *regionDistance) Name() schedulerconfigv1alpha1.ScorePluginName {
+	return schedulerconfigv1alpha1.ScorePluginRegionDistance
+}
+
+func (r *regionDistance) Score(sctx *scoringContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var (
+		shoot     = sctx.shoot
+		distances = make(map[string]int64, len(seeds))
+	)
+
+	if sctx.regionConfig != nil && sctx.regionConfig.Data[shoot.Spec.Region] != "" {
+		regionConfigData := make(map[string]int)
+		if err := yaml.Unmarshal([]byte(sctx.regionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
+			return nil, fmt.Errorf("wrong format in region ConfigMap %s/%s, Region %q: %w", sctx.regionConfig.Namespace, sctx.regionConfig.Name, shoot.Spec.Region, err)
+		}
+
+		// If not configured otherwise, assume that a region has the smallest possible distance to itself.
+		if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
+			regionConfigData[shoot.Spec.Region] = 0
+		}
+
+		var unknownRegionSeeds []string
+		for _, seed := range seeds {
+			dist, ok := regionConfigData[seed.Spec.Provider.Region]
+			if !ok {
+				sctx.log.Info("Seed region not available in scheduler region ConfigMap for shoot region", "seedName", seed.Name, "shootRegion", shoot.Spec.Region, "seedRegion", seed.Spec.Provider.Region)
+				unknownRegionSeeds = append(unknownRegionSeeds, seed.Name)
+				continue
+			}
+			distances[seed.Name] = int64(dist)
+		}
+
+		scores := normalizeInverse(distances)
+		// Seeds in regions which are not part of the region config are considered to be the farthest away.
+		for _, seedName := range unknownRegionSeeds {
+			scores[seedName] = minPluginScore
+		}
+		return scores, nil
+	}
+
+	for _, seed := range seeds {
+		dist := distance(seed.Spec.Provider.Region, shoot.Spec.Region)
+		if seed.Spec.Provider.Type != shoot.Spec.Provider.Type {
+			dist += 2
+		}
+		distances[seed.Name] = int64(dist)
+	}
+
+	return normalizeInverse(distances), nil
+}
+
+// zoneMatch prefers seeds whose zones overlap with the zones of the shoot's worker pools. The score is the percentage of
+// the shoot's zones which are available in the seed.
+type zoneMatch struct{}
+
+func (z *zoneMatch) Name() schedulerconfigv1alpha1.ScorePluginName {
+	return schedulerconfigv1alpha1.ScorePluginZoneMatch
+}
+
+func (z *zoneMatch) Score(sctx *scoringContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var (
+		scores     = make(map[string]int64, len(seeds))
+		shootZones = allShootZones(sctx.shoot.Spec.Provider.Workers)
+	)
+
+	if len(shootZones) == 0 {
+		return scores, nil
+	}
+
+	for _, seed := range seeds {
+		matchingZones := sets.New(seed.Spec.Provider.Zones...).Intersection(sets.New(shootZones...)).Len()
+		scores[seed.Name] = maxPluginScore * int64(matchingZones) / int64(len(shootZones))
+	}
+
+	return scores, nil
+}
+
+// labelAffinity prefers seeds matching the configured preferred label selectors. The score is the percentage of
+// matching selectors.
+type labelAffinity struct {
+	selectors []labels.Selector
+}
+
+func (l *labelAffinity) Name() schedulerconfigv1alpha1.ScorePluginName {
+	return schedulerconfigv1alpha1.ScorePluginLabelAffinity
+}
+
+func (l *labelAffinity) Score(_ *scoringContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	scores := make(map[string]int64, len(seeds))
+
+	if len(l.selectors) == 0 {
+		return scores, nil
+	}
+
+	for _, seed := range seeds {
+		var matches int64
+		for _, selector := range l.selectors {
+			if selector.Matches(labels.Set(seed.Labels)) {
+				matches++
+			}
+		}
+		scores[seed.Name] = maxPluginScore * matches / int64(len(l.selectors))
+	}
+
+	return scores, nil
+}
+
+// seedAge prefers seeds which exist for a longer time. The oldest candidate gets the highest score, the youngest
+// candidate the lowest.
+type seedAge struct{}
+
+func (s *seedAge) Name() schedulerconfigv1alpha1.ScorePluginName {
+	return schedulerconfigv1alpha1.ScorePluginSeedAge
+}
+
+func (s *seedAge) Score(_ *scoringContext, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	creationTimes := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		creationTimes[seed.Name] = seed.CreationTimestamp.Unix()
+	}
+	return normalizeInverse(creationTimes), nil
+}
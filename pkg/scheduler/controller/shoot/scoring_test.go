@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/config/scheduler/v1alpha1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var _ = Describe("Scoring", func() {
+	var (
+		sctx  *scoringContext
+		shoot *gardencorev1beta1.Shoot
+
+		seed1, seed2, seed3 gardencorev1beta1.Seed
+		seeds               []gardencorev1beta1.Seed
+	)
+
+	newSeed := func(name, region string, zones ...string) gardencorev1beta1.Seed {
+		return gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{
+					Type:   "foo",
+					Region: region,
+					Zones:  zones,
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Region: "europe-1",
+				Provider: gardencorev1beta1.Provider{
+					Type: "foo",
+				},
+			},
+		}
+
+		seed1 = newSeed("seed-1", "europe-1")
+		seed2 = newSeed("seed-2", "europe-2")
+		seed3 = newSeed("seed-3", "asia-1")
+		seeds = []gardencorev1beta1.Seed{seed1, seed2, seed3}
+
+		sctx = &scoringContext{
+			log:       logr.Discard(),
+			shoot:     shoot,
+			seedUsage: map[string]int{},
+		}
+	})
+
+	Describe("#capacityHeadroom", func() {
+		It("should score seeds by their free allocatable capacity", func() {
+			seeds[0].Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("10")}
+			seeds[1].Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("4")}
+			sctx.seedUsage = map[string]int{"seed-1": 5, "seed-2": 1}
+
+			Expect((&capacityHeadroom{}).Score(sctx, seeds[:2])).To(Equal(map[string]int64{
+				"seed-1": 50,
+				"seed-2": 75,
+			}))
+		})
+
+		It("should score seeds without allocatable capacity by their usage", func() {
+			sctx.seedUsage = map[string]int{"seed-1": 4, "seed-2": 1}
+
+			Expect((&capacityHeadroom{}).Score(sctx, seeds)).To(Equal(map[string]int64{
+				"seed-1": 0,
+				"seed-2": 75,
+				"seed-3": 100,
+			}))
+		})
+	})
+
+	Describe("#regionDistance", func() {
+		It("should score seeds by the Levenshtein distance if no region config is available", func() {
+			scores, err := (&regionDistance{}).Score(sctx, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scores).To(HaveKeyWithValue("seed-1", int64(100)))
+			Expect(scores).To(HaveKeyWithValue("seed-3", int64(0)))
+			Expect(scores["seed-2"]).To(BeNumerically(">", scores["seed-3"]))
+		})
+
+		It("should score seeds by the distances of the region config", func() {
+			sctx.regionConfig = &corev1.ConfigMap{
+				Data: map[string]string{
+					"europe-1": "europe-2: 10\nasia-1: 40\n",
+				},
+			}
+			seeds = append(seeds, newSeed("seed-4", "unknown"))
+
+			Expect((&regionDistance{}).Score(sctx, seeds)).To(Equal(map[string]int64{
+				"seed-1": 100,
+				"seed-2": 75,
+				"seed-3": 0,
+				"seed-4": 0,
+			}))
+		})
+
+		It("should fail if the region config is malformed", func() {
+			sctx.regionConfig = &corev1.ConfigMap{
+				Data: map[string]string{
+					"europe-1": "invalid",
+				},
+			}
+
+			_, err := (&regionDistance{}).Score(sctx, seeds)
+			Expect(err).To(MatchError(ContainSubstring("wrong format in region ConfigMap")))
+		})
+	})
+
+	Describe("#zoneMatch", func() {
+		It("should not score seeds if the shoot does not define zones", func() {
+			Expect((&zoneMatch{}).Score(sctx, seeds)).To(BeEmpty())
+		})
+
+		It("should score seeds by their zone overlap", func() {
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Zones: []string{"a", "b"}}}
+			seeds[0].Spec.Provider.Zones = []string{"a", "b", "c"}
+			seeds[1].Spec.Provider.Zones = []string{"b"}
+
+			Expect((&zoneMatch{}).Score(sctx, seeds)).To(Equal(map[string]int64{
+				"seed-1": 100,
+				"seed-2": 50,
+				"seed-3": 0,
+			}))
+		})
+	})
+
+	Describe("#labelAffinity", func() {
+		It("should score seeds by the number of matching preferred selectors", func() {
+			plugin, err := newScorePlugin(schedulerconfigv1alpha1.ScorePluginLabelAffinity, &schedulerconfigv1alpha1.ScoringConfiguration{
+				LabelAffinity: &schedulerconfigv1alpha1.LabelAffinityArgs{
+					PreferredSeedSelectors: []metav1.LabelSelector{
+						{MatchLabels: map[string]string{"tier": "premium"}},
+						{MatchLabels: map[string]string{"environment": "prod"}},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			seeds[0].Labels = map[string]string{"tier": "premium", "environment": "prod"}
+			seeds[1].Labels = map[string]string{"environment": "prod"}
+
+			Expect(plugin.Score(sctx, seeds)).To(Equal(map[string]int64{
+				"seed-1": 100,
+				"seed-2": 50,
+				"seed-3": 0,
+			}))
+		})
+	})
+
+	Describe("#seedAge", func() {
+		It("should prefer older seeds", func() {
+			now := time.Now()
+			seeds[0].CreationTimestamp = metav1.NewTime(now.Add(-10 * time.Hour))
+			seeds[1].CreationTimestamp = metav1.NewTime(now.Add(-5 * time.Hour))
+			seeds[2].CreationTimestamp = metav1.NewTime(now)
+
+			Expect((&seedAge{}).Score(sctx, seeds)).To(Equal(map[string]int64{
+				"seed-1": 100,
+				"seed-2": 50,
+				"seed-3": 0,
+			}))
+		})
+	})
+
+	Describe("#scoreSeeds", func() {
+		It("should compute the weighted sum of all plugin scores", func() {
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Zones: []string{"a"}}}
+			seeds[2].Spec.Provider.Zones = []string{"a"}
+
+			scores, err := scoreSeeds(sctx, seeds, &schedulerconfigv1alpha1.ScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{
+					{Name: schedulerconfigv1alpha1.ScorePluginZoneMatch, Weight: 3},
+					{Name: schedulerconfigv1alpha1.ScorePluginCapacityHeadroom, Weight: 1},
+					{Name: schedulerconfigv1alpha1.ScorePluginSeedAge, Weight: 0},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scores).To(Equal([]SeedScore{
+				{
+					SeedName: "seed-3",
+					Score:    400,
+					PluginScores: map[schedulerconfigv1alpha1.ScorePluginName]int64{
+						schedulerconfigv1alpha1.ScorePluginZoneMatch:        100,
+						schedulerconfigv1alpha1.ScorePluginCapacityHeadroom: 100,
+					},
+				},
+				{
+					SeedName: "seed-1",
+					Score:    100,
+					PluginScores: map[schedulerconfigv1alpha1.ScorePluginName]int64{
+						schedulerconfigv1alpha1.ScorePluginZoneMatch:        0,
+						schedulerconfigv1alpha1.ScorePluginCapacityHeadroom: 100,
+					},
+				},
+				{
+					SeedName: "seed-2",
+					Score:    100,
+					PluginScores: map[schedulerconfigv1alpha1.ScorePluginName]int64{
+						schedulerconfigv1alpha1.ScorePluginZoneMatch:        0,
+						schedulerconfigv1alpha1.ScorePluginCapacityHeadroom: 100,
+					},
+				},
+			}))
+			Expect(seedScoresToString(scores)).To(Equal("seed-3=400, seed-1=100, seed-2=100"))
+			Expect(highestScoredSeeds(seeds, scores)).To(ConsistOf(seeds[2]))
+		})
+
+		It("should fail for an unknown score plugin", func() {
+			_, err := scoreSeeds(sctx, seeds, &schedulerconfigv1alpha1.ScoringConfiguration{
+				Plugins: []schedulerconfigv1alpha1.ScorePlugin{{Name: "Unknown", Weight: 1}},
+			})
+			Expect(err).To(MatchError(ContainSubstring(`unknown score plugin "Unknown"`)))
+		})
+
+		It("should fail if no scoring configuration is given", func() {
+			_, err := scoreSeeds(sctx, seeds, nil)
+			Expect(err).To(MatchError(ContainSubstring("scoring configuration is required")))
+		})
+	})
+})
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cosign
+
+import (
+	"crypto"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/sigstore/sigstore/pkg/signature"
+)
+
+const (
+	// ArtifactTypeBundlePrefix is the prefix of the artifact type of manifests which contain a sigstore bundle. cosign
+	// stores such manifests as OCI referrers of the signed image manifest.
+	ArtifactTypeBundlePrefix = "application/vnd.dev.sigstore.bundle"
+
+	bundleMediaTypeV01 = "application/vnd.dev.sigstore.bundle+json;version=0.1"
+	// predicateTypeCosignSign is the predicate type of the in-toto statements which are signed by `cosign sign` when
+	// using the bundle format.
+	predicateTypeCosignSign = "https://sigstore.dev/cosign/sign/v1"
+)
+
+// IsBundleMediaType returns true if the given media type or artifact type denotes a sigstore bundle.
+func IsBundleMediaType(mediaType string) bool {
+	return strings.HasPrefix(mediaType, ArtifactTypeBundlePrefix)
+}
+
+// ParseBundle parses the given JSON-encoded sigstore bundle.
+func ParseBundle(data []byte) (*bundle.Bundle, error) {
+	b := &bundle.Bundle{}
+	if err := b.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("failed decoding sigstore bundle: %w", err)
+	}
+	return b, nil
+}
+
+// VerifyBundle verifies that the given sigstore bundle was signed by any of the given public keys and that it signs the
+// image manifest with the given digest.
+func VerifyBundle(b *bundle.Bundle, manifestDigest digest.Digest, publicKeys []crypto.PublicKey) error {
+	var errs []error
+
+	for _, publicKey := range publicKeys {
+		signatureVerifier, err := signature.LoadVerifier(publicKey, crypto.SHA256)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		trustedMaterial := root.NewTrustedPublicKeyMaterial(func(string) (root.TimeConstrainedVerifier, error) {
+			return root.NewExpiringKey(signatureVerifier, time.Time{}, time.Time{}), nil
+		})
+
+		// Signatures created with keys are trusted regardless of whether they were recorded in a transparency log, like
+		// signatures verified with VerifyPayload.
+		verifier, err := verify.NewVerifier(trustedMaterial, verify.WithNoObserverTimestamps())
+		if err != nil {
+			return err
+		}
+
+		if err := verifyBundle(verifier, b, manifestDigest, verify.WithKey()); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return nil
+	}
+
+	return fmt.Errorf("bundle was not signed by any of the trusted public keys: %w", errors.Join(errs...))
+}
+
+func verifyBundle(verifier *verify.Verifier, b *bundle.Bundle, manifestDigest digest.Digest, options ...verify.PolicyOption) error {
+	rawDigest, err := hex.DecodeString(manifestDigest.Encoded())
+	if err != nil {
+		return fmt.Errorf("failed decoding manifest digest: %w", err)
+	}
+
+	result, err := verifier.Verify(b, verify.NewPolicy(verify.WithArtifactDigest(manifestDigest.Algorithm().String(), rawDigest), options...))
+	if err != nil {
+		return err
+	}
+
+	// Bundles created by cosign for images contain an in-toto statement with the image manifest as subject. Other
+	// statements, e.g. attestations, must not be accepted as signatures.
+	if result.Statement != nil && result.Statement.GetPredicateType() != predicateTypeCosignSign {
+		return fmt.Errorf("signed statement has unexpected predicate type %q", result.Statement.GetPredicateType())
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cosign_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
+	protocommon "github.com/sigstore/protobuf-specs/gen/pb-go/common/v1"
+	protodsse "github.com/sigstore/protobuf-specs/gen/pb-go/dsse"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+
+	. "github.com/gardener/gardener/pkg/utils/cosign"
+)
+
+var _ = Describe("Bundle", func() {
+	var (
+		manifestDigest = digest.FromString("manifest")
+		signingKey     *ecdsa.PrivateKey
+	)
+
+	BeforeEach(func() {
+		var err error
+		signingKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#IsBundleMediaType", func() {
+		It("should return true for sigstore bundles", func() {
+			Expect(IsBundleMediaType("application/vnd.dev.sigstore.bundle.v0.3+json")).To(BeTrue())
+			Expect(IsBundleMediaType("application/vnd.dev.sigstore.bundle+json;version=0.2")).To(BeTrue())
+		})
+
+		It("should return false for other media types", func() {
+			Expect(IsBundleMediaType(MediaTypeSimpleSigning)).To(BeFalse())
+		})
+	})
+
+	Describe("#ParseBundle", func() {
+		It("should fail if the bundle is invalid", func() {
+			_, err := ParseBundle([]byte(`{"mediaType":"foo"}`))
+			Expect(err).To(MatchError(ContainSubstring("failed decoding sigstore bundle")))
+		})
+	})
+
+	Describe("#VerifyBundle", func() {
+		It("should succeed if the message signature was created by a trusted key", func() {
+			b := newKeyBundle(messageSignature(signingKey, manifestDigest))
+			Expect(VerifyBundle(b, manifestDigest, []crypto.PublicKey{signingKey.Public()})).To(Succeed())
+		})
+
+		It("should succeed if the statement was signed by a trusted key", func() {
+			b := newKeyBundle(dsseEnvelope(signingKey, manifestDigest, "https://sigstore.dev/cosign/sign/v1"))
+			Expect(VerifyBundle(b, manifestDigest, []crypto.PublicKey{signingKey.Public()})).To(Succeed())
+		})
+
+		It("should fail if the bundle was signed by an untrusted key", func() {
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			b := newKeyBundle(messageSignature(otherKey, manifestDigest))
+			Expect(VerifyBundle(b, manifestDigest, []crypto.PublicKey{signingKey.Public()})).To(MatchError(ContainSubstring("bundle was not signed by any of the trusted public keys")))
+		})
+
+		It("should fail if the message signature refers to another manifest", func() {
+			b := newKeyBundle(messageSignature(signingKey, digest.FromString("foo")))
+			Expect(VerifyBundle(b, manifestDigest, []crypto.PublicKey{signingKey.Public()})).To(MatchError(ContainSubstring("bundle was not signed by any of the trusted public keys")))
+		})
+
+		It("should fail if the statement refers to another manifest", func() {
+			b := newKeyBundle(dsseEnvelope(signingKey, digest.FromString("foo"), "https://sigstore.dev/cosign/sign/v1"))
+			Expect(VerifyBundle(b, manifestDigest, []crypto.PublicKey{signingKey.Public()})).To(MatchError(ContainSubstring("provided artifact digest does not match any digest in statement")))
+		})
+
+		It("should fail if the statement is not a signature", func() {
+			b := newKeyBundle(dsseEnvelope(signingKey, manifestDigest, "https://slsa.dev/provenance/v1"))
+			Expect(VerifyBundle(b, manifestDigest, []crypto.PublicKey{signingKey.Public()})).To(MatchError(ContainSubstring(`signed statement has unexpected predicate type "https://slsa.dev/provenance/v1"`)))
+		})
+	})
+})
+
+// newKeyBundle returns a sigstore bundle with the given content which was signed with a key and was not recorded in a
+// transparency log.
+func newKeyBundle(content any) *bundle.Bundle {
+	b := &protobundle.Bundle{
+		MediaType: "application/vnd.dev.sigstore.bundle.v0.3+json",
+		VerificationMaterial: &protobundle.VerificationMaterial{
+			Content: &protobundle.VerificationMaterial_PublicKey{PublicKey: &protocommon.PublicKeyIdentifier{}},
+		},
+	}
+
+	switch c := content.(type) {
+	case *protocommon.MessageSignature:
+		b.Content = &protobundle.Bundle_MessageSignature{MessageSignature: c}
+	case *protodsse.Envelope:
+		b.Content = &protobundle.Bundle_DsseEnvelope{DsseEnvelope: c}
+	}
+
+	return encodeAndParseBundle(b)
+}
+
+// encodeAndParseBundle encodes the given bundle and parses it again like bundles fetched from registries.
+func encodeAndParseBundle(pb *protobundle.Bundle) *bundle.Bundle {
+	b, err := bundle.NewBundle(pb)
+	Expect(err).NotTo(HaveOccurred())
+	data, err := b.MarshalJSON()
+	Expect(err).NotTo(HaveOccurred())
+
+	b, err = ParseBundle(data)
+	Expect(err).NotTo(HaveOccurred())
+	return b
+}
+
+// messageSignature returns a signature of the given manifest digest.
+func messageSignature(signer crypto.Signer, manifestDigest digest.Digest) *protocommon.MessageSignature {
+	rawDigest, err := hex.DecodeString(manifestDigest.Encoded())
+	Expect(err).NotTo(HaveOccurred())
+	signature, err := signer.Sign(rand.Reader, rawDigest, crypto.SHA256)
+	Expect(err).NotTo(HaveOccurred())
+
+	return &protocommon.MessageSignature{
+		MessageDigest: &protocommon.HashOutput{Algorithm: protocommon.HashAlgorithm_SHA2_256, Digest: rawDigest},
+		Signature:     signature,
+	}
+}
+
+// dsseEnvelope returns a signed in-toto statement of the given predicate type for the given manifest digest.
+func dsseEnvelope(signer crypto.Signer, manifestDigest digest.Digest, predicateType string) *protodsse.Envelope {
+	const payloadType = "application/vnd.in-toto+json"
+	payload := fmt.Appendf(nil, `{"_type":"https://in-toto.io/Statement/v1","subject":[{"name":"example.com/foo","digest":{%q:%q}}],"predicateType":%q,"predicate":{}}`, manifestDigest.Algorithm(), manifestDigest.Encoded(), predicateType)
+	pae := fmt.Appendf(nil, "DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload)
+
+	return &protodsse.Envelope{
+		Payload:     payload,
+		PayloadType: payloadType,
+		Signatures:  []*protodsse.Signature{{Sig: sign(signer, pae)}},
+	}
+}
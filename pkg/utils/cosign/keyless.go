@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cosign
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
+	protocommon "github.com/sigstore/protobuf-specs/gen/pb-go/common/v1"
+	protorekor "github.com/sigstore/protobuf-specs/gen/pb-go/rekor/v1"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+)
+
+// Identity is an identity for which signing certificates are issued.
+type Identity struct {
+	// Issuer is the OIDC issuer which authenticated the identity.
+	Issuer string
+	// Subject is the email address or URI contained in the signing certificate.
+	Subject string
+}
+
+// KeylessOptions are options for verifying signatures which were created with short-lived certificates.
+type KeylessOptions struct {
+	// Identities are the identities which are trusted for signing.
+	Identities []Identity
+	// Roots are the trusted root certificates of the certificate authority.
+	Roots []*x509.Certificate
+	// Intermediates are the trusted intermediate certificates of the certificate authority.
+	Intermediates []*x509.Certificate
+	// TransparencyLogPublicKeys are the public keys of the transparency logs which are trusted for recording signatures.
+	TransparencyLogPublicKeys []crypto.PublicKey
+	// CertificateTransparencyLogPublicKeys are the public keys of the certificate transparency logs which are trusted
+	// for publishing the signing certificates.
+	CertificateTransparencyLogPublicKeys []crypto.PublicKey
+}
+
+// ParseCertificateAuthorities parses the given PEM-encoded bundle of certificates. Self-signed certificates are
+// returned as roots, all other certificates as intermediates.
+func ParseCertificateAuthorities(bundlePEM string) (roots, intermediates []*x509.Certificate, err error) {
+	certificates, err := parseCertificates([]byte(bundlePEM))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(certificates) == 0 {
+		return nil, nil, errors.New("no certificates found")
+	}
+
+	for _, certificate := range certificates {
+		if bytes.Equal(certificate.RawIssuer, certificate.RawSubject) && certificate.CheckSignatureFrom(certificate) == nil {
+			roots = append(roots, certificate)
+		} else {
+			intermediates = append(intermediates, certificate)
+		}
+	}
+	if len(roots) == 0 {
+		return nil, nil, errors.New("no root certificate found")
+	}
+
+	return roots, intermediates, nil
+}
+
+// KeylessVerifier verifies signatures which were created with short-lived certificates. The certificate must have been
+// issued by the trusted certificate authority for any of the trusted identities and must have been published in a
+// trusted certificate transparency log. The signature must have been recorded in a trusted transparency log while the
+// certificate was valid.
+type KeylessVerifier struct {
+	verifier   *verify.Verifier
+	identities []verify.CertificateIdentity
+}
+
+// NewKeylessVerifier returns a new verifier for signatures which were created with short-lived certificates.
+func NewKeylessVerifier(opts KeylessOptions) (*KeylessVerifier, error) {
+	certificateAuthorities := make([]root.CertificateAuthority, 0, len(opts.Roots))
+	for _, rootCertificate := range opts.Roots {
+		certificateAuthorities = append(certificateAuthorities, &root.FulcioCertificateAuthority{
+			Root:          rootCertificate,
+			Intermediates: opts.Intermediates,
+		})
+	}
+
+	transparencyLogs, err := transparencyLogsFor(opts.TransparencyLogPublicKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing transparency log public keys: %w", err)
+	}
+	certificateTransparencyLogs, err := transparencyLogsFor(opts.CertificateTransparencyLogPublicKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing certificate transparency log public keys: %w", err)
+	}
+
+	trustedRoot, err := root.NewTrustedRoot(root.TrustedRootMediaType01, certificateAuthorities, certificateTransparencyLogs, nil, transparencyLogs)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := verify.NewVerifier(trustedRoot,
+		verify.WithTransparencyLog(1),
+		verify.WithIntegratedTimestamps(1),
+		verify.WithSignedCertificateTimestamps(1),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &KeylessVerifier{verifier: verifier}
+	for _, identity := range opts.Identities {
+		certificateIdentity, err := verify.NewShortCertificateIdentity(identity.Issuer, "", identity.Subject, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing identity (issuer %q, subject %q): %w", identity.Issuer, identity.Subject, err)
+		}
+		v.identities = append(v.identities, certificateIdentity)
+	}
+
+	return v, nil
+}
+
+// transparencyLogsFor returns the transparency logs with the given public keys keyed by their hex-encoded log IDs.
+func transparencyLogsFor(publicKeys []crypto.PublicKey) (map[string]*root.TransparencyLog, error) {
+	transparencyLogs := make(map[string]*root.TransparencyLog, len(publicKeys))
+
+	for _, publicKey := range publicKeys {
+		der, err := x509.MarshalPKIXPublicKey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		logID := sha256.Sum256(der)
+
+		transparencyLogs[hex.EncodeToString(logID[:])] = &root.TransparencyLog{
+			ID:        logID[:],
+			HashFunc:  crypto.SHA256,
+			PublicKey: publicKey,
+			// The keys are configured without validity period, hence they are trusted for all entries. Still, the start
+			// of the validity period must be set for verifying signed entry timestamps.
+			ValidityPeriodStart: time.Unix(0, 0),
+			SignatureHashFunc:   crypto.SHA256,
+		}
+	}
+
+	return transparencyLogs, nil
+}
+
+// Verify verifies the given signature of the given cosign payload. The certificate and the transparency log entry are
+// read from the given annotations of the signature layer. Finally, the payload must refer to the image manifest with
+// the given digest.
+func (v *KeylessVerifier) Verify(payload, signature []byte, annotations map[string]string, manifestDigest digest.Digest) error {
+	b, err := bundleForSignature(payload, signature, annotations)
+	if err != nil {
+		return err
+	}
+
+	if _, err := v.verifier.Verify(b, verify.NewPolicy(verify.WithArtifact(bytes.NewReader(payload)), v.policyOptions()...)); err != nil {
+		return err
+	}
+
+	return verifySimpleSigningPayload(payload, manifestDigest)
+}
+
+// VerifyBundle verifies the given sigstore bundle of the image manifest with the given digest.
+func (v *KeylessVerifier) VerifyBundle(b *bundle.Bundle, manifestDigest digest.Digest) error {
+	return verifyBundle(v.verifier, b, manifestDigest, v.policyOptions()...)
+}
+
+func (v *KeylessVerifier) policyOptions() []verify.PolicyOption {
+	options := make([]verify.PolicyOption, 0, len(v.identities))
+	for _, identity := range v.identities {
+		options = append(options, verify.WithCertificateIdentity(identity))
+	}
+	return options
+}
+
+// legacyBundle is the transparency log entry of a signature which is attached to cosign signatures.
+type legacyBundle struct {
+	SignedEntryTimestamp []byte `json:"SignedEntryTimestamp"`
+	Payload              struct {
+		Body           string `json:"body"`
+		IntegratedTime int64  `json:"integratedTime"`
+		LogID          string `json:"logID"`
+		LogIndex       int64  `json:"logIndex"`
+	} `json:"Payload"`
+}
+
+// bundleForSignature converts the given signature in the legacy cosign format to a sigstore bundle, so that it can be
+// verified like signatures in the bundle format.
+func bundleForSignature(payload, signature []byte, annotations map[string]string) (*bundle.Bundle, error) {
+	certificates, err := parseCertificates([]byte(annotations[AnnotationKeyCertificate]))
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing signing certificate: %w", err)
+	}
+	if len(certificates) != 1 {
+		return nil, fmt.Errorf("expected exactly one signing certificate but found %d", len(certificates))
+	}
+
+	if annotations[AnnotationKeyBundle] == "" {
+		return nil, errors.New("signature was not recorded in a transparency log")
+	}
+
+	var lb legacyBundle
+	if err := json.Unmarshal([]byte(annotations[AnnotationKeyBundle]), &lb); err != nil {
+		return nil, fmt.Errorf("failed decoding transparency log entry: %w", err)
+	}
+
+	body, err := base64.StdEncoding.DecodeString(lb.Payload.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding body of transparency log entry: %w", err)
+	}
+	var kindVersion struct {
+		Kind       string `json:"kind"`
+		APIVersion string `json:"apiVersion"`
+	}
+	if err := json.Unmarshal(body, &kindVersion); err != nil {
+		return nil, fmt.Errorf("failed decoding body of transparency log entry: %w", err)
+	}
+
+	logID, err := hex.DecodeString(lb.Payload.LogID)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding log ID of transparency log entry: %w", err)
+	}
+
+	payloadHash := sha256.Sum256(payload)
+
+	return bundle.NewBundle(&protobundle.Bundle{
+		MediaType: bundleMediaTypeV01,
+		VerificationMaterial: &protobundle.VerificationMaterial{
+			Content: &protobundle.VerificationMaterial_X509CertificateChain{
+				X509CertificateChain: &protocommon.X509CertificateChain{
+					Certificates: []*protocommon.X509Certificate{{RawBytes: certificates[0].Raw}},
+				},
+			},
+			TlogEntries: []*protorekor.TransparencyLogEntry{{
+				LogIndex:          lb.Payload.LogIndex,
+				LogId:             &protocommon.LogId{KeyId: logID},
+				KindVersion:       &protorekor.KindVersion{Kind: kindVersion.Kind, Version: kindVersion.APIVersion},
+				IntegratedTime:    lb.Payload.IntegratedTime,
+				InclusionPromise:  &protorekor.InclusionPromise{SignedEntryTimestamp: lb.SignedEntryTimestamp},
+				CanonicalizedBody: body,
+			}},
+		},
+		Content: &protobundle.Bundle_MessageSignature{
+			MessageSignature: &protocommon.MessageSignature{
+				MessageDigest: &protocommon.HashOutput{Algorithm: protocommon.HashAlgorithm_SHA2_256, Digest: payloadHash[:]},
+				Signature:     signature,
+			},
+		},
+	})
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+
+	for {
+		var block *pem.Block
+		if block, data = pem.Decode(data); block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	return certificates, nil
+}
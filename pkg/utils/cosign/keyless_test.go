@@ -0,0 +1,442 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cosign_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
+	protocommon "github.com/sigstore/protobuf-specs/gen/pb-go/common/v1"
+	protorekor "github.com/sigstore/protobuf-specs/gen/pb-go/rekor/v1"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"golang.org/x/crypto/cryptobyte"
+
+	. "github.com/gardener/gardener/pkg/utils/cosign"
+)
+
+var _ = Describe("Keyless", func() {
+	var (
+		manifestDigest = digest.FromString("manifest")
+		identity       = Identity{Issuer: "https://token.actions.githubusercontent.com", Subject: "https://github.com/gardener/gardener/.github/workflows/release.yaml@refs/heads/master"}
+
+		now                      time.Time
+		rootKey, intermediateKey *ecdsa.PrivateKey
+		root, intermediate       *x509.Certificate
+		logKey, ctLogKey         *ecdsa.PrivateKey
+
+		opts KeylessOptions
+	)
+
+	BeforeEach(func() {
+		now = time.Now().Truncate(time.Second)
+
+		rootKey, root = newCertificate(&x509.Certificate{
+			Subject:               pkix.Name{CommonName: "sigstore"},
+			NotBefore:             now.Add(-time.Hour),
+			NotAfter:              now.Add(time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}, nil, nil)
+		intermediateKey, intermediate = newCertificate(&x509.Certificate{
+			Subject:               pkix.Name{CommonName: "sigstore-intermediate"},
+			NotBefore:             now.Add(-time.Hour),
+			NotAfter:              now.Add(time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		}, root, rootKey)
+
+		var err error
+		logKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		ctLogKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		roots, intermediates, err := ParseCertificateAuthorities(certificatePEM(root) + certificatePEM(intermediate))
+		Expect(err).NotTo(HaveOccurred())
+
+		opts = KeylessOptions{
+			Identities:                []Identity{identity},
+			Roots:                     roots,
+			Intermediates:             intermediates,
+			TransparencyLogPublicKeys: []crypto.PublicKey{logKey.Public()},
+
+			CertificateTransparencyLogPublicKeys: []crypto.PublicKey{ctLogKey.Public()},
+		}
+	})
+
+	// newSignedCertificateTimestamps returns the extension containing an SCT of the given certificate transparency log
+	// for the given precertificate.
+	newSignedCertificateTimestamps := func(key *ecdsa.PrivateKey, precertificate *x509.Certificate) pkix.Extension {
+		der, err := x509.MarshalPKIXPublicKey(key.Public())
+		Expect(err).NotTo(HaveOccurred())
+		logID := sha256.Sum256(der)
+		issuerKeyHash := sha256.Sum256(intermediate.RawSubjectPublicKeyInfo)
+		timestamp := uint64(now.UnixMilli())
+
+		var signed cryptobyte.Builder
+		signed.AddUint8(0)
+		signed.AddUint8(0)
+		signed.AddUint64(timestamp)
+		signed.AddUint16(1)
+		signed.AddBytes(issuerKeyHash[:])
+		signed.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(precertificate.RawTBSCertificate) })
+		signed.AddUint16(0)
+
+		var list cryptobyte.Builder
+		list.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+				b.AddUint8(0)
+				b.AddBytes(logID[:])
+				b.AddUint64(timestamp)
+				b.AddUint16(0)
+				b.AddUint8(4) // sha256
+				b.AddUint8(3) // ecdsa
+				b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(sign(key, signed.BytesOrPanic())) })
+			})
+		})
+
+		value, err := asn1.Marshal(list.BytesOrPanic())
+		Expect(err).NotTo(HaveOccurred())
+		return pkix.Extension{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2}, Value: value}
+	}
+
+	newSigningCertificateWithCTLog := func(identity Identity, notBefore time.Time, ctLogKey *ecdsa.PrivateKey) (*ecdsa.PrivateKey, *x509.Certificate) {
+		issuer, err := asn1.MarshalWithParams(identity.Issuer, "utf8")
+		Expect(err).NotTo(HaveOccurred())
+
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+		Expect(err).NotTo(HaveOccurred())
+
+		template := &x509.Certificate{
+			SerialNumber:    serialNumber,
+			NotBefore:       notBefore,
+			NotAfter:        notBefore.Add(10 * time.Minute),
+			KeyUsage:        x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+			EmailAddresses:  []string{identity.Subject},
+			ExtraExtensions: []pkix.Extension{{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}, Value: issuer}},
+		}
+
+		der, err := x509.CreateCertificate(rand.Reader, template, intermediate, key.Public(), intermediateKey)
+		Expect(err).NotTo(HaveOccurred())
+		precertificate, err := x509.ParseCertificate(der)
+		Expect(err).NotTo(HaveOccurred())
+
+		if ctLogKey != nil {
+			template.ExtraExtensions = append(template.ExtraExtensions, newSignedCertificateTimestamps(ctLogKey, precertificate))
+		}
+
+		der, err = x509.CreateCertificate(rand.Reader, template, intermediate, key.Public(), intermediateKey)
+		Expect(err).NotTo(HaveOccurred())
+		certificate, err := x509.ParseCertificate(der)
+		Expect(err).NotTo(HaveOccurred())
+		return key, certificate
+	}
+
+	newSigningCertificate := func(identity Identity, notBefore time.Time) (*ecdsa.PrivateKey, *x509.Certificate) {
+		return newSigningCertificateWithCTLog(identity, notBefore, ctLogKey)
+	}
+
+	// newLogEntry returns the canonicalized body of a transparency log entry recording the given signature of data with
+	// the given hash, the entry itself and its signed entry timestamp.
+	newLogEntry := func(key *ecdsa.PrivateKey, hash []byte, signature []byte, certificate *x509.Certificate, integratedTime time.Time) ([]byte, []byte, []byte) {
+		body, err := json.Marshal(map[string]any{
+			"apiVersion": "0.0.1",
+			"kind":       "hashedrekord",
+			"spec": map[string]any{
+				"data":      map[string]any{"hash": map[string]any{"algorithm": "sha256", "value": hex.EncodeToString(hash)}},
+				"signature": map[string]any{"content": signature, "publicKey": map[string]any{"content": []byte(certificatePEM(certificate))}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		der, err := x509.MarshalPKIXPublicKey(key.Public())
+		Expect(err).NotTo(HaveOccurred())
+		logID := sha256.Sum256(der)
+
+		entry := fmt.Appendf(nil, `{"body":%q,"integratedTime":%d,"logID":%q,"logIndex":42}`, base64.StdEncoding.EncodeToString(body), integratedTime.Unix(), hex.EncodeToString(logID[:]))
+		return body, entry, sign(key, entry)
+	}
+
+	newBundle := func(key *ecdsa.PrivateKey, payload, signature []byte, certificate *x509.Certificate, integratedTime time.Time) string {
+		payloadHash := sha256.Sum256(payload)
+		_, entry, signedEntryTimestamp := newLogEntry(key, payloadHash[:], signature, certificate, integratedTime)
+
+		b, err := json.Marshal(map[string]any{"SignedEntryTimestamp": signedEntryTimestamp, "Payload": json.RawMessage(entry)})
+		Expect(err).NotTo(HaveOccurred())
+		return string(b)
+	}
+
+	newKeylessBundle := func(signature *protocommon.MessageSignature, certificate *x509.Certificate) *bundle.Bundle {
+		body, _, signedEntryTimestamp := newLogEntry(logKey, signature.MessageDigest.Digest, signature.Signature, certificate, now)
+
+		der, err := x509.MarshalPKIXPublicKey(logKey.Public())
+		Expect(err).NotTo(HaveOccurred())
+		logID := sha256.Sum256(der)
+
+		return encodeAndParseBundle(&protobundle.Bundle{
+			MediaType: "application/vnd.dev.sigstore.bundle+json;version=0.1",
+			VerificationMaterial: &protobundle.VerificationMaterial{
+				Content: &protobundle.VerificationMaterial_X509CertificateChain{
+					X509CertificateChain: &protocommon.X509CertificateChain{Certificates: []*protocommon.X509Certificate{{RawBytes: certificate.Raw}}},
+				},
+				TlogEntries: []*protorekor.TransparencyLogEntry{{
+					LogIndex:          42,
+					LogId:             &protocommon.LogId{KeyId: logID[:]},
+					KindVersion:       &protorekor.KindVersion{Kind: "hashedrekord", Version: "0.0.1"},
+					IntegratedTime:    now.Unix(),
+					InclusionPromise:  &protorekor.InclusionPromise{SignedEntryTimestamp: signedEntryTimestamp},
+					CanonicalizedBody: body,
+				}},
+			},
+			Content: &protobundle.Bundle_MessageSignature{MessageSignature: signature},
+		})
+	}
+
+	signKeyless := func(identity Identity, payloadDigest digest.Digest) ([]byte, []byte, map[string]string) {
+		signingKey, certificate := newSigningCertificate(identity, now.Add(-time.Minute))
+		payload, signature := signPayload(signingKey, payloadDigest)
+
+		return payload, signature, map[string]string{
+			AnnotationKeyCertificate: certificatePEM(certificate),
+			AnnotationKeyBundle:      newBundle(logKey, payload, signature, certificate, now),
+		}
+	}
+
+	verifyKeyless := func(payload, signature []byte, annotations map[string]string) error {
+		verifier, err := NewKeylessVerifier(opts)
+		Expect(err).NotTo(HaveOccurred())
+		return verifier.Verify(payload, signature, annotations, manifestDigest)
+	}
+
+	Describe("#ParseCertificateAuthorities", func() {
+		It("should return self-signed certificates as roots", func() {
+			roots, intermediates, err := ParseCertificateAuthorities(certificatePEM(intermediate) + certificatePEM(root))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roots).To(ConsistOf(root))
+			Expect(intermediates).To(ConsistOf(intermediate))
+		})
+
+		It("should fail if the bundle contains no certificates", func() {
+			_, _, err := ParseCertificateAuthorities("foo")
+			Expect(err).To(MatchError("no certificates found"))
+		})
+
+		It("should fail if the bundle contains no root certificate", func() {
+			_, _, err := ParseCertificateAuthorities(certificatePEM(intermediate))
+			Expect(err).To(MatchError("no root certificate found"))
+		})
+	})
+
+	Describe("#Verify", func() {
+		It("should succeed if the payload is signed by a trusted identity", func() {
+			payload, signature, annotations := signKeyless(identity, manifestDigest)
+			Expect(verifyKeyless(payload, signature, annotations)).To(Succeed())
+		})
+
+		It("should fail if the intermediate certificate is not trusted", func() {
+			payload, signature, annotations := signKeyless(identity, manifestDigest)
+			opts.Intermediates = nil
+
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError(ContainSubstring("leaf certificate verification failed")))
+		})
+
+		It("should fail if the payload is signed by an untrusted identity", func() {
+			payload, signature, annotations := signKeyless(Identity{Issuer: identity.Issuer, Subject: "foo@example.com"}, manifestDigest)
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError(ContainSubstring(`no matching CertificateIdentity found, last error: expected SAN value "https://github.com/gardener/gardener/.github/workflows/release.yaml@refs/heads/master", got "foo@example.com"`)))
+		})
+
+		It("should fail if the certificate was not issued by the trusted certificate authority", func() {
+			payload, signature, annotations := signKeyless(identity, manifestDigest)
+
+			_, otherRoot := newCertificate(&x509.Certificate{
+				Subject:               pkix.Name{CommonName: "other"},
+				NotBefore:             now.Add(-time.Hour),
+				NotAfter:              now.Add(time.Hour),
+				IsCA:                  true,
+				BasicConstraintsValid: true,
+				KeyUsage:              x509.KeyUsageCertSign,
+			}, nil, nil)
+			opts.Roots, opts.Intermediates, _ = ParseCertificateAuthorities(certificatePEM(otherRoot))
+
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError(ContainSubstring("leaf certificate verification failed")))
+		})
+
+		It("should fail if the signature was recorded after the certificate expired", func() {
+			signingKey, certificate := newSigningCertificate(identity, now.Add(-time.Hour))
+			payload, signature := signPayload(signingKey, manifestDigest)
+			annotations := map[string]string{
+				AnnotationKeyCertificate: certificatePEM(certificate),
+				AnnotationKeyBundle:      newBundle(logKey, payload, signature, certificate, now),
+			}
+
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError(ContainSubstring("integrated time outside certificate validity")))
+		})
+
+		It("should fail if the signature was not recorded in a transparency log", func() {
+			payload, signature, annotations := signKeyless(identity, manifestDigest)
+			delete(annotations, AnnotationKeyBundle)
+
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError("signature was not recorded in a transparency log"))
+		})
+
+		It("should fail if the signature was recorded in an untrusted transparency log", func() {
+			payload, signature, annotations := signKeyless(identity, manifestDigest)
+
+			otherLogKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			opts.TransparencyLogPublicKeys = []crypto.PublicKey{otherLogKey.Public()}
+
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError(ContainSubstring("not enough verified log entries from transparency log")))
+		})
+
+		It("should fail if the transparency log entry records another signature", func() {
+			signingKey, certificate := newSigningCertificate(identity, now.Add(-time.Minute))
+			payload, signature := signPayload(signingKey, manifestDigest)
+			otherPayload, otherSignature := signPayload(signingKey, digest.FromString("foo"))
+			annotations := map[string]string{
+				AnnotationKeyCertificate: certificatePEM(certificate),
+				AnnotationKeyBundle:      newBundle(logKey, otherPayload, otherSignature, certificate, now),
+			}
+
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError(ContainSubstring("transparency log signature does not match")))
+		})
+
+		It("should fail if the payload refers to another manifest", func() {
+			payload, signature, annotations := signKeyless(identity, digest.FromString("foo"))
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError(ContainSubstring("signed payload refers to digest")))
+		})
+
+		It("should fail if the signing certificate does not contain a signed certificate timestamp", func() {
+			signingKey, certificate := newSigningCertificateWithCTLog(identity, now.Add(-time.Minute), nil)
+			payload, signature := signPayload(signingKey, manifestDigest)
+			annotations := map[string]string{
+				AnnotationKeyCertificate: certificatePEM(certificate),
+				AnnotationKeyBundle:      newBundle(logKey, payload, signature, certificate, now),
+			}
+
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError(ContainSubstring("only able to verify 0 SCT entries")))
+		})
+
+		It("should fail if the signing certificate was published in an untrusted certificate transparency log", func() {
+			payload, signature, annotations := signKeyless(identity, manifestDigest)
+
+			otherCTLogKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			opts.CertificateTransparencyLogPublicKeys = []crypto.PublicKey{otherCTLogKey.Public()}
+
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError(ContainSubstring("only able to verify 0 SCT entries")))
+		})
+
+		It("should fail if the signed certificate timestamp was issued for another certificate", func() {
+			signingKey, certificate := newSigningCertificate(identity, now.Add(-time.Minute))
+			_, otherCertificate := newSigningCertificate(identity, now.Add(-time.Minute))
+
+			// Replace the SCT extension of the signing certificate with the one of the other certificate.
+			template := *certificate
+			template.ExtraExtensions = nil
+			for _, extension := range certificate.Extensions {
+				if extension.Id.Equal(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2}) {
+					continue
+				}
+				if extension.Id.Equal(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}) {
+					template.ExtraExtensions = append(template.ExtraExtensions, extension)
+				}
+			}
+			for _, extension := range otherCertificate.Extensions {
+				if extension.Id.Equal(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2}) {
+					template.ExtraExtensions = append(template.ExtraExtensions, extension)
+				}
+			}
+			der, err := x509.CreateCertificate(rand.Reader, &template, intermediate, signingKey.Public(), intermediateKey)
+			Expect(err).NotTo(HaveOccurred())
+			certificate, err = x509.ParseCertificate(der)
+			Expect(err).NotTo(HaveOccurred())
+
+			payload, signature := signPayload(signingKey, manifestDigest)
+			annotations := map[string]string{
+				AnnotationKeyCertificate: certificatePEM(certificate),
+				AnnotationKeyBundle:      newBundle(logKey, payload, signature, certificate, now),
+			}
+
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError(ContainSubstring("only able to verify 0 SCT entries")))
+		})
+
+		It("should fail if there is no signing certificate", func() {
+			payload, signature, annotations := signKeyless(identity, manifestDigest)
+			delete(annotations, AnnotationKeyCertificate)
+
+			Expect(verifyKeyless(payload, signature, annotations)).To(MatchError("expected exactly one signing certificate but found 0"))
+		})
+	})
+
+	Describe("#VerifyBundle", func() {
+		verifyBundle := func(b *bundle.Bundle) error {
+			verifier, err := NewKeylessVerifier(opts)
+			Expect(err).NotTo(HaveOccurred())
+			return verifier.VerifyBundle(b, manifestDigest)
+		}
+
+		It("should succeed if the bundle is signed by a trusted identity", func() {
+			signingKey, certificate := newSigningCertificate(identity, now.Add(-time.Minute))
+			Expect(verifyBundle(newKeylessBundle(messageSignature(signingKey, manifestDigest), certificate))).To(Succeed())
+		})
+
+		It("should fail if the bundle is signed by an untrusted identity", func() {
+			signingKey, certificate := newSigningCertificate(Identity{Issuer: identity.Issuer, Subject: "foo@example.com"}, now.Add(-time.Minute))
+			Expect(verifyBundle(newKeylessBundle(messageSignature(signingKey, manifestDigest), certificate))).To(MatchError(ContainSubstring("no matching CertificateIdentity found")))
+		})
+
+		It("should fail if the bundle refers to another manifest", func() {
+			signingKey, certificate := newSigningCertificate(identity, now.Add(-time.Minute))
+			Expect(verifyBundle(newKeylessBundle(messageSignature(signingKey, digest.FromString("foo")), certificate))).To(MatchError(ContainSubstring("failed to verify signature")))
+		})
+	})
+})
+
+func newCertificate(template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*ecdsa.PrivateKey, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	Expect(err).NotTo(HaveOccurred())
+	template.SerialNumber = serialNumber
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	Expect(err).NotTo(HaveOccurred())
+	certificate, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+	return key, certificate
+}
+
+func certificatePEM(certificate *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw}))
+}
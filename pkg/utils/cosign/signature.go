@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cosign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	// MediaTypeSimpleSigning is the media type of layers in cosign signature manifests which contain the signed payload.
+	MediaTypeSimpleSigning = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// AnnotationKeySignature is the annotation key on layers in cosign signature manifests which contains the base64
+	// encoded signature of the payload.
+	AnnotationKeySignature = "dev.cosignproject.cosign/signature"
+	// AnnotationKeyCertificate is the annotation key on layers in cosign signature manifests which contains the
+	// PEM-encoded signing certificate of keyless signatures.
+	AnnotationKeyCertificate = "dev.sigstore.cosign/certificate"
+	// AnnotationKeyBundle is the annotation key on layers in cosign signature manifests which contains the transparency
+	// log entry of the signature.
+	AnnotationKeyBundle = "dev.sigstore.cosign/bundle"
+
+	payloadTypeCosignSignature = "cosign container image signature"
+)
+
+// SignatureTag returns the tag under which cosign stores the signatures for the image manifest with the given digest.
+func SignatureTag(manifestDigest digest.Digest) string {
+	return strings.Replace(manifestDigest.String(), ":", "-", 1) + ".sig"
+}
+
+// ParsePublicKeys parses the given PEM-encoded public keys.
+func ParsePublicKeys(publicKeysPEM []string) ([]crypto.PublicKey, error) {
+	publicKeys := make([]crypto.PublicKey, 0, len(publicKeysPEM))
+
+	for i, publicKeyPEM := range publicKeysPEM {
+		block, _ := pem.Decode([]byte(publicKeyPEM))
+		if block == nil {
+			return nil, fmt.Errorf("public key at index %d is not PEM-encoded", i)
+		}
+
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing public key at index %d: %w", i, err)
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return publicKeys, nil
+}
+
+// simpleSigningPayload is the payload signed by cosign, see
+// https://github.com/containers/image/blob/main/docs/containers-signature.5.md.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// VerifyPayload verifies that the given signature of the given cosign payload was created by any of the given public
+// keys and that the payload refers to the image manifest with the given digest.
+func VerifyPayload(payload, signature []byte, manifestDigest digest.Digest, publicKeys []crypto.PublicKey) error {
+	if !slices.ContainsFunc(publicKeys, func(publicKey crypto.PublicKey) bool { return verifySignature(publicKey, payload, signature) }) {
+		return errors.New("signature was not created by any of the trusted public keys")
+	}
+
+	return verifySimpleSigningPayload(payload, manifestDigest)
+}
+
+// verifySimpleSigningPayload verifies that the given cosign payload refers to the image manifest with the given digest.
+func verifySimpleSigningPayload(payload []byte, manifestDigest digest.Digest) error {
+	var p simpleSigningPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("failed decoding signed payload: %w", err)
+	}
+
+	if p.Critical.Type != payloadTypeCosignSignature {
+		return fmt.Errorf("signed payload has unexpected type %q", p.Critical.Type)
+	}
+	if p.Critical.Image.DockerManifestDigest != manifestDigest.String() {
+		return fmt.Errorf("signed payload refers to digest %q instead of %q", p.Critical.Image.DockerManifestDigest, manifestDigest)
+	}
+
+	return nil
+}
+
+func verifySignature(publicKey crypto.PublicKey, payload, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, hash[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, payload, signature)
+	}
+
+	return false
+}
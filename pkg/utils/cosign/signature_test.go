@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cosign_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+
+	. "github.com/gardener/gardener/pkg/utils/cosign"
+)
+
+var _ = Describe("Signature", func() {
+	var (
+		manifestDigest = digest.FromString("manifest")
+		signingKey     *ecdsa.PrivateKey
+	)
+
+	BeforeEach(func() {
+		var err error
+		signingKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#SignatureTag", func() {
+		It("should return the tag of the signature manifest", func() {
+			Expect(SignatureTag(manifestDigest)).To(Equal("sha256-" + manifestDigest.Encoded() + ".sig"))
+		})
+	})
+
+	Describe("#ParsePublicKeys", func() {
+		It("should parse PEM-encoded public keys", func() {
+			publicKeys, err := ParsePublicKeys([]string{publicKeyPEM(signingKey.Public())})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(publicKeys).To(ConsistOf(signingKey.Public()))
+		})
+
+		It("should fail for invalid public keys", func() {
+			_, err := ParsePublicKeys([]string{"foo"})
+			Expect(err).To(MatchError("public key at index 0 is not PEM-encoded"))
+		})
+	})
+
+	Describe("#VerifyPayload", func() {
+		It("should succeed if the payload is signed by a trusted key", func() {
+			payload, signature := signPayload(signingKey, manifestDigest)
+			Expect(VerifyPayload(payload, signature, manifestDigest, []crypto.PublicKey{signingKey.Public()})).To(Succeed())
+		})
+
+		It("should fail if the payload is signed by an untrusted key", func() {
+			untrustedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			payload, signature := signPayload(untrustedKey, manifestDigest)
+
+			Expect(VerifyPayload(payload, signature, manifestDigest, []crypto.PublicKey{signingKey.Public()})).To(MatchError("signature was not created by any of the trusted public keys"))
+		})
+
+		It("should fail if the payload refers to another manifest", func() {
+			payload, signature := signPayload(signingKey, digest.FromString("foo"))
+			Expect(VerifyPayload(payload, signature, manifestDigest, []crypto.PublicKey{signingKey.Public()})).To(MatchError(ContainSubstring("signed payload refers to digest")))
+		})
+	})
+})
+
+func publicKeyPEM(publicKey crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	Expect(err).NotTo(HaveOccurred())
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func signPayload(signer crypto.Signer, manifestDigest digest.Digest) ([]byte, []byte) {
+	payload := fmt.Appendf(nil, `{"critical":{"identity":{"docker-reference":"example.com/foo"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, manifestDigest)
+	return payload, sign(signer, payload)
+}
+
+func sign(signer crypto.Signer, data []byte) []byte {
+	hash := sha256.Sum256(data)
+	signature, err := signer.Sign(rand.Reader, hash[:], crypto.SHA256)
+	Expect(err).NotTo(HaveOccurred())
+	return signature
+}
@@ -5,6 +5,9 @@
 package utils
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
@@ -12,6 +15,7 @@ import (
 	"encoding/hex"
 	"encoding/pem"
 	"errors"
+	"fmt"
 	"slices"
 	"strconv"
 	"strings"
@@ -79,6 +83,80 @@ func DecodePrivateKey(bytes []byte) (*rsa.PrivateKey, error) {
 	return x509.ParsePKCS1PrivateKey(block.Bytes)
 }
 
+// EncodeAnyPrivateKey takes a RSA, ECDSA or Ed25519 private key object, encodes it to the PEM format, and returns it
+// as a byte slice. RSA keys are encoded in PKCS1 format and ECDSA keys in SEC1 format since these are the formats
+// understood by all Kubernetes components. Ed25519 keys are encoded in PKCS8 format.
+func EncodeAnyPrivateKey(key crypto.Signer) ([]byte, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return EncodePrivateKey(k), nil
+	case *ecdsa.PrivateKey:
+		bytes, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: bytes}), nil
+	case ed25519.PrivateKey:
+		return encodePKCS8PrivateKey(k)
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+// EncodeAnyPrivateKeyInPKCS8 takes a RSA, ECDSA or Ed25519 private key object, encodes it to the PKCS8 format, and
+// returns it as a byte slice. For backwards-compatibility, RSA keys are encoded the same way as by
+// EncodePrivateKeyInPKCS8.
+func EncodeAnyPrivateKeyInPKCS8(key crypto.Signer) ([]byte, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return EncodePrivateKeyInPKCS8(k)
+	case *ecdsa.PrivateKey, ed25519.PrivateKey:
+		return encodePKCS8PrivateKey(k)
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+func encodePKCS8PrivateKey(key crypto.Signer) ([]byte, error) {
+	bytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: bytes}), nil
+}
+
+// DecodeAnyPrivateKey takes a byte slice, decodes it from the PEM format, converts it to a RSA, ECDSA or Ed25519
+// private key object, and returns it. It supports the formats produced by EncodeAnyPrivateKey and
+// EncodeAnyPrivateKeyInPKCS8. In case an error occurs, it returns the error.
+func DecodeAnyPrivateKey(bytes []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(bytes)
+	if block == nil {
+		return nil, errors.New("could not decode the PEM-encoded private key")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return key, nil
+		}
+		return DecodeRSAPrivateKeyFromPKCS8(bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q for private key", block.Type)
+	}
+}
+
 // EncodeCertificate takes a certificate as a byte slice, encodes it to the PEM format, and returns
 // it as byte slice.
 func EncodeCertificate(certificate []byte) []byte {
@@ -5,6 +5,12 @@
 package utils_test
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
 	"errors"
 	"strings"
@@ -18,6 +24,59 @@ import (
 )
 
 var _ = Describe("Encoding", func() {
+	Describe("#EncodeAnyPrivateKey, #EncodeAnyPrivateKeyInPKCS8, #DecodeAnyPrivateKey", func() {
+		var (
+			rsaKey     *rsa.PrivateKey
+			ecdsaKey   *ecdsa.PrivateKey
+			ed25519Key ed25519.PrivateKey
+		)
+
+		BeforeEach(func() {
+			var err error
+			rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			ecdsaKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			_, ed25519Key, err = ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		DescribeTable("should encode and decode the private key",
+			func(getKey func() crypto.Signer, encode func(crypto.Signer) ([]byte, error), blockType string) {
+				key := getKey()
+
+				data, err := encode(key)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(data)).To(HavePrefix("-----BEGIN " + blockType + "-----"))
+
+				decoded, err := DecodeAnyPrivateKey(data)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decoded).To(Equal(key))
+			},
+
+			Entry("RSA", func() crypto.Signer { return rsaKey }, EncodeAnyPrivateKey, "RSA PRIVATE KEY"),
+			Entry("RSA in PKCS8", func() crypto.Signer { return rsaKey }, EncodeAnyPrivateKeyInPKCS8, "RSA PRIVATE KEY"),
+			Entry("ECDSA", func() crypto.Signer { return ecdsaKey }, EncodeAnyPrivateKey, "EC PRIVATE KEY"),
+			Entry("ECDSA in PKCS8", func() crypto.Signer { return ecdsaKey }, EncodeAnyPrivateKeyInPKCS8, "PRIVATE KEY"),
+			Entry("Ed25519", func() crypto.Signer { return ed25519Key }, EncodeAnyPrivateKey, "PRIVATE KEY"),
+			Entry("Ed25519 in PKCS8", func() crypto.Signer { return ed25519Key }, EncodeAnyPrivateKeyInPKCS8, "PRIVATE KEY"),
+		)
+
+		It("should keep the RSA encoding of EncodePrivateKey", func() {
+			Expect(EncodeAnyPrivateKey(rsaKey)).To(Equal(EncodePrivateKey(rsaKey)))
+		})
+
+		It("should fail decoding invalid data", func() {
+			_, err := DecodeAnyPrivateKey([]byte("foo"))
+			Expect(err).To(MatchError("could not decode the PEM-encoded private key"))
+		})
+
+		It("should fail decoding unsupported PEM blocks", func() {
+			_, err := DecodeAnyPrivateKey(EncodeCertificate([]byte("foo")))
+			Expect(err).To(MatchError(`unsupported PEM block type "CERTIFICATE" for private key`))
+		})
+	})
+
 	Describe("#CreateBcryptCredentials", func() {
 		It("should create the expected credentials", func() {
 			credentials, err := CreateBcryptCredentials([]byte("username"), []byte("password"))
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Checkpoint contains the tasks of a flow which succeeded in a previous execution.
+type Checkpoint struct {
+	// Hash is the hash of the flow's graph and inputs the checkpoint was recorded for.
+	Hash string `json:"hash"`
+	// CompletedTaskIDs are the IDs of the tasks which succeeded.
+	CompletedTaskIDs []TaskID `json:"completedTaskIDs"`
+}
+
+// CheckpointStore persists the checkpoints of flow executions.
+type CheckpointStore interface {
+	// Load returns the checkpoint of the flow with the given name. It returns nil if there is no checkpoint.
+	Load(ctx context.Context, flowName string) (*Checkpoint, error)
+	// Save persists the checkpoint of the flow with the given name.
+	Save(ctx context.Context, flowName string, checkpoint *Checkpoint) error
+	// Delete removes the checkpoint of the flow with the given name.
+	Delete(ctx context.Context, flowName string) error
+}
+
+// checkpointHash computes a hash of the flow's graph and the given input hash. Checkpoints are only used if this hash
+// did not change since they were recorded, i.e., if neither the tasks and their dependencies nor the inputs changed.
+func (f *Flow) checkpointHash(inputHash string) string {
+	hash := sha256.New()
+	for _, id := range f.allTaskIDs().List() {
+		_, _ = fmt.Fprintf(hash, "%s->%s;", id, strings.Join(f.nodes[id].targetIDs.StringList(), ","))
+	}
+	_, _ = fmt.Fprintf(hash, "input=%s", inputHash)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (f *Flow) allTaskIDs() TaskIDs {
+	all := NewTaskIDs()
+	for id := range f.nodes {
+		all.Insert(id)
+	}
+	return all
+}
+
+var invalidConfigMapKeyCharacters = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+
+// ConfigMapCheckpointStore is a CheckpointStore which persists checkpoints in a ConfigMap. The checkpoint of every
+// flow is stored in a separate data key, hence, multiple flows can share the same ConfigMap.
+type ConfigMapCheckpointStore struct {
+	// Client is used to read and write the ConfigMap.
+	Client client.Client
+	// Namespace is the namespace of the ConfigMap.
+	Namespace string
+	// Name is the name of the ConfigMap.
+	Name string
+}
+
+var _ CheckpointStore = &ConfigMapCheckpointStore{}
+
+func (s *ConfigMapCheckpointStore) key(flowName string) string {
+	return strings.Trim(invalidConfigMapKeyCharacters.ReplaceAllString(strings.ToLower(flowName), "-"), "-")
+}
+
+func (s *ConfigMapCheckpointStore) get(ctx context.Context) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: s.Name, Namespace: s.Namespace}}
+	if err := s.Client.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+		return configMap, err
+	}
+	return configMap, nil
+}
+
+// Load implements CheckpointStore.
+func (s *ConfigMapCheckpointStore) Load(ctx context.Context, flowName string) (*Checkpoint, error) {
+	configMap, err := s.get(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading checkpoint ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	data, ok := configMap.Data[s.key(flowName)]
+	if !ok {
+		return nil, nil
+	}
+
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal([]byte(data), checkpoint); err != nil {
+		return nil, fmt.Errorf("failed decoding checkpoint of flow %q: %w", flowName, err)
+	}
+	return checkpoint, nil
+}
+
+// Save implements CheckpointStore.
+func (s *ConfigMapCheckpointStore) Save(ctx context.Context, flowName string, checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("failed encoding checkpoint of flow %q: %w", flowName, err)
+	}
+
+	configMap, err := s.get(ctx)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading checkpoint ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+		}
+
+		configMap.Data = map[string]string{s.key(flowName): string(data)}
+		return s.Client.Create(ctx, configMap)
+	}
+
+	patch := client.MergeFromWithOptions(configMap.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	if configMap.Data == nil {
+		configMap.Data = make(map[string]string, 1)
+	}
+	configMap.Data[s.key(flowName)] = string(data)
+	return s.Client.Patch(ctx, configMap, patch)
+}
+
+// Delete implements CheckpointStore. The ConfigMap is deleted when it does not contain any checkpoint anymore.
+func (s *ConfigMapCheckpointStore) Delete(ctx context.Context, flowName string) error {
+	configMap, err := s.get(ctx)
+	if err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if _, ok := configMap.Data[s.key(flowName)]; !ok {
+		return nil
+	}
+
+	if len(configMap.Data) == 1 {
+		return client.IgnoreNotFound(s.Client.Delete(ctx, configMap, client.Preconditions{ResourceVersion: &configMap.ResourceVersion}))
+	}
+
+	patch := client.MergeFromWithOptions(configMap.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	delete(configMap.Data, s.key(flowName))
+	return s.Client.Patch(ctx, configMap, patch)
+}
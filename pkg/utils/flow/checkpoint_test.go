@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Checkpoint", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		store      *flow.ConfigMapCheckpointStore
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+		store = &flow.ConfigMapCheckpointStore{Client: fakeClient, Namespace: "shoot--foo--bar", Name: "flow-checkpoints"}
+	})
+
+	Describe("#Run", func() {
+		var (
+			list    *AtomicStringList
+			failY   bool
+			newFlow func(extraTask bool) *flow.Flow
+		)
+
+		BeforeEach(func() {
+			list = NewAtomicStringList()
+			failY = true
+
+			newFlow = func(extraTask bool) *flow.Flow {
+				var (
+					g = flow.NewGraph("Shoot cluster reconciliation")
+					x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error {
+						list.Append("x")
+						return nil
+					}})
+					y = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error {
+						list.Append("y")
+						if failY {
+							return errors.New("y failed")
+						}
+						return nil
+					}, Dependencies: flow.NewTaskIDs(x)})
+					_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error {
+						list.Append("z")
+						return nil
+					}, Dependencies: flow.NewTaskIDs(y)})
+				)
+
+				if extraTask {
+					g.Add(flow.Task{Name: "extra", Fn: func(_ context.Context) error {
+						list.Append("extra")
+						return nil
+					}, Dependencies: flow.NewTaskIDs(x)})
+				}
+
+				return g.Compile()
+			}
+		})
+
+		It("should resume from the failed task and delete the checkpoint when the flow succeeded", func() {
+			Expect(newFlow(false).Run(ctx, flow.Opts{CheckpointStore: store, CheckpointInputHash: "spec-1"})).NotTo(Succeed())
+			Expect(list.Values()).To(Equal([]string{"x", "y"}))
+
+			checkpoint, err := store.Load(ctx, "Shoot cluster reconciliation")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkpoint.CompletedTaskIDs).To(ConsistOf(flow.TaskID("x")))
+
+			failY = false
+			Expect(newFlow(false).Run(ctx, flow.Opts{CheckpointStore: store, CheckpointInputHash: "spec-1"})).To(Succeed())
+			Expect(list.Values()).To(Equal([]string{"x", "y", "y", "z"}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: store.Namespace, Name: store.Name}, &corev1.ConfigMap{})).To(BeNotFoundError())
+		})
+
+		It("should discard the checkpoint if the inputs of the flow changed", func() {
+			Expect(newFlow(false).Run(ctx, flow.Opts{CheckpointStore: store, CheckpointInputHash: "spec-1"})).NotTo(Succeed())
+
+			failY = false
+			Expect(newFlow(false).Run(ctx, flow.Opts{CheckpointStore: store, CheckpointInputHash: "spec-2"})).To(Succeed())
+			Expect(list.Values()).To(Equal([]string{"x", "y", "x", "y", "z"}))
+		})
+
+		It("should discard the checkpoint if the tasks of the flow changed", func() {
+			Expect(newFlow(false).Run(ctx, flow.Opts{CheckpointStore: store, CheckpointInputHash: "spec-1"})).NotTo(Succeed())
+
+			failY = false
+			Expect(newFlow(true).Run(ctx, flow.Opts{CheckpointStore: store, CheckpointInputHash: "spec-1"})).To(Succeed())
+			Expect(list.Values()[2]).To(Equal("x"))
+			Expect(list.Values()).To(HaveLen(6))
+		})
+
+		It("should run tasks which must always run even if they succeeded in a previous execution", func() {
+			newAlwaysRunFlow := func() *flow.Flow {
+				g := flow.NewGraph("Shoot cluster reconciliation")
+				initialize := g.Add(flow.Task{Name: "initialize", Fn: func(_ context.Context) error {
+					list.Append("initialize")
+					return nil
+				}, AlwaysRun: true})
+				_ = g.Add(flow.Task{Name: "deploy", Fn: func(_ context.Context) error {
+					list.Append("deploy")
+					if failY {
+						return errors.New("deploy failed")
+					}
+					return nil
+				}, Dependencies: flow.NewTaskIDs(initialize)})
+				return g.Compile()
+			}
+
+			Expect(newAlwaysRunFlow().Run(ctx, flow.Opts{CheckpointStore: store, CheckpointInputHash: "spec-1"})).NotTo(Succeed())
+
+			failY = false
+			var execution *flow.Execution
+			Expect(newAlwaysRunFlow().Run(ctx, flow.Opts{CheckpointStore: store, CheckpointInputHash: "spec-1", ExecutionRecorder: func(e *flow.Execution) { execution = e }})).To(Succeed())
+			Expect(list.Values()).To(Equal([]string{"initialize", "deploy", "initialize", "deploy"}))
+			Expect(execution.Resumed()).To(BeFalse())
+		})
+
+		It("should report that the execution was resumed", func() {
+			Expect(newFlow(false).Run(ctx, flow.Opts{CheckpointStore: store, CheckpointInputHash: "spec-1"})).NotTo(Succeed())
+
+			failY = false
+			var execution *flow.Execution
+			Expect(newFlow(false).Run(ctx, flow.Opts{CheckpointStore: store, CheckpointInputHash: "spec-1", ExecutionRecorder: func(e *flow.Execution) { execution = e }})).To(Succeed())
+			Expect(execution.Resumed()).To(BeTrue())
+		})
+
+		It("should batch the writes of the checkpoint", func() {
+			countingStore := &countingCheckpointStore{CheckpointStore: store}
+
+			Expect(newFlow(false).Run(ctx, flow.Opts{CheckpointStore: countingStore, CheckpointInputHash: "spec-1"})).NotTo(Succeed())
+			Expect(countingStore.saves).To(Equal(1))
+
+			checkpoint, err := store.Load(ctx, "Shoot cluster reconciliation")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkpoint.CompletedTaskIDs).To(ConsistOf(flow.TaskID("x")))
+		})
+
+		It("should write the checkpoint after every task if the interval is zero", func() {
+			countingStore := &countingCheckpointStore{CheckpointStore: store}
+
+			failY = false
+			Expect(newFlow(false).Run(ctx, flow.Opts{CheckpointStore: countingStore, CheckpointInputHash: "spec-1", CheckpointInterval: ptr.To(time.Duration(0))})).To(Succeed())
+			Expect(countingStore.saves).To(Equal(3))
+		})
+
+		It("should write the checkpoint if the flow was canceled", func() {
+			cancelCtx, cancel := context.WithCancel(ctx)
+
+			g := flow.NewGraph("Shoot cluster reconciliation")
+			x := g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+			_ = g.Add(flow.Task{Name: "y", Fn: func(ctx context.Context) error {
+				cancel()
+				<-ctx.Done()
+				return ctx.Err()
+			}, Dependencies: flow.NewTaskIDs(x)})
+
+			Expect(g.Compile().Run(cancelCtx, flow.Opts{CheckpointStore: store, CheckpointInputHash: "spec-1"})).NotTo(Succeed())
+
+			checkpoint, err := store.Load(ctx, "Shoot cluster reconciliation")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkpoint.CompletedTaskIDs).To(ConsistOf(flow.TaskID("x")))
+		})
+
+		It("should not persist anything without checkpoint store", func() {
+			Expect(newFlow(false).Run(ctx, flow.Opts{})).NotTo(Succeed())
+
+			failY = false
+			Expect(newFlow(false).Run(ctx, flow.Opts{})).To(Succeed())
+			Expect(list.Values()).To(Equal([]string{"x", "y", "x", "y", "z"}))
+		})
+	})
+
+	Describe("ConfigMapCheckpointStore", func() {
+		It("should return nil if there is no checkpoint", func() {
+			Expect(store.Load(ctx, "foo")).To(BeNil())
+		})
+
+		It("should store the checkpoints of multiple flows in the same ConfigMap", func() {
+			checkpoint1 := &flow.Checkpoint{Hash: "1", CompletedTaskIDs: []flow.TaskID{"a"}}
+			checkpoint2 := &flow.Checkpoint{Hash: "2", CompletedTaskIDs: []flow.TaskID{"b", "c"}}
+
+			Expect(store.Save(ctx, "Shoot cluster reconciliation", checkpoint1)).To(Succeed())
+			Expect(store.Save(ctx, "Shoot cluster deletion", checkpoint2)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: store.Namespace, Name: store.Name}, configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKey("shoot-cluster-reconciliation"))
+			Expect(configMap.Data).To(HaveKey("shoot-cluster-deletion"))
+
+			Expect(store.Load(ctx, "Shoot cluster reconciliation")).To(Equal(checkpoint1))
+			Expect(store.Load(ctx, "Shoot cluster deletion")).To(Equal(checkpoint2))
+
+			Expect(store.Delete(ctx, "Shoot cluster reconciliation")).To(Succeed())
+			Expect(store.Load(ctx, "Shoot cluster reconciliation")).To(BeNil())
+			Expect(store.Load(ctx, "Shoot cluster deletion")).To(Equal(checkpoint2))
+
+			Expect(store.Delete(ctx, "Shoot cluster deletion")).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: store.Namespace, Name: store.Name}, configMap)).To(BeNotFoundError())
+		})
+
+		It("should not fail deleting a non-existing checkpoint", func() {
+			Expect(store.Delete(ctx, "foo")).To(Succeed())
+		})
+	})
+})
+
+type countingCheckpointStore struct {
+	flow.CheckpointStore
+	saves int
+}
+
+func (s *countingCheckpointStore) Save(ctx context.Context, flowName string, checkpoint *flow.Checkpoint) error {
+	s.saves++
+	return s.CheckpointStore.Save(ctx, flowName, checkpoint)
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DebugHandlerPath is the HTTP handler path for this debug handler.
+const DebugHandlerPath = "/debug/flows"
+
+// ExecutionStore keeps the last execution of flows, e.g., to render them in the debug handler.
+type ExecutionStore struct {
+	lock       sync.RWMutex
+	executions map[string]*Execution
+}
+
+// NewExecutionStore creates a new ExecutionStore.
+func NewExecutionStore() *ExecutionStore {
+	return &ExecutionStore{executions: make(map[string]*Execution)}
+}
+
+// Recorder returns a function which can be used as Opts.ExecutionRecorder. It stores the execution under the given key
+// and replaces any execution previously stored under this key.
+func (s *ExecutionStore) Recorder(key string) func(*Execution) {
+	return func(execution *Execution) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.executions[key] = execution
+	}
+}
+
+// Get returns the execution stored under the given key. It returns nil if there is no such execution.
+func (s *ExecutionStore) Get(key string) *Execution {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.executions[key]
+}
+
+// Delete removes the execution stored under the given key.
+func (s *ExecutionStore) Delete(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.executions, key)
+}
+
+// Keys returns the sorted keys of all stored executions.
+func (s *ExecutionStore) Keys() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	keys := make([]string, 0, len(s.executions))
+	for key := range s.executions {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+type debugHandler struct {
+	store *ExecutionStore
+}
+
+// NewDebugHandler creates a new HTTP handler for debugging the last executions of flows. Without query parameters, it
+// lists all stored executions. With the 'key' and 'format' ('dot' or 'mermaid') query parameters, it renders the
+// execution stored under the given key.
+func NewDebugHandler(store *ExecutionStore) http.HandlerFunc {
+	return (&debugHandler{store}).Handle
+}
+
+func (h *debugHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var (
+		key    = r.URL.Query().Get("key")
+		format = r.URL.Query().Get("format")
+	)
+
+	if key == "" {
+		h.list(w)
+		return
+	}
+
+	execution := h.store.Get(key)
+	if execution == nil {
+		http.Error(w, fmt.Sprintf("no flow execution found for key %q", key), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	switch format {
+	case "", "dot":
+		fmt.Fprint(w, execution.DOT())
+	case "mermaid":
+		fmt.Fprint(w, execution.Mermaid())
+	default:
+		http.Error(w, fmt.Sprintf("unsupported format %q, must be one of [dot mermaid]", format), http.StatusBadRequest)
+	}
+}
+
+func (h *debugHandler) list(w http.ResponseWriter) {
+	var out strings.Builder
+
+	for _, key := range h.store.Keys() {
+		execution := h.store.Get(key)
+		if execution == nil {
+			continue
+		}
+
+		path := fmt.Sprintf("%s?key=%s", DebugHandlerPath, url.QueryEscape(key))
+		fmt.Fprintf(&out, `%s: %s (started %s, took %s) <a href="%s&format=dot">dot</a> <a href="%s&format=mermaid">mermaid</a><br />`,
+			html.EscapeString(key),
+			html.EscapeString(execution.FlowName),
+			execution.Start.UTC().Format(time.RFC3339),
+			execution.Duration.Round(time.Millisecond),
+			path,
+			path,
+		)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, `<font size="2" face="Courier New">`+out.String()+`</font>`)
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Debug", func() {
+	var (
+		store   *flow.ExecutionStore
+		handler http.HandlerFunc
+
+		serve = func(target string) *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			handler(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+			return recorder
+		}
+	)
+
+	BeforeEach(func() {
+		store = flow.NewExecutionStore()
+		handler = flow.NewDebugHandler(store)
+
+		store.Recorder("garden-foo/bar")(&flow.Execution{
+			FlowName: "Shoot cluster reconciliation",
+			Tasks:    map[flow.TaskID]*flow.TaskExecution{"x": {State: flow.TaskStateSucceeded}},
+		})
+	})
+
+	Describe("ExecutionStore", func() {
+		It("should replace and delete executions", func() {
+			execution := &flow.Execution{FlowName: "Shoot cluster deletion"}
+			store.Recorder("garden-foo/bar")(execution)
+			Expect(store.Get("garden-foo/bar")).To(BeIdenticalTo(execution))
+			Expect(store.Keys()).To(ConsistOf("garden-foo/bar"))
+
+			store.Delete("garden-foo/bar")
+			Expect(store.Get("garden-foo/bar")).To(BeNil())
+			Expect(store.Keys()).To(BeEmpty())
+		})
+	})
+
+	Describe("#NewDebugHandler", func() {
+		It("should list all executions", func() {
+			response := serve(flow.DebugHandlerPath)
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(response.Body.String()).To(ContainSubstring(`garden-foo/bar: Shoot cluster reconciliation`))
+			Expect(response.Body.String()).To(ContainSubstring(`<a href="/debug/flows?key=garden-foo%2Fbar&format=mermaid">mermaid</a>`))
+		})
+
+		It("should render the execution as DOT", func() {
+			response := serve(flow.DebugHandlerPath + "?key=garden-foo%2Fbar&format=dot")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(response.Body.String()).To(HavePrefix(`digraph "Shoot cluster reconciliation" {`))
+		})
+
+		It("should render the execution as Mermaid", func() {
+			response := serve(flow.DebugHandlerPath + "?key=garden-foo%2Fbar&format=mermaid")
+			Expect(response.Code).To(Equal(http.StatusOK))
+			Expect(response.Body.String()).To(ContainSubstring("flowchart LR"))
+		})
+
+		It("should fail for unknown keys and formats", func() {
+			Expect(serve(flow.DebugHandlerPath + "?key=foo").Code).To(Equal(http.StatusNotFound))
+			Expect(serve(flow.DebugHandlerPath + "?key=garden-foo%2Fbar&format=svg").Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -0,0 +1,307 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TaskState is the state of a task in a flow execution.
+type TaskState string
+
+const (
+	// TaskStatePending is the state of tasks which did not run.
+	TaskStatePending TaskState = "Pending"
+	// TaskStateSucceeded is the state of tasks which succeeded.
+	TaskStateSucceeded TaskState = "Succeeded"
+	// TaskStateFailed is the state of tasks which failed.
+	TaskStateFailed TaskState = "Failed"
+	// TaskStateSkipped is the state of tasks which were skipped.
+	TaskStateSkipped TaskState = "Skipped"
+	// TaskStateRestored is the state of tasks which were not run since they succeeded in a previous execution.
+	TaskStateRestored TaskState = "Restored"
+)
+
+// TaskExecution is the record of a task in a flow execution.
+type TaskExecution struct {
+	// Dependencies are the IDs of the tasks this task depends on.
+	Dependencies TaskIDSlice
+	// State is the state of the task.
+	State TaskState
+	// Delay is the duration from the start of the flow until the task was started.
+	Delay time.Duration
+	// Duration is the duration of the task, including all retries.
+	Duration time.Duration
+	// Retries is the number of retries of the task.
+	Retries int
+}
+
+// end returns the duration from the start of the flow until the task finished.
+func (t *TaskExecution) end() time.Duration {
+	return t.Delay + t.Duration
+}
+
+// Execution is the record of a flow execution. It can be rendered as Graphviz DOT or Mermaid flowchart.
+type Execution struct {
+	// FlowName is the name of the flow.
+	FlowName string
+	// Start is the time when the flow was started.
+	Start time.Time
+	// Duration is the total duration of the flow.
+	Duration time.Duration
+	// Tasks contains the records of all tasks of the flow.
+	Tasks map[TaskID]*TaskExecution
+}
+
+// Resumed returns whether tasks of the execution were restored from the checkpoint of a previous execution.
+func (e *Execution) Resumed() bool {
+	for _, task := range e.Tasks {
+		if task.State == TaskStateRestored {
+			return true
+		}
+	}
+	return false
+}
+
+// taskIDs returns the sorted IDs of all tasks of the execution.
+func (e *Execution) taskIDs() TaskIDSlice {
+	ids := NewTaskIDs()
+	for id := range e.Tasks {
+		ids.Insert(id)
+	}
+	return ids.List()
+}
+
+// CriticalPath returns the chain of tasks which determined the duration of the execution, starting with the first
+// task. It begins with the task which finished last and walks back along the dependencies which finished last.
+// It returns nil if the flow was not executed.
+func (e *Execution) CriticalPath() TaskIDSlice {
+	var (
+		path TaskIDSlice
+		last *TaskID
+	)
+
+	if e.Start.IsZero() {
+		return nil
+	}
+
+	latest := func(candidates TaskIDSlice) *TaskID {
+		var result *TaskID
+		for _, id := range candidates {
+			task, ok := e.Tasks[id]
+			if !ok || task.State == TaskStatePending {
+				continue
+			}
+			if result == nil || task.end() > e.Tasks[*result].end() {
+				result = &id
+			}
+		}
+		return result
+	}
+
+	for last = latest(e.taskIDs()); last != nil; last = latest(e.Tasks[*last].Dependencies) {
+		path = append(TaskIDSlice{*last}, path...)
+	}
+
+	return path
+}
+
+// DOT renders the execution as Graphviz DOT. Nodes are colored according to the state of their tasks, the critical
+// path is highlighted.
+func (e *Execution) DOT() string {
+	var (
+		out          strings.Builder
+		criticalPath = e.criticalPathEdges()
+	)
+
+	fmt.Fprintf(&out, "digraph %s {\n", dotQuote(e.FlowName))
+	out.WriteString("  rankdir=LR;\n  node [shape=box, style=filled];\n")
+
+	for _, id := range e.taskIDs() {
+		task := e.Tasks[id]
+		attributes := fmt.Sprintf("label=%s, fillcolor=%q", dotQuote(e.label(id, "\n")), dotColors[task.State])
+		if criticalPath.nodes.Has(id) {
+			attributes += `, color="red", penwidth=2`
+		}
+		fmt.Fprintf(&out, "  %s [%s];\n", dotQuote(string(id)), attributes)
+	}
+
+	for _, id := range e.taskIDs() {
+		for _, dependency := range e.Tasks[id].Dependencies {
+			var attributes string
+			if criticalPath.has(dependency, id) {
+				attributes = ` [color="red", penwidth=2]`
+			}
+			fmt.Fprintf(&out, "  %s -> %s%s;\n", dotQuote(string(dependency)), dotQuote(string(id)), attributes)
+		}
+	}
+
+	out.WriteString("}\n")
+	return out.String()
+}
+
+// Mermaid renders the execution as Mermaid flowchart. Nodes are styled according to the state of their tasks, the
+// critical path is highlighted.
+func (e *Execution) Mermaid() string {
+	var (
+		out          strings.Builder
+		criticalPath = e.criticalPathEdges()
+		ids          = e.taskIDs()
+		mermaidIDs   = make(map[TaskID]string, len(ids))
+		edge         int
+	)
+
+	for i, id := range ids {
+		mermaidIDs[id] = fmt.Sprintf("t%d", i)
+	}
+
+	fmt.Fprintf(&out, "---\ntitle: %s\n---\nflowchart LR\n", mermaidEscape(e.FlowName))
+	for _, id := range ids {
+		fmt.Fprintf(&out, "  %s[\"%s\"]:::%s\n", mermaidIDs[id], mermaidEscape(e.label(id, "<br/>")), strings.ToLower(string(e.Tasks[id].State)))
+	}
+
+	var criticalEdges []string
+	for _, id := range ids {
+		for _, dependency := range e.Tasks[id].Dependencies {
+			if _, ok := mermaidIDs[dependency]; !ok {
+				continue
+			}
+			fmt.Fprintf(&out, "  %s --> %s\n", mermaidIDs[dependency], mermaidIDs[id])
+			if criticalPath.has(dependency, id) {
+				criticalEdges = append(criticalEdges, fmt.Sprintf("%d", edge))
+			}
+			edge++
+		}
+	}
+
+	for _, state := range []TaskState{TaskStatePending, TaskStateSucceeded, TaskStateFailed, TaskStateSkipped, TaskStateRestored} {
+		fmt.Fprintf(&out, "  classDef %s fill:%s\n", strings.ToLower(string(state)), mermaidColors[state])
+	}
+	for _, id := range criticalPath.nodes.List() {
+		fmt.Fprintf(&out, "  style %s stroke:#ff0000,stroke-width:3px\n", mermaidIDs[id])
+	}
+	if len(criticalEdges) > 0 {
+		fmt.Fprintf(&out, "  linkStyle %s stroke:#ff0000,stroke-width:3px\n", strings.Join(criticalEdges, ","))
+	}
+
+	return out.String()
+}
+
+func (e *Execution) label(id TaskID, separator string) string {
+	task := e.Tasks[id]
+	if task.State != TaskStateSucceeded && task.State != TaskStateFailed {
+		return string(id)
+	}
+
+	label := fmt.Sprintf("%s%s%s", id, separator, task.Duration.Round(time.Millisecond))
+	if task.Retries > 0 {
+		label += fmt.Sprintf(" (%d retries)", task.Retries)
+	}
+	return label
+}
+
+type criticalPathEdges struct {
+	nodes TaskIDs
+	next  map[TaskID]TaskID
+}
+
+func (c criticalPathEdges) has(from, to TaskID) bool {
+	next, ok := c.next[from]
+	return ok && next == to
+}
+
+func (e *Execution) criticalPathEdges() criticalPathEdges {
+	path := e.CriticalPath()
+	edges := criticalPathEdges{nodes: NewTaskIDs(path), next: make(map[TaskID]TaskID, len(path))}
+	for i := 0; i < len(path)-1; i++ {
+		edges.next[path[i]] = path[i+1]
+	}
+	return edges
+}
+
+var (
+	dotColors = map[TaskState]string{
+		TaskStatePending:   "white",
+		TaskStateSucceeded: "palegreen",
+		TaskStateFailed:    "salmon",
+		TaskStateSkipped:   "lightgrey",
+		TaskStateRestored:  "lightblue",
+	}
+	mermaidColors = map[TaskState]string{
+		TaskStatePending:   "#ffffff",
+		TaskStateSucceeded: "#98fb98",
+		TaskStateFailed:    "#fa8072",
+		TaskStateSkipped:   "#d3d3d3",
+		TaskStateRestored:  "#add8e6",
+	}
+)
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;").Replace(s)
+}
+
+// execution returns the record of the last execution of the flow. If the flow was not run yet, all tasks are pending
+// (or skipped).
+func (f *Flow) execution() *Execution {
+	if f.lastExecution != nil {
+		return f.lastExecution
+	}
+	return f.newExecutionRecord()
+}
+
+func (f *Flow) newExecutionRecord() *Execution {
+	execution := &Execution{FlowName: f.name, Tasks: make(map[TaskID]*TaskExecution, len(f.nodes))}
+	for id, node := range f.nodes {
+		state := TaskStatePending
+		if node.skip {
+			state = TaskStateSkipped
+		}
+		execution.Tasks[id] = &TaskExecution{Dependencies: f.dependencies(id), State: state}
+	}
+	return execution
+}
+
+// dependencies returns the sorted IDs of the tasks the given task depends on.
+func (f *Flow) dependencies(id TaskID) TaskIDSlice {
+	dependencies := NewTaskIDs()
+	for dependencyID, node := range f.nodes {
+		if node.targetIDs.Has(id) {
+			dependencies.Insert(dependencyID)
+		}
+	}
+	return dependencies.List()
+}
+
+// LastExecution returns the record of the last execution of the flow. It returns nil if the flow was not run yet.
+func (f *Flow) LastExecution() *Execution {
+	return f.lastExecution
+}
+
+// DOT renders the flow as Graphviz DOT. If the flow was already run, the timings of the last execution are included.
+func (f *Flow) DOT() string {
+	return f.execution().DOT()
+}
+
+// Mermaid renders the flow as Mermaid flowchart. If the flow was already run, the timings of the last execution are
+// included.
+func (f *Flow) Mermaid() string {
+	return f.execution().Mermaid()
+}
+
+// DOT renders the graph as Graphviz DOT.
+func (g *Graph) DOT() string {
+	return g.Compile().DOT()
+}
+
+// Mermaid renders the graph as Mermaid flowchart.
+func (g *Graph) Mermaid() string {
+	return g.Compile().Mermaid()
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Export", func() {
+	var execution *flow.Execution
+
+	BeforeEach(func() {
+		execution = &flow.Execution{
+			FlowName: "Shoot cluster reconciliation",
+			Start:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			Tasks: map[flow.TaskID]*flow.TaskExecution{
+				"a": {State: flow.TaskStateSucceeded, Duration: time.Second},
+				"b": {State: flow.TaskStateSucceeded, Dependencies: flow.TaskIDSlice{"a"}, Delay: time.Second, Duration: 5 * time.Second, Retries: 2},
+				"c": {State: flow.TaskStateSucceeded, Dependencies: flow.TaskIDSlice{"a"}, Delay: time.Second, Duration: time.Second},
+				"d": {State: flow.TaskStateFailed, Dependencies: flow.TaskIDSlice{"b", "c"}, Delay: 6 * time.Second, Duration: time.Second},
+				"e": {State: flow.TaskStatePending, Dependencies: flow.TaskIDSlice{"d"}},
+			},
+		}
+	})
+
+	Describe("#CriticalPath", func() {
+		It("should return the chain of tasks which finished last", func() {
+			Expect(execution.CriticalPath()).To(Equal(flow.TaskIDSlice{"a", "b", "d"}))
+		})
+
+		It("should return nil if the flow was not executed", func() {
+			Expect((&flow.Execution{Tasks: map[flow.TaskID]*flow.TaskExecution{"a": {State: flow.TaskStateSkipped}}}).CriticalPath()).To(BeNil())
+		})
+	})
+
+	Describe("#DOT", func() {
+		It("should render the execution and highlight the critical path", func() {
+			Expect(execution.DOT()).To(Equal(`digraph "Shoot cluster reconciliation" {
+  rankdir=LR;
+  node [shape=box, style=filled];
+  "a" [label="a\n1s", fillcolor="palegreen", color="red", penwidth=2];
+  "b" [label="b\n5s (2 retries)", fillcolor="palegreen", color="red", penwidth=2];
+  "c" [label="c\n1s", fillcolor="palegreen"];
+  "d" [label="d\n1s", fillcolor="salmon", color="red", penwidth=2];
+  "e" [label="e", fillcolor="white"];
+  "a" -> "b" [color="red", penwidth=2];
+  "a" -> "c";
+  "b" -> "d" [color="red", penwidth=2];
+  "c" -> "d";
+  "d" -> "e";
+}
+`))
+		})
+	})
+
+	Describe("#Mermaid", func() {
+		It("should render the execution and highlight the critical path", func() {
+			Expect(execution.Mermaid()).To(Equal(`---
+title: Shoot cluster reconciliation
+---
+flowchart LR
+  t0["a<br/>1s"]:::succeeded
+  t1["b<br/>5s (2 retries)"]:::succeeded
+  t2["c<br/>1s"]:::succeeded
+  t3["d<br/>1s"]:::failed
+  t4["e"]:::pending
+  t0 --> t1
+  t0 --> t2
+  t1 --> t3
+  t2 --> t3
+  t3 --> t4
+  classDef pending fill:#ffffff
+  classDef succeeded fill:#98fb98
+  classDef failed fill:#fa8072
+  classDef skipped fill:#d3d3d3
+  classDef restored fill:#add8e6
+  style t0 stroke:#ff0000,stroke-width:3px
+  style t1 stroke:#ff0000,stroke-width:3px
+  style t3 stroke:#ff0000,stroke-width:3px
+  linkStyle 0,2 stroke:#ff0000,stroke-width:3px
+`))
+		})
+	})
+
+	Describe("Graph and Flow", func() {
+		var (
+			g *flow.Graph
+			x flow.TaskID
+		)
+
+		BeforeEach(func() {
+			g = flow.NewGraph("foo")
+			x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+			g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return errors.New("err") }, Dependencies: flow.NewTaskIDs(x)})
+			g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return nil }, SkipIf: true, Dependencies: flow.NewTaskIDs(x)})
+		})
+
+		It("should render the graph without timings", func() {
+			Expect(g.DOT()).To(ContainSubstring(`"x" [label="x", fillcolor="white"];`))
+			Expect(g.DOT()).To(ContainSubstring(`"z" [label="z", fillcolor="lightgrey"];`))
+			Expect(g.DOT()).To(ContainSubstring(`"x" -> "y";`))
+			Expect(g.Mermaid()).To(ContainSubstring(`t0 --> t1`))
+		})
+
+		It("should record the execution of the flow", func() {
+			var recorded *flow.Execution
+
+			f := g.Compile()
+			Expect(f.LastExecution()).To(BeNil())
+			Expect(f.Run(context.Background(), flow.Opts{ExecutionRecorder: func(e *flow.Execution) { recorded = e }})).NotTo(Succeed())
+
+			Expect(recorded).To(BeIdenticalTo(f.LastExecution()))
+			Expect(recorded.FlowName).To(Equal("foo"))
+			Expect(recorded.Tasks).To(HaveKeyWithValue(flow.TaskID("x"), HaveField("State", flow.TaskStateSucceeded)))
+			Expect(recorded.Tasks).To(HaveKeyWithValue(flow.TaskID("y"), HaveField("State", flow.TaskStateFailed)))
+			Expect(recorded.Tasks).To(HaveKeyWithValue(flow.TaskID("z"), HaveField("State", flow.TaskStateSkipped)))
+			Expect(recorded.Tasks["y"].Dependencies).To(Equal(flow.TaskIDSlice{"x"}))
+			Expect(f.DOT()).To(ContainSubstring(`fillcolor="salmon"`))
+		})
+	})
+})
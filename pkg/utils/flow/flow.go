@@ -9,11 +9,17 @@ package flow
 import (
 	"context"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gardener/gardener/pkg/utils"
@@ -23,6 +29,8 @@ import (
 const (
 	logKeyFlow = "flow"
 	logKeyTask = "task"
+
+	tracerName = "github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // ErrorCleaner is called when a task which errored during the previous reconciliation phase completes with success
@@ -40,6 +48,30 @@ func (ns nodes) rootIDs() TaskIDs {
 	return roots
 }
 
+// topologicalOrder returns the IDs of all nodes such that every node comes after all of its dependencies.
+func (ns nodes) topologicalOrder() TaskIDSlice {
+	var (
+		order    = make(TaskIDSlice, 0, len(ns))
+		required = make(map[TaskID]int, len(ns))
+		ready    = ns.rootIDs().List()
+	)
+
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		order = append(order, id)
+
+		for _, target := range ns[id].targetIDs.List() {
+			required[target]++
+			if required[target] == ns[target].required {
+				ready = append(ready, target)
+			}
+		}
+	}
+
+	return order
+}
+
 func (ns nodes) getOrCreate(id TaskID) *node {
 	n, ok := ns[id]
 	if !ok {
@@ -56,6 +88,8 @@ type Flow struct {
 
 	clock clock.Clock
 	start time.Time
+
+	lastExecution *Execution
 }
 
 // Name retrieves the name of a flow.
@@ -71,16 +105,28 @@ func (f *Flow) Len() int {
 // node is a compiled Task that contains the triggered Tasks, the
 // number of triggers the node itself requires and its payload function.
 type node struct {
-	targetIDs TaskIDs
-	required  int
-	fn        TaskFn
-	skip      bool
+	targetIDs   TaskIDs
+	required    int
+	fn          TaskFn
+	skip        bool
+	timeout     time.Duration
+	retryPolicy *RetryPolicy
+	compensate  TaskFn
+	alwaysRun   bool
 }
 
 func (n *node) String() string {
 	return fmt.Sprintf("node{targets=%s, required=%d}", n.targetIDs.List(), n.required)
 }
 
+// withTimeout applies the timeout of the node to the given function.
+func (n *node) withTimeout(fn TaskFn) TaskFn {
+	if n.timeout <= 0 {
+		return fn
+	}
+	return fn.Timeout(n.timeout)
+}
+
 // addTargets adds the given TaskIDs as targets to the node.
 func (n *node) addTargets(taskIDs ...TaskID) {
 	if n.targetIDs == nil {
@@ -102,7 +148,25 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
-}
+	// CheckpointStore is used to persist the IDs of the tasks which succeeded. If it is set, tasks which succeeded in a
+	// previous execution of the flow are not run again, i.e., the execution resumes from the tasks which failed or did
+	// not run. The checkpoint is deleted when the flow succeeds.
+	// Tasks which initialize in-memory state required by other tasks must set Task.AlwaysRun.
+	CheckpointStore CheckpointStore
+	// CheckpointInputHash is a hash of the inputs of the flow, e.g., the specification of the reconciled object. A stored
+	// checkpoint is discarded if it was recorded with another input hash or if the tasks of the flow changed.
+	CheckpointInputHash string
+	// CheckpointInterval is the minimum duration between two writes of the checkpoint while the flow is running. Tasks
+	// succeeding in between are batched into the next write. The checkpoint is always written when the flow finished
+	// unsuccessfully. Defaults to DefaultCheckpointInterval.
+	CheckpointInterval *time.Duration
+	// ExecutionRecorder is called with the record of the execution when the flow finished. The record can be used to
+	// render the executed flow, see Execution.
+	ExecutionRecorder func(*Execution)
+}
+
+// DefaultCheckpointInterval is the default minimum duration between two writes of the checkpoint of a running flow.
+const DefaultCheckpointInterval = 15 * time.Second
 
 // Run starts an execution of a Flow.
 // It blocks until the Flow has finished and returns the error, if any.
@@ -111,9 +175,11 @@ func (f *Flow) Run(ctx context.Context, opts Opts) error {
 }
 
 type nodeResult struct {
-	TaskID  TaskID
-	Error   error
-	skipped bool
+	TaskID   TaskID
+	Error    error
+	skipped  bool
+	restored bool
+	retries  int
 
 	delay    time.Duration
 	duration time.Duration
@@ -128,6 +194,10 @@ type Stats struct {
 	Running   TaskIDs
 	Skipped   TaskIDs
 	Pending   TaskIDs
+	// Retries contains the number of retries of the tasks which were retried.
+	Retries map[TaskID]int
+	// Compensated contains the tasks whose effects were undone by their compensation function after the flow failed.
+	Compensated TaskIDs
 }
 
 // ProgressPercent retrieves the progress of a Flow execution in percent.
@@ -139,13 +209,15 @@ func (s *Stats) ProgressPercent() int32 {
 // Copy deeply copies a Stats object.
 func (s *Stats) Copy() *Stats {
 	return &Stats{
-		s.FlowName,
-		s.All.Copy(),
-		s.Succeeded.Copy(),
-		s.Failed.Copy(),
-		s.Running.Copy(),
-		s.Skipped.Copy(),
-		s.Pending.Copy(),
+		FlowName:    s.FlowName,
+		All:         s.All.Copy(),
+		Succeeded:   s.Succeeded.Copy(),
+		Failed:      s.Failed.Copy(),
+		Running:     s.Running.Copy(),
+		Skipped:     s.Skipped.Copy(),
+		Pending:     s.Pending.Copy(),
+		Retries:     maps.Clone(s.Retries),
+		Compensated: s.Compensated.Copy(),
 	}
 }
 
@@ -153,13 +225,15 @@ func (s *Stats) Copy() *Stats {
 // The initial TaskIDs are added to all TaskIDs as well as to the pending ones.
 func InitialStats(flowName string, all TaskIDs) *Stats {
 	return &Stats{
-		flowName,
-		all,
-		NewTaskIDs(),
-		NewTaskIDs(),
-		NewTaskIDs(),
-		NewTaskIDs(),
-		all.Copy(),
+		FlowName:    flowName,
+		All:         all,
+		Succeeded:   NewTaskIDs(),
+		Failed:      NewTaskIDs(),
+		Running:     NewTaskIDs(),
+		Skipped:     NewTaskIDs(),
+		Pending:     all.Copy(),
+		Retries:     make(map[TaskID]int),
+		Compensated: NewTaskIDs(),
 	}
 }
 
@@ -177,30 +251,51 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		log = opts.Log.WithValues(logKeyFlow, flow.name)
 	}
 
-	return &execution{
-		flow,
-		InitialStats(flow.name, all),
-		nil,
-		log,
-		opts.ProgressReporter,
-		opts.ErrorCleaner,
-		opts.ErrorContext,
-		make(chan *nodeResult),
-		make(map[TaskID]int),
+	e := &execution{
+		flow:             flow,
+		stats:            InitialStats(flow.name, all),
+		log:              log,
+		progressReporter: opts.ProgressReporter,
+		errorCleaner:     opts.ErrorCleaner,
+		errorContext:     opts.ErrorContext,
+		checkpointStore:    opts.CheckpointStore,
+		checkpointInterval: ptr.Deref(opts.CheckpointInterval, DefaultCheckpointInterval),
+		record:           flow.newExecutionRecord(),
+		recorder:         opts.ExecutionRecorder,
+		restoredTaskIDs:  NewTaskIDs(),
+		done:             make(chan *nodeResult),
+		triggerCounts:    make(map[TaskID]int),
+	}
+
+	if e.checkpointStore != nil {
+		e.checkpointHash = flow.checkpointHash(opts.CheckpointInputHash)
 	}
+
+	return e
 }
 
 type execution struct {
 	flow *Flow
 
-	stats      *Stats
-	taskErrors []error
+	stats              *Stats
+	taskErrors         []error
+	compensationErrors []error
 
 	log              logr.Logger
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
 
+	checkpointStore    CheckpointStore
+	checkpointHash     string
+	checkpointInterval time.Duration
+	checkpointDirty    bool
+	checkpointSavedAt  time.Time
+	restoredTaskIDs    TaskIDs
+
+	record   *Execution
+	recorder func(*Execution)
+
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
 }
@@ -228,6 +323,16 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	e.stats.Pending.Delete(id)
 	e.stats.Running.Insert(id)
 
+	if e.restoredTaskIDs.Has(id) {
+		log.Info("Succeeded in previous execution, skipping")
+
+		go func() {
+			e.done <- &nodeResult{TaskID: id, Error: nil, restored: true, delay: taskStartDelay}
+		}()
+
+		return
+	}
+
 	go func() {
 		defer func() {
 			if r := recover(); r != nil {
@@ -239,25 +344,55 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 
 		start := e.flow.clock.Now().UTC()
 		log.V(1).Info("Started")
-		taskCtx := ctx
+		// The span is parented to the span in the given context, e.g., the span of the reconciliation running the flow.
+		taskCtx, span := otel.Tracer(tracerName).Start(ctx, string(id), trace.WithAttributes(
+			attribute.String("flow.name", e.flow.name),
+			attribute.String("flow.task", string(id)),
+		))
+		defer span.End()
 		if rr, ok := e.progressReporter.(RetryReporter); ok {
-			taskCtx = withRetryReporter(ctx, id, rr)
+			taskCtx = withRetryReporter(taskCtx, id, rr)
 		}
-		err := node.fn(taskCtx)
+		retries, err := e.runTaskFn(taskCtx, log, node)
 		duration := e.flow.clock.Now().UTC().Sub(start)
-		log.V(1).Info("Finished", "duration", duration)
+		log.V(1).Info("Finished", "duration", duration, "retries", retries)
+		span.SetAttributes(attribute.Int("flow.task.retries", retries))
 
 		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 			log.Error(err, "Error")
 			err = fmt.Errorf("task %q failed: %w", id, err)
 		} else {
 			log.Info("Succeeded")
 		}
 
-		e.done <- &nodeResult{TaskID: id, Error: err, delay: taskStartDelay, duration: duration}
+		e.done <- &nodeResult{TaskID: id, Error: err, retries: retries, delay: taskStartDelay, duration: duration}
 	}()
 }
 
+// runTaskFn runs the payload function of the given node. Every attempt is limited by the node's timeout, failed attempts
+// are retried according to the node's retry policy. It returns the number of retries and the error of the last attempt.
+func (e *execution) runTaskFn(ctx context.Context, log logr.Logger, node *node) (int, error) {
+	fn := node.withTimeout(node.fn)
+
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || !node.retryPolicy.shouldRetry(attempt) || ctx.Err() != nil {
+			return attempt - 1, err
+		}
+
+		log.V(1).Info("Attempt failed, retrying", "attempt", attempt, "error", err.Error())
+		ReportRetry(ctx, err)
+
+		select {
+		case <-ctx.Done():
+			return attempt - 1, err
+		case <-e.flow.clock.After(node.retryPolicy.Interval):
+		}
+	}
+}
+
 func (e *execution) updateSuccess(id TaskID) {
 	e.stats.Running.Delete(id)
 	e.stats.Succeeded.Insert(id)
@@ -302,6 +437,8 @@ func (e *execution) run(ctx context.Context) error {
 	}
 
 	e.log.Info("Starting")
+	e.restoreCheckpoint(ctx)
+	e.checkpointSavedAt = e.flow.start
 	e.reportProgress(ctx)
 
 	var (
@@ -319,17 +456,25 @@ func (e *execution) run(ctx context.Context) error {
 	for e.stats.Running.Len() > 0 || e.stats.Skipped.Len() > 0 {
 		result := <-e.done
 		e.reportTaskMetrics(result)
+		e.recordTask(result)
 		if result.skipped {
 			e.stats.Skipped.Delete(result.TaskID)
 			if cancelErr = ctx.Err(); cancelErr == nil {
 				e.processTriggers(ctx, result.TaskID)
 			}
 		} else {
+			if result.retries > 0 {
+				e.stats.Retries[result.TaskID] = result.retries
+			}
 			if result.Error != nil {
 				e.taskErrors = append(e.taskErrors, errorsutils.WithID(string(result.TaskID), result.Error))
 				e.updateFailure(result.TaskID)
 			} else {
 				e.updateSuccess(result.TaskID)
+				if !result.restored {
+					e.checkpointDirty = true
+					e.saveCheckpoint(ctx, false)
+				}
 				if e.errorContext != nil && e.errorContext.HasLastErrorWithID(string(result.TaskID)) {
 					e.cleanErrors(ctx, result.TaskID)
 				}
@@ -342,10 +487,155 @@ func (e *execution) run(ctx context.Context) error {
 		e.reportProgress(ctx)
 	}
 
+	if cancelErr == nil && len(e.taskErrors) > 0 {
+		e.compensate(ctx)
+	}
+
+	// After compensations ran, the checkpoint does not reflect the state of the system anymore.
+	if cancelErr == nil && (len(e.taskErrors) == 0 || e.stats.Compensated.Len() > 0 || len(e.compensationErrors) > 0) {
+		e.deleteCheckpoint(ctx)
+	} else {
+		e.saveCheckpoint(ctx, true)
+	}
+
+	e.finishRecord()
 	e.log.Info("Finished")
 	return e.result(cancelErr)
 }
 
+func (e *execution) recordTask(r *nodeResult) {
+	task := e.record.Tasks[r.TaskID]
+	task.Delay, task.Duration, task.Retries = r.delay, r.duration, r.retries
+
+	switch {
+	case r.skipped:
+		task.State = TaskStateSkipped
+	case r.restored:
+		task.State = TaskStateRestored
+	case r.Error != nil:
+		task.State = TaskStateFailed
+	default:
+		task.State = TaskStateSucceeded
+	}
+}
+
+func (e *execution) finishRecord() {
+	e.record.Start = e.flow.start
+	e.record.Duration = e.flow.clock.Now().UTC().Sub(e.flow.start.UTC())
+	e.flow.lastExecution = e.record
+
+	if e.recorder != nil {
+		e.recorder(e.record)
+	}
+}
+
+// compensate runs the compensation functions of all succeeded tasks in reverse dependency order, i.e., the effects of a
+// task are only undone after the effects of all tasks depending on it were undone. Failed compensations are recorded
+// but do not prevent the remaining compensations from running.
+func (e *execution) compensate(ctx context.Context) {
+	order := e.flow.nodes.topologicalOrder()
+
+	for i := len(order) - 1; i >= 0; i-- {
+		id := order[i]
+		node := e.flow.nodes[id]
+		if node.compensate == nil || !e.stats.Succeeded.Has(id) {
+			continue
+		}
+
+		log := e.log.WithValues(logKeyTask, id)
+		log.Info("Compensating")
+
+		if err := e.runCompensation(ctx, node); err != nil {
+			log.Error(err, "Compensation failed")
+			e.compensationErrors = append(e.compensationErrors, errorsutils.WithID(string(id), fmt.Errorf("compensation of task %q failed: %w", id, err)))
+			e.reportCompensationMetrics(id, err)
+			continue
+		}
+
+		log.Info("Compensated")
+		e.stats.Compensated.Insert(id)
+		e.reportCompensationMetrics(id, nil)
+		e.reportProgress(ctx)
+	}
+}
+
+func (e *execution) runCompensation(ctx context.Context, node *node) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered panic: %v", r)
+		}
+	}()
+
+	return node.withTimeout(node.compensate)(ctx)
+}
+
+func (e *execution) restoreCheckpoint(ctx context.Context) {
+	if e.checkpointStore == nil {
+		return
+	}
+
+	checkpoint, err := e.checkpointStore.Load(ctx, e.flow.name)
+	if err != nil {
+		e.log.Error(err, "Failed loading checkpoint, running all tasks")
+		return
+	}
+	if checkpoint == nil {
+		return
+	}
+	if checkpoint.Hash != e.checkpointHash {
+		e.log.Info("Discarding checkpoint since the tasks or the inputs of the flow changed")
+		return
+	}
+
+	for _, id := range checkpoint.CompletedTaskIDs {
+		if node, ok := e.flow.nodes[id]; ok && !node.skip && !node.alwaysRun {
+			e.restoredTaskIDs.Insert(id)
+		}
+	}
+	e.log.Info("Resuming from checkpoint", "succeededTasks", e.restoredTaskIDs.Len())
+}
+
+// saveCheckpoint writes the checkpoint if tasks succeeded since the last write. Unless final is true, the write is
+// deferred until the checkpoint interval passed since the last write. The final write is not aborted if the flow's
+// context is canceled, so that the progress made until the cancellation is not lost.
+func (e *execution) saveCheckpoint(ctx context.Context, final bool) {
+	if e.checkpointStore == nil || !e.checkpointDirty {
+		return
+	}
+
+	now := e.flow.clock.Now()
+	if !final && now.Sub(e.checkpointSavedAt) < e.checkpointInterval {
+		return
+	}
+
+	if final {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+		defer cancel()
+	}
+
+	if err := e.checkpointStore.Save(ctx, e.flow.name, &Checkpoint{
+		Hash:             e.checkpointHash,
+		CompletedTaskIDs: e.stats.Succeeded.List(),
+	}); err != nil {
+		e.log.Error(err, "Failed saving checkpoint")
+		return
+	}
+
+	e.checkpointDirty = false
+	e.checkpointSavedAt = now
+}
+
+func (e *execution) deleteCheckpoint(ctx context.Context) {
+	if e.checkpointStore == nil {
+		return
+	}
+
+	if err := e.checkpointStore.Delete(ctx, e.flow.name); err != nil {
+		e.log.Error(err, "Failed deleting checkpoint")
+	}
+}
+
 func (e *execution) result(cancelErr error) error {
 	e.reportFlowMetrics()
 	if cancelErr != nil {
@@ -358,8 +648,9 @@ func (e *execution) result(cancelErr error) error {
 
 	if len(e.taskErrors) > 0 {
 		return &flowFailed{
-			name:       e.flow.name,
-			taskErrors: e.taskErrors,
+			name:               e.flow.name,
+			taskErrors:         e.taskErrors,
+			compensationErrors: e.compensationErrors,
 		}
 	}
 	return nil
@@ -368,15 +659,24 @@ func (e *execution) result(cancelErr error) error {
 func (e *execution) reportTaskMetrics(r *nodeResult) {
 	if flowTaskDelaySeconds != nil {
 		flowTaskDelaySeconds.
-			WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.skipped, "true", "false")).
+			WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.skipped || r.restored, "true", "false")).
 			Observe(r.delay.Seconds())
 	}
-	if flowTaskDurationSeconds != nil && !r.skipped {
+	if flowTaskDurationSeconds != nil && !r.skipped && !r.restored {
 		flowTaskDurationSeconds.WithLabelValues(e.flow.name, string(r.TaskID)).Observe(r.duration.Seconds())
 	}
 	if flowTaskResults != nil {
 		flowTaskResults.WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.Error == nil, "success", "error")).Inc()
 	}
+	if flowTaskRetries != nil && r.retries > 0 {
+		flowTaskRetries.WithLabelValues(e.flow.name, string(r.TaskID)).Add(float64(r.retries))
+	}
+}
+
+func (e *execution) reportCompensationMetrics(id TaskID, err error) {
+	if flowTaskCompensations != nil {
+		flowTaskCompensations.WithLabelValues(e.flow.name, string(id), utils.IifString(err == nil, "success", "error")).Inc()
+	}
 }
 
 func (e *execution) reportFlowMetrics() {
@@ -392,8 +692,9 @@ type flowCanceled struct {
 }
 
 type flowFailed struct {
-	name       string
-	taskErrors []error
+	name               string
+	taskErrors         []error
+	compensationErrors []error
 }
 
 func (f *flowCanceled) Error() string {
@@ -409,7 +710,11 @@ func (f *flowCanceled) Unwrap() error {
 }
 
 func (f *flowFailed) Error() string {
-	return fmt.Sprintf("flow %q encountered task errors: %v", f.name, f.taskErrors)
+	if len(f.compensationErrors) == 0 {
+		return fmt.Sprintf("flow %q encountered task errors: %v", f.name, f.taskErrors)
+	}
+	return fmt.Sprintf("flow %q encountered task errors: %v. Encountered compensation errors: %v",
+		f.name, f.taskErrors, f.compensationErrors)
 }
 
 func (f *flowFailed) Unwrap() error {
@@ -9,9 +9,14 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/goleak"
 	"go.uber.org/mock/gomock"
 
@@ -185,6 +190,177 @@ var _ = Describe("Flow", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(flow.WasCanceled(err)).To(BeTrue())
 		})
+
+		It("should retry failed tasks according to their retry policy", func() {
+			var (
+				attempts int
+				stats    *flow.Stats
+
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error {
+					attempts++
+					if attempts < 3 {
+						return errors.New("not yet")
+					}
+					return nil
+				}, RetryPolicy: &flow.RetryPolicy{MaxAttempts: 5, Interval: time.Millisecond}})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{ProgressReporter: flow.NewImmediateProgressReporter(func(_ context.Context, s *flow.Stats) {
+				stats = s
+			})})).To(Succeed())
+			Expect(attempts).To(Equal(3))
+			Expect(stats.Retries).To(Equal(map[flow.TaskID]int{"x": 2}))
+		})
+
+		It("should fail the task after the maximum number of attempts", func() {
+			var (
+				attempts int
+				err1     = errors.New("err1")
+
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error {
+					attempts++
+					return err1
+				}, RetryPolicy: &flow.RetryPolicy{MaxAttempts: 2, Interval: time.Millisecond}})
+				f = g.Compile()
+			)
+
+			err := f.Run(ctx, flow.Opts{})
+			Expect(err).To(HaveOccurred())
+			Expect(flow.Causes(err).Errors).To(ConsistOf(err1))
+			Expect(attempts).To(Equal(2))
+		})
+
+		It("should apply the timeout to every attempt of a task", func() {
+			var (
+				attempts int
+
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(ctx context.Context) error {
+					attempts++
+					if attempts == 1 {
+						<-ctx.Done()
+						return ctx.Err()
+					}
+					return nil
+				}, Timeout: 10 * time.Millisecond, RetryPolicy: &flow.RetryPolicy{MaxAttempts: 2}})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{})).To(Succeed())
+			Expect(attempts).To(Equal(2))
+		})
+
+		It("should run the compensations of succeeded tasks in reverse dependency order if the flow fails", func() {
+			var (
+				list  = NewAtomicStringList()
+				stats *flow.Stats
+				err1  = errors.New("err1")
+
+				mkTask = func(name string, dependencies ...flow.TaskID) flow.Task {
+					return flow.Task{
+						Name:         name,
+						Fn:           func(_ context.Context) error { return nil },
+						Compensate:   func(_ context.Context) error { list.Append(name); return nil },
+						Dependencies: flow.NewTaskIDs(flow.TaskIDSlice(dependencies)),
+					}
+				}
+
+				g = flow.NewGraph("foo")
+				x = g.Add(mkTask("x"))
+				y = g.Add(mkTask("y", x))
+				z = g.Add(mkTask("z", y))
+				_ = g.Add(flow.Task{Name: "fail", Fn: func(_ context.Context) error { return err1 }, Compensate: func(_ context.Context) error {
+					list.Append("fail")
+					return nil
+				}, Dependencies: flow.NewTaskIDs(z)})
+				_ = g.Add(flow.Task{Name: "no-compensation", Fn: func(_ context.Context) error { return nil }, Dependencies: flow.NewTaskIDs(x)})
+				f = g.Compile()
+			)
+
+			err := f.Run(ctx, flow.Opts{ProgressReporter: flow.NewImmediateProgressReporter(func(_ context.Context, s *flow.Stats) {
+				stats = s
+			})})
+			Expect(err).To(HaveOccurred())
+			Expect(flow.Causes(err).Errors).To(ConsistOf(err1))
+			Expect(list.Values()).To(Equal([]string{"z", "y", "x"}))
+			Expect(stats.Compensated).To(Equal(flow.NewTaskIDs(x, y, z)))
+		})
+
+		It("should continue compensating and report the errors if a compensation fails", func() {
+			var (
+				list = NewAtomicStringList()
+				err1 = errors.New("err1")
+				err2 = errors.New("err2")
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }, Compensate: func(_ context.Context) error {
+					list.Append("x")
+					return nil
+				}})
+				y = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return nil }, Compensate: func(_ context.Context) error {
+					list.Append("y")
+					return err2
+				}, Dependencies: flow.NewTaskIDs(x)})
+				_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return err1 }, Dependencies: flow.NewTaskIDs(y)})
+				f = g.Compile()
+			)
+
+			err := f.Run(ctx, flow.Opts{})
+			Expect(err).To(MatchError(ContainSubstring("compensation of task \"y\" failed: err2")))
+			Expect(flow.Causes(err).Errors).To(ConsistOf(err1))
+			Expect(list.Values()).To(Equal([]string{"y", "x"}))
+		})
+
+		It("should emit a span for every task parented to the span of the given context", func() {
+			var (
+				spanRecorder   = tracetest.NewSpanRecorder()
+				tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+				err1           = errors.New("err1")
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return err1 }, Dependencies: flow.NewTaskIDs(x)})
+				_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return nil }, SkipIf: true})
+				f = g.Compile()
+			)
+
+			previousTracerProvider := otel.GetTracerProvider()
+			otel.SetTracerProvider(tracerProvider)
+			DeferCleanup(func() {
+				otel.SetTracerProvider(previousTracerProvider)
+				Expect(tracerProvider.Shutdown(context.Background())).To(Succeed())
+			})
+
+			reconcileCtx, reconcileSpan := tracerProvider.Tracer("test").Start(ctx, "reconcile")
+			Expect(f.Run(reconcileCtx, flow.Opts{})).NotTo(Succeed())
+			reconcileSpan.End()
+
+			spans := spanRecorder.Ended()
+			Expect(spans).To(HaveLen(3))
+			for _, span := range spans[:2] {
+				Expect(span.Parent().SpanID()).To(Equal(reconcileSpan.SpanContext().SpanID()))
+			}
+			Expect(spans[0].Name()).To(Equal("x"))
+			Expect(spans[0].Status().Code).To(Equal(codes.Unset))
+			Expect(spans[1].Name()).To(Equal("y"))
+			Expect(spans[1].Status().Code).To(Equal(codes.Error))
+		})
+
+		It("should not run compensations if the flow succeeds", func() {
+			var (
+				g = flow.NewGraph("foo")
+				_ = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }, Compensate: func(_ context.Context) error {
+					Fail("Compensation has been called")
+					return nil
+				}})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{})).To(Succeed())
+		})
 	})
 
 	Describe("#Sequential", func() {
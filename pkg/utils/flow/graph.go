@@ -6,6 +6,7 @@ package flow
 
 import (
 	"fmt"
+	"time"
 
 	"k8s.io/utils/clock"
 )
@@ -17,14 +18,42 @@ type Task struct {
 	Fn           TaskFn
 	SkipIf       bool
 	Dependencies TaskIDs
+	// Timeout is the maximum duration of a single attempt of Fn. If it is zero, no timeout is applied.
+	Timeout time.Duration
+	// RetryPolicy defines how Fn is retried if it fails. If it is nil, Fn is not retried.
+	RetryPolicy *RetryPolicy
+	// Compensate undoes the effects of Fn. If the flow fails, the compensation functions of all succeeded tasks are
+	// run in reverse dependency order.
+	Compensate TaskFn
+	// AlwaysRun specifies that the task is run even if it succeeded according to the checkpoint of a previous
+	// execution, e.g., because it initializes in-memory state which is required by other tasks.
+	AlwaysRun bool
+}
+
+// RetryPolicy defines how a failed task is retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one. If it is zero, the task is retried until
+	// the flow's context is done.
+	MaxAttempts int
+	// Interval is the duration to wait between two attempts.
+	Interval time.Duration
+}
+
+// shouldRetry returns whether another attempt should be made after the given number of failed attempts.
+func (p *RetryPolicy) shouldRetry(attempts int) bool {
+	return p != nil && (p.MaxAttempts <= 0 || attempts < p.MaxAttempts)
 }
 
 // Spec returns the TaskSpec of a task.
 func (t *Task) Spec() *TaskSpec {
 	return &TaskSpec{
-		t.Fn,
-		t.SkipIf,
-		t.Dependencies.Copy(),
+		Fn:           t.Fn,
+		Skip:         t.SkipIf,
+		Dependencies: t.Dependencies.Copy(),
+		Timeout:      t.Timeout,
+		RetryPolicy:  t.RetryPolicy,
+		Compensate:   t.Compensate,
+		AlwaysRun:    t.AlwaysRun,
 	}
 }
 
@@ -34,6 +63,10 @@ type TaskSpec struct {
 	Fn           TaskFn
 	Skip         bool
 	Dependencies TaskIDs
+	Timeout      time.Duration
+	RetryPolicy  *RetryPolicy
+	Compensate   TaskFn
+	AlwaysRun    bool
 }
 
 // Tasks is a mapping from TaskID to TaskSpec.
@@ -91,6 +124,10 @@ func (g *Graph) Compile() *Flow {
 		node := nodes.getOrCreate(taskName)
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.timeout = taskSpec.Timeout
+		node.retryPolicy = taskSpec.RetryPolicy
+		node.compensate = taskSpec.Compensate
+		node.alwaysRun = taskSpec.AlwaysRun
 		node.required = taskSpec.Dependencies.Len()
 	}
 
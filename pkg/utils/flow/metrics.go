@@ -15,6 +15,8 @@ var (
 	flowTaskDelaySeconds    *prometheus.HistogramVec
 	flowTaskDurationSeconds *prometheus.HistogramVec
 	flowTaskResults         *prometheus.CounterVec
+	flowTaskRetries         *prometheus.CounterVec
+	flowTaskCompensations   *prometheus.CounterVec
 	flowDurationSeconds     *prometheus.HistogramVec
 )
 
@@ -69,6 +71,31 @@ func RegisterMetrics(r prometheus.Registerer) {
 		},
 	)
 
+	flowTaskRetries = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "task_retries_total",
+			Help:      "Number of retries of flow tasks with a retry policy.",
+		},
+		[]string{
+			"flow",
+			"task_id",
+		},
+	)
+
+	flowTaskCompensations = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "task_compensations_total",
+			Help:      "Flow task compensation counter. The value of the label 'result' can either be 'success' or 'error'.",
+		},
+		[]string{
+			"flow",
+			"task_id",
+			"result",
+		},
+	)
+
 	flowDurationSeconds = factory.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: metricsNamespace,
@@ -9,12 +9,14 @@ import (
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
 )
 
 const (
@@ -95,10 +97,6 @@ func ControllerRegistrationForExtension(extension *operatorv1alpha1.Extension) (
 			ObjectMeta: metav1.ObjectMeta{
 				Name: extension.Name,
 			},
-			Helm: &gardencorev1.HelmControllerDeployment{
-				Values:        extension.Spec.Deployment.ExtensionDeployment.Values,
-				OCIRepository: extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository,
-			},
 			InjectGardenKubeconfig: extension.Spec.Deployment.ExtensionDeployment.InjectGardenKubeconfig,
 		}
 
@@ -121,9 +119,31 @@ func ControllerRegistrationForExtension(extension *operatorv1alpha1.Extension) (
 		}
 	)
 
+	if manifests := extension.Spec.Deployment.ExtensionDeployment.Manifests; manifests != nil {
+		controllerDeployment.Manifests = &gardencorev1.ManifestsControllerDeployment{
+			Values:        extension.Spec.Deployment.ExtensionDeployment.Values,
+			OCIRepository: manifests.OCIRepository,
+			Path:          manifests.Path,
+		}
+	} else {
+		controllerDeployment.Helm = &gardencorev1.HelmControllerDeployment{
+			Values:        extension.Spec.Deployment.ExtensionDeployment.Values,
+			OCIRepository: extension.Spec.Deployment.ExtensionDeployment.Helm.OCIRepository,
+		}
+	}
+
 	if v, ok := extension.Annotations[v1beta1constants.AnnotationPodSecurityEnforce]; ok {
 		metav1.SetMetaDataAnnotation(&controllerRegistration.ObjectMeta, v1beta1constants.AnnotationPodSecurityEnforce, v)
 	}
 
 	return controllerRegistration, controllerDeployment
 }
+
+// RenderExtensionDeployment renders the manifests of the given deployment from the given archive. Depending on the
+// deployment, the archive is either rendered as Helm chart or as manifests (or kustomization).
+func RenderExtensionDeployment(renderer chartrenderer.Interface, deployment *operatorv1alpha1.DeploymentSpec, archive []byte, releaseName, namespace string, values any) (*chartrenderer.RenderedChart, error) {
+	if deployment.Manifests != nil {
+		return renderer.RenderManifestsArchive(archive, ptr.Deref(deployment.Manifests.Path, ""), releaseName, namespace, values)
+	}
+	return renderer.RenderArchive(archive, releaseName, namespace, values)
+}
@@ -133,6 +133,23 @@ var _ = Describe("Extension", func() {
 			Expect(deployment.InjectGardenKubeconfig).To(Equal(extension.Spec.Deployment.ExtensionDeployment.InjectGardenKubeconfig))
 		})
 
+		It("should return a ControllerDeployment with manifests", func() {
+			extension.Spec.Deployment.ExtensionDeployment.Helm = nil
+			extension.Spec.Deployment.ExtensionDeployment.Manifests = &operatorv1alpha1.ExtensionManifests{
+				OCIRepository: &gardencorev1.OCIRepository{Ref: new("garden.local/extension-manifests:test")},
+				Path:          new("overlays/garden"),
+			}
+
+			_, deployment := ControllerRegistrationForExtension(extension)
+
+			Expect(deployment.Helm).To(BeNil())
+			Expect(deployment.Manifests).To(Equal(&gardencorev1.ManifestsControllerDeployment{
+				OCIRepository: extension.Spec.Deployment.ExtensionDeployment.Manifests.OCIRepository,
+				Path:          new("overlays/garden"),
+				Values:        extension.Spec.Deployment.ExtensionDeployment.Values,
+			}))
+		})
+
 		It("should remove garden from autoEnable and clusterCompatibility", func() {
 			extension.Spec.Resources[0].AutoEnable = []gardencorev1beta1.ClusterType{"seed", "garden"}
 			extension.Spec.Resources[0].ClusterCompatibility = []gardencorev1beta1.ClusterType{"shoot", "seed", "garden"}
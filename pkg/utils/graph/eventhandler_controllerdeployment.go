@@ -12,6 +12,7 @@ import (
 	toolscache "k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 
+	v1helper "github.com/gardener/gardener/pkg/api/core/v1/helper"
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
@@ -47,7 +48,8 @@ func extractControllerDeploymentInfo(obj any) (string, []string, []string) {
 		return "", nil, nil
 	}
 
-	if controllerDeployment.Helm == nil || controllerDeployment.Helm.OCIRepository == nil {
+	ociRepository := v1helper.GetOCIRepositoryForControllerDeployment(controllerDeployment)
+	if ociRepository == nil {
 		return controllerDeployment.Name, nil, nil
 	}
 
@@ -56,11 +58,11 @@ func extractControllerDeploymentInfo(obj any) (string, []string, []string) {
 		configMapsNames []string
 	)
 
-	if controllerDeployment.Helm.OCIRepository.PullSecretRef != nil {
-		secretNames = append(secretNames, controllerDeployment.Helm.OCIRepository.PullSecretRef.Name)
+	if ociRepository.PullSecretRef != nil {
+		secretNames = append(secretNames, ociRepository.PullSecretRef.Name)
 	}
-	if controllerDeployment.Helm.OCIRepository.CABundleSecretRef != nil {
-		secretNames = append(secretNames, controllerDeployment.Helm.OCIRepository.CABundleSecretRef.Name)
+	if ociRepository.CABundleSecretRef != nil {
+		secretNames = append(secretNames, ociRepository.CABundleSecretRef.Name)
 	}
 
 	for _, resource := range controllerDeployment.Resources {
@@ -1727,6 +1727,30 @@ yO57qEcJqG1cB7iSchFuCSTuDBbZlN0fXgn4YjiWZyb4l3BDp3rm4iJImA==
 		Expect(graph.HasVertex(VertexTypeControllerDeployment, "", controllerDeployment.Name)).To(BeFalse())
 	})
 
+	It("should track the pull secret of gardencorev1.ControllerDeployment with manifests", func() {
+		pullSecretName := "my-pull-secret"
+
+		controllerDeployment := &gardencorev1.ControllerDeployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "controllerdeployment"},
+			Manifests: &gardencorev1.ManifestsControllerDeployment{
+				OCIRepository: &gardencorev1.OCIRepository{
+					PullSecretRef: &corev1.LocalObjectReference{Name: pullSecretName},
+				},
+			},
+		}
+
+		By("Add")
+		fakeInformerControllerDeployment.Add(controllerDeployment)
+		Expect(graph.graph.Nodes().Len()).To(Equal(2))
+		Expect(graph.graph.Edges().Len()).To(Equal(1))
+		Expect(graph.HasPathFrom(VertexTypeSecret, v1beta1constants.GardenNamespace, pullSecretName, VertexTypeControllerDeployment, "", controllerDeployment.Name)).To(BeTrue())
+
+		By("Delete")
+		fakeInformerControllerDeployment.Delete(controllerDeployment)
+		Expect(graph.graph.Nodes().Len()).To(BeZero())
+		Expect(graph.graph.Edges().Len()).To(BeZero())
+	})
+
 	It("should track Secret and ConfigMap references from gardencorev1.ControllerDeployment.Resources", func() {
 		secretRefName := "resource-secret"
 		newSecretRefName := "new-resource-secret"
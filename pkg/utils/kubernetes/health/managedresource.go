@@ -29,6 +29,9 @@ func CheckManagedResourceApplied(mr *resourcesv1alpha1.ManagedResource) error {
 	if status.ObservedGeneration != mr.GetGeneration() {
 		return fmt.Errorf("observed generation of managed resource %s/%s outdated (%d/%d)", mr.GetNamespace(), mr.GetName(), status.ObservedGeneration, mr.GetGeneration())
 	}
+	if status.PendingSecretsDataChecksum != nil {
+		return fmt.Errorf("changes of managed resource %s/%s with checksum %s are held back until their rollout is approved", mr.GetNamespace(), mr.GetName(), *status.PendingSecretsDataChecksum)
+	}
 
 	conditionApplied := v1beta1helper.GetCondition(status.Conditions, resourcesv1alpha1.ResourcesApplied)
 
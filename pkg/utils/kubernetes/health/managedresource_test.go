@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
@@ -161,6 +162,20 @@ var _ = Describe("Managedresource", func() {
 					ObservedGeneration: 1,
 				},
 			}, HaveOccurred()),
+			Entry("pending rollout", resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Status: resourcesv1alpha1.ManagedResourceStatus{
+					ObservedGeneration:         1,
+					PendingSecretsDataChecksum: ptr.To("checksum"),
+					Conditions: []gardencorev1beta1.Condition{
+						{
+							Type:   resourcesv1alpha1.ResourcesApplied,
+							Status: gardencorev1beta1.ConditionTrue,
+							Reason: resourcesv1alpha1.ConditionRolloutPending,
+						},
+					},
+				},
+			}, MatchError(ContainSubstring("held back until their rollout is approved"))),
 			Entry("no status", resourcesv1alpha1.ManagedResource{
 				ObjectMeta: metav1.ObjectMeta{Generation: 2},
 			}, HaveOccurred()),
@@ -7,19 +7,27 @@ package builder
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils"
 )
 
+// RolloutGroups contains the names of ManagedResources in the control plane namespaces of shoots whose changes are
+// rolled out progressively. Such ManagedResources are labeled with their name as rollout group when they are created or
+// updated, so that their changes are held back from the beginning. It must only be set on startup.
+var RolloutGroups = sets.New[string]()
+
 // ManagedResource is a structure managing a ManagedResource.
 type ManagedResource struct {
 	client            client.Client
@@ -130,6 +138,10 @@ func (m *ManagedResource) Reconcile(ctx context.Context) error {
 			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, k, v)
 		}
 
+		if RolloutGroups.Has(obj.Name) && strings.HasPrefix(obj.Namespace, v1beta1constants.TechnicalIDPrefix) {
+			metav1.SetMetaDataLabel(&obj.ObjectMeta, resourcesv1alpha1.RolloutGroup, obj.Name)
+		}
+
 		obj.Spec = m.resource.Spec
 
 		// the annotations should be injected after the spec is updated!
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils"
 	. "github.com/gardener/gardener/pkg/utils/managedresources/builder"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -463,5 +465,35 @@ var _ = Describe("Resource Manager", func() {
 
 			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, mr)).To(BeNotFoundError())
 		})
+
+		Context("rollout groups", func() {
+			BeforeEach(func() {
+				DeferCleanup(test.WithVar(&RolloutGroups, sets.New(name)))
+			})
+
+			It("should label configured managed resources in shoot namespaces with their rollout group", func() {
+				Expect(NewManagedResource(fakeClient).WithNamespacedName("shoot--foo--bar", name).Reconcile(ctx)).To(Succeed())
+
+				mr := &resourcesv1alpha1.ManagedResource{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "shoot--foo--bar", Name: name}, mr)).To(Succeed())
+				Expect(mr.Labels).To(HaveKeyWithValue("resources.gardener.cloud/rollout-group", name))
+			})
+
+			It("should not label configured managed resources outside of shoot namespaces", func() {
+				Expect(NewManagedResource(fakeClient).WithNamespacedName(namespace, name).Reconcile(ctx)).To(Succeed())
+
+				mr := &resourcesv1alpha1.ManagedResource{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, mr)).To(Succeed())
+				Expect(mr.Labels).NotTo(HaveKey("resources.gardener.cloud/rollout-group"))
+			})
+
+			It("should not label other managed resources", func() {
+				Expect(NewManagedResource(fakeClient).WithNamespacedName("shoot--foo--bar", "other").Reconcile(ctx)).To(Succeed())
+
+				mr := &resourcesv1alpha1.ManagedResource{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "shoot--foo--bar", Name: "other"}, mr)).To(Succeed())
+				Expect(mr.Labels).NotTo(HaveKey("resources.gardener.cloud/rollout-group"))
+			})
+		})
 	})
 })
@@ -4,36 +4,278 @@
 
 package oci
 
-import "sync"
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
 
-var defaultCache = newCache()
+// DefaultCacheMaxSizeBytes is the default maximum total size of all Helm charts in the cache.
+const DefaultCacheMaxSizeBytes int64 = 256 << 20
 
-type cacher interface {
+// Cache caches pulled Helm charts and manifests. It can be shared by multiple HelmRegistry instances.
+type Cache interface {
+	// Get returns the cached blob for the given key and whether it was found.
 	Get(key string) ([]byte, bool)
+	// Set adds the given blob to the cache.
 	Set(key string, blob []byte)
 }
 
-func newCache() *cache {
-	return &cache{
-		items: map[string][]byte{},
+// CacheOptions are options for the cache of pulled Helm charts.
+type CacheOptions struct {
+	// Directory is the directory in which the cached Helm charts are persisted. Persisted charts survive restarts of the
+	// process. If empty, the charts are only cached in memory.
+	Directory string
+	// MaxSizeBytes is the maximum total size of all cached Helm charts. When it is exceeded, the least recently used
+	// charts are evicted.
+	MaxSizeBytes int64
+}
+
+// NewCache creates a new Cache with the given options. If a directory is configured, the charts which were persisted
+// in it before are loaded.
+func NewCache(opts CacheOptions) (Cache, error) {
+	return newCache(opts)
+}
+
+func mustNewCache(opts CacheOptions) *cache {
+	c, err := newCache(opts)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func newCache(opts CacheOptions) (*cache, error) {
+	c := &cache{
+		directory: opts.Directory,
+		maxSize:   opts.MaxSizeBytes,
+		lru:       list.New(),
+		entries:   map[string]*list.Element{},
 	}
+
+	if c.directory != "" {
+		if err := c.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
 }
 
-// cache is a basic key-value cache i.e. a map protected by a mutex. Items are never removed from the cache.
+// cache is a size-bounded key-value cache for Helm charts which evicts the least recently used items. The keys contain
+// the digest of the pulled artifact, hence cached items never become outdated. If a directory is configured, the items
+// are stored in files named after the hash of their keys, otherwise they are kept in memory. Files are prefixed with the
+// checksum of the stored item which is verified when the item is read, so that corrupted or tampered files are not
+// used.
 type cache struct {
-	mu    sync.RWMutex
-	items map[string][]byte
+	directory string
+	maxSize   int64
+
+	mu   sync.Mutex
+	size int64
+	// lru contains the cached items, the most recently used item is at the front.
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	id   string
+	size int64
+	// blob is only set if the cache is not backed by a directory.
+	blob []byte
 }
 
 func (c *cache) Get(key string) ([]byte, bool) {
-	c.mu.RLock()
-	blob, found := c.items[key]
-	c.mu.RUnlock()
-	return blob, found
+	id := cacheID(key)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, found := c.entries[id]
+	if !found {
+		cacheMisses.Inc()
+		return nil, false
+	}
+
+	entry := element.Value.(*cacheEntry)
+	blob := entry.blob
+	if c.directory != "" {
+		data, err := os.ReadFile(c.path(id))
+		if err != nil {
+			// The file was removed or cannot be read, hence the chart must be pulled again.
+			c.remove(element)
+			cacheMisses.Inc()
+			return nil, false
+		}
+
+		var ok bool
+		if blob, ok = verifyChecksum(data); !ok {
+			// The file was modified after it was written, hence the chart must be pulled again.
+			c.remove(element)
+			cacheMisses.Inc()
+			return nil, false
+		}
+
+		// The modification time is used to restore the order of the items when the cache is loaded after a restart.
+		now := time.Now()
+		_ = os.Chtimes(c.path(id), now, now)
+	}
+
+	c.lru.MoveToFront(element)
+	cacheHits.Inc()
+	return blob, true
 }
 
+// Set adds the given blob to the cache. Caching is best effort, hence blobs which cannot be persisted or which exceed
+// the maximum size of the cache are not cached.
 func (c *cache) Set(key string, blob []byte) {
+	id := cacheID(key)
+	size := int64(len(blob))
+	if size > c.maxSize {
+		return
+	}
+
 	c.mu.Lock()
-	c.items[key] = blob
-	c.mu.Unlock()
+	defer c.mu.Unlock()
+
+	if element, found := c.entries[id]; found {
+		c.remove(element)
+	}
+
+	entry := &cacheEntry{id: id, size: size}
+	if c.directory == "" {
+		entry.blob = blob
+	} else if err := writeFileAtomically(c.path(id), withChecksum(blob)); err != nil {
+		return
+	}
+
+	c.add(entry)
+	c.evict()
+}
+
+func (c *cache) load() error {
+	if err := os.MkdirAll(c.directory, 0700); err != nil {
+		return fmt.Errorf("failed creating cache directory %s: %w", c.directory, err)
+	}
+
+	dirEntries, err := os.ReadDir(c.directory)
+	if err != nil {
+		return fmt.Errorf("failed reading cache directory %s: %w", c.directory, err)
+	}
+
+	type file struct {
+		id      string
+		size    int64
+		modTime time.Time
+	}
+
+	var files []file
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.Type().IsRegular() {
+			continue
+		}
+
+		info, err := dirEntry.Info()
+		if err != nil {
+			return fmt.Errorf("failed reading cache file %s: %w", dirEntry.Name(), err)
+		}
+
+		if filepath.Ext(dirEntry.Name()) != "" || info.Size() < sha256.Size {
+			// Leftover of an interrupted write or a file which cannot contain a checksum.
+			_ = os.Remove(filepath.Join(c.directory, dirEntry.Name()))
+			continue
+		}
+
+		files = append(files, file{id: dirEntry.Name(), size: info.Size() - sha256.Size, modTime: info.ModTime()})
+	}
+
+	slices.SortFunc(files, func(a, b file) int { return a.modTime.Compare(b.modTime) })
+
+	for _, f := range files {
+		c.add(&cacheEntry{id: f.id, size: f.size})
+	}
+	c.evict()
+
+	return nil
+}
+
+func (c *cache) add(entry *cacheEntry) {
+	c.entries[entry.id] = c.lru.PushFront(entry)
+	c.size += entry.size
+	c.updateMetrics()
+}
+
+func (c *cache) remove(element *list.Element) {
+	entry := element.Value.(*cacheEntry)
+
+	c.lru.Remove(element)
+	delete(c.entries, entry.id)
+	c.size -= entry.size
+	if c.directory != "" {
+		_ = os.Remove(c.path(entry.id))
+	}
+	c.updateMetrics()
+}
+
+func (c *cache) evict() {
+	for c.size > c.maxSize && c.lru.Len() > 0 {
+		c.remove(c.lru.Back())
+		cacheEvictions.Inc()
+	}
+}
+
+func (c *cache) updateMetrics() {
+	cacheSizeBytes.Set(float64(c.size))
+	cacheItems.Set(float64(c.lru.Len()))
+}
+
+func (c *cache) path(id string) string {
+	return filepath.Join(c.directory, id)
+}
+
+func cacheID(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// withChecksum returns the given blob prefixed with its SHA-256 checksum.
+func withChecksum(blob []byte) []byte {
+	checksum := sha256.Sum256(blob)
+	return append(checksum[:], blob...)
+}
+
+// verifyChecksum returns the blob of data written by withChecksum and whether it matches the checksum.
+func verifyChecksum(data []byte) ([]byte, bool) {
+	if len(data) < sha256.Size {
+		return nil, false
+	}
+
+	blob := data[sha256.Size:]
+	checksum := sha256.Sum256(blob)
+	return blob, bytes.Equal(checksum[:], data[:sha256.Size])
+}
+
+func writeFileAtomically(path string, data []byte) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
 }
@@ -5,15 +5,24 @@
 package oci
 
 import (
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("cache", func() {
+	get := func(c *cache, key string) []byte {
+		blob, found := c.Get(key)
+		Expect(found).To(BeTrue(), "expected %q to be cached", key)
+		return blob
+	}
+
 	It("should store and retrieve values", func() {
 		key := "foo"
 		data := []byte("bar")
-		c := newCache()
+		c := mustNewCache(CacheOptions{MaxSizeBytes: DefaultCacheMaxSizeBytes})
 
 		_, found := c.Get(key)
 		Expect(found).To(BeFalse())
@@ -24,4 +33,119 @@ var _ = Describe("cache", func() {
 		Expect(found).To(BeTrue())
 		Expect(out).To(Equal(data))
 	})
+
+	It("should evict the least recently used values when the maximum size is exceeded", func() {
+		c := mustNewCache(CacheOptions{MaxSizeBytes: 6})
+
+		c.Set("foo", []byte("foo"))
+		c.Set("bar", []byte("bar"))
+		Expect(get(c, "foo")).To(Equal([]byte("foo")))
+
+		c.Set("baz", []byte("baz"))
+
+		Expect(get(c, "foo")).To(Equal([]byte("foo")))
+		Expect(get(c, "baz")).To(Equal([]byte("baz")))
+		_, found := c.Get("bar")
+		Expect(found).To(BeFalse())
+	})
+
+	It("should not cache values exceeding the maximum size", func() {
+		c := mustNewCache(CacheOptions{MaxSizeBytes: 2})
+
+		c.Set("foo", []byte("foo"))
+
+		_, found := c.Get("foo")
+		Expect(found).To(BeFalse())
+	})
+
+	Context("with directory", func() {
+		var directory string
+
+		BeforeEach(func() {
+			directory = GinkgoT().TempDir()
+		})
+
+		It("should persist the values across restarts", func() {
+			c, err := newCache(CacheOptions{Directory: directory, MaxSizeBytes: DefaultCacheMaxSizeBytes})
+			Expect(err).NotTo(HaveOccurred())
+			c.Set("foo", []byte("bar"))
+
+			c, err = newCache(CacheOptions{Directory: directory, MaxSizeBytes: DefaultCacheMaxSizeBytes})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(get(c, "foo")).To(Equal([]byte("bar")))
+		})
+
+		It("should remove evicted values from the directory", func() {
+			c, err := newCache(CacheOptions{Directory: directory, MaxSizeBytes: 3})
+			Expect(err).NotTo(HaveOccurred())
+
+			c.Set("foo", []byte("foo"))
+			c.Set("bar", []byte("bar"))
+
+			Expect(os.ReadDir(directory)).To(HaveLen(1))
+			Expect(filepath.Join(directory, cacheID("bar"))).To(BeAnExistingFile())
+		})
+
+		It("should evict values when loading a directory exceeding the maximum size", func() {
+			c, err := newCache(CacheOptions{Directory: directory, MaxSizeBytes: 6})
+			Expect(err).NotTo(HaveOccurred())
+			c.Set("foo", []byte("foo"))
+			c.Set("bar", []byte("bar"))
+
+			c, err = newCache(CacheOptions{Directory: directory, MaxSizeBytes: 3})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(get(c, "bar")).To(Equal([]byte("bar")))
+			_, found := c.Get("foo")
+			Expect(found).To(BeFalse())
+		})
+
+		It("should treat values whose files were removed as missing", func() {
+			c, err := newCache(CacheOptions{Directory: directory, MaxSizeBytes: DefaultCacheMaxSizeBytes})
+			Expect(err).NotTo(HaveOccurred())
+			c.Set("foo", []byte("bar"))
+
+			Expect(os.Remove(filepath.Join(directory, cacheID("foo")))).To(Succeed())
+
+			_, found := c.Get("foo")
+			Expect(found).To(BeFalse())
+			Expect(c.size).To(BeZero())
+		})
+
+		It("should treat values whose files were modified as missing", func() {
+			c, err := newCache(CacheOptions{Directory: directory, MaxSizeBytes: DefaultCacheMaxSizeBytes})
+			Expect(err).NotTo(HaveOccurred())
+			c.Set("foo", []byte("bar"))
+
+			path := filepath.Join(directory, cacheID("foo"))
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			data[len(data)-1] = 'z'
+			Expect(os.WriteFile(path, data, 0600)).To(Succeed())
+
+			c, err = newCache(CacheOptions{Directory: directory, MaxSizeBytes: DefaultCacheMaxSizeBytes})
+			Expect(err).NotTo(HaveOccurred())
+			_, found := c.Get("foo")
+			Expect(found).To(BeFalse())
+			Expect(c.size).To(BeZero())
+			Expect(path).NotTo(BeAnExistingFile())
+		})
+
+		It("should remove files which are too small to contain a checksum", func() {
+			Expect(os.WriteFile(filepath.Join(directory, cacheID("foo")), []byte("bar"), 0600)).To(Succeed())
+
+			c, err := newCache(CacheOptions{Directory: directory, MaxSizeBytes: DefaultCacheMaxSizeBytes})
+			Expect(err).NotTo(HaveOccurred())
+			_, found := c.Get("foo")
+			Expect(found).To(BeFalse())
+			Expect(os.ReadDir(directory)).To(BeEmpty())
+		})
+
+		It("should remove leftovers of interrupted writes", func() {
+			Expect(os.WriteFile(filepath.Join(directory, "foo.123.tmp"), []byte("foo"), 0600)).To(Succeed())
+
+			_, err := newCache(CacheOptions{Directory: directory, MaxSizeBytes: DefaultCacheMaxSizeBytes})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.ReadDir(directory)).To(BeEmpty())
+		})
+	})
 })
@@ -17,10 +17,12 @@ import (
 
 var _ oci.Interface = &Registry{}
 
-// Registry implements oci.Interface and returns the artifacts previously added via `.SetArtifact()`.
+// Registry implements oci.Interface and returns the artifacts previously added via `.AddArtifact()` or the errors
+// previously added via `.AddError()`.
 type Registry struct {
 	mu        sync.Mutex
 	artifacts map[string][]byte
+	errors    map[string]error
 
 	expectedSecretNamespace string
 }
@@ -29,6 +31,7 @@ type Registry struct {
 func NewRegistry() *Registry {
 	return &Registry{
 		artifacts: make(map[string][]byte),
+		errors:    make(map[string]error),
 	}
 }
 
@@ -47,6 +50,12 @@ func (r *Registry) Pull(ctx context.Context, ociRepo *gardencorev1.OCIRepository
 			return nil, fmt.Errorf("expected secret namespace %q, but got %q", r.expectedSecretNamespace, vs)
 		}
 	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err, ok := r.errors[artifactKey(ociRepo)]; ok {
+		return nil, err
+	}
 	data, ok := r.artifacts[artifactKey(ociRepo)]
 	if !ok {
 		return nil, fmt.Errorf("not found")
@@ -61,6 +70,14 @@ func (r *Registry) AddArtifact(oci *gardencorev1.OCIRepository, data []byte) {
 	r.artifacts[artifactKey(oci)] = data
 }
 
+// AddError adds an error which is returned when pulling the artifact from the fake registry, e.g., to simulate failed
+// signature verifications.
+func (r *Registry) AddError(oci *gardencorev1.OCIRepository, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errors[artifactKey(oci)] = err
+}
+
 func artifactKey(oci *gardencorev1.OCIRepository) string {
 	if oci.Ref != nil {
 		return *oci.Ref
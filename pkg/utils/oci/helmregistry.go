@@ -12,7 +12,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
@@ -28,6 +30,11 @@ import (
 
 const (
 	mediaTypeHelm = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	// mediaTypeFluxContent is the media type of artifacts pushed with `flux push artifact`, e.g., containing a
+	// kustomization.
+	mediaTypeFluxContent = "application/vnd.cncf.flux.content.v1.tar+gzip"
+	// mediaTypeImageLayer is the media type of generic gzip'ed tar layers, e.g., pushed with `oras push`.
+	mediaTypeImageLayer = "application/vnd.oci.image.layer.v1.tar+gzip"
 
 	localRegistryPattern = "registry.local.gardener.cloud:"
 )
@@ -41,22 +48,31 @@ var (
 
 // Interface represents an OCI compatible registry.
 type Interface interface {
-	// Pull from the repository and return the Helm chart.
+	// Pull from the repository and return the compressed archive of the Helm chart or manifests.
 	// The context can be used to pass the secret namespace with the key ContextKeySecretNamespace.
 	Pull(ctx context.Context, oci *gardencorev1.OCIRepository) ([]byte, error)
 }
 
-// HelmRegistry can pull OCI Helm Charts.
+// HelmRegistry can pull OCI Helm Charts and OCI artifacts containing manifests.
 type HelmRegistry struct {
-	cache  cacher
+	cache  Cache
 	client client.Client
+
+	verifiersLock sync.Mutex
+	// verifiers are the verifiers built for the verification policies, keyed by the checksum of the policy.
+	verifiers map[string]*verifier
 }
 
 // NewHelmRegistry creates a new HelmRegistry.
-// The client is used to get pull secrets if needed.
-func NewHelmRegistry(c client.Client) *HelmRegistry {
+// The client is used to get pull secrets if needed. The cache is used for the pulled artifacts, it should be shared by
+// all HelmRegistry instances of the process. If it is nil, the artifacts are cached in memory for this instance only.
+func NewHelmRegistry(c client.Client, cache Cache) *HelmRegistry {
+	if cache == nil {
+		cache = mustNewCache(CacheOptions{MaxSizeBytes: DefaultCacheMaxSizeBytes})
+	}
+
 	return &HelmRegistry{
-		cache:  defaultCache,
+		cache:  cache,
 		client: c,
 	}
 }
@@ -120,31 +136,36 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 		remoteOpts = append(remoteOpts, remote.WithAuthFromKeychain(&keychain{pullSecret: string(secret.Data[corev1.DockerConfigJsonKey])}))
 	}
 
-	key, err := cacheKeyFromRef(ref, remoteOpts...)
+	digestRef, err := resolveDigest(ref, remoteOpts...)
 	if err != nil {
 		return nil, err
 	}
-	if key != "" {
-		if blob, found := r.cache.Get(key); found {
-			return blob, nil
+
+	if oci.Verification != nil {
+		v, err := r.verifierFor(oci.Verification)
+		if err != nil {
+			return nil, err
+		}
+		if err := v.verify(digestRef, remoteOpts...); err != nil {
+			return nil, fmt.Errorf("failed verifying signature of artifact %s: %w", digestRef, err)
 		}
 	}
 
-	img, err := remote.Image(ref, remoteOpts...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to pull artifact %s: %w", ref, err)
+	// the cache key is based on the digest of the artifact
+	key := digestRef.Name()
+	if blob, found := r.cache.Get(key); found {
+		return blob, nil
 	}
-	blob, err := extractHelmLayer(img)
+
+	// pull by digest so that exactly the verified artifact is used even if the tag is moved in the meantime
+	img, err := remote.Image(digestRef, remoteOpts...)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to pull artifact %s: %w", ref, err)
 	}
-
-	// construct cache key based on digest of the pulled artifact
-	digest, err := img.Digest()
+	blob, err := extractContentLayer(img)
 	if err != nil {
 		return nil, err
 	}
-	key = ref.Context().Digest(digest.String()).Name()
 	r.cache.Set(key, blob)
 
 	return blob, nil
@@ -165,11 +186,11 @@ func buildRef(oci *gardencorev1.OCIRepository) (name.Reference, error) {
 	return name.ParseReference(ref, opts...)
 }
 
-// cacheKeyFromRef returns "repo@sha256:digest". If the ref is not a digest, the remote repository is queried to
+// resolveDigest returns "repo@sha256:digest". If the ref is not a digest, the remote repository is queried to
 // retrieve the digest pointed to by the ref.
-func cacheKeyFromRef(ref name.Reference, opts ...remote.Option) (string, error) {
+func resolveDigest(ref name.Reference, opts ...remote.Option) (name.Digest, error) {
 	if ref, ok := ref.(name.Digest); ok {
-		return ref.Name(), nil
+		return ref, nil
 	}
 
 	var digest gcrv1.Hash
@@ -179,14 +200,18 @@ func cacheKeyFromRef(ref name.Reference, opts ...remote.Option) (string, error)
 	} else {
 		rd, gErr := remote.Get(ref, opts...)
 		if gErr != nil {
-			return "", fmt.Errorf("failed get manifest from remote trying to determine digest: %w", errors.Join(gErr, hErr))
+			return name.Digest{}, fmt.Errorf("failed get manifest from remote trying to determine digest: %w", errors.Join(gErr, hErr))
 		}
 		digest = rd.Digest
 	}
-	return ref.Context().Digest(digest.String()).Name(), nil
+	return ref.Context().Digest(digest.String()), nil
 }
 
-func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
+// contentMediaTypes are the media types of the layers which can contain the archive of a Helm chart or manifests, in
+// the order of their preference.
+var contentMediaTypes = []string{mediaTypeHelm, mediaTypeFluxContent, mediaTypeImageLayer}
+
+func extractContentLayer(image gcrv1.Image) ([]byte, error) {
 	layers, err := image.Layers()
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse layers: %w", err)
@@ -196,19 +221,21 @@ func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
 		return nil, fmt.Errorf("no layers found")
 	}
 
-	var layer gcrv1.Layer
+	var (
+		layer         gcrv1.Layer
+		layerPriority = len(contentMediaTypes)
+	)
 	for _, l := range layers {
 		mt, err := l.MediaType()
 		if err != nil {
 			return nil, err
 		}
-		if string(mt) == mediaTypeHelm {
-			layer = l
-			break
+		if priority := slices.Index(contentMediaTypes, string(mt)); priority >= 0 && priority < layerPriority {
+			layer, layerPriority = l, priority
 		}
 	}
 	if layer == nil {
-		return nil, fmt.Errorf("no helm layer found in artifact")
+		return nil, fmt.Errorf("no helm or manifests layer found in artifact")
 	}
 	blob, err := layer.Compressed()
 	if err != nil {
@@ -216,7 +243,7 @@ func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
 	}
 	raw, err := io.ReadAll(blob)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read content of helm layer: %w", err)
+		return nil, fmt.Errorf("failed to read content of layer: %w", err)
 	}
 	return raw, nil
 }
@@ -6,13 +6,30 @@ package oci
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
 	"fmt"
+	"net/http"
+	"strings"
 
 	_ "github.com/distribution/distribution/v3/registry/storage/driver/inmemory"
 	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -20,6 +37,7 @@ import (
 
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/cosign"
 )
 
 var _ = Describe("helmregistry", func() {
@@ -34,7 +52,7 @@ var _ = Describe("helmregistry", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		rc = &recordingCache{cache: newCache()}
+		rc = &recordingCache{cache: mustNewCache(CacheOptions{MaxSizeBytes: DefaultCacheMaxSizeBytes})}
 
 		caBundleSecret = &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
@@ -225,10 +243,237 @@ var _ = Describe("helmregistry", func() {
 		Expect(out).NotTo(BeEmpty())
 		Expect(authProvider.receivedAuthorization).To(Equal(fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte("foo:bar")))))
 	})
+
+	Context("verification", func() {
+		var (
+			repository string
+			signingKey *ecdsa.PrivateKey
+			remoteOpts []remote.Option
+		)
+
+		BeforeEach(func() {
+			certPool := x509.NewCertPool()
+			Expect(certPool.AppendCertsFromPEM(testCACert)).To(BeTrue())
+			transport := remote.DefaultTransport.(*http.Transport).Clone()
+			transport.TLSClientConfig = &tls.Config{RootCAs: certPool, MinVersion: tls.VersionTLS12}
+			remoteOpts = []remote.Option{remote.WithTransport(transport)}
+
+			// copy the example chart to a dedicated repository so that signatures do not affect other tests
+			repository = registryAddress + "/charts/verification-" + strings.ToLower(rand.Text())
+			chart, err := remote.Image(mustNewDigest(registryAddress+"/charts/example@"+exampleChartDigest), remoteOpts...)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(remote.Write(mustNewTag(repository+":0.1.0"), chart, remoteOpts...)).To(Succeed())
+
+			signingKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		sign := func(key *ecdsa.PrivateKey, manifestDigest string) {
+			payload := fmt.Appendf(nil, `{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, repository, manifestDigest)
+			hash := sha256.Sum256(payload)
+			signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+			Expect(err).NotTo(HaveOccurred())
+
+			signatureImage, err := mutate.Append(empty.Image, mutate.Addendum{
+				Layer:       static.NewLayer(payload, cosign.MediaTypeSimpleSigning),
+				Annotations: map[string]string{cosign.AnnotationKeySignature: base64.StdEncoding.EncodeToString(signature)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			signatureImage = mutate.MediaType(signatureImage, types.OCIManifestSchema1)
+
+			Expect(remote.Write(mustNewTag(repository+":"+cosign.SignatureTag(digest.Digest(exampleChartDigest))), signatureImage, remoteOpts...)).To(Succeed())
+		}
+
+		// attachBundle stores a sigstore bundle containing a signature of the given manifest digest as OCI referrer of the
+		// chart, like `cosign sign --new-bundle-format` does.
+		attachBundle := func(key *ecdsa.PrivateKey, manifestDigest string) {
+			rawDigest, err := hex.DecodeString(digest.Digest(manifestDigest).Encoded())
+			Expect(err).NotTo(HaveOccurred())
+			signature, err := ecdsa.SignASN1(rand.Reader, key, rawDigest)
+			Expect(err).NotTo(HaveOccurred())
+
+			bundle := fmt.Appendf(nil, `{"mediaType":%q,"verificationMaterial":{"publicKey":{"hint":""}},"messageSignature":{"messageDigest":{"algorithm":"SHA2_256","digest":%q},"signature":%q}}`,
+				"application/vnd.dev.sigstore.bundle.v0.3+json", base64.StdEncoding.EncodeToString(rawDigest), base64.StdEncoding.EncodeToString(signature))
+
+			chartDescriptor, err := remote.Head(mustNewDigest(repository+"@"+exampleChartDigest), remoteOpts...)
+			Expect(err).NotTo(HaveOccurred())
+
+			bundleImage, err := mutate.Append(empty.Image, mutate.Addendum{
+				Layer: static.NewLayer(bundle, "application/vnd.dev.sigstore.bundle.v0.3+json"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			bundleImage = mutate.MediaType(bundleImage, types.OCIManifestSchema1)
+			bundleImage = mutate.ConfigMediaType(bundleImage, "application/vnd.dev.sigstore.bundle.v0.3+json")
+			bundleImage = mutate.Subject(bundleImage, *chartDescriptor).(gcrv1.Image)
+
+			bundleDigest, err := bundleImage.Digest()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(remote.Write(mustNewDigest(repository+"@"+bundleDigest.String()), bundleImage, remoteOpts...)).To(Succeed())
+		}
+
+		publicKeyPEM := func(key *ecdsa.PrivateKey) string {
+			der, err := x509.MarshalPKIXPublicKey(key.Public())
+			Expect(err).NotTo(HaveOccurred())
+			return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+		}
+
+		ociRepository := func(publicKeys ...string) *gardencorev1.OCIRepository {
+			return &gardencorev1.OCIRepository{
+				Repository:        new(repository),
+				Tag:               new("0.1.0"),
+				CABundleSecretRef: &corev1.LocalObjectReference{Name: caBundleSecretName},
+				Verification:      &gardencorev1.OCIRepositoryVerification{PublicKeys: publicKeys},
+			}
+		}
+
+		It("should pull the chart if it is signed by a trusted key", func() {
+			sign(signingKey, exampleChartDigest)
+
+			out, err := hr.Pull(ctx, ociRepository(publicKeyPEM(signingKey)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+		})
+
+		It("should pull the chart if a bundle attached as referrer is signed by a trusted key", func() {
+			attachBundle(signingKey, exampleChartDigest)
+
+			out, err := hr.Pull(ctx, ociRepository(publicKeyPEM(signingKey)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+		})
+
+		It("should fail if a bundle attached as referrer is signed by an untrusted key", func() {
+			untrustedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			attachBundle(untrustedKey, exampleChartDigest)
+
+			_, err = hr.Pull(ctx, ociRepository(publicKeyPEM(signingKey)))
+			Expect(err).To(MatchError(ErrVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("bundle was not signed by any of the trusted public keys")))
+		})
+
+		It("should verify the signature even if the chart is cached", func() {
+			_, err := hr.Pull(ctx, &gardencorev1.OCIRepository{
+				Repository:        new(repository),
+				Tag:               new("0.1.0"),
+				CABundleSecretRef: &corev1.LocalObjectReference{Name: caBundleSecretName},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = hr.Pull(ctx, ociRepository(publicKeyPEM(signingKey)))
+			Expect(err).To(MatchError(ErrVerificationFailed))
+			Expect(rc.cacheHits).To(Equal(0))
+		})
+
+		It("should fail if the chart is not signed", func() {
+			_, err := hr.Pull(ctx, ociRepository(publicKeyPEM(signingKey)))
+			Expect(err).To(MatchError(ErrVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("no signature found")))
+		})
+
+		It("should fail if the chart is signed by an untrusted key", func() {
+			untrustedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			sign(untrustedKey, exampleChartDigest)
+
+			_, err = hr.Pull(ctx, ociRepository(publicKeyPEM(signingKey)))
+			Expect(err).To(MatchError(ErrVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("signature was not created by any of the trusted public keys")))
+		})
+
+		It("should fail if the signature refers to another artifact", func() {
+			sign(signingKey, "sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a")
+
+			_, err := hr.Pull(ctx, ociRepository(publicKeyPEM(signingKey)))
+			Expect(err).To(MatchError(ErrVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("signed payload refers to digest")))
+		})
+
+		It("should fail if the trusted public keys are invalid", func() {
+			_, err := hr.Pull(ctx, ociRepository("foo"))
+			Expect(err).To(MatchError(ErrVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("failed parsing trusted public keys")))
+		})
+
+		It("should build the verifier only once per verification policy", func() {
+			sign(signingKey, exampleChartDigest)
+
+			_, err := hr.Pull(ctx, ociRepository(publicKeyPEM(signingKey)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hr.verifiers).To(HaveLen(1))
+
+			_, err = hr.Pull(ctx, ociRepository(publicKeyPEM(signingKey)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hr.verifiers).To(HaveLen(1))
+
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = hr.Pull(ctx, ociRepository(publicKeyPEM(signingKey), publicKeyPEM(otherKey)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hr.verifiers).To(HaveLen(2))
+		})
+	})
+
+	Context("layers", func() {
+		var (
+			repository string
+			remoteOpts []remote.Option
+		)
+
+		BeforeEach(func() {
+			certPool := x509.NewCertPool()
+			Expect(certPool.AppendCertsFromPEM(testCACert)).To(BeTrue())
+			transport := remote.DefaultTransport.(*http.Transport).Clone()
+			transport.TLSClientConfig = &tls.Config{RootCAs: certPool, MinVersion: tls.VersionTLS12}
+			remoteOpts = []remote.Option{remote.WithTransport(transport)}
+
+			repository = registryAddress + "/manifests/layers-" + strings.ToLower(rand.Text())
+		})
+
+		push := func(layers map[types.MediaType]string) *gardencorev1.OCIRepository {
+			image := empty.Image
+			for mediaType, content := range layers {
+				var err error
+				image, err = mutate.AppendLayers(image, static.NewLayer([]byte(content), mediaType))
+				Expect(err).NotTo(HaveOccurred())
+			}
+			image = mutate.MediaType(image, types.OCIManifestSchema1)
+			Expect(remote.Write(mustNewTag(repository+":1.0.0"), image, remoteOpts...)).To(Succeed())
+
+			return &gardencorev1.OCIRepository{
+				Repository:        new(repository),
+				Tag:               new("1.0.0"),
+				CABundleSecretRef: &corev1.LocalObjectReference{Name: caBundleSecretName},
+			}
+		}
+
+		It("should pull the manifests of flux artifacts", func() {
+			out, err := hr.Pull(ctx, push(map[types.MediaType]string{"application/vnd.cncf.flux.content.v1.tar+gzip": "manifests"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(out)).To(Equal("manifests"))
+		})
+
+		It("should pull the manifests of generic artifacts", func() {
+			out, err := hr.Pull(ctx, push(map[types.MediaType]string{types.OCILayer: "manifests"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(out)).To(Equal("manifests"))
+		})
+
+		It("should prefer the helm layer", func() {
+			out, err := hr.Pull(ctx, push(map[types.MediaType]string{types.OCILayer: "manifests", "application/vnd.cncf.helm.chart.content.v1.tar+gzip": "chart"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(out)).To(Equal("chart"))
+		})
+
+		It("should fail if the artifact does not contain a helm or manifests layer", func() {
+			_, err := hr.Pull(ctx, push(map[types.MediaType]string{"text/plain": "foo"}))
+			Expect(err).To(MatchError("no helm or manifests layer found in artifact"))
+		})
+	})
 })
 
 type recordingCache struct {
-	cache     cacher
+	cache     Cache
 	cacheHits int
 }
 
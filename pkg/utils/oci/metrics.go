@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "gardener"
+	metricsSubsystem = "oci_helm_chart_cache"
+)
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	cacheHits = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "hits_total",
+		Help:      "Number of Helm charts which were served from the cache.",
+	})
+	cacheMisses = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "misses_total",
+		Help:      "Number of Helm charts which were not found in the cache.",
+	})
+	cacheEvictions = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "evictions_total",
+		Help:      "Number of Helm charts which were evicted from the cache.",
+	})
+	cacheSizeBytes = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "size_bytes",
+		Help:      "Total size of all Helm charts in the cache.",
+	})
+	cacheItems = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "items",
+		Help:      "Number of Helm charts in the cache.",
+	})
+)
@@ -78,6 +78,13 @@ var _ = BeforeSuite(func() {
 
 	registryAddress, err = startTestRegistry(ctx, certDir)
 	Expect(err).NotTo(HaveOccurred())
+	Eventually(func() error {
+		conn, err := net.Dial("tcp", registryAddress)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}).Should(Succeed())
 	rawChart, err = os.ReadFile("./testdata/example-0.1.0.tgz")
 	Expect(err).NotTo(HaveOccurred())
 	res, err := c.Push(rawChart, fmt.Sprintf("%s/charts/example:0.1.0", registryAddress))
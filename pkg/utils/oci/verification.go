@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/opencontainers/go-digest"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/cosign"
+)
+
+// maxSignaturePayloadSize is the maximum size of signed payloads which are fetched for verifying artifacts.
+const maxSignaturePayloadSize = 4 << 20
+
+// ErrVerificationFailed is returned (wrapped) if the signature of an artifact does not match the verification policy.
+var ErrVerificationFailed = errors.New("artifact verification failed")
+
+// verifier verifies the cosign signatures of artifacts according to a verification policy.
+type verifier struct {
+	publicKeys []crypto.PublicKey
+	keyless    *cosign.KeylessVerifier
+}
+
+func newVerifier(verification *gardencorev1.OCIRepositoryVerification) (*verifier, error) {
+	publicKeys, err := cosign.ParsePublicKeys(verification.PublicKeys)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed parsing trusted public keys: %w", ErrVerificationFailed, err)
+	}
+
+	v := &verifier{publicKeys: publicKeys}
+
+	if keyless := verification.Keyless; keyless != nil {
+		roots, intermediates, err := cosign.ParseCertificateAuthorities(keyless.CertificateAuthorities)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed parsing trusted certificate authorities: %w", ErrVerificationFailed, err)
+		}
+
+		transparencyLogPublicKeys, err := cosign.ParsePublicKeys(keyless.TransparencyLogPublicKeys)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed parsing trusted transparency log public keys: %w", ErrVerificationFailed, err)
+		}
+
+		certificateTransparencyLogPublicKeys, err := cosign.ParsePublicKeys(keyless.CertificateTransparencyLogPublicKeys)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed parsing trusted certificate transparency log public keys: %w", ErrVerificationFailed, err)
+		}
+
+		opts := cosign.KeylessOptions{
+			Roots:                                roots,
+			Intermediates:                        intermediates,
+			TransparencyLogPublicKeys:            transparencyLogPublicKeys,
+			CertificateTransparencyLogPublicKeys: certificateTransparencyLogPublicKeys,
+		}
+		for _, identity := range keyless.Identities {
+			opts.Identities = append(opts.Identities, cosign.Identity{Issuer: identity.Issuer, Subject: identity.Subject})
+		}
+
+		v.keyless, err = cosign.NewKeylessVerifier(opts)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed building keyless verifier: %w", ErrVerificationFailed, err)
+		}
+	}
+
+	return v, nil
+}
+
+// verifierFor returns the verifier for the given verification policy. The verifier is only built once per policy since
+// parsing the trusted keys and certificates is comparably expensive, and reused for all subsequent pulls.
+func (r *HelmRegistry) verifierFor(verification *gardencorev1.OCIRepositoryVerification) (*verifier, error) {
+	data, err := json.Marshal(verification)
+	if err != nil {
+		return nil, err
+	}
+	key := utils.ComputeSHA256Hex(data)
+
+	r.verifiersLock.Lock()
+	defer r.verifiersLock.Unlock()
+
+	if v, ok := r.verifiers[key]; ok {
+		return v, nil
+	}
+
+	v, err := newVerifier(verification)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.verifiers == nil {
+		r.verifiers = make(map[string]*verifier)
+	}
+	r.verifiers[key] = v
+	return v, nil
+}
+
+// verify verifies that the artifact with the given digest has a cosign signature which matches the verification
+// policy. Signatures are looked up in the legacy cosign format (stored under the signature tag) and as sigstore bundles
+// (stored as OCI referrers of the artifact). Errors caused by a failed verification (in contrast to, e.g., network
+// errors) wrap ErrVerificationFailed.
+func (v *verifier) verify(ref name.Digest, opts ...remote.Option) error {
+	manifestDigest := digest.Digest(ref.DigestStr())
+
+	verified, signatureErrs, err := v.verifySignatureTag(ref, manifestDigest, opts...)
+	if err != nil || verified {
+		return err
+	}
+
+	verified, bundleErrs, err := v.verifyBundleReferrers(ref, manifestDigest, opts...)
+	if err != nil || verified {
+		return err
+	}
+
+	errs := append(signatureErrs, bundleErrs...)
+	if len(errs) == 0 {
+		return fmt.Errorf("%w: no signature found (%s)", ErrVerificationFailed, ref)
+	}
+	return fmt.Errorf("%w: no valid signature found: %w", ErrVerificationFailed, errors.Join(errs...))
+}
+
+// verifySignatureTag verifies the signatures in the legacy cosign format which are stored under the signature tag of
+// the artifact with the given digest. It returns whether any signature is valid, and the verification errors of all
+// signatures otherwise.
+func (v *verifier) verifySignatureTag(ref name.Digest, manifestDigest digest.Digest, opts ...remote.Option) (bool, []error, error) {
+	signatureRef := ref.Context().Tag(cosign.SignatureTag(manifestDigest))
+
+	signatureImage, err := remote.Image(signatureRef, opts...)
+	if err != nil {
+		var transportErr *transport.Error
+		if errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound {
+			return false, nil, nil
+		}
+		return false, nil, fmt.Errorf("failed fetching signature %s: %w", signatureRef, err)
+	}
+
+	manifest, err := signatureImage.Manifest()
+	if err != nil {
+		return false, []error{fmt.Errorf("failed decoding signature manifest %s: %w", signatureRef, err)}, nil
+	}
+
+	var errs []error
+	for _, layer := range manifest.Layers {
+		if string(layer.MediaType) != cosign.MediaTypeSimpleSigning {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[cosign.AnnotationKeySignature])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed decoding signature of layer %s: %w", layer.Digest, err))
+			continue
+		}
+
+		payload, err := fetchPayload(signatureImage, layer)
+		if err != nil {
+			return false, nil, fmt.Errorf("failed fetching signed payload %s: %w", layer.Digest, err)
+		}
+
+		if err := v.verifyPayload(payload, signature, layer.Annotations, manifestDigest); err != nil {
+			errs = append(errs, fmt.Errorf("layer %s: %w", layer.Digest, err))
+			continue
+		}
+
+		return true, nil, nil
+	}
+
+	if len(errs) == 0 {
+		errs = append(errs, fmt.Errorf("signature manifest %s does not contain any signatures", signatureRef))
+	}
+	return false, errs, nil
+}
+
+func (v *verifier) verifyPayload(payload, signature []byte, annotations map[string]string, manifestDigest digest.Digest) error {
+	var errs []error
+
+	if len(v.publicKeys) > 0 {
+		err := cosign.VerifyPayload(payload, signature, manifestDigest, v.publicKeys)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+
+	if v.keyless != nil {
+		err := v.keyless.Verify(payload, signature, annotations, manifestDigest)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// verifyBundleReferrers verifies the sigstore bundles which are stored as OCI referrers of the artifact with the given
+// digest. It returns whether any bundle is valid, and the verification errors of all bundles otherwise.
+func (v *verifier) verifyBundleReferrers(ref name.Digest, manifestDigest digest.Digest, opts ...remote.Option) (bool, []error, error) {
+	referrers, err := remote.Referrers(ref, opts...)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed fetching referrers of %s: %w", ref, err)
+	}
+
+	index, err := referrers.IndexManifest()
+	if err != nil {
+		return false, []error{fmt.Errorf("failed decoding referrers of %s: %w", ref, err)}, nil
+	}
+
+	var errs []error
+	for _, descriptor := range index.Manifests {
+		if !cosign.IsBundleMediaType(descriptor.ArtifactType) {
+			continue
+		}
+
+		bundleRef := ref.Context().Digest(descriptor.Digest.String())
+		bundleImage, err := remote.Image(bundleRef, opts...)
+		if err != nil {
+			return false, nil, fmt.Errorf("failed fetching bundle %s: %w", bundleRef, err)
+		}
+
+		if err := v.verifyBundleImage(bundleImage, manifestDigest); err != nil {
+			errs = append(errs, fmt.Errorf("bundle %s: %w", descriptor.Digest, err))
+			continue
+		}
+
+		return true, nil, nil
+	}
+
+	return false, errs, nil
+}
+
+func (v *verifier) verifyBundleImage(bundleImage gcrv1.Image, manifestDigest digest.Digest) error {
+	manifest, err := bundleImage.Manifest()
+	if err != nil {
+		return fmt.Errorf("failed decoding bundle manifest: %w", err)
+	}
+
+	layerIndex := slices.IndexFunc(manifest.Layers, func(layer gcrv1.Descriptor) bool {
+		return cosign.IsBundleMediaType(string(layer.MediaType))
+	})
+	if layerIndex == -1 {
+		return errors.New("bundle manifest does not contain a bundle")
+	}
+
+	data, err := fetchPayload(bundleImage, manifest.Layers[layerIndex])
+	if err != nil {
+		return fmt.Errorf("failed fetching bundle: %w", err)
+	}
+
+	b, err := cosign.ParseBundle(data)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	if len(v.publicKeys) > 0 {
+		err := cosign.VerifyBundle(b, manifestDigest, v.publicKeys)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+
+	if v.keyless != nil {
+		err := v.keyless.VerifyBundle(b, manifestDigest)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func fetchPayload(image gcrv1.Image, descriptor gcrv1.Descriptor) ([]byte, error) {
+	if descriptor.Size > maxSignaturePayloadSize {
+		return nil, fmt.Errorf("size %d exceeds the maximum size of %d bytes", descriptor.Size, maxSignaturePayloadSize)
+	}
+
+	layer, err := image.LayerByDigest(descriptor.Digest)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rc.Close() }()
+
+	return io.ReadAll(io.LimitReader(rc, maxSignaturePayloadSize))
+}
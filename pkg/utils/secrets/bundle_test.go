@@ -5,8 +5,11 @@
 package secrets_test
 
 import (
+	"crypto"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/client-go/util/keyutil"
 
 	. "github.com/gardener/gardener/pkg/utils/secrets"
 )
@@ -79,6 +82,27 @@ var _ = Describe("Bundle Secrets", func() {
 
 				Expect(bundle.SecretData()).To(Equal(map[string][]byte{"bundle.key": bundle.Bundle}))
 			})
+
+			It("should generate a bundle of keys with different algorithms readable by Kubernetes", func() {
+				var (
+					privateKeyPEMs [][]byte
+					keys           []crypto.PublicKey
+				)
+				for _, keyAlgorithm := range []KeyAlgorithm{KeyAlgorithmRSA, KeyAlgorithmECDSAP256} {
+					obj, err := (&RSASecretConfig{Name: "key", Bits: 2048, KeyAlgorithm: keyAlgorithm}).Generate()
+					Expect(err).NotTo(HaveOccurred())
+					privateKeyPEMs = append(privateKeyPEMs, obj.SecretData()[DataKeyRSAPrivateKey])
+					keys = append(keys, obj.(*RSAKeys).Key.Public())
+				}
+				config.PrivateKeyPEMs = privateKeyPEMs
+
+				obj, err := config.Generate()
+				Expect(err).NotTo(HaveOccurred())
+
+				publicKeys, err := keyutil.ParsePublicKeysPEM(obj.SecretData()[DataKeyPrivateKeyBundle])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(publicKeys).To(Equal([]any{keys[0], keys[1]}))
+			})
 		})
 	})
 
@@ -5,10 +5,12 @@
 package secrets
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"fmt"
 	"math/big"
 	"net"
 	"os"
@@ -54,7 +56,7 @@ const (
 )
 
 // CertificateSecretConfig contains the specification a to-be-generated CA, server, or client certificate.
-// It always contains a 3072-bit RSA private key.
+// By default, it contains a 3072-bit RSA private key.
 type CertificateSecretConfig struct {
 	Name string
 
@@ -66,6 +68,15 @@ type CertificateSecretConfig struct {
 	CertType  CertType
 	SigningCA *Certificate
 	PKCS      int
+	// KeyAlgorithm is the algorithm of the private key. Defaults to KeyAlgorithmRSA. It is not considered for the
+	// checksum of the config, i.e., changing it does not cause the secrets manager to regenerate existing secrets.
+	// Instead, the new algorithm is used the next time the secret is rotated or renewed.
+	KeyAlgorithm KeyAlgorithm `hash:"ignore"`
+	// PrivateKey is an existing private key which is used instead of generating a new one, e.g., a signer for a key
+	// managed by a KeyBackend. It requires PrivateKeyReference to be set, and only the reference is stored in the secret
+	// data. This is only supported for self-signed CA certificates.
+	PrivateKey          crypto.Signer `hash:"ignore"`
+	PrivateKeyReference string        `hash:"ignore"`
 
 	Validity                          *time.Duration
 	SkipPublishingCACertificate       bool
@@ -82,8 +93,14 @@ type Certificate struct {
 	SkipPublishingCACertificate       bool
 	IncludeCACertificateInServerChain bool
 
-	PrivateKey    *rsa.PrivateKey
+	// PrivateKey is the private key of the certificate if it is an RSA key, otherwise it is nil.
+	PrivateKey *rsa.PrivateKey
+	// Signer is the private key of the certificate regardless of its algorithm.
+	Signer        crypto.Signer
 	PrivateKeyPEM []byte
+	// PrivateKeyReference is the reference to the private key if it is managed by a KeyBackend. In this case,
+	// PrivateKeyPEM is empty.
+	PrivateKeyReference string
 
 	Certificate    *x509.Certificate
 	CertificatePEM []byte
@@ -110,21 +127,25 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 	}
 
 	// If no cert type is given then we only return a certificate object that contains the CA.
+	if s.CertType != "" && s.PrivateKey != nil {
+		return s.generateCertificateForPrivateKey(certificateObj)
+	}
+
 	if s.CertType != "" {
-		privateKey, err := GenerateKey(rand.Reader, 3072)
+		privateKey, err := generatePrivateKey(s.KeyAlgorithm, 3072)
 		if err != nil {
 			return nil, err
 		}
 
 		var (
-			certificate       = s.generateCertificateTemplate()
+			certificate       = s.generateCertificateTemplate(privateKey)
 			certificateSigner = certificate
 			privateKeySigner  = privateKey
 		)
 
 		if s.SigningCA != nil {
 			certificateSigner = s.SigningCA.Certificate
-			privateKeySigner = s.SigningCA.PrivateKey
+			privateKeySigner = s.SigningCA.signer()
 		}
 
 		certificatePEM, err := signCertificate(certificate, privateKey, certificateSigner, privateKeySigner)
@@ -135,16 +156,15 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 		var pk []byte
 		switch s.PKCS {
 		case PKCS1:
-			pk = utils.EncodePrivateKey(privateKey)
+			pk, err = utils.EncodeAnyPrivateKey(privateKey)
 		case PKCS8:
-			pk, err = utils.EncodePrivateKeyInPKCS8(privateKey)
-
-			if err != nil {
-				return nil, err
-			}
+			pk, err = utils.EncodeAnyPrivateKeyInPKCS8(privateKey)
+		}
+		if err != nil {
+			return nil, err
 		}
 
-		certificateObj.PrivateKey = privateKey
+		certificateObj.setPrivateKey(privateKey)
 		certificateObj.PrivateKeyPEM = pk
 		certificateObj.Certificate = certificate
 		certificateObj.CertificatePEM = certificatePEM
@@ -153,6 +173,27 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 	return certificateObj, nil
 }
 
+func (s *CertificateSecretConfig) generateCertificateForPrivateKey(certificateObj *Certificate) (*Certificate, error) {
+	if s.CertType != CACert || s.SigningCA != nil {
+		return nil, fmt.Errorf("existing private keys are only supported for self-signed CA certificates")
+	}
+	if len(s.PrivateKeyReference) == 0 {
+		return nil, fmt.Errorf("private key reference must be set when using an existing private key")
+	}
+
+	certificate := s.generateCertificateTemplate(s.PrivateKey)
+	certificatePEM, err := signCertificate(certificate, s.PrivateKey, certificate, s.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	certificateObj.setPrivateKey(s.PrivateKey)
+	certificateObj.PrivateKeyReference = s.PrivateKeyReference
+	certificateObj.Certificate = certificate
+	certificateObj.CertificatePEM = certificatePEM
+	return certificateObj, nil
+}
+
 // SecretData computes the data map which can be used in a Kubernetes secret.
 func (c *Certificate) SecretData() map[string][]byte {
 	data := map[string][]byte{}
@@ -166,7 +207,11 @@ func (c *Certificate) SecretData() map[string][]byte {
 		// The certificate is a CA certificate itself, so we use different keys in the secret data (for backwards-
 		// compatibility).
 		data[DataKeyCertificateCA] = c.CertificatePEM
-		data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		if len(c.PrivateKeyReference) > 0 {
+			data[DataKeyPrivateKeyReferenceCA] = []byte(c.PrivateKeyReference)
+		} else {
+			data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		}
 
 	case c.CA != nil:
 		cert := c.CertificatePEM
@@ -189,7 +234,7 @@ func (c *Certificate) SecretData() map[string][]byte {
 // LoadCertificate takes a byte slice representation of a certificate and the corresponding private key, and returns its de-serialized private
 // key, certificate template and PEM certificate which can be used to sign other x509 certificates.
 func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certificate, error) {
-	privateKey, err := utils.DecodePrivateKey(privateKeyPEM)
+	privateKey, err := utils.DecodeAnyPrivateKey(privateKeyPEM)
 	if err != nil {
 		return nil, err
 	}
@@ -198,22 +243,41 @@ func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certif
 		return nil, err
 	}
 
-	return &Certificate{
+	certificateObj := &Certificate{
 		Name: name,
 
-		PrivateKey:    privateKey,
 		PrivateKeyPEM: privateKeyPEM,
 
 		Certificate:    certificate,
 		CertificatePEM: certificatePEM,
-	}, nil
+	}
+	certificateObj.setPrivateKey(privateKey)
+
+	return certificateObj, nil
+}
+
+func (c *Certificate) setPrivateKey(privateKey crypto.Signer) {
+	c.Signer = privateKey
+	c.PrivateKey, _ = privateKey.(*rsa.PrivateKey)
+}
+
+// signer returns the private key used for signing. It falls back to the RSA private key for certificate objects
+// which were constructed without setting the Signer field.
+func (c *Certificate) signer() crypto.Signer {
+	if c.Signer != nil {
+		return c.Signer
+	}
+	if c.PrivateKey != nil {
+		return c.PrivateKey
+	}
+	return nil
 }
 
 // generateCertificateTemplate creates a X509 Certificate object based on the provided information regarding
 // common name, organization, SANs (DNS names and IP addresses). It can create a server or a client certificate
 // or both, depending on the <certType> value. If <isCACert> is true, then a CA certificate is being created.
-// The certificates a valid for 10 years.
-func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificate {
+// The certificates a valid for 10 years. The key encipherment usage is only set for RSA keys.
+func (s *CertificateSecretConfig) generateCertificateTemplate(privateKey crypto.Signer) *x509.Certificate {
 	now := Clock.Now()
 
 	expiration := now.AddDate(10, 0, 0) // + 10 years
@@ -231,7 +295,7 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 			SerialNumber:          serialNumber,
 			NotBefore:             AdjustToClockSkew(now),
 			NotAfter:              expiration,
-			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			KeyUsage:              x509.KeyUsageDigitalSignature,
 			Subject: pkix.Name{
 				CommonName:   s.CommonName,
 				Organization: s.Organization,
@@ -241,6 +305,10 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 		}
 	)
 
+	if isRSAKey(privateKey) {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
 	switch s.CertType {
 	case CACert:
 		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
@@ -258,8 +326,8 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
 // the first. It also requires the corresponding private keys of both certificates. The created certificate
 // is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+func signCertificate(certificateTemplate *x509.Certificate, privateKey crypto.Signer, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, privateKey.Public(), privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
@@ -5,8 +5,11 @@
 package secrets
 
 import (
+	"crypto/tls"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/clientcmd"
 	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/yaml"
 )
@@ -244,5 +247,37 @@ var _ = Describe("utils", func() {
 				})
 			})
 		})
+
+		DescribeTable("#Generate with kubeconfig requests",
+			func(keyAlgorithm KeyAlgorithm) {
+				ca, err := (&CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: CACert}).GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				obj, err := (&ControlPlaneSecretConfig{
+					Name: "client",
+					CertificateSecretConfig: &CertificateSecretConfig{
+						CommonName:   "client",
+						CertType:     ClientCert,
+						SigningCA:    ca,
+						KeyAlgorithm: keyAlgorithm,
+					},
+					KubeConfigRequests: []KubeConfigRequest{{
+						ClusterName:   "test-cluster",
+						APIServerHost: "kube-apiserver",
+						CAData:        ca.CertificatePEM,
+					}},
+				}).Generate()
+				Expect(err).NotTo(HaveOccurred())
+
+				restConfig, err := clientcmd.RESTConfigFromKubeConfig(obj.SecretData()[DataKeyKubeconfig])
+				Expect(err).NotTo(HaveOccurred())
+				_, err = tls.X509KeyPair(restConfig.CertData, restConfig.KeyData)
+				Expect(err).NotTo(HaveOccurred())
+			},
+
+			Entry("RSA", KeyAlgorithmRSA),
+			Entry("ECDSA", KeyAlgorithmECDSAP256),
+			Entry("Ed25519", KeyAlgorithmEd25519),
+		)
 	})
 })
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// KeyBackend is an in-memory implementation of secretsutils.KeyBackend which can be used in unit tests.
+type KeyBackend struct {
+	lock    sync.RWMutex
+	keys    map[string]crypto.Signer
+	counter int
+}
+
+var _ secretsutils.KeyBackend = &KeyBackend{}
+
+// NewKeyBackend returns a new in-memory key backend.
+func NewKeyBackend() *KeyBackend {
+	return &KeyBackend{keys: make(map[string]crypto.Signer)}
+}
+
+// CreateKey implements secretsutils.KeyBackend.
+func (b *KeyBackend) CreateKey(_ context.Context, name string, algorithm secretsutils.KeyAlgorithm) (string, error) {
+	var (
+		key crypto.Signer
+		err error
+	)
+
+	switch algorithm {
+	case "", secretsutils.KeyAlgorithmRSA:
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case secretsutils.KeyAlgorithmECDSAP256:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case secretsutils.KeyAlgorithmECDSAP384:
+		key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case secretsutils.KeyAlgorithmEd25519:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		err = fmt.Errorf("unsupported key algorithm %q", algorithm)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.counter++
+	keyRef := fmt.Sprintf("fake://%s/%d", name, b.counter)
+	b.keys[keyRef] = key
+	return keyRef, nil
+}
+
+// Signer implements secretsutils.KeyBackend.
+func (b *KeyBackend) Signer(_ context.Context, keyRef string) (crypto.Signer, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	key, ok := b.keys[keyRef]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found", keyRef)
+	}
+	// Wrap the key so that callers cannot rely on the concrete private key type, like for keys in a real backend.
+	return &signer{key: key}, nil
+}
+
+// DeleteKey implements secretsutils.KeyBackend.
+func (b *KeyBackend) DeleteKey(_ context.Context, keyRef string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.keys, keyRef)
+	return nil
+}
+
+// KeyReferences returns the sorted references of all keys stored in the backend.
+func (b *KeyBackend) KeyReferences() []string {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	keyRefs := make([]string, 0, len(b.keys))
+	for keyRef := range b.keys {
+		keyRefs = append(keyRefs, keyRef)
+	}
+	slices.Sort(keyRefs)
+	return keyRefs
+}
+
+type signer struct {
+	key crypto.Signer
+}
+
+func (s *signer) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+func (s *signer) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	return s.key.Sign(rand, digest, opts)
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	. "github.com/gardener/gardener/pkg/utils/secrets/fake"
+)
+
+var _ = Describe("KeyBackend", func() {
+	var (
+		ctx        = context.TODO()
+		keyBackend *KeyBackend
+	)
+
+	BeforeEach(func() {
+		keyBackend = NewKeyBackend()
+	})
+
+	DescribeTable("should create keys and return signers for them",
+		func(algorithm secretsutils.KeyAlgorithm, publicKey any) {
+			keyRef, err := keyBackend.CreateKey(ctx, "foo", algorithm)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keyBackend.KeyReferences()).To(ConsistOf(keyRef))
+
+			signer, err := keyBackend.Signer(ctx, keyRef)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer.Public()).To(BeAssignableToTypeOf(publicKey))
+
+			var (
+				digest                   = []byte("message")
+				opts   crypto.SignerOpts = crypto.Hash(0)
+			)
+			if algorithm != secretsutils.KeyAlgorithmEd25519 {
+				sum := sha256.Sum256(digest)
+				digest, opts = sum[:], crypto.SHA256
+			}
+			Expect(signer.Sign(rand.Reader, digest, opts)).NotTo(BeEmpty())
+		},
+
+		Entry("RSA", secretsutils.KeyAlgorithmRSA, &rsa.PublicKey{}),
+		Entry("ECDSA-P256", secretsutils.KeyAlgorithmECDSAP256, &ecdsa.PublicKey{}),
+		Entry("ECDSA-P384", secretsutils.KeyAlgorithmECDSAP384, &ecdsa.PublicKey{}),
+		Entry("Ed25519", secretsutils.KeyAlgorithmEd25519, ed25519.PublicKey{}),
+	)
+
+	It("should fail for unsupported algorithms", func() {
+		_, err := keyBackend.CreateKey(ctx, "foo", "DSA")
+		Expect(err).To(MatchError(ContainSubstring("unsupported key algorithm")))
+	})
+
+	It("should delete keys", func() {
+		keyRef, err := keyBackend.CreateKey(ctx, "foo", secretsutils.KeyAlgorithmECDSAP256)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(keyBackend.DeleteKey(ctx, keyRef)).To(Succeed())
+		Expect(keyBackend.DeleteKey(ctx, keyRef)).To(Succeed())
+		Expect(keyBackend.KeyReferences()).To(BeEmpty())
+
+		_, err = keyBackend.Signer(ctx, keyRef)
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+	})
+})
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"reflect"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// DataKeyPrivateKeyReferenceCA is the key in a secret data holding the reference to the CA private key managed by a
+// KeyBackend. It is used instead of DataKeyPrivateKeyCA if the private key does not leave the backend.
+const DataKeyPrivateKeyReferenceCA = "ca.key-ref"
+
+// KeyBackend manages private keys in an external key management system (KMS) or hardware security module (HSM), e.g.,
+// the transit secrets engine of HashiCorp Vault. The private keys never leave the backend, only references to them are
+// stored in secrets.
+type KeyBackend interface {
+	// CreateKey creates a new private key with the given algorithm and returns a reference to it. The name identifies
+	// the secret the key is created for.
+	CreateKey(ctx context.Context, name string, algorithm KeyAlgorithm) (string, error)
+	// Signer returns a signer for the private key with the given reference. Since crypto.Signer does not take a
+	// context, signing requests of the returned signer are bound to the given context.
+	Signer(ctx context.Context, keyRef string) (crypto.Signer, error)
+	// DeleteKey deletes the private key with the given reference. It must not return an error if the key does not
+	// exist.
+	DeleteKey(ctx context.Context, keyRef string) error
+}
+
+// LoadCertificateFromKeyBackend takes a byte slice representation of a certificate and the reference to the
+// corresponding private key managed by the given backend, and returns a certificate which can be used to sign other
+// x509 certificates.
+func LoadCertificateFromKeyBackend(ctx context.Context, name string, backend KeyBackend, keyRef string, certificatePEM []byte) (*Certificate, error) {
+	certificate, err := utils.DecodeCertificate(certificatePEM)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := backend.Signer(ctx, keyRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting signer for private key %q: %w", keyRef, err)
+	}
+
+	if publicKey, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool }); !ok || !publicKey.Equal(certificate.PublicKey) {
+		return nil, fmt.Errorf("public key of private key %q (%s) does not match certificate", keyRef, reflect.TypeOf(signer.Public()))
+	}
+
+	return &Certificate{
+		Name: name,
+
+		Signer:              signer,
+		PrivateKeyReference: keyRef,
+
+		Certificate:    certificate,
+		CertificatePEM: certificatePEM,
+	}, nil
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"context"
+	"crypto/x509"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+	fakesecretsutils "github.com/gardener/gardener/pkg/utils/secrets/fake"
+)
+
+var _ = Describe("KeyBackend", func() {
+	var (
+		ctx        = context.TODO()
+		keyBackend *fakesecretsutils.KeyBackend
+		keyRef     string
+		caConfig   *CertificateSecretConfig
+	)
+
+	BeforeEach(func() {
+		keyBackend = fakesecretsutils.NewKeyBackend()
+
+		var err error
+		keyRef, err = keyBackend.CreateKey(ctx, "ca", KeyAlgorithmECDSAP256)
+		Expect(err).NotTo(HaveOccurred())
+		signer, err := keyBackend.Signer(ctx, keyRef)
+		Expect(err).NotTo(HaveOccurred())
+
+		caConfig = &CertificateSecretConfig{
+			Name:                "ca",
+			CommonName:          "ca",
+			CertType:            CACert,
+			PrivateKey:          signer,
+			PrivateKeyReference: keyRef,
+		}
+	})
+
+	Describe("#GenerateCertificate", func() {
+		It("should generate a CA certificate for the existing private key and only store its reference", func() {
+			ca, err := caConfig.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ca.PrivateKeyPEM).To(BeEmpty())
+			Expect(ca.PrivateKeyReference).To(Equal(keyRef))
+			Expect(ca.SecretData()).To(Equal(map[string][]byte{
+				"ca.crt":     ca.CertificatePEM,
+				"ca.key-ref": []byte(keyRef),
+			}))
+
+			certificate, err := utils.DecodeCertificate(ca.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificate.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+			Expect(certificate.CheckSignatureFrom(certificate)).To(Succeed())
+		})
+
+		It("should fail if the private key reference is not set", func() {
+			caConfig.PrivateKeyReference = ""
+
+			_, err := caConfig.GenerateCertificate()
+			Expect(err).To(MatchError(ContainSubstring("private key reference must be set")))
+		})
+
+		It("should fail for non-CA certificates", func() {
+			caConfig.CertType = ServerCert
+
+			_, err := caConfig.GenerateCertificate()
+			Expect(err).To(MatchError(ContainSubstring("only supported for self-signed CA certificates")))
+		})
+	})
+
+	Describe("#LoadCertificateFromKeyBackend", func() {
+		It("should load the CA and sign certificates with the private key in the backend", func() {
+			ca, err := caConfig.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			loadedCA, err := LoadCertificateFromKeyBackend(ctx, "ca", keyBackend, keyRef, ca.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loadedCA.PrivateKeyReference).To(Equal(keyRef))
+
+			serverConfig := &CertificateSecretConfig{
+				Name:       "server",
+				CommonName: "server",
+				CertType:   ServerCert,
+				SigningCA:  loadedCA,
+			}
+			server, err := serverConfig.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			caCertificate, err := utils.DecodeCertificate(ca.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			serverCertificate, err := utils.DecodeCertificate(server.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serverCertificate.CheckSignatureFrom(caCertificate)).To(Succeed())
+		})
+
+		It("should fail if the key does not exist", func() {
+			ca, err := caConfig.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keyBackend.DeleteKey(ctx, keyRef)).To(Succeed())
+
+			_, err = LoadCertificateFromKeyBackend(ctx, "ca", keyBackend, keyRef, ca.CertificatePEM)
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+		})
+
+		It("should fail if the key does not match the certificate", func() {
+			ca, err := caConfig.GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			otherKeyRef, err := keyBackend.CreateKey(ctx, "other", KeyAlgorithmECDSAP256)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = LoadCertificateFromKeyBackend(ctx, "ca", keyBackend, otherKeyRef, ca.CertificatePEM)
+			Expect(err).To(MatchError(ContainSubstring("does not match certificate")))
+		})
+	})
+})
@@ -6,10 +6,12 @@ package manager
 
 import (
 	"context"
+	"fmt"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
 func (m *manager) Cleanup(ctx context.Context) error {
@@ -34,6 +36,15 @@ func (m *manager) Cleanup(ctx context.Context) error {
 		}
 
 		fns = append(fns, func(ctx context.Context) error {
+			// Delete the private key first so that it is not leaked if the deletion of the secret succeeds but the
+			// deletion of the key fails.
+			if keyRef, ok := secret.Data[secretsutils.DataKeyPrivateKeyReferenceCA]; ok && m.opts.KeyBackend != nil {
+				m.logger.Info("Deleting private key of stale secret from key backend", "secret", client.ObjectKeyFromObject(&secret), "keyRef", string(keyRef))
+				if err := m.opts.KeyBackend.DeleteKey(ctx, string(keyRef)); err != nil {
+					return fmt.Errorf("failed deleting private key %q of secret %s from key backend: %w", string(keyRef), client.ObjectKeyFromObject(&secret), err)
+				}
+			}
+
 			m.logger.Info("Deleting stale secret", "secret", client.ObjectKeyFromObject(&secret))
 			return client.IgnoreNotFound(m.client.Delete(ctx, &secret))
 		})
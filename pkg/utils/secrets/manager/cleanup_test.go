@@ -17,6 +17,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	fakesecretsutils "github.com/gardener/gardener/pkg/utils/secrets/fake"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -108,6 +110,32 @@ var _ = Describe("Cleanup", func() {
 			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secretsInNamespace2[7]), &corev1.Secret{})).To(BeNotFoundError())
 		})
 
+		It("should delete the private keys of stale CA secrets from the key backend", func() {
+			keyBackend := fakesecretsutils.NewKeyBackend()
+			mgr, err := New(ctx, logr.Discard(), clock.RealClock{}, fakeClient, testIdentity, WithNamespaces(namespace), WithKeyBackend(keyBackend))
+			Expect(err).NotTo(HaveOccurred())
+			m = mgr.(*manager)
+
+			currentKeyRef, err := keyBackend.CreateKey(ctx, "current", secretsutils.KeyAlgorithmECDSAP256)
+			Expect(err).NotTo(HaveOccurred())
+			staleKeyRef, err := keyBackend.CreateKey(ctx, "stale", secretsutils.KeyAlgorithmECDSAP256)
+			Expect(err).NotTo(HaveOccurred())
+
+			secrets := secretList(testIdentity, namespace)[:2]
+			secrets[0].Data = map[string][]byte{"ca.crt": []byte("current"), "ca.key-ref": []byte(currentKeyRef)}
+			secrets[1].Data = map[string][]byte{"ca.crt": []byte("stale"), "ca.key-ref": []byte(staleKeyRef)}
+			for i := range secrets {
+				Expect(fakeClient.Create(ctx, secrets[i])).To(Succeed())
+			}
+			Expect(m.addToStore("first", secrets[0], current)).To(Succeed())
+
+			Expect(m.Cleanup(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[0]), &corev1.Secret{})).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[1]), &corev1.Secret{})).To(BeNotFoundError())
+			Expect(keyBackend.KeyReferences()).To(ConsistOf(currentKeyRef))
+		})
+
 		It("should not touch secrets from other manager instance", func() {
 			secrets := secretList(testIdentity, "other")
 			for i := range secrets {
@@ -6,6 +6,7 @@ package manager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
@@ -28,6 +29,14 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 		return nil, fmt.Errorf("failed applying generate options for config %s: %w", config.GetName(), err)
 	}
 
+	if options.signingCA != nil {
+		ca, err := m.loadCertificateAuthority(ctx, options.signingCA.name, options.signingCA.data)
+		if err != nil {
+			return nil, fmt.Errorf("failed loading signing CA %s for config %s: %w", options.signingCA.name, config.GetName(), err)
+		}
+		certificateSecretConfig(config).SigningCA = ca
+	}
+
 	var bundleFor *string
 	if options.isBundleSecret {
 		bundleFor = new(strings.TrimSuffix(config.GetName(), nameSuffixBundle))
@@ -72,6 +81,7 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 	if err := m.maintainLifetimeLabels(config, secret, desiredLabels, options.Validity, options.RenewAfterValidityPercentage); err != nil {
 		return nil, fmt.Errorf("failed maintaining lifetime labels on secret %s for config %s: %w", client.ObjectKeyFromObject(secret), config.GetName(), err)
 	}
+	m.maintainRenewalLabels(config, desiredLabels, options.RotationStrategy)
 
 	if !options.isBundleSecret {
 		if err := m.addToStore(config.GetName(), secret, current); err != nil {
@@ -104,20 +114,27 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		certConfig.CommonName = objectMeta.Name
 	}
 
+	m.defaultKeyAlgorithm(config)
+
+	keyRef, err := m.createKeyInBackendIfNeeded(ctx, config, objectMeta)
+	if err != nil {
+		return nil, err
+	}
+
 	data, err := config.Generate()
 	if err != nil {
-		return nil, fmt.Errorf("failed generating data: %w", err)
+		return nil, errors.Join(fmt.Errorf("failed generating data: %w", err), m.deleteUnusedKeyFromBackend(ctx, keyRef, nil))
 	}
 
 	dataMap, err := m.keepExistingSecretsIfNeeded(ctx, config.GetName(), data.SecretData(), objectMeta.Namespace)
 	if err != nil {
-		return nil, fmt.Errorf("failed taking over data from existing secret when needed: %w", err)
+		return nil, errors.Join(fmt.Errorf("failed taking over data from existing secret when needed: %w", err), m.deleteUnusedKeyFromBackend(ctx, keyRef, nil))
 	}
 
 	secret := Secret(objectMeta, dataMap)
 	if err := m.client.Create(ctx, secret); err != nil {
 		if !apierrors.IsAlreadyExists(err) {
-			return nil, fmt.Errorf("failed creating new secret: %w", err)
+			return nil, errors.Join(fmt.Errorf("failed creating new secret: %w", err), m.deleteUnusedKeyFromBackend(ctx, keyRef, nil))
 		}
 
 		if err := m.client.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
@@ -125,10 +142,65 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		}
 	}
 
+	// The key is not used if the data of an existing secret was taken over or if the secret was created concurrently.
+	if err := m.deleteUnusedKeyFromBackend(ctx, keyRef, secret.Data); err != nil {
+		return nil, err
+	}
+
 	m.logger.Info("Generated new secret", "configName", config.GetName(), "secret", client.ObjectKeyFromObject(secret))
 	return secret, nil
 }
 
+func (m *manager) createKeyInBackendIfNeeded(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta) (string, error) {
+	if m.opts.KeyBackend == nil || slices.Contains(m.opts.KeyBackendExcludedConfigNames, config.GetName()) {
+		return "", nil
+	}
+
+	certConfig, ok := config.(*secretsutils.CertificateSecretConfig)
+	if !ok || certConfig.CertType != secretsutils.CACert || certConfig.SigningCA != nil {
+		return "", nil
+	}
+
+	keyRef, err := m.opts.KeyBackend.CreateKey(ctx, objectMeta.Namespace+"/"+objectMeta.Name, certConfig.KeyAlgorithm)
+	if err != nil {
+		return "", fmt.Errorf("failed creating private key in key backend: %w", err)
+	}
+
+	signer, err := m.opts.KeyBackend.Signer(ctx, keyRef)
+	if err != nil {
+		return "", errors.Join(fmt.Errorf("failed getting signer for private key %q: %w", keyRef, err), m.deleteUnusedKeyFromBackend(ctx, keyRef, nil))
+	}
+
+	certConfig.PrivateKey, certConfig.PrivateKeyReference = signer, keyRef
+	return keyRef, nil
+}
+
+func (m *manager) deleteUnusedKeyFromBackend(ctx context.Context, keyRef string, data map[string][]byte) error {
+	if len(keyRef) == 0 || string(data[secretsutils.DataKeyPrivateKeyReferenceCA]) == keyRef {
+		return nil
+	}
+
+	if err := m.opts.KeyBackend.DeleteKey(ctx, keyRef); err != nil {
+		return fmt.Errorf("failed deleting unused private key %q from key backend: %w", keyRef, err)
+	}
+	return nil
+}
+
+func (m *manager) defaultKeyAlgorithm(config secretsutils.ConfigInterface) {
+	if len(m.opts.KeyAlgorithm) == 0 {
+		return
+	}
+
+	if certConfig := certificateSecretConfig(config); certConfig != nil && len(certConfig.KeyAlgorithm) == 0 {
+		certConfig.KeyAlgorithm = m.opts.KeyAlgorithm
+	}
+
+	if rsaConfig, ok := config.(*secretsutils.RSASecretConfig); ok && len(rsaConfig.KeyAlgorithm) == 0 &&
+		(m.opts.KeyAlgorithm != secretsutils.KeyAlgorithmEd25519 || rsaConfig.UsedForSSH) {
+		rsaConfig.KeyAlgorithm = m.opts.KeyAlgorithm
+	}
+}
+
 func (m *manager) keepExistingSecretsIfNeeded(ctx context.Context, configName string, newData map[string][]byte, namespace string) (map[string][]byte, error) {
 	existingSecrets := &corev1.SecretList{}
 	if err := m.client.List(ctx, existingSecrets, client.InNamespace(namespace), client.MatchingLabels{LabelKeyUseDataForName: configName}); err != nil {
@@ -334,6 +406,30 @@ func (m *manager) maintainLifetimeLabels(
 	return nil
 }
 
+func (m *manager) maintainRenewalLabels(config secretsutils.ConfigInterface, desiredLabels map[string]string, strategy rotationStrategy) {
+	if len(strategy) > 0 {
+		desiredLabels[LabelKeyRotationStrategy] = string(strategy)
+	}
+
+	if _, ok := desiredLabels[LabelKeyValidUntilTime]; ok {
+		desiredLabels[LabelKeyAutoRenewal] = strconv.FormatBool(m.isAutomaticallyRenewed(config))
+	}
+}
+
+// isAutomaticallyRenewed returns whether secrets for the given config are automatically renewed before they expire,
+// see prepareExpiringSecretsForAutoRenewal.
+func (m *manager) isAutomaticallyRenewed(config secretsutils.ConfigInterface) bool {
+	if m.opts.DisableAutomaticSecretRenewal {
+		return false
+	}
+
+	if certConfig := certificateSecretConfig(config); certConfig != nil && certConfig.CertType == secretsutils.CACert {
+		return m.opts.CASecretAutoRotation
+	}
+
+	return true
+}
+
 func (m *manager) reconcileSecret(ctx context.Context, secret *corev1.Secret, labels map[string]string) error {
 	patch := client.MergeFrom(secret.DeepCopy())
 
@@ -394,10 +490,18 @@ type GenerateOptions struct {
 	// Labels are additional labels that should be added to the secret.
 	Labels map[string]string
 
+	signingCA         *signingCA
 	signingCAChecksum *string
 	isBundleSecret    bool
 }
 
+// signingCA is the secret of the CA which should sign a certificate. It is loaded by Generate since loading CAs whose
+// private keys are managed by a key backend requires a context.
+type signingCA struct {
+	name string
+	data map[string][]byte
+}
+
 type rotationStrategy string
 
 const (
@@ -471,8 +575,8 @@ func LoadMissingCAFromCluster(ctx context.Context) SignedByCAOption {
 	})
 }
 
-// SignedByCA returns a function which sets the 'SigningCA' field in case the ConfigInterface provided to the
-// Generate request is a CertificateSecretConfig. Additionally, in such case it stores a checksum of the signing
+// SignedByCA returns a function which makes Generate set the 'SigningCA' field in case the ConfigInterface provided to
+// the Generate request is a CertificateSecretConfig. Additionally, in such case it stores a checksum of the signing
 // CA in the options.
 func SignedByCA(name string, opts ...SignedByCAOption) GenerateOption {
 	signedByCAOptions := &SignedByCAOptions{}
@@ -518,12 +622,7 @@ func SignedByCA(name string, opts ...SignedByCAOption) GenerateOption {
 			}
 		}
 
-		ca, err := secretsutils.LoadCertificate(name, secret.obj.Data[secretsutils.DataKeyPrivateKeyCA], secret.obj.Data[secretsutils.DataKeyCertificateCA])
-		if err != nil {
-			return err
-		}
-
-		certificateConfig.SigningCA = ca
+		options.signingCA = &signingCA{name: name, data: secret.obj.Data}
 		options.signingCAChecksum = new(kubernetesutils.TruncateLabelValue(secret.dataChecksum))
 		return nil
 	}
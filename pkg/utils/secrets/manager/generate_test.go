@@ -6,6 +6,8 @@ package manager
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
 	"fmt"
 	"sort"
 	"strconv"
@@ -18,11 +20,15 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	certutil "k8s.io/client-go/util/cert"
 	testclock "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	"github.com/gardener/gardener/pkg/utils"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	fakesecretsutils "github.com/gardener/gardener/pkg/utils/secrets/fake"
 	"github.com/gardener/gardener/pkg/utils/test"
 )
 
@@ -492,6 +498,76 @@ var _ = Describe("Generate", func() {
 				Expect(secret.Name).To(Equal(name))
 			})
 
+			It("should generate a new CA secret with the configured key algorithm", func() {
+				config.KeyAlgorithm = secretsutils.KeyAlgorithmECDSAP256
+
+				By("Generate new secret")
+				secret, err := m.Generate(ctx, config)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret.Name).To(Equal(name+"-54620669"), "key algorithm must not be considered for the secret name")
+				expectSecretWasCreated(ctx, fakeClient, secret)
+
+				cert, err := secretsutils.LoadCertificate("", secret.Data["ca.key"], secret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.Signer).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+				Expect(cert.Certificate.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+			})
+
+			It("should use the default key algorithm of the secrets manager", func() {
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, WithNamespaces(namespace), WithKeyAlgorithm(secretsutils.KeyAlgorithmEd25519))
+				Expect(err).NotTo(HaveOccurred())
+				m = mgr.(*manager)
+
+				By("Generate new secret")
+				secret, err := m.Generate(ctx, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				cert, err := secretsutils.LoadCertificate("", secret.Data["ca.key"], secret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.Certificate.PublicKeyAlgorithm).To(Equal(x509.Ed25519))
+			})
+
+			It("should keep an existing RSA CA secret when the key algorithm changes and migrate it on rotation", func() {
+				By("Generate new secret")
+				secret, err := m.Generate(ctx, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("Change key algorithm and generate again")
+				config = &secretsutils.CertificateSecretConfig{
+					Name:         name,
+					CommonName:   commonName,
+					CertType:     secretsutils.CACert,
+					KeyAlgorithm: secretsutils.KeyAlgorithmECDSAP384,
+				}
+				sameSecret, err := m.Generate(ctx, config)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sameSecret.Name).To(Equal(secret.Name))
+				Expect(sameSecret.Data).To(Equal(secret.Data))
+
+				By("Rotate secret")
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, WithSecretNamesToTimes(map[string]time.Time{name: time.Now()}), WithNamespaces(namespace))
+				Expect(err).NotTo(HaveOccurred())
+				m = mgr.(*manager)
+
+				newSecret, err := m.Generate(ctx, config, Rotate(KeepOld))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(newSecret.Name).NotTo(Equal(secret.Name))
+
+				newCert, err := secretsutils.LoadCertificate("", newSecret.Data["ca.key"], newSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(newCert.Certificate.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+
+				By("Verify bundle contains old RSA and new ECDSA CA certificate")
+				secretInfos, found := m.getFromStore(name)
+				Expect(found).To(BeTrue())
+				certs, err := certutil.ParseCertsPEM(secretInfos.bundle.obj.Data["bundle.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(certs).To(ConsistOf(
+					HaveField("PublicKeyAlgorithm", x509.ECDSA),
+					HaveField("PublicKeyAlgorithm", x509.RSA),
+				))
+			})
+
 			It("should rotate a CA secret and add old and new to the corresponding bundle", func() {
 				By("Generate new secret")
 				secret, err := m.Generate(ctx, config)
@@ -976,6 +1052,155 @@ var _ = Describe("Generate", func() {
 			})
 		})
 
+		Context("with key backend", func() {
+			var (
+				caName, serverName       = "ca", "server"
+				caConfig, serverConfig   *secretsutils.CertificateSecretConfig
+				keyBackend               *fakesecretsutils.KeyBackend
+				newManagerWithKeyBackend = func(opts ...NewOption) {
+					mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, append([]NewOption{WithNamespaces(namespace), WithKeyBackend(keyBackend)}, opts...)...)
+					Expect(err).NotTo(HaveOccurred())
+					m = mgr.(*manager)
+				}
+			)
+
+			BeforeEach(func() {
+				caConfig = &secretsutils.CertificateSecretConfig{
+					Name:         caName,
+					CommonName:   caName,
+					CertType:     secretsutils.CACert,
+					KeyAlgorithm: secretsutils.KeyAlgorithmECDSAP256,
+				}
+				serverConfig = &secretsutils.CertificateSecretConfig{
+					Name:       serverName,
+					CommonName: serverName,
+					CertType:   secretsutils.ServerCert,
+				}
+
+				keyBackend = fakesecretsutils.NewKeyBackend()
+				newManagerWithKeyBackend()
+			})
+
+			It("should only store a reference to the CA private key in the secret", func() {
+				secret, err := m.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, secret)
+
+				Expect(secret.Data).To(HaveKey("ca.crt"))
+				Expect(secret.Data).NotTo(HaveKey("ca.key"))
+				Expect(keyBackend.KeyReferences()).To(ConsistOf(string(secret.Data["ca.key-ref"])))
+
+				ca, err := secretsutils.LoadCertificateFromKeyBackend(ctx, caName, keyBackend, string(secret.Data["ca.key-ref"]), secret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ca.Certificate.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+				Expect(ca.Certificate.Subject.CommonName).To(Equal(secret.Name))
+			})
+
+			It("should keep the CA private key in the secret if the CA is excluded from the key backend", func() {
+				newManagerWithKeyBackend(WithKeyBackend(keyBackend, "foo", caName))
+
+				secret, err := m.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(secret.Data).To(HaveKey("ca.key"))
+				Expect(secret.Data).NotTo(HaveKey("ca.key-ref"))
+				Expect(keyBackend.KeyReferences()).To(BeEmpty())
+			})
+
+			It("should not use the key backend for certificates which are not self-signed CAs", func() {
+				_, err := m.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				secret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret.Data).To(HaveKey("tls.key"))
+				Expect(keyBackend.KeyReferences()).To(HaveLen(1))
+			})
+
+			It("should sign certificates with the private key in the key backend", func() {
+				caSecret, err := m.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				secret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+
+				caCert, err := utils.DecodeCertificate(caSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				cert, err := utils.DecodeCertificate(secret.Data["tls.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.CheckSignatureFrom(caCert)).To(Succeed())
+			})
+
+			It("should create a new key when rotating the CA and keep signing with the old one", func() {
+				oldSecret, err := m.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("Rotate CA")
+				newManagerWithKeyBackend(WithSecretNamesToTimes(map[string]time.Time{caName: time.Now()}))
+				caConfig.CommonName = caName
+				newSecret, err := m.Generate(ctx, caConfig, Rotate(KeepOld))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(newSecret.Data["ca.key-ref"]).NotTo(Equal(oldSecret.Data["ca.key-ref"]))
+				Expect(keyBackend.KeyReferences()).To(ConsistOf(string(oldSecret.Data["ca.key-ref"]), string(newSecret.Data["ca.key-ref"])))
+
+				By("Verify server certificate is signed with old CA")
+				secret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+				oldCACert, err := utils.DecodeCertificate(oldSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				cert, err := utils.DecodeCertificate(secret.Data["tls.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.CheckSignatureFrom(oldCACert)).To(Succeed())
+			})
+
+			It("should keep an existing CA with a local private key and migrate it on rotation", func() {
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, WithNamespaces(namespace))
+				Expect(err).NotTo(HaveOccurred())
+				oldSecret, err := mgr.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(oldSecret.Data).To(HaveKey("ca.key"))
+
+				By("Generate with key backend")
+				newManagerWithKeyBackend()
+				caConfig.CommonName = caName
+				sameSecret, err := m.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sameSecret.Data).To(Equal(oldSecret.Data))
+				Expect(keyBackend.KeyReferences()).To(BeEmpty())
+
+				By("Rotate CA")
+				newManagerWithKeyBackend(WithSecretNamesToTimes(map[string]time.Time{caName: time.Now()}))
+				caConfig.CommonName = caName
+				newSecret, err := m.Generate(ctx, caConfig, Rotate(KeepOld))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(newSecret.Data).To(HaveKey("ca.key-ref"))
+				Expect(newSecret.Data).NotTo(HaveKey("ca.key"))
+			})
+
+			It("should fail signing certificates if no key backend is configured", func() {
+				_, err := m.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, WithNamespaces(namespace))
+				Expect(err).NotTo(HaveOccurred())
+				_, err = mgr.Generate(ctx, serverConfig, SignedByCA(caName, LoadMissingCAFromCluster(ctx)))
+				Expect(err).To(MatchError(ContainSubstring("no key backend is configured")))
+			})
+
+			It("should delete the created key if the secret cannot be created", func() {
+				fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+						return fmt.Errorf("fake")
+					},
+				}).Build()
+				newManagerWithKeyBackend()
+
+				_, err := m.Generate(ctx, caConfig)
+				Expect(err).To(MatchError(ContainSubstring("failed creating new secret")))
+				Expect(keyBackend.KeyReferences()).To(BeEmpty())
+			})
+		})
+
 		Context("for RSA Private Key secrets", func() {
 			var config *secretsutils.RSASecretConfig
 
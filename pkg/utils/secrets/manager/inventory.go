@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"cmp"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// SecretType is the type of secret managed by a secrets manager.
+type SecretType string
+
+const (
+	// SecretTypeCA is the type of secrets containing a certificate authority.
+	SecretTypeCA SecretType = "ca"
+	// SecretTypeCertificate is the type of secrets containing a certificate signed by a certificate authority.
+	SecretTypeCertificate SecretType = "certificate"
+	// SecretTypeOther is the type of all other secrets, e.g., basic auth credentials or private keys.
+	SecretTypeOther SecretType = "other"
+)
+
+// InventoryEntry describes a secret managed by a secrets manager. It never contains the secret data.
+type InventoryEntry struct {
+	// Namespace is the namespace of the secret.
+	Namespace string `json:"namespace"`
+	// Name is the name of the secret config.
+	Name string `json:"name"`
+	// SecretName is the name of the secret object.
+	SecretName string `json:"secretName"`
+	// ManagerIdentity is the identity of the secrets manager instance managing the secret.
+	ManagerIdentity string `json:"managerIdentity"`
+	// Type is the type of the secret.
+	Type SecretType `json:"type"`
+	// Subject is the subject of the certificate (only for certificates).
+	Subject string `json:"subject,omitempty"`
+	// Issuer is the issuer of the certificate (only for certificates).
+	Issuer string `json:"issuer,omitempty"`
+	// IssuedAt is the time when the secret data was created.
+	IssuedAt *time.Time `json:"issuedAt,omitempty"`
+	// ValidUntil is the time until the secret data is valid. For certificates, it is the certificate's 'not after'
+	// field.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+	// RotationStrategy is the rotation strategy of the secret.
+	RotationStrategy string `json:"rotationStrategy,omitempty"`
+	// AutoRenewal states whether the secret is automatically renewed before it expires. It is nil for secrets without
+	// limited validity or for secrets created by older versions of the secrets manager.
+	AutoRenewal *bool `json:"autoRenewal,omitempty"`
+}
+
+// Inventory lists all secrets managed by secrets managers (except for bundle secrets) and returns an inventory entry
+// for each of them. The entries are sorted by namespace, name, and secret name.
+func Inventory(ctx context.Context, reader client.Reader, opts ...client.ListOption) ([]InventoryEntry, error) {
+	secretList := &corev1.SecretList{}
+	if err := reader.List(ctx, secretList, append([]client.ListOption{client.MatchingLabels{LabelKeyManagedBy: LabelValueSecretsManager}}, opts...)...); err != nil {
+		return nil, fmt.Errorf("failed listing secrets managed by secrets manager: %w", err)
+	}
+
+	entries := make([]InventoryEntry, 0, len(secretList.Items))
+	for _, secret := range secretList.Items {
+		if _, ok := secret.Labels[LabelKeyBundleFor]; ok {
+			continue
+		}
+		entries = append(entries, NewInventoryEntry(&secret))
+	}
+
+	slices.SortFunc(entries, func(a, b InventoryEntry) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name), cmp.Compare(a.SecretName, b.SecretName))
+	})
+
+	return entries, nil
+}
+
+// NewInventoryEntry computes the inventory entry for the given secret.
+func NewInventoryEntry(secret *corev1.Secret) InventoryEntry {
+	entry := InventoryEntry{
+		Namespace:        secret.Namespace,
+		Name:             secret.Labels[LabelKeyName],
+		SecretName:       secret.Name,
+		ManagerIdentity:  secret.Labels[LabelKeyManagerIdentity],
+		Type:             SecretTypeOther,
+		IssuedAt:         unixTimeFromLabel(secret.Labels[LabelKeyIssuedAtTime]),
+		ValidUntil:       unixTimeFromLabel(secret.Labels[LabelKeyValidUntilTime]),
+		RotationStrategy: secret.Labels[LabelKeyRotationStrategy],
+	}
+
+	if autoRenewal, err := strconv.ParseBool(secret.Labels[LabelKeyAutoRenewal]); err == nil {
+		entry.AutoRenewal = &autoRenewal
+	}
+
+	var certificatePEM []byte
+	switch {
+	case isCASecret(secret.Data):
+		entry.Type = SecretTypeCA
+		certificatePEM = secret.Data[secretsutils.DataKeyCertificateCA]
+	case secret.Data[secretsutils.DataKeyCertificate] != nil:
+		entry.Type = SecretTypeCertificate
+		certificatePEM = secret.Data[secretsutils.DataKeyCertificate]
+	}
+
+	// The certificate of the secret is the first one in the PEM data, the following ones (if any) are CA certificates
+	// included in the chain.
+	if certificate, err := utils.DecodeCertificate(certificatePEM); err == nil {
+		setCertificateFields(&entry, certificate)
+	}
+
+	return entry
+}
+
+func setCertificateFields(entry *InventoryEntry, certificate *x509.Certificate) {
+	entry.Subject = certificate.Subject.String()
+	entry.Issuer = certificate.Issuer.String()
+	entry.IssuedAt = new(certificate.NotBefore.UTC())
+	entry.ValidUntil = new(certificate.NotAfter.UTC())
+}
+
+func unixTimeFromLabel(value string) *time.Time {
+	unix, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return new(time.Unix(unix, 0).UTC())
+}
+
+// NewInventoryHandler returns an HTTP handler serving the inventory of all secrets managed by secrets managers as JSON,
+// grouped by namespace. The optional 'namespace' query parameter restricts the inventory to the given namespace. The
+// 'expiresWithin' query parameter (a duration, e.g., '720h') restricts it to secrets expiring within the given
+// duration.
+func NewInventoryHandler(reader client.Reader, clock clock.Clock) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var opts []client.ListOption
+		if namespace := r.URL.Query().Get("namespace"); namespace != "" {
+			opts = append(opts, client.InNamespace(namespace))
+		}
+
+		var expiresBefore *time.Time
+		if value := r.URL.Query().Get("expiresWithin"); value != "" {
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid value for query parameter expiresWithin: %v", err), http.StatusBadRequest)
+				return
+			}
+			expiresBefore = new(clock.Now().Add(duration))
+		}
+
+		entries, err := Inventory(r.Context(), reader, opts...)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		inventory := make(map[string][]InventoryEntry)
+		for _, entry := range entries {
+			if expiresBefore != nil && (entry.ValidUntil == nil || entry.ValidUntil.After(*expiresBefore)) {
+				continue
+			}
+			inventory[entry.Namespace] = append(inventory[entry.Namespace], entry)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(inventory); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const inventoryCollectorTimeout = 10 * time.Second
+
+type inventoryCollector struct {
+	log    logr.Logger
+	reader client.Reader
+	clock  clock.Clock
+
+	validUntil *prometheus.Desc
+	age        *prometheus.Desc
+}
+
+// NewInventoryCollector returns a Prometheus collector exporting metrics for all secrets managed by secrets managers.
+// The metric names are prefixed with the given namespace, e.g., '<namespace>_secrets_manager_secret_age_seconds'.
+// The secrets are listed with a label selector on every scrape. Hence, the reader should not be backed by a cache since
+// this would start an informer for all secrets in the cluster.
+func NewInventoryCollector(log logr.Logger, reader client.Reader, clock clock.Clock, namespace string) prometheus.Collector {
+	labels := []string{"secret_namespace", "name", "secret", "manager_identity", "type", "rotation_strategy"}
+
+	return &inventoryCollector{
+		log:    log,
+		reader: reader,
+		clock:  clock,
+
+		validUntil: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "secrets_manager", "secret_valid_until_timestamp_seconds"),
+			"Unix timestamp until which the secret is valid. For certificates, it is the certificate's 'not after' field.",
+			append(labels, "issuer", "auto_renewal"),
+			nil,
+		),
+		age: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "secrets_manager", "secret_age_seconds"),
+			"Time in seconds since the secret data was created.",
+			labels,
+			nil,
+		),
+	}
+}
+
+func (c *inventoryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.validUntil
+	ch <- c.age
+}
+
+func (c *inventoryCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), inventoryCollectorTimeout)
+	defer cancel()
+
+	entries, err := Inventory(ctx, c.reader)
+	if err != nil {
+		c.log.Error(err, "Failed collecting secrets inventory metrics")
+		return
+	}
+
+	now := c.clock.Now()
+	for _, entry := range entries {
+		labels := []string{entry.Namespace, entry.Name, entry.SecretName, entry.ManagerIdentity, string(entry.Type), entry.RotationStrategy}
+
+		if entry.ValidUntil != nil {
+			autoRenewal := ""
+			if entry.AutoRenewal != nil {
+				autoRenewal = strconv.FormatBool(*entry.AutoRenewal)
+			}
+			ch <- prometheus.MustNewConstMetric(c.validUntil, prometheus.GaugeValue, float64(entry.ValidUntil.Unix()), append(labels, entry.Issuer, autoRenewal)...)
+		}
+
+		if entry.IssuedAt != nil {
+			ch <- prometheus.MustNewConstMetric(c.age, prometheus.GaugeValue, now.Sub(*entry.IssuedAt).Seconds(), labels...)
+		}
+	}
+}
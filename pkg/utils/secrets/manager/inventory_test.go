@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Inventory", func() {
+	var (
+		ctx        = context.TODO()
+		namespace  = "shoot--foo--bar"
+		namespace2 = "shoot--bar--foo"
+
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Truncate(time.Second))
+
+		By("Generate secrets in first namespace")
+		mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, "test", WithNamespaces(namespace))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = mgr.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}, Rotate(KeepOld))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = mgr.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "server", CommonName: "server", CertType: secretsutils.ServerCert, Validity: new(30 * 24 * time.Hour)}, SignedByCA("ca"), Rotate(InPlace))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = mgr.Generate(ctx, &secretsutils.BasicAuthSecretConfig{Name: "basic-auth", Format: secretsutils.BasicAuthFormatNormal, Username: "foo", PasswordLength: 3})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Generate secrets in second namespace")
+		mgr, err = New(ctx, logr.Discard(), fakeClock, fakeClient, "other", WithNamespaces(namespace2), WithCASecretAutoRotation())
+		Expect(err).NotTo(HaveOccurred())
+		_, err = mgr.Generate(ctx, &secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#Inventory", func() {
+		It("should return the inventory of all managed secrets without bundles", func() {
+			entries, err := Inventory(ctx, fakeClient)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(entries).To(HaveExactElements(
+				And(
+					HaveField("Namespace", namespace2),
+					HaveField("Name", "ca"),
+					HaveField("ManagerIdentity", "other"),
+					HaveField("Type", SecretTypeCA),
+					HaveField("AutoRenewal", PointTo(BeTrue())),
+				),
+				And(
+					HaveField("Namespace", namespace),
+					HaveField("Name", "basic-auth"),
+					HaveField("Type", SecretTypeOther),
+					HaveField("Issuer", BeEmpty()),
+					HaveField("IssuedAt", PointTo(Equal(fakeClock.Now().UTC()))),
+					HaveField("ValidUntil", BeNil()),
+					HaveField("AutoRenewal", BeNil()),
+				),
+				And(
+					HaveField("Namespace", namespace),
+					HaveField("Name", "ca"),
+					HaveField("ManagerIdentity", "test"),
+					HaveField("Type", SecretTypeCA),
+					HaveField("Subject", HavePrefix("CN=ca-")),
+					HaveField("Issuer", HavePrefix("CN=ca-")),
+					HaveField("ValidUntil", Not(BeNil())),
+					HaveField("RotationStrategy", "keepold"),
+					HaveField("AutoRenewal", PointTo(BeFalse())),
+				),
+				And(
+					HaveField("Namespace", namespace),
+					HaveField("Name", "server"),
+					HaveField("Type", SecretTypeCertificate),
+					HaveField("Subject", "CN=server"),
+					HaveField("Issuer", HavePrefix("CN=ca-")),
+					HaveField("RotationStrategy", "inplace"),
+					HaveField("AutoRenewal", PointTo(BeTrue())),
+				),
+			))
+		})
+
+		It("should respect the list options", func() {
+			entries, err := Inventory(ctx, fakeClient, client.InNamespace(namespace2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(ConsistOf(HaveField("Namespace", namespace2)))
+		})
+	})
+
+	Describe("#NewInventoryHandler", func() {
+		var handler http.Handler
+
+		BeforeEach(func() {
+			handler = NewInventoryHandler(fakeClient, fakeClock)
+		})
+
+		serve := func(url string) *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, url, nil))
+			return recorder
+		}
+
+		It("should serve the inventory grouped by namespace", func() {
+			recorder := serve("/debug/secrets-inventory")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			inventory := map[string][]InventoryEntry{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &inventory)).To(Succeed())
+			Expect(inventory).To(HaveKeyWithValue(namespace, HaveLen(3)))
+			Expect(inventory).To(HaveKeyWithValue(namespace2, HaveLen(1)))
+		})
+
+		It("should filter by namespace and expiry", func() {
+			recorder := serve("/debug/secrets-inventory?namespace=" + namespace + "&expiresWithin=1000h")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			inventory := map[string][]InventoryEntry{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &inventory)).To(Succeed())
+			Expect(inventory).To(HaveLen(1))
+			Expect(inventory[namespace]).To(ConsistOf(HaveField("Name", "server")))
+		})
+
+		It("should fail for invalid durations", func() {
+			Expect(serve("/debug/secrets-inventory?expiresWithin=foo").Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("#NewInventoryCollector", func() {
+		It("should export the metrics for all managed secrets", func() {
+			collector := NewInventoryCollector(logr.Discard(), fakeClient, fakeClock, "test")
+			Expect(testutil.CollectAndCount(collector, "test_secrets_manager_secret_valid_until_timestamp_seconds")).To(Equal(3))
+			Expect(testutil.CollectAndCount(collector, "test_secrets_manager_secret_age_seconds")).To(Equal(4))
+
+			By("Verify metric values")
+			fakeClock.Step(time.Hour)
+			registry := prometheus.NewPedanticRegistry()
+			Expect(registry.Register(collector)).To(Succeed())
+			metricFamilies, err := registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(metricFamilies).To(ContainElement(And(
+				HaveField("GetName()", "test_secrets_manager_secret_age_seconds"),
+				HaveField("GetMetric()", ContainElement(And(
+					HaveField("GetLabel()", ContainElement(And(HaveField("GetName()", "name"), HaveField("GetValue()", "basic-auth")))),
+					HaveField("GetGauge().GetValue()", Equal(time.Hour.Seconds())),
+				))),
+			)))
+			Expect(metricFamilies).To(ContainElement(And(
+				HaveField("GetName()", "test_secrets_manager_secret_valid_until_timestamp_seconds"),
+				HaveField("GetMetric()", ContainElement(HaveField("GetLabel()", ContainElements(
+					And(HaveField("GetName()", "secret_namespace"), HaveField("GetValue()", namespace)),
+					And(HaveField("GetName()", "type"), HaveField("GetValue()", "ca")),
+					And(HaveField("GetName()", "rotation_strategy"), HaveField("GetValue()", "keepold")),
+					And(HaveField("GetName()", "auto_renewal"), HaveField("GetValue()", "false")),
+				)))),
+			)))
+		})
+	})
+})
@@ -59,6 +59,12 @@ const (
 	// LabelKeyUseDataForName is a constant for a key of a label on a Secret describing that its data should be used
 	// instead of generating a fresh secret with the same name.
 	LabelKeyUseDataForName = "secrets-manager-use-data-for-name"
+	// LabelKeyRotationStrategy is a constant for a key of a label on a Secret describing the rotation strategy which
+	// is used when the secret gets rotated.
+	LabelKeyRotationStrategy = "rotation-strategy"
+	// LabelKeyAutoRenewal is a constant for a key of a label on a Secret with limited validity describing whether it
+	// is automatically renewed before it expires.
+	LabelKeyAutoRenewal = "auto-renewal"
 
 	// LabelValueTrue is a constant for a value of a label on a Secret describing the value 'true'.
 	LabelValueTrue = "true"
@@ -106,6 +112,15 @@ type (
 		// DisableAutomaticSecretRenewal states whether automatic secret renewal should be disabled even if a secret's
 		// configuration would otherwise require it.
 		DisableAutomaticSecretRenewal bool
+		// KeyAlgorithm is the default algorithm of private keys generated for configs which don't specify an algorithm
+		// themselves.
+		KeyAlgorithm secretsutils.KeyAlgorithm
+		// KeyBackend is an external backend managing the private keys of self-signed CA certificates. If nil, the
+		// private keys are generated locally and stored in the secrets.
+		KeyBackend secretsutils.KeyBackend
+		// KeyBackendExcludedConfigNames are the names of CA configs whose private keys are generated locally and
+		// stored in the secrets even if a KeyBackend is configured.
+		KeyBackendExcludedConfigNames []string
 	}
 	// NewOption is some configuration that configures a secrets manager instance when creating it with [New].
 	NewOption func(*NewOptions)
@@ -142,6 +157,28 @@ func WithoutAutomaticSecretRenewal() NewOption {
 	}
 }
 
+// WithKeyAlgorithm sets the default algorithm of private keys generated for certificate and RSA key configs which don't
+// specify an algorithm themselves. Since Kubernetes does not support Ed25519 keys for signing service account tokens,
+// an Ed25519 default is only applied to RSA key configs used for SSH. Existing secrets are not regenerated, i.e., the
+// algorithm takes effect the next time a secret is rotated or renewed.
+func WithKeyAlgorithm(algorithm secretsutils.KeyAlgorithm) NewOption {
+	return func(options *NewOptions) {
+		options.KeyAlgorithm = algorithm
+	}
+}
+
+// WithKeyBackend configures an external backend (e.g., a KMS or HSM) managing the private keys of self-signed CA
+// certificates. Newly generated CA secrets only contain a reference to the private key, and certificates signed by
+// them are signed by the backend. Existing CA secrets keep their private keys until they are rotated. CAs whose private
+// keys must be read from the secrets by other components (e.g., for signing CSRs) can be excluded by their config
+// names.
+func WithKeyBackend(backend secretsutils.KeyBackend, excludedConfigNames ...string) NewOption {
+	return func(options *NewOptions) {
+		options.KeyBackend = backend
+		options.KeyBackendExcludedConfigNames = excludedConfigNames
+	}
+}
+
 var _ Interface = &manager{}
 
 type secretClass string
@@ -440,7 +477,20 @@ func unixTime(in time.Time) string {
 }
 
 func isCASecret(data map[string][]byte) bool {
-	return data[secretsutils.DataKeyCertificateCA] != nil && data[secretsutils.DataKeyPrivateKeyCA] != nil
+	return data[secretsutils.DataKeyCertificateCA] != nil &&
+		(data[secretsutils.DataKeyPrivateKeyCA] != nil || data[secretsutils.DataKeyPrivateKeyReferenceCA] != nil)
+}
+
+func (m *manager) loadCertificateAuthority(ctx context.Context, name string, data map[string][]byte) (*secretsutils.Certificate, error) {
+	keyRef, ok := data[secretsutils.DataKeyPrivateKeyReferenceCA]
+	if !ok {
+		return secretsutils.LoadCertificate(name, data[secretsutils.DataKeyPrivateKeyCA], data[secretsutils.DataKeyCertificateCA])
+	}
+
+	if m.opts.KeyBackend == nil {
+		return nil, fmt.Errorf("private key of CA %q is managed by a key backend, but no key backend is configured", name)
+	}
+	return secretsutils.LoadCertificateFromKeyBackend(ctx, name, m.opts.KeyBackend, string(keyRef), data[secretsutils.DataKeyCertificateCA])
 }
 
 func certificateSecretConfig(config secretsutils.ConfigInterface) *secretsutils.CertificateSecretConfig {
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+)
+
+// KeyAlgorithm is the algorithm of a to-be-generated private key.
+type KeyAlgorithm string
+
+const (
+	// KeyAlgorithmRSA indicates that an RSA private key should be generated. This is the default if no algorithm is
+	// specified.
+	KeyAlgorithmRSA KeyAlgorithm = "RSA"
+	// KeyAlgorithmECDSAP256 indicates that an ECDSA private key on the NIST P-256 curve should be generated.
+	KeyAlgorithmECDSAP256 KeyAlgorithm = "ECDSA-P256"
+	// KeyAlgorithmECDSAP384 indicates that an ECDSA private key on the NIST P-384 curve should be generated.
+	KeyAlgorithmECDSAP384 KeyAlgorithm = "ECDSA-P384"
+	// KeyAlgorithmEd25519 indicates that an Ed25519 private key should be generated. Note that Kubernetes does not
+	// support Ed25519 keys for signing service account tokens.
+	KeyAlgorithmEd25519 KeyAlgorithm = "Ed25519"
+)
+
+// generatePrivateKey generates a private key for the given algorithm. The number of bits is only considered for RSA
+// keys.
+func generatePrivateKey(algorithm KeyAlgorithm, rsaBits int) (crypto.Signer, error) {
+	switch algorithm {
+	case "", KeyAlgorithmRSA:
+		key, err := GenerateKey(rand.Reader, rsaBits)
+		if err != nil {
+			return nil, err
+		}
+		return key, nil
+
+	case KeyAlgorithmECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	case KeyAlgorithmECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+
+	case KeyAlgorithmEd25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key algorithm %q", algorithm)
+}
+
+// isRSAKey returns true if the given key is an RSA private key. The public key is checked so that signers of keys
+// managed by a KeyBackend are detected as well.
+func isRSAKey(key crypto.Signer) bool {
+	_, ok := key.Public().(*rsa.PublicKey)
+	return ok
+}
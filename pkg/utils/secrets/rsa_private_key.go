@@ -6,8 +6,9 @@ package secrets
 
 import (
 	"bytes"
-	"crypto/rand"
+	"crypto"
 	"crypto/rsa"
+	"fmt"
 
 	"golang.org/x/crypto/ssh"
 
@@ -22,21 +23,32 @@ const (
 )
 
 // RSASecretConfig containing information about the number of bits which should be used for the to-be-created RSA private key.
+// Despite its name, it can also be used for generating ECDSA or Ed25519 private keys by specifying the KeyAlgorithm.
 type RSASecretConfig struct {
 	Name string
 
 	Bits       int
 	UsedForSSH bool
+	// KeyAlgorithm is the algorithm of the private key. Defaults to KeyAlgorithmRSA. Ed25519 keys are only supported if
+	// the key is used for SSH since Kubernetes does not support them for signing service account tokens. The algorithm
+	// is not considered for the checksum of the config, i.e., changing it does not cause the secrets manager to
+	// regenerate existing secrets. Instead, the new algorithm is used the next time the secret is rotated.
+	KeyAlgorithm KeyAlgorithm `hash:"ignore"`
 }
 
 // RSAKeys contains the private key, the public key, and optionally the OpenSSH-formatted authorized keys file data.
 type RSAKeys struct {
 	Name string
 
+	// PrivateKey and PublicKey are only set for RSA keys.
 	PrivateKey *rsa.PrivateKey
 	PublicKey  *rsa.PublicKey
+	// Key is the private key for all key algorithms.
+	Key crypto.Signer
 
 	OpenSSHAuthorizedKey []byte
+
+	privateKeyPEM []byte
 }
 
 // GetName returns the name of the secret.
@@ -46,33 +58,52 @@ func (s *RSASecretConfig) GetName() string {
 
 // Generate implements ConfigInterface.
 func (s *RSASecretConfig) Generate() (DataInterface, error) {
-	privateKey, err := GenerateKey(rand.Reader, s.Bits)
+	if s.KeyAlgorithm == KeyAlgorithmEd25519 && !s.UsedForSSH {
+		return nil, fmt.Errorf("key algorithm %q is only supported for keys used for SSH", s.KeyAlgorithm)
+	}
+
+	privateKey, err := generatePrivateKey(s.KeyAlgorithm, s.Bits)
 	if err != nil {
 		return nil, err
 	}
 
-	rsa := &RSAKeys{
+	privateKeyPEM, err := utils.EncodeAnyPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := &RSAKeys{
 		Name: s.Name,
 
-		PrivateKey: privateKey,
-		PublicKey:  &privateKey.PublicKey,
+		Key:           privateKey,
+		privateKeyPEM: privateKeyPEM,
+	}
+
+	if rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey); ok {
+		keys.PrivateKey = rsaPrivateKey
+		keys.PublicKey = &rsaPrivateKey.PublicKey
 	}
 
 	if s.UsedForSSH {
-		sshPublicKey, err := generateSSHAuthorizedKeys(rsa.PrivateKey)
+		sshPublicKey, err := generateSSHAuthorizedKeys(privateKey)
 		if err != nil {
 			return nil, err
 		}
-		rsa.OpenSSHAuthorizedKey = sshPublicKey
+		keys.OpenSSHAuthorizedKey = sshPublicKey
 	}
 
-	return rsa, nil
+	return keys, nil
 }
 
 // SecretData computes the data map which can be used in a Kubernetes secret.
 func (r *RSAKeys) SecretData() map[string][]byte {
+	privateKeyPEM := r.privateKeyPEM
+	if privateKeyPEM == nil {
+		privateKeyPEM = utils.EncodePrivateKey(r.PrivateKey)
+	}
+
 	data := map[string][]byte{
-		DataKeyRSAPrivateKey: utils.EncodePrivateKey(r.PrivateKey),
+		DataKeyRSAPrivateKey: privateKeyPEM,
 	}
 
 	if r.OpenSSHAuthorizedKey != nil {
@@ -82,11 +113,11 @@ func (r *RSAKeys) SecretData() map[string][]byte {
 	return data
 }
 
-// generateSSHAuthorizedKeys takes a RSA private key <privateKey> and generates the corresponding public key.
+// generateSSHAuthorizedKeys takes a private key <privateKey> and generates the corresponding public key.
 // It serializes the public key for inclusion in an OpenSSH `authorized_keys` file and it trims the new-
 // line at the end.
-func generateSSHAuthorizedKeys(privateKey *rsa.PrivateKey) ([]byte, error) {
-	pubKey, err := ssh.NewPublicKey(&privateKey.PublicKey)
+func generateSSHAuthorizedKeys(privateKey crypto.Signer) ([]byte, error) {
+	pubKey, err := ssh.NewPublicKey(privateKey.Public())
 	if err != nil {
 		return nil, err
 	}
@@ -5,11 +5,14 @@
 package secrets_test
 
 import (
+	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/rsa"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	"k8s.io/client-go/util/keyutil"
 
 	"github.com/gardener/gardener/pkg/utils"
 	. "github.com/gardener/gardener/pkg/utils/secrets"
@@ -47,6 +50,40 @@ var _ = Describe("RSA Private Key Secrets", func() {
 				Expect(ok).To(BeTrue())
 				Expect(rsaSecret.OpenSSHAuthorizedKey).NotTo(BeNil())
 			})
+
+			It("should generate an ECDSA key if specified in the config", func() {
+				rsaPrivateKeyConfig.KeyAlgorithm = KeyAlgorithmECDSAP256
+				obj, err := rsaPrivateKeyConfig.Generate()
+				Expect(err).NotTo(HaveOccurred())
+
+				keys, ok := obj.(*RSAKeys)
+				Expect(ok).To(BeTrue())
+				Expect(keys.PrivateKey).To(BeNil())
+				Expect(keys.Key).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+
+				By("Verify that Kubernetes can read the public key from the secret data")
+				publicKeys, err := keyutil.ParsePublicKeysPEM(keys.SecretData()[DataKeyRSAPrivateKey])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(publicKeys).To(ConsistOf(keys.Key.Public()))
+			})
+
+			It("should generate an Ed25519 ssh key if specified in the config", func() {
+				rsaPrivateKeyConfig.KeyAlgorithm = KeyAlgorithmEd25519
+				rsaPrivateKeyConfig.UsedForSSH = true
+				obj, err := rsaPrivateKeyConfig.Generate()
+				Expect(err).NotTo(HaveOccurred())
+
+				keys, ok := obj.(*RSAKeys)
+				Expect(ok).To(BeTrue())
+				Expect(string(keys.OpenSSHAuthorizedKey)).To(HavePrefix("ssh-ed25519 "))
+				Expect(ssh.ParsePrivateKey(keys.SecretData()[DataKeyRSAPrivateKey])).NotTo(BeNil())
+			})
+
+			It("should fail generating an Ed25519 key which is not used for SSH", func() {
+				rsaPrivateKeyConfig.KeyAlgorithm = KeyAlgorithmEd25519
+				_, err := rsaPrivateKeyConfig.Generate()
+				Expect(err).To(MatchError(ContainSubstring("only supported for keys used for SSH")))
+			})
 		})
 	})
 
@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// DefaultTransitMountPath is the default mount path of the transit secrets engine.
+const DefaultTransitMountPath = "transit"
+
+// TransitConfig contains the configuration for a TransitKeyBackend.
+type TransitConfig struct {
+	// Address is the address of the Vault server, e.g. https://vault.example.com:8200.
+	Address string
+	// MountPath is the path the transit secrets engine is mounted at. Defaults to DefaultTransitMountPath.
+	MountPath string
+	// Namespace is the Vault namespace (Vault Enterprise only).
+	Namespace string
+	// TokenFile is the path to a file containing the Vault token. It is read for every request so that rotated
+	// tokens are picked up.
+	TokenFile string
+	// HTTPClient is the client used for requests to Vault. Defaults to a client with a timeout of 30s.
+	HTTPClient *http.Client
+}
+
+// TransitKeyBackend is a secretsutils.KeyBackend which manages the private keys with the transit secrets engine of
+// HashiCorp Vault (or OpenBao). The private keys never leave Vault, the signing is done via its API.
+type TransitKeyBackend struct {
+	config TransitConfig
+}
+
+var _ secretsutils.KeyBackend = &TransitKeyBackend{}
+
+// NewTransitKeyBackend returns a new key backend for the transit secrets engine.
+func NewTransitKeyBackend(config TransitConfig) (*TransitKeyBackend, error) {
+	if _, err := url.ParseRequestURI(config.Address); err != nil {
+		return nil, fmt.Errorf("invalid Vault address %q: %w", config.Address, err)
+	}
+	if len(config.TokenFile) == 0 {
+		return nil, fmt.Errorf("token file must be set")
+	}
+
+	if len(config.MountPath) == 0 {
+		config.MountPath = DefaultTransitMountPath
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	config.Address = strings.TrimSuffix(config.Address, "/")
+	config.MountPath = strings.Trim(config.MountPath, "/")
+
+	return &TransitKeyBackend{config: config}, nil
+}
+
+// CreateKey implements secretsutils.KeyBackend. The returned reference is the name of the key in the transit secrets
+// engine.
+func (b *TransitKeyBackend) CreateKey(ctx context.Context, name string, algorithm secretsutils.KeyAlgorithm) (string, error) {
+	keyType, err := transitKeyType(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	// Keys are never reused, hence a random suffix makes sure that a retried or concurrent creation for the same name
+	// does not return an existing key.
+	suffix, err := utils.GenerateRandomStringFromCharset(8, "0123456789abcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		return "", err
+	}
+	keyRef := strings.ReplaceAll(name, "/", ".") + "-" + suffix
+
+	if err := b.do(ctx, http.MethodPost, b.keyPath(keyRef), map[string]any{"type": keyType}, nil); err != nil {
+		return "", fmt.Errorf("failed creating key %q: %w", keyRef, err)
+	}
+	return keyRef, nil
+}
+
+// Signer implements secretsutils.KeyBackend.
+func (b *TransitKeyBackend) Signer(ctx context.Context, keyRef string) (crypto.Signer, error) {
+	key, err := b.readKey(ctx, keyRef)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, fmt.Errorf("key %q not found", keyRef)
+	}
+
+	version, ok := key.Keys[strconv.Itoa(key.LatestVersion)]
+	if !ok {
+		return nil, fmt.Errorf("latest version %d of key %q not found", key.LatestVersion, keyRef)
+	}
+
+	publicKey, err := parsePublicKey(key.Type, version.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing public key of key %q: %w", keyRef, err)
+	}
+
+	return &transitSigner{ctx: ctx, backend: b, keyRef: keyRef, keyType: key.Type, publicKey: publicKey}, nil
+}
+
+// DeleteKey implements secretsutils.KeyBackend.
+func (b *TransitKeyBackend) DeleteKey(ctx context.Context, keyRef string) error {
+	key, err := b.readKey(ctx, keyRef)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return nil
+	}
+
+	// The transit secrets engine refuses deleting keys unless this is explicitly allowed in the key's config.
+	if err := b.do(ctx, http.MethodPost, b.keyPath(keyRef)+"/config", map[string]any{"deletion_allowed": true}, nil); err != nil {
+		return fmt.Errorf("failed allowing deletion of key %q: %w", keyRef, err)
+	}
+
+	if err := b.do(ctx, http.MethodDelete, b.keyPath(keyRef), nil, nil); err != nil && !isNotFound(err) {
+		return fmt.Errorf("failed deleting key %q: %w", keyRef, err)
+	}
+	return nil
+}
+
+type transitKey struct {
+	Type          string `json:"type"`
+	LatestVersion int    `json:"latest_version"`
+	Keys          map[string]struct {
+		PublicKey string `json:"public_key"`
+	} `json:"keys"`
+}
+
+func (b *TransitKeyBackend) readKey(ctx context.Context, keyRef string) (*transitKey, error) {
+	key := &transitKey{}
+	if err := b.do(ctx, http.MethodGet, b.keyPath(keyRef), nil, key); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading key %q: %w", keyRef, err)
+	}
+	return key, nil
+}
+
+func (b *TransitKeyBackend) keyPath(keyRef string) string {
+	return "/keys/" + url.PathEscape(keyRef)
+}
+
+type responseError struct {
+	statusCode int
+	errors     []string
+}
+
+func (e *responseError) Error() string {
+	return fmt.Sprintf("unexpected status code %d: %s", e.statusCode, strings.Join(e.errors, ", "))
+}
+
+func isNotFound(err error) bool {
+	var respErr *responseError
+	return errors.As(err, &respErr) && respErr.statusCode == http.StatusNotFound
+}
+
+// do sends a request to the transit secrets engine and decodes the 'data' field of the response into out (if not
+// nil).
+func (b *TransitKeyBackend) do(ctx context.Context, method, path string, in, out any) error {
+	token, err := os.ReadFile(b.config.TokenFile)
+	if err != nil {
+		return fmt.Errorf("failed reading token file: %w", err)
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, b.config.Address+"/v1/"+b.config.MountPath+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", strings.TrimSpace(string(token)))
+	if len(b.config.Namespace) > 0 {
+		req.Header.Set("X-Vault-Namespace", b.config.Namespace)
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := b.config.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respErr := &responseError{statusCode: resp.StatusCode}
+		var errorResponse struct {
+			Errors []string `json:"errors"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&errorResponse); err == nil {
+			respErr.errors = errorResponse.Errors
+		}
+		return respErr
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	var response struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed decoding response: %w", err)
+	}
+	return json.Unmarshal(response.Data, out)
+}
+
+func transitKeyType(algorithm secretsutils.KeyAlgorithm) (string, error) {
+	switch algorithm {
+	case "", secretsutils.KeyAlgorithmRSA:
+		return "rsa-3072", nil
+	case secretsutils.KeyAlgorithmECDSAP256:
+		return "ecdsa-p256", nil
+	case secretsutils.KeyAlgorithmECDSAP384:
+		return "ecdsa-p384", nil
+	case secretsutils.KeyAlgorithmEd25519:
+		return "ed25519", nil
+	default:
+		return "", fmt.Errorf("unsupported key algorithm %q", algorithm)
+	}
+}
+
+func parsePublicKey(keyType, publicKey string) (crypto.PublicKey, error) {
+	// The transit secrets engine returns Ed25519 public keys base64-encoded, all other public keys PEM-encoded.
+	if keyType == "ed25519" {
+		data, err := base64.StdEncoding.DecodeString(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 public key size %d", len(data))
+		}
+		return ed25519.PublicKey(data), nil
+	}
+
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+type transitSigner struct {
+	// ctx is the context passed to Signer. It is used for signing requests since crypto.Signer does not take a context.
+	ctx       context.Context
+	backend   *TransitKeyBackend
+	keyRef    string
+	keyType   string
+	publicKey crypto.PublicKey
+}
+
+// Public implements crypto.Signer.
+func (s *transitSigner) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+// Sign implements crypto.Signer. The request is bound to the context the signer was created with.
+func (s *transitSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	request := map[string]any{"input": base64.StdEncoding.EncodeToString(digest)}
+
+	// Ed25519 signs the message itself, all other algorithms sign a digest.
+	if s.keyType != "ed25519" {
+		hashAlgorithm, err := transitHashAlgorithm(opts.HashFunc())
+		if err != nil {
+			return nil, err
+		}
+		request["prehashed"] = true
+		request["hash_algorithm"] = hashAlgorithm
+	}
+
+	if strings.HasPrefix(s.keyType, "rsa-") {
+		request["signature_algorithm"] = "pkcs1v15"
+		if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+			request["signature_algorithm"] = "pss"
+			switch pssOpts.SaltLength {
+			case rsa.PSSSaltLengthAuto:
+				request["salt_length"] = "auto"
+			case rsa.PSSSaltLengthEqualsHash:
+				request["salt_length"] = "hash"
+			default:
+				request["salt_length"] = strconv.Itoa(pssOpts.SaltLength)
+			}
+		}
+	}
+
+	var response struct {
+		Signature string `json:"signature"`
+	}
+	if err := s.backend.do(s.ctx, http.MethodPost, "/sign/"+url.PathEscape(s.keyRef), request, &response); err != nil {
+		return nil, fmt.Errorf("failed signing with key %q: %w", s.keyRef, err)
+	}
+
+	// Signatures have the format 'vault:v<version>:<base64-encoded signature>'.
+	parts := strings.Split(response.Signature, ":")
+	if len(parts) != 3 || parts[0] != "vault" {
+		return nil, fmt.Errorf("unexpected signature format returned for key %q", s.keyRef)
+	}
+	return base64.StdEncoding.DecodeString(parts[2])
+}
+
+func transitHashAlgorithm(hash crypto.Hash) (string, error) {
+	switch hash {
+	case crypto.SHA224:
+		return "sha2-224", nil
+	case crypto.SHA256:
+		return "sha2-256", nil
+	case crypto.SHA384:
+		return "sha2-384", nil
+	case crypto.SHA512:
+		return "sha2-512", nil
+	default:
+		return "", fmt.Errorf("unsupported hash function %s", hash)
+	}
+}
@@ -0,0 +1,368 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package vault_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	. "github.com/gardener/gardener/pkg/utils/secrets/vault"
+)
+
+var _ = Describe("TransitKeyBackend", func() {
+	var (
+		ctx = context.TODO()
+
+		transit    *fakeTransit
+		server     *httptest.Server
+		tokenFile  string
+		keyBackend *TransitKeyBackend
+	)
+
+	BeforeEach(func() {
+		transit = &fakeTransit{token: "s.token", keys: map[string]*fakeTransitKey{}}
+		server = httptest.NewServer(transit.handler())
+		DeferCleanup(server.Close)
+
+		tokenFile = filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte("s.token\n"), 0600)).To(Succeed())
+
+		var err error
+		keyBackend, err = NewTransitKeyBackend(TransitConfig{Address: server.URL, TokenFile: tokenFile})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#NewTransitKeyBackend", func() {
+		It("should fail for an invalid address", func() {
+			_, err := NewTransitKeyBackend(TransitConfig{Address: "vault", TokenFile: tokenFile})
+			Expect(err).To(MatchError(ContainSubstring("invalid Vault address")))
+		})
+
+		It("should fail if no token file is set", func() {
+			_, err := NewTransitKeyBackend(TransitConfig{Address: server.URL})
+			Expect(err).To(MatchError("token file must be set"))
+		})
+	})
+
+	DescribeTable("should create keys and sign certificates with them",
+		func(algorithm secretsutils.KeyAlgorithm, keyType string, publicKey any) {
+			keyRef, err := keyBackend.CreateKey(ctx, "shoot--foo--bar/ca-etcd", algorithm)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keyRef).To(MatchRegexp(`^shoot--foo--bar\.ca-etcd-[a-z0-9]{8}$`))
+			Expect(transit.keys).To(HaveKey(keyRef))
+			Expect(transit.keys[keyRef].keyType).To(Equal(keyType))
+
+			signer, err := keyBackend.Signer(ctx, keyRef)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer.Public()).To(BeAssignableToTypeOf(publicKey))
+
+			ca, err := (&secretsutils.CertificateSecretConfig{
+				Name:                "ca",
+				CommonName:          "ca",
+				CertType:            secretsutils.CACert,
+				PrivateKey:          signer,
+				PrivateKeyReference: keyRef,
+			}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			loaded, err := secretsutils.LoadCertificateFromKeyBackend(ctx, "ca", keyBackend, keyRef, ca.CertificatePEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded.Certificate.CheckSignatureFrom(loaded.Certificate)).To(Succeed())
+		},
+
+		Entry("RSA (default)", secretsutils.KeyAlgorithm(""), "rsa-3072", &rsa.PublicKey{}),
+		Entry("ECDSA P-256", secretsutils.KeyAlgorithmECDSAP256, "ecdsa-p256", &ecdsa.PublicKey{}),
+		Entry("ECDSA P-384", secretsutils.KeyAlgorithmECDSAP384, "ecdsa-p384", &ecdsa.PublicKey{}),
+		Entry("Ed25519", secretsutils.KeyAlgorithmEd25519, "ed25519", ed25519.PublicKey{}),
+	)
+
+	It("should sign with RSA-PSS", func() {
+		keyRef, err := keyBackend.CreateKey(ctx, "foo", secretsutils.KeyAlgorithmRSA)
+		Expect(err).NotTo(HaveOccurred())
+		signer, err := keyBackend.Signer(ctx, keyRef)
+		Expect(err).NotTo(HaveOccurred())
+
+		digest := sha256.Sum256([]byte("foo"))
+		opts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}
+		signature, err := signer.Sign(rand.Reader, digest[:], opts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rsa.VerifyPSS(signer.Public().(*rsa.PublicKey), crypto.SHA256, digest[:], signature, opts)).To(Succeed())
+	})
+
+	It("should bind signing requests to the context of the signer", func() {
+		keyRef, err := keyBackend.CreateKey(ctx, "foo", secretsutils.KeyAlgorithmECDSAP256)
+		Expect(err).NotTo(HaveOccurred())
+
+		signerCtx, cancel := context.WithCancel(ctx)
+		signer, err := keyBackend.Signer(signerCtx, keyRef)
+		Expect(err).NotTo(HaveOccurred())
+		cancel()
+
+		digest := sha256.Sum256([]byte("foo"))
+		_, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+		Expect(err).To(MatchError(context.Canceled))
+	})
+
+	It("should fail creating a key with an unsupported algorithm", func() {
+		_, err := keyBackend.CreateKey(ctx, "foo", "DSA")
+		Expect(err).To(MatchError(`unsupported key algorithm "DSA"`))
+	})
+
+	It("should fail getting a signer for a non-existing key", func() {
+		_, err := keyBackend.Signer(ctx, "foo")
+		Expect(err).To(MatchError(`key "foo" not found`))
+	})
+
+	It("should return the errors of Vault", func() {
+		Expect(os.WriteFile(tokenFile, []byte("s.other"), 0600)).To(Succeed())
+
+		_, err := keyBackend.CreateKey(ctx, "foo", secretsutils.KeyAlgorithmECDSAP256)
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code 403: permission denied")))
+	})
+
+	It("should delete keys", func() {
+		keyRef, err := keyBackend.CreateKey(ctx, "foo", secretsutils.KeyAlgorithmECDSAP256)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(keyBackend.DeleteKey(ctx, keyRef)).To(Succeed())
+		Expect(transit.keys).To(BeEmpty())
+
+		By("Delete non-existing key")
+		Expect(keyBackend.DeleteKey(ctx, keyRef)).To(Succeed())
+	})
+
+	It("should use the configured mount path and namespace", func() {
+		transit.mountPath, transit.namespace = "pki-keys", "gardener"
+
+		keyBackend, err := NewTransitKeyBackend(TransitConfig{Address: server.URL + "/", MountPath: "/pki-keys/", Namespace: "gardener", TokenFile: tokenFile})
+		Expect(err).NotTo(HaveOccurred())
+
+		keyRef, err := keyBackend.CreateKey(ctx, "foo", secretsutils.KeyAlgorithmECDSAP256)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = keyBackend.Signer(ctx, keyRef)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
+
+type fakeTransitKey struct {
+	keyType         string
+	key             crypto.Signer
+	deletionAllowed bool
+}
+
+// fakeTransit implements the parts of the transit secrets engine API used by the TransitKeyBackend.
+type fakeTransit struct {
+	lock      sync.Mutex
+	token     string
+	mountPath string
+	namespace string
+	keys      map[string]*fakeTransitKey
+}
+
+func (t *fakeTransit) handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != t.token || r.Header.Get("X-Vault-Namespace") != t.namespace {
+			writeErrors(w, http.StatusForbidden, "permission denied")
+			return
+		}
+
+		prefix := "/v1/transit"
+		if len(t.mountPath) > 0 {
+			prefix = "/v1/" + t.mountPath
+		}
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST "+prefix+"/keys/{name}", t.createKey)
+		mux.HandleFunc("GET "+prefix+"/keys/{name}", t.readKey)
+		mux.HandleFunc("DELETE "+prefix+"/keys/{name}", t.deleteKey)
+		mux.HandleFunc("POST "+prefix+"/keys/{name}/config", t.configKey)
+		mux.HandleFunc("POST "+prefix+"/sign/{name}", t.sign)
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (t *fakeTransit) createKey(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Type string `json:"type"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var (
+		key crypto.Signer
+		err error
+	)
+	switch request.Type {
+	case "rsa-3072":
+		key, err = rsa.GenerateKey(rand.Reader, 3072)
+	case "ecdsa-p256":
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "ecdsa-p384":
+		key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case "ed25519":
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		writeErrors(w, http.StatusBadRequest, "unknown key type")
+		return
+	}
+	if err != nil {
+		writeErrors(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.keys[r.PathValue("name")] = &fakeTransitKey{keyType: request.Type, key: key}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (t *fakeTransit) readKey(w http.ResponseWriter, r *http.Request) {
+	key := t.getKey(w, r)
+	if key == nil {
+		return
+	}
+
+	var publicKey string
+	if key.keyType == "ed25519" {
+		publicKey = base64.StdEncoding.EncodeToString(key.key.Public().(ed25519.PublicKey))
+	} else {
+		der, err := x509.MarshalPKIXPublicKey(key.key.Public())
+		if err != nil {
+			writeErrors(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		publicKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	}
+
+	writeData(w, map[string]any{
+		"type":           key.keyType,
+		"latest_version": 1,
+		"keys":           map[string]any{"1": map[string]any{"public_key": publicKey}},
+	})
+}
+
+func (t *fakeTransit) configKey(w http.ResponseWriter, r *http.Request) {
+	key := t.getKey(w, r)
+	if key == nil {
+		return
+	}
+
+	var request struct {
+		DeletionAllowed bool `json:"deletion_allowed"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	key.deletionAllowed = request.DeletionAllowed
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (t *fakeTransit) deleteKey(w http.ResponseWriter, r *http.Request) {
+	key := t.getKey(w, r)
+	if key == nil {
+		return
+	}
+	if !key.deletionAllowed {
+		writeErrors(w, http.StatusBadRequest, "deletion is not allowed for this key")
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.keys, r.PathValue("name"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (t *fakeTransit) sign(w http.ResponseWriter, r *http.Request) {
+	key := t.getKey(w, r)
+	if key == nil {
+		return
+	}
+
+	var request struct {
+		Input              string `json:"input"`
+		Prehashed          bool   `json:"prehashed"`
+		HashAlgorithm      string `json:"hash_algorithm"`
+		SignatureAlgorithm string `json:"signature_algorithm"`
+		SaltLength         string `json:"salt_length"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	input, err := base64.StdEncoding.DecodeString(request.Input)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var opts crypto.SignerOpts = crypto.Hash(0)
+	if key.keyType != "ed25519" {
+		hash, ok := map[string]crypto.Hash{"sha2-256": crypto.SHA256, "sha2-384": crypto.SHA384, "sha2-512": crypto.SHA512}[request.HashAlgorithm]
+		if !request.Prehashed || !ok {
+			writeErrors(w, http.StatusBadRequest, "unsupported hash algorithm")
+			return
+		}
+		opts = hash
+		if request.SignatureAlgorithm == "pss" && request.SaltLength == "hash" {
+			opts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: hash}
+		}
+	}
+
+	signature, err := key.key.Sign(rand.Reader, input, opts)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeData(w, map[string]any{"signature": "vault:v1:" + base64.StdEncoding.EncodeToString(signature)})
+}
+
+func (t *fakeTransit) getKey(w http.ResponseWriter, r *http.Request) *fakeTransitKey {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	key, ok := t.keys[r.PathValue("name")]
+	if !ok {
+		writeErrors(w, http.StatusNotFound)
+		return nil
+	}
+	return key
+}
+
+func writeData(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+func writeErrors(w http.ResponseWriter, statusCode int, errors ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{"errors": append([]string{}, errors...)})
+}
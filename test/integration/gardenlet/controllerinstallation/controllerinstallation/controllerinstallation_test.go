@@ -5,7 +5,13 @@
 package controllerinstallation_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
+	"fmt"
 	"strings"
 	"time"
 
@@ -35,6 +41,7 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/controllerinstallation"
 	gardenletfeatures "github.com/gardener/gardener/pkg/gardenlet/features"
+	ociutils "github.com/gardener/gardener/pkg/utils/oci"
 	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
@@ -203,6 +210,41 @@ var _ = Describe("ControllerInstallation controller tests", func() {
 		})
 	})
 
+	Context("responsible with OCI and failing signature verification", func() {
+		BeforeEach(func() {
+			signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			publicKey, err := x509.MarshalPKIXPublicKey(signingKey.Public())
+			Expect(err).NotTo(HaveOccurred())
+
+			oci := &gardencorev1.OCIRepository{
+				Repository: new("test-unsigned"),
+				Tag:        new("0.1.0"),
+				Verification: &gardencorev1.OCIRepositoryVerification{
+					PublicKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))},
+				},
+			}
+			controllerDeployment.Helm = &gardencorev1.HelmControllerDeployment{
+				OCIRepository: oci,
+			}
+			fakeRegistry.AddError(oci, fmt.Errorf("%w: no signature found", ociutils.ErrVerificationFailed))
+		})
+
+		It("should not deploy the chart and report the verification failure", func() {
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(controllerInstallation), controllerInstallation)).To(Succeed())
+				return controllerInstallation.Status.Conditions
+			}).Should(And(
+				ContainCondition(OfType(gardencorev1beta1.ControllerInstallationValid), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("OCIChartCannotBePulled")),
+				ContainCondition(OfType(gardencorev1beta1.ControllerInstallationInstalled), WithStatus(gardencorev1beta1.ConditionFalse), WithReason("ChartVerificationFailed"), WithMessageSubstrings("no signature found")),
+			))
+
+			Consistently(func() error {
+				return testClient.Get(ctx, client.ObjectKey{Namespace: "garden", Name: controllerInstallation.Name}, &resourcesv1alpha1.ManagedResource{})
+			}).Should(BeNotFoundError())
+		})
+	})
+
 	Context("responsible", func() {
 		BeforeEach(func() {
 			DeferCleanup(test.WithVar(&controllerinstallation.RequeueDurationWhenResourceDeletionStillPresent, 500*time.Millisecond))
@@ -759,6 +801,7 @@ var _ = Describe("ControllerInstallation controller tests", func() {
 					GardenClient:          testClient,
 					GardenConfig:          restConfig,
 					SeedClientSet:         testClientSet,
+					ChartRenderer:         testClientSet.ChartRenderer(),
 					HelmRegistry:          fakeRegistry,
 					Clock:                 clock.RealClock{},
 					Config:                gardenletconfigv1alpha1.GardenletConfiguration{Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{ControllerInstallation: &gardenletconfigv1alpha1.ControllerInstallationControllerConfiguration{ConcurrentSyncs: new(5)}}},
@@ -968,6 +1011,7 @@ var _ = Describe("ControllerInstallation controller tests", func() {
 					GardenClient:          testClient,
 					GardenConfig:          restConfig,
 					SeedClientSet:         testClientSet,
+					ChartRenderer:         testClientSet.ChartRenderer(),
 					HelmRegistry:          fakeRegistry,
 					Clock:                 clock.RealClock{},
 					Config:                gardenletconfigv1alpha1.GardenletConfiguration{Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{ControllerInstallation: &gardenletconfigv1alpha1.ControllerInstallationControllerConfiguration{ConcurrentSyncs: new(5)}}},